@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 	"strconv"
@@ -33,7 +32,7 @@ var activityCreateCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -61,7 +60,7 @@ var activityCreateCommand = &cobra.Command{
 
 		log.Debug("Creating activity", "request", req)
 
-		res, err := c.CreateActivityWithResponse(ctx, req)
+		res, err := c.CreateActivityWithResponse(ctx, &api.CreateActivityParams{Space: getSpace()}, req)
 		if err != nil {
 			return err
 		}
@@ -69,7 +68,7 @@ var activityCreateCommand = &cobra.Command{
 		log.Debug("Created activity", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		log.Debug("Writing activity to stdout")
@@ -84,6 +83,7 @@ var activityCreateCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(activityCreateCommand.PersistentFlags())
+	addSpaceFlags(activityCreateCommand.PersistentFlags())
 
 	activityCreateCommand.PersistentFlags().String(nameKey, "", "Name of the activity")
 	activityCreateCommand.PersistentFlags().String(dateKey, "", "Date of the activity (format: YYYY-MM-DD)")
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	startDateKey = "start-date"
+	endDateKey   = "end-date"
+)
+
+var activityListCommand = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"lis", "ls", "l"},
+	Short:   "List the activities of all contacts",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		// Only the filters whose flags were set are sent
+		params := api.GetActivitiesParams{Space: getSpace()}
+		if viper.IsSet(contactIDKey) {
+			v := viper.GetInt64(contactIDKey)
+
+			params.ContactId = &v
+		}
+
+		if viper.IsSet(startDateKey) {
+			params.StartDate = &types.Date{
+				Time: viper.GetTime(startDateKey),
+			}
+		}
+
+		if viper.IsSet(endDateKey) {
+			params.EndDate = &types.Date{
+				Time: viper.GetTime(endDateKey),
+			}
+		}
+
+		if viper.IsSet(queryKey) {
+			v := viper.GetString(queryKey)
+
+			params.Query = &v
+		}
+
+		log.Debug("Listing activities", "params", params)
+
+		res, err := c.GetActivitiesWithResponse(ctx, &params)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Got activities", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		log.Debug("Writing activities to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(res.JSON200); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addAuthFlags(activityListCommand.PersistentFlags())
+	addSpaceFlags(activityListCommand.PersistentFlags())
+
+	activityListCommand.PersistentFlags().Int64(contactIDKey, 0, "Only list the activities of the contact with this ID (optional)")
+	activityListCommand.PersistentFlags().String(startDateKey, "", "Only list the activities on or after this date (optional, format: YYYY-MM-DD)")
+	activityListCommand.PersistentFlags().String(endDateKey, "", "Only list the activities on or before this date (optional, format: YYYY-MM-DD)")
+	activityListCommand.PersistentFlags().String(queryKey, "", "Only list the activities whose name, description or contact's name contain this text (optional)")
+
+	viper.AutomaticEnv()
+
+	activityCommand.AddCommand(activityListCommand)
+}
@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 	"strconv"
@@ -28,7 +27,7 @@ var activityUpdateCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -38,24 +37,25 @@ var activityUpdateCommand = &cobra.Command{
 			return err
 		}
 
-		var description *string
-		if viper.IsSet(descriptionKey) {
-			v := viper.GetString(descriptionKey)
-
-			description = &v
+		// Only the fields of the flags that were set are sent, so all other fields of the activity are kept
+		req := api.ActivityPatch{}
+		if viper.IsSet(nameKey) {
+			req["name"] = viper.GetString(nameKey)
 		}
 
-		req := api.UpdateActivityJSONRequestBody{
-			Date: types.Date{
+		if viper.IsSet(dateKey) {
+			req["date"] = types.Date{
 				Time: viper.GetTime(dateKey),
-			},
-			Description: description,
-			Name:        viper.GetString(nameKey),
+			}
+		}
+
+		if viper.IsSet(descriptionKey) {
+			req["description"] = viper.GetString(descriptionKey)
 		}
 
 		log.Debug("Updating activity", "id", id, "request", req)
 
-		res, err := c.UpdateActivityWithResponse(ctx, int64(id), req)
+		res, err := c.PatchActivityWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, int64(id), &api.PatchActivityParams{Space: getSpace()}, req)
 		if err != nil {
 			return err
 		}
@@ -63,7 +63,7 @@ var activityUpdateCommand = &cobra.Command{
 		log.Debug("Updated activity", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		log.Debug("Writing activity to stdout")
@@ -78,6 +78,7 @@ var activityUpdateCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(activityUpdateCommand.PersistentFlags())
+	addSpaceFlags(activityUpdateCommand.PersistentFlags())
 
 	activityUpdateCommand.PersistentFlags().String(nameKey, "", "Name of the activity")
 	activityUpdateCommand.PersistentFlags().String(dateKey, "", "Date of the activity (format: YYYY-MM-DD)")
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var batchCommand = &cobra.Command{
+	Use:     "batch",
+	Aliases: []string{"bat", "b"},
+	Short:   "Batch operations",
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	indexCommand.AddCommand(batchCommand)
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	fileKey = "file"
+)
+
+var (
+	errMissingBatchFile  = errors.New("missing batch file, set one with --file")
+	errBatchNotCommitted = errors.New("batch was not committed since one of its operations failed")
+)
+
+var batchApplyCommand = &cobra.Command{
+	Use:     "apply",
+	Aliases: []string{"app", "a"},
+	Short:   "Apply a batch of operations from a YAML file in one transaction",
+	Long: `Apply a batch of operations from a YAML file in one transaction. The file contains a list of operations
+with the same fields as the operations of the POST /batch endpoint, for example:
+
+- entity_type: contact
+  operation: create
+  contact:
+    first_name: Jean
+    last_name: Doe
+    email: jean@example.com
+    pronouns: they/them
+- entity_type: debt
+  operation: create
+  contact_ref: 0
+  debt:
+    amount: 10
+    currency: EUR
+    you_owe: false`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		file := viper.GetString(fileKey)
+		if file == "" {
+			return errMissingBatchFile
+		}
+
+		var r io.Reader = os.Stdin
+		if file != "-" {
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			r = f
+		}
+
+		log.Debug("Reading batch", "file", file)
+
+		var node yaml.Node
+		if err := yaml.NewDecoder(r).Decode(&node); err != nil {
+			return err
+		}
+
+		// Dates are passed to the API as they were written instead of as timestamps
+		keepTimestampsAsStrings(&node)
+
+		// The operations are decoded as JSON so that they use the same field names as the API
+		var rawOperations []any
+		if err := node.Decode(&rawOperations); err != nil {
+			return err
+		}
+
+		operationsJSON, err := json.Marshal(rawOperations)
+		if err != nil {
+			return err
+		}
+
+		req := api.PostBatchJSONRequestBody{}
+		if err := json.Unmarshal(operationsJSON, &req.Operations); err != nil {
+			return err
+		}
+
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Applying batch", "len", len(req.Operations))
+
+		res, err := c.PostBatchWithResponse(ctx, &api.PostBatchParams{Space: getSpace()}, req)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Applied batch", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		log.Debug("Writing batch results to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(res.JSON200); err != nil {
+			return err
+		}
+
+		if !res.JSON200.Committed {
+			return errBatchNotCommitted
+		}
+
+		return nil
+	},
+}
+
+func keepTimestampsAsStrings(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!timestamp" {
+		node.Tag = "!!str"
+	}
+
+	for _, child := range node.Content {
+		keepTimestampsAsStrings(child)
+	}
+}
+
+func init() {
+	addAuthFlags(batchApplyCommand.PersistentFlags())
+	addSpaceFlags(batchApplyCommand.PersistentFlags())
+
+	batchApplyCommand.PersistentFlags().StringP(fileKey, "f", "", "YAML file with the operations to apply (- reads from stdin)")
+
+	viper.AutomaticEnv()
+
+	batchCommand.AddCommand(batchApplyCommand)
+}
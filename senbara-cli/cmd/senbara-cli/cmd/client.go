@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	errMissingOIDCConfiguration = errors.New("server did not provide an OIDC configuration")
+	errNotRegistered            = errors.New("no OIDC client registration, register with `client register` or log in with `login`")
+)
+
+const (
+	oidcClientName = "Senbara CLI"
+)
+
+// openAPISpec is the subset of the OpenAPI spec required to discover the OIDC issuer
+type openAPISpec struct {
+	Components struct {
+		SecuritySchemes struct {
+			OIDC struct {
+				OpenIDConnectURL string `json:"openIdConnectUrl"`
+				Audience         string `json:"x-oidc-audience"`
+			} `json:"oidc"`
+		} `json:"securitySchemes"`
+	} `json:"components"`
+}
+
+func getRedirectURL() string {
+	return "http://" + viper.GetString(callbackLaddrKey) + "/authorize"
+}
+
+// discoverOIDCProvider discovers the OIDC provider configuration of the OIDC issuer used by the Senbara server and
+// returns the audience to request access tokens for, which is empty if the server accepts ID tokens instead
+func discoverOIDCProvider(ctx context.Context) (*authn.OIDCProviderConfiguration, string, error) {
+	c, err := createClient(ctx, false)
+	if err != nil {
+		return nil, "", err
+	}
+
+	log.Debug("Getting OpenAPI spec")
+
+	res, err := c.GetOpenAPISpec(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer res.Body.Close()
+
+	log.Debug("Received OpenAPI spec", "status", res.StatusCode)
+
+	if res.StatusCode != http.StatusOK {
+		return nil, "", readResponseError(res)
+	}
+
+	var spec openAPISpec
+	if err := json.NewDecoder(res.Body).Decode(&spec); err != nil {
+		return nil, "", err
+	}
+
+	if spec.Components.SecuritySchemes.OIDC.OpenIDConnectURL == "" {
+		return nil, "", errMissingOIDCConfiguration
+	}
+
+	o, err := authn.DiscoverOIDCProviderConfiguration(
+		ctx,
+
+		slog.New(log.Handler().WithGroup("oidcDiscovery")),
+
+		spec.Components.SecuritySchemes.OIDC.OpenIDConnectURL,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return o, spec.Components.SecuritySchemes.OIDC.Audience, nil
+}
+
+// getClientRegistration returns the OIDC client registration of a session or nil if the client wasn't registered by the CLI
+func getClientRegistration(s *session) *authn.OIDCClientRegistrationResponse {
+	if s == nil || s.RegistrationClientURI == "" {
+		return nil
+	}
+
+	return &authn.OIDCClientRegistrationResponse{
+		ClientID:                s.ClientID,
+		RegistrationAccessToken: s.RegistrationAccessToken,
+		RegistrationClientURI:   s.RegistrationClientURI,
+	}
+}
+
+// setClientRegistration updates the OIDC client registration of a session and drops
+// its tokens if they were issued to a different client
+func setClientRegistration(s *session, r *authn.OIDCClientRegistrationResponse, redirectURL string) {
+	if s.ClientID != r.ClientID {
+		s.RefreshToken, s.RefreshTokenExpiry = "", time.Time{}
+		s.IDToken, s.IDTokenExpiry = "", time.Time{}
+		s.AccessToken, s.AccessTokenExpiry = "", time.Time{}
+	}
+
+	s.ClientID = r.ClientID
+	s.RedirectURL = redirectURL
+	s.RegistrationClientURI = r.RegistrationClientURI
+	s.RegistrationAccessToken = r.RegistrationAccessToken
+}
+
+// ensureClientRegistration registers the CLI with the OIDC provider or updates its registration if it is outdated, and
+// registers it again if the OIDC provider has revoked the registration
+func ensureClientRegistration(
+	ctx context.Context,
+
+	raddr string,
+	o *authn.OIDCProviderConfiguration,
+	s *session,
+
+	redirectURL,
+	initialAccessToken string,
+) (*session, error) {
+	// Sessions for a different issuer can't be re-used
+	if s == nil || s.Issuer != o.Issuer {
+		s = &session{
+			Issuer:             o.Issuer,
+			EndSessionEndpoint: o.EndSessionEndpoint,
+		}
+	}
+
+	log.Debug("Ensuring OIDC client registration")
+
+	r, changed, err := authn.EnsureOIDCClient(
+		ctx,
+
+		slog.New(log.Handler().WithGroup("oidcRegistration")),
+
+		o,
+		getClientRegistration(s),
+
+		oidcClientName,
+		redirectURL,
+
+		initialAccessToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !changed && s.RedirectURL == redirectURL {
+		return s, nil
+	}
+
+	setClientRegistration(s, r, redirectURL)
+
+	if err := saveSession(raddr, s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// writeClientRegistration writes the public parts of an OIDC client registration to stdout
+func writeClientRegistration(r *authn.OIDCClientRegistrationResponse) error {
+	log.Debug("Writing client registration to stdout")
+
+	return yaml.NewEncoder(os.Stdout).Encode(map[string]any{
+		"client_id":               r.ClientID,
+		"client_name":             r.ClientName,
+		"redirect_uris":           r.RedirectURIs,
+		"registration_client_uri": r.RegistrationClientURI,
+	})
+}
+
+var clientCommand = &cobra.Command{
+	Use:     "client",
+	Aliases: []string{"cli", "cl"},
+	Short:   "OIDC client registration operations",
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	indexCommand.AddCommand(clientCommand)
+}
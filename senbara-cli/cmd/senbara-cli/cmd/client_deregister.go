@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var clientDeregisterCommand = &cobra.Command{
+	Use:     "deregister",
+	Aliases: []string{"der", "d"},
+	Short:   "Deregister the CLI from the OIDC provider and delete the stored session",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		raddr := viper.GetString(raddrKey)
+
+		s, err := loadSession(raddr)
+		if err != nil {
+			return err
+		}
+
+		registration := getClientRegistration(s)
+		if registration == nil {
+			return errNotRegistered
+		}
+
+		log.Debug("Deregistering client")
+
+		// If the registration has already been revoked upstream, there is nothing left to deregister
+		if err := authn.DeregisterOIDCClient(
+			ctx,
+
+			slog.New(log.Handler().WithGroup("oidcDeregistration")),
+
+			registration.RegistrationAccessToken,
+			registration.RegistrationClientURI,
+		); err != nil && !errors.Is(err, authn.ErrOIDCClientRegistrationInvalid) {
+			return err
+		}
+
+		log.Debug("Deleting session")
+
+		return deleteSession(raddr)
+	},
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	clientCommand.AddCommand(clientDeregisterCommand)
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var clientRegisterCommand = &cobra.Command{
+	Use:     "register",
+	Aliases: []string{"reg", "r"},
+	Short:   "Register the CLI with the OIDC provider (or update or renew an existing registration)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		raddr := viper.GetString(raddrKey)
+
+		o, _, err := discoverOIDCProvider(ctx)
+		if err != nil {
+			return err
+		}
+
+		s, err := loadSession(raddr)
+		if err != nil {
+			return err
+		}
+
+		s, err = ensureClientRegistration(ctx, raddr, o, s, getRedirectURL(), viper.GetString(initialAccessTokenKey))
+		if err != nil {
+			return err
+		}
+
+		return writeClientRegistration(&authn.OIDCClientRegistrationResponse{
+			ClientID:              s.ClientID,
+			ClientName:            oidcClientName,
+			RedirectURIs:          []string{s.RedirectURL},
+			RegistrationClientURI: s.RegistrationClientURI,
+		})
+	},
+}
+
+func init() {
+	clientRegisterCommand.PersistentFlags().String(callbackLaddrKey, "localhost:1338", "Listen address for the loopback OIDC redirect")
+	clientRegisterCommand.PersistentFlags().String(initialAccessTokenKey, "", "Initial access token to use for OIDC dynamic client registration (if required by the OIDC provider)")
+
+	viper.AutomaticEnv()
+
+	clientCommand.AddCommand(clientRegisterCommand)
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var clientShowCommand = &cobra.Command{
+	Use:     "show",
+	Aliases: []string{"sho", "s"},
+	Short:   "Show the OIDC client registration of the CLI",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		raddr := viper.GetString(raddrKey)
+
+		s, err := loadSession(raddr)
+		if err != nil {
+			return err
+		}
+
+		registration := getClientRegistration(s)
+		if registration == nil {
+			return errNotRegistered
+		}
+
+		log.Debug("Getting client registration")
+
+		r, err := authn.GetOIDCClient(
+			ctx,
+
+			slog.New(log.Handler().WithGroup("oidcRegistration")),
+
+			registration.RegistrationAccessToken,
+			registration.RegistrationClientURI,
+		)
+		if err != nil {
+			return err
+		}
+
+		// The OIDC provider might have rotated the registration access token
+		if r.RegistrationAccessToken != s.RegistrationAccessToken || r.RegistrationClientURI != s.RegistrationClientURI {
+			setClientRegistration(s, r, s.RedirectURL)
+
+			if err := saveSession(raddr, s); err != nil {
+				return err
+			}
+		}
+
+		return writeClientRegistration(r)
+	},
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	clientCommand.AddCommand(clientShowCommand)
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	clientNameKey = "client-name"
+)
+
+var clientUpdateCommand = &cobra.Command{
+	Use:     "update",
+	Aliases: []string{"upd", "u"},
+	Short:   "Update the OIDC client registration of the CLI",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		raddr := viper.GetString(raddrKey)
+
+		s, err := loadSession(raddr)
+		if err != nil {
+			return err
+		}
+
+		registration := getClientRegistration(s)
+		if registration == nil {
+			return errNotRegistered
+		}
+
+		redirectURL := getRedirectURL()
+
+		log.Debug("Updating client registration")
+
+		r, err := authn.UpdateOIDCClient(
+			ctx,
+
+			slog.New(log.Handler().WithGroup("oidcRegistration")),
+
+			registration.RegistrationAccessToken,
+			registration.RegistrationClientURI,
+
+			registration.ClientID,
+			viper.GetString(clientNameKey),
+			redirectURL,
+		)
+		if err != nil {
+			return err
+		}
+
+		setClientRegistration(s, r, redirectURL)
+
+		if err := saveSession(raddr, s); err != nil {
+			return err
+		}
+
+		return writeClientRegistration(r)
+	},
+}
+
+func init() {
+	clientUpdateCommand.PersistentFlags().String(callbackLaddrKey, "localhost:1338", "Listen address for the loopback OIDC redirect")
+	clientUpdateCommand.PersistentFlags().String(clientNameKey, oidcClientName, "Client name to register with the OIDC provider")
+
+	viper.AutomaticEnv()
+
+	clientCommand.AddCommand(clientUpdateCommand)
+}
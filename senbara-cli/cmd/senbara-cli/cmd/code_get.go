@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"io"
 	"net/http"
 	"os"
@@ -23,7 +22,7 @@ var codeGetCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(false)
+		c, err := createClient(ctx, false)
 		if err != nil {
 			return err
 		}
@@ -39,7 +38,7 @@ var codeGetCommand = &cobra.Command{
 		log.Debug("Received code", "status", res.StatusCode)
 
 		if res.StatusCode != http.StatusOK {
-			return errors.New(res.Status)
+			return readResponseError(res)
 		}
 
 		log.Debug("Writing code to stdout")
@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 
@@ -34,7 +33,7 @@ var contactCreateCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -56,7 +55,7 @@ var contactCreateCommand = &cobra.Command{
 
 		log.Debug("Creating contact", "request", req)
 
-		res, err := c.CreateContactWithResponse(ctx, req)
+		res, err := c.CreateContactWithResponse(ctx, &api.CreateContactParams{Space: getSpace()}, req)
 		if err != nil {
 			return err
 		}
@@ -64,7 +63,7 @@ var contactCreateCommand = &cobra.Command{
 		log.Debug("Created contact", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		log.Debug("Writing contact to stdout")
@@ -79,6 +78,7 @@ var contactCreateCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(contactCreateCommand.PersistentFlags())
+	addSpaceFlags(contactCreateCommand.PersistentFlags())
 
 	contactCreateCommand.PersistentFlags().String(emailKey, "", "Email address for the contact")
 	contactCreateCommand.PersistentFlags().String(firstNameKey, "", "First name for the contact")
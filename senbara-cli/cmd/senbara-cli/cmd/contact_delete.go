@@ -2,11 +2,11 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 	"strconv"
 
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -26,7 +26,7 @@ var contactDeleteCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -38,7 +38,7 @@ var contactDeleteCommand = &cobra.Command{
 
 		log.Debug("Deleting contact", "id", id)
 
-		res, err := c.DeleteContactWithResponse(ctx, int64(id))
+		res, err := c.DeleteContactWithResponse(ctx, int64(id), &api.DeleteContactParams{Space: getSpace()})
 		if err != nil {
 			return err
 		}
@@ -46,7 +46,7 @@ var contactDeleteCommand = &cobra.Command{
 		log.Debug("Deleted contact", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		log.Debug("Writing contact to stdout")
@@ -61,6 +61,7 @@ var contactDeleteCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(contactDeleteCommand.PersistentFlags())
+	addSpaceFlags(contactDeleteCommand.PersistentFlags())
 
 	contactDeleteCommand.PersistentFlags().Int64(idKey, 0, "ID of the contact")
 
@@ -2,10 +2,10 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -24,14 +24,14 @@ var contactListCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
 
 		log.Debug("Listing contacts")
 
-		res, err := c.GetContactsWithResponse(ctx)
+		res, err := c.GetContactsWithResponse(ctx, &api.GetContactsParams{Space: getSpace()})
 		if err != nil {
 			return err
 		}
@@ -39,7 +39,7 @@ var contactListCommand = &cobra.Command{
 		log.Debug("Got contacts", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		log.Debug("Writing contacts to stdout")
@@ -54,6 +54,7 @@ var contactListCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(contactListCommand.PersistentFlags())
+	addSpaceFlags(contactListCommand.PersistentFlags())
 
 	viper.AutomaticEnv()
 
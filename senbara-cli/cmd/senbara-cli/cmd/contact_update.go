@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 	"strconv"
@@ -34,7 +33,7 @@ var contactUpdateCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -44,48 +43,36 @@ var contactUpdateCommand = &cobra.Command{
 			return err
 		}
 
-		var nickname *string
-		if viper.IsSet(nicknameKey) {
-			v := viper.GetString(nicknameKey)
-
-			nickname = &v
+		// Only the fields of the flags that were set are sent, so all other fields of the contact are kept
+		req := api.ContactPatch{}
+		for key, field := range map[string]string{
+			firstNameKey: "first_name",
+			lastNameKey:  "last_name",
+			nicknameKey:  "nickname",
+			emailKey:     "email",
+			pronounsKey:  "pronouns",
+			addressKey:   "address",
+			notesKey:     "notes",
+		} {
+			if viper.IsSet(key) {
+				req[field] = viper.GetString(key)
+			}
 		}
 
-		var birthday *types.Date
 		if viper.IsSet(birthdayKey) {
-			birthday = &types.Date{
-				Time: viper.GetTime(birthdayKey),
+			// An empty birthday removes the contact's birthday
+			if viper.GetString(birthdayKey) == "" {
+				req["birthday"] = nil
+			} else {
+				req["birthday"] = types.Date{
+					Time: viper.GetTime(birthdayKey),
+				}
 			}
 		}
 
-		var address *string
-		if viper.IsSet(addressKey) {
-			v := viper.GetString(addressKey)
-
-			address = &v
-		}
-
-		var notes *string
-		if viper.IsSet(notesKey) {
-			v := viper.GetString(notesKey)
-
-			notes = &v
-		}
-
-		req := api.UpdateContactJSONRequestBody{
-			Address:   address,
-			Birthday:  birthday,
-			Email:     (types.Email)(viper.GetString(emailKey)),
-			FirstName: viper.GetString(firstNameKey),
-			LastName:  viper.GetString(lastNameKey),
-			Nickname:  nickname,
-			Notes:     notes,
-			Pronouns:  viper.GetString(pronounsKey),
-		}
-
 		log.Debug("Updating contact", "id", id, "request", req)
 
-		res, err := c.UpdateContactWithResponse(ctx, int64(id), req)
+		res, err := c.PatchContactWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, int64(id), &api.PatchContactParams{Space: getSpace()}, req)
 		if err != nil {
 			return err
 		}
@@ -93,7 +80,7 @@ var contactUpdateCommand = &cobra.Command{
 		log.Debug("Updated contact", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		log.Debug("Writing contact to stdout")
@@ -108,9 +95,10 @@ var contactUpdateCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(contactUpdateCommand.PersistentFlags())
+	addSpaceFlags(contactUpdateCommand.PersistentFlags())
 
 	contactUpdateCommand.PersistentFlags().String(addressKey, "", "Address for the contact (optional)")
-	contactUpdateCommand.PersistentFlags().String(birthdayKey, "", "Birthday for the contact (optional, format: YYYY-MM-DD)")
+	contactUpdateCommand.PersistentFlags().String(birthdayKey, "", "Birthday for the contact (optional, format: YYYY-MM-DD, empty to remove the birthday)")
 	contactUpdateCommand.PersistentFlags().String(emailKey, "", "Email address for the contact")
 	contactUpdateCommand.PersistentFlags().String(firstNameKey, "", "First name for the contact")
 	contactUpdateCommand.PersistentFlags().String(lastNameKey, "", "Last name for the contact")
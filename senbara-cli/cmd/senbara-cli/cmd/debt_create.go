@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 	"strconv"
@@ -34,7 +33,7 @@ var debtCreateCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -61,7 +60,7 @@ var debtCreateCommand = &cobra.Command{
 
 		log.Debug("Creating debt", "request", req)
 
-		res, err := c.CreateDebtWithResponse(ctx, req)
+		res, err := c.CreateDebtWithResponse(ctx, &api.CreateDebtParams{Space: getSpace()}, req)
 		if err != nil {
 			return err
 		}
@@ -69,7 +68,7 @@ var debtCreateCommand = &cobra.Command{
 		log.Debug("Created debt", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		log.Debug("Writing debt to stdout")
@@ -84,6 +83,7 @@ var debtCreateCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(debtCreateCommand.PersistentFlags())
+	addSpaceFlags(debtCreateCommand.PersistentFlags())
 
 	debtCreateCommand.PersistentFlags().Float64(amountKey, 0.0, "Amount of the debt")
 	debtCreateCommand.PersistentFlags().String(currencyKey, "", "Currency for the debt")
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	contactIDKey = "contact-id"
+	queryKey     = "query"
+)
+
+var debtListCommand = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"lis", "ls", "l"},
+	Short:   "List the debts of all contacts",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		// Only the filters whose flags were set are sent
+		params := api.GetDebtsParams{Space: getSpace()}
+		if viper.IsSet(contactIDKey) {
+			v := viper.GetInt64(contactIDKey)
+
+			params.ContactId = &v
+		}
+
+		if viper.IsSet(currencyKey) {
+			v := viper.GetString(currencyKey)
+
+			params.Currency = &v
+		}
+
+		if viper.IsSet(youOweKey) {
+			v := viper.GetBool(youOweKey)
+
+			params.YouOwe = &v
+		}
+
+		if viper.IsSet(startDateKey) {
+			params.StartDate = &types.Date{
+				Time: viper.GetTime(startDateKey),
+			}
+		}
+
+		if viper.IsSet(endDateKey) {
+			params.EndDate = &types.Date{
+				Time: viper.GetTime(endDateKey),
+			}
+		}
+
+		if viper.IsSet(queryKey) {
+			v := viper.GetString(queryKey)
+
+			params.Query = &v
+		}
+
+		log.Debug("Listing debts", "params", params)
+
+		res, err := c.GetDebtsWithResponse(ctx, &params)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Got debts", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		log.Debug("Writing debts to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(res.JSON200); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addAuthFlags(debtListCommand.PersistentFlags())
+	addSpaceFlags(debtListCommand.PersistentFlags())
+
+	debtListCommand.PersistentFlags().Int64(contactIDKey, 0, "Only list the debts of the contact with this ID (optional)")
+	debtListCommand.PersistentFlags().String(currencyKey, "", "Only list the debts in this currency (optional)")
+	debtListCommand.PersistentFlags().Bool(youOweKey, false, "Only list the debts you owe, or with --you-owe=false the debts that are owed to you (optional)")
+	debtListCommand.PersistentFlags().String(startDateKey, "", "Only list the debts created on or after this date (optional, format: YYYY-MM-DD)")
+	debtListCommand.PersistentFlags().String(endDateKey, "", "Only list the debts created on or before this date (optional, format: YYYY-MM-DD)")
+	debtListCommand.PersistentFlags().String(queryKey, "", "Only list the debts whose description or contact's name contain this text (optional)")
+
+	viper.AutomaticEnv()
+
+	debtCommand.AddCommand(debtListCommand)
+}
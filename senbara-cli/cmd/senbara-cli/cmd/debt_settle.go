@@ -2,11 +2,11 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 	"strconv"
 
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -26,7 +26,7 @@ var debtSettleCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -38,7 +38,7 @@ var debtSettleCommand = &cobra.Command{
 
 		log.Debug("Settling debt", "id", id)
 
-		res, err := c.SettleDebtWithResponse(ctx, int64(id))
+		res, err := c.SettleDebtWithResponse(ctx, int64(id), &api.SettleDebtParams{Space: getSpace()})
 		if err != nil {
 			return err
 		}
@@ -46,7 +46,7 @@ var debtSettleCommand = &cobra.Command{
 		log.Debug("Settled debt", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		log.Debug("Writing debt to stdout")
@@ -61,6 +61,7 @@ var debtSettleCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(debtSettleCommand.PersistentFlags())
+	addSpaceFlags(debtSettleCommand.PersistentFlags())
 
 	debtSettleCommand.PersistentFlags().Int64(idKey, 0, "ID of the debt")
 
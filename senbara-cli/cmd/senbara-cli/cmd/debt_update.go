@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 	"strconv"
@@ -27,7 +26,7 @@ var debtUpdateCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -37,23 +36,27 @@ var debtUpdateCommand = &cobra.Command{
 			return err
 		}
 
-		var description *string
-		if viper.IsSet(descriptionKey) {
-			v := viper.GetString(descriptionKey)
+		// Only the fields of the flags that were set are sent, so all other fields of the debt are kept
+		req := api.DebtPatch{}
+		if viper.IsSet(amountKey) {
+			req["amount"] = float32(viper.GetFloat64(amountKey))
+		}
 
-			description = &v
+		if viper.IsSet(currencyKey) {
+			req["currency"] = viper.GetString(currencyKey)
+		}
+
+		if viper.IsSet(descriptionKey) {
+			req["description"] = viper.GetString(descriptionKey)
 		}
 
-		req := api.UpdateDebtJSONRequestBody{
-			Amount:      float32(viper.GetFloat64(amountKey)),
-			Currency:    viper.GetString(currencyKey),
-			Description: description,
-			YouOwe:      viper.GetBool(youOweKey),
+		if viper.IsSet(youOweKey) {
+			req["you_owe"] = viper.GetBool(youOweKey)
 		}
 
 		log.Debug("Updating debt", "id", id, "request", req)
 
-		res, err := c.UpdateDebtWithResponse(ctx, int64(id), req)
+		res, err := c.PatchDebtWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, int64(id), &api.PatchDebtParams{Space: getSpace()}, req)
 		if err != nil {
 			return err
 		}
@@ -61,7 +64,7 @@ var debtUpdateCommand = &cobra.Command{
 		log.Debug("Updated debt", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		log.Debug("Writing debt to stdout")
@@ -76,6 +79,7 @@ var debtUpdateCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(debtUpdateCommand.PersistentFlags())
+	addSpaceFlags(debtUpdateCommand.PersistentFlags())
 
 	debtUpdateCommand.PersistentFlags().Float64(amountKey, 0.0, "Amount of the debt")
 	debtUpdateCommand.PersistentFlags().String(currencyKey, "", "Currency for the debt")
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/encryption"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/zalando/go-keyring"
+)
+
+var (
+	errMissingPassphrase = errors.New("missing passphrase, set one with --passphrase or store one in the keyring with `journal passphrase set`")
+)
+
+const (
+	passphraseKey = "passphrase"
+	encryptKey    = "encrypt"
+
+	keyringService        = "senbara-cli"
+	keyringPassphraseUser = "journal-passphrase"
+	keyringSaltUser       = "journal-salt"
+)
+
+func addEncryptionFlags(f *pflag.FlagSet) {
+	f.String(passphraseKey, "", "Passphrase to derive the journal encryption key from (by default the passphrase stored in the keyring is used)")
+}
+
+// createEncrypter returns nil if no passphrase was provided and none is stored in the keyring. Since new values are
+// sealed with the salt that is stored in the keyring, a salt is only stored if `seal` is set.
+func createEncrypter(seal bool) (*encryption.Encrypter, error) {
+	passphrase := viper.GetString(passphraseKey)
+	if passphrase == "" {
+		log.Debug("Passphrase not set, reading from keyring")
+
+		p, err := keyring.Get(keyringService, keyringPassphraseUser)
+		if err != nil {
+			log.Debug("Could not read passphrase from keyring, continuing without encryption", "err", err)
+
+			return nil, nil
+		}
+
+		passphrase = p
+	}
+
+	salt, err := getSalt(seal)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryption.NewEncrypter(passphrase, salt)
+}
+
+// getSalt returns the salt that is stored in the keyring. If none is stored, a new salt is generated,
+// which is only stored in the keyring if `store` is set.
+func getSalt(store bool) ([]byte, error) {
+	rawSalt, err := keyring.Get(keyringService, keyringSaltUser)
+	if err == nil {
+		return base64.StdEncoding.DecodeString(rawSalt)
+	}
+
+	if !errors.Is(err, keyring.ErrNotFound) {
+		log.Debug("Could not read salt from keyring, continuing with new salt", "err", err)
+	}
+
+	salt, err := encryption.NewSalt()
+	if err != nil {
+		return nil, err
+	}
+
+	if !store {
+		return salt, nil
+	}
+
+	if err := keyring.Set(keyringService, keyringSaltUser, base64.StdEncoding.EncodeToString(salt)); err != nil {
+		log.Debug("Could not store salt in keyring, continuing with new salt", "err", err)
+	}
+
+	return salt, nil
+}
+
+func sealJournalEntry(e *encryption.Encrypter, title, body string) (encryptedTitle, encryptedBody string, envelope *api.EncryptionEnvelope, err error) {
+	env := e.Envelope()
+
+	encryptedTitle, err = e.Seal(env, title)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	encryptedBody, err = e.Seal(env, body)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return encryptedTitle, encryptedBody, &api.EncryptionEnvelope{
+		Algorithm: api.EncryptionEnvelopeAlgorithm(env.Algorithm),
+		Kdf:       api.EncryptionEnvelopeKdf(env.KDF),
+		Salt:      env.Salt,
+	}, nil
+}
+
+// openJournalEntry decrypts the title and body of the journal entry in place
+func openJournalEntry(e *encryption.Encrypter, journalEntry *api.JournalEntry) error {
+	if journalEntry.Encrypted == nil || !*journalEntry.Encrypted || journalEntry.Encryption == nil {
+		return nil
+	}
+
+	if e == nil {
+		log.Debug("Journal entry is encrypted but no passphrase is available, skipping decryption", "id", journalEntry.Id)
+
+		return nil
+	}
+
+	env := encryption.Envelope{
+		Algorithm: string(journalEntry.Encryption.Algorithm),
+		KDF:       string(journalEntry.Encryption.Kdf),
+		Salt:      journalEntry.Encryption.Salt,
+	}
+
+	if journalEntry.Title != nil {
+		title, err := e.Open(env, *journalEntry.Title)
+		if err != nil {
+			return err
+		}
+
+		journalEntry.Title = &title
+	}
+
+	if journalEntry.Body != nil {
+		body, err := e.Open(env, *journalEntry.Body)
+		if err != nil {
+			return err
+		}
+
+		journalEntry.Body = &body
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"log/slog"
 	"os"
@@ -15,15 +16,12 @@ import (
 	"github.com/spf13/viper"
 )
 
-var (
-	errMissingToken = errors.New("missing token")
-)
-
 const (
 	verboseKey = "verbose"
 	configKey  = "config"
 	raddrKey   = "laddr"
 	tokenKey   = "token"
+	spaceKey   = "space"
 )
 
 var (
@@ -84,21 +82,41 @@ func Execute() error {
 }
 
 func addAuthFlags(f *pflag.FlagSet) {
-	f.String(tokenKey, "", "Bearer token to authenticate with")
+	f.String(tokenKey, "", "Bearer token (OIDC ID token or personal access token) to authenticate with (by default the session from `login` is used)")
+}
+
+func addSpaceFlags(f *pflag.FlagSet) {
+	f.Int64(spaceKey, 0, "ID of the space to use (by default the personal space is used)")
+}
+
+func getSpace() *api.SpaceSelector {
+	if !viper.IsSet(spaceKey) || viper.GetInt64(spaceKey) == 0 {
+		return nil
+	}
+
+	space := viper.GetInt64(spaceKey)
+
+	return &space
 }
 
-func createClient(auth bool) (*api.ClientWithResponses, error) {
+func createClient(ctx context.Context, auth bool) (*api.ClientWithResponses, error) {
 	opts := []api.ClientOption{}
 	if auth {
 		log.Debug("Creating authenticated client")
 
+		token := viper.GetString(tokenKey)
 		if !viper.IsSet(tokenKey) {
-			log.Debug("Missing token")
+			log.Debug("Token not set, using session")
+
+			idToken, _, err := authorizeSession(ctx)
+			if err != nil {
+				return nil, err
+			}
 
-			return nil, errMissingToken
+			token = idToken
 		}
 
-		a, err := securityprovider.NewSecurityProviderBearerToken(viper.GetString(tokenKey))
+		a, err := securityprovider.NewSecurityProviderBearerToken(token)
 		if err != nil {
 			log.Debug("Could not set up security provider for token")
 
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var invitationCommand = &cobra.Command{
+	Use:     "invitation",
+	Aliases: []string{"inv", "i"},
+	Short:   "Space invitation operations",
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	indexCommand.AddCommand(invitationCommand)
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+var invitationAcceptCommand = &cobra.Command{
+	Use:     "accept <id>",
+	Aliases: []string{"acc", "a"},
+	Short:   "Accept a space invitation",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Accepting space invitation", "id", id)
+
+		res, err := c.AcceptSpaceInvitationWithResponse(ctx, int64(id))
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Accepted space invitation", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		log.Debug("Writing space to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(res.JSON200); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addAuthFlags(invitationAcceptCommand.PersistentFlags())
+
+	viper.AutomaticEnv()
+
+	invitationCommand.AddCommand(invitationAcceptCommand)
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+var invitationDeclineCommand = &cobra.Command{
+	Use:     "decline <id>",
+	Aliases: []string{"dec", "d"},
+	Short:   "Decline a space invitation",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Declining space invitation", "id", id)
+
+		res, err := c.DeclineSpaceInvitationWithResponse(ctx, int64(id))
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Declined space invitation", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		log.Debug("Writing space invitation ID to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(res.JSON200); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addAuthFlags(invitationDeclineCommand.PersistentFlags())
+
+	viper.AutomaticEnv()
+
+	invitationCommand.AddCommand(invitationDeclineCommand)
+}
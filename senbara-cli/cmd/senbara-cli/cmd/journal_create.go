@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 
@@ -31,7 +30,7 @@ var journalCreateCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -42,9 +41,27 @@ var journalCreateCommand = &cobra.Command{
 			Title:  viper.GetString(titleKey),
 		}
 
+		e, err := createEncrypter(viper.GetBool(encryptKey))
+		if err != nil {
+			return err
+		}
+
+		if viper.GetBool(encryptKey) {
+			if e == nil {
+				return errMissingPassphrase
+			}
+
+			log.Debug("Encrypting journal entry")
+
+			req.Title, req.Body, req.Encryption, err = sealJournalEntry(e, req.Title, req.Body)
+			if err != nil {
+				return err
+			}
+		}
+
 		log.Debug("Creating journal entry", "request", req)
 
-		res, err := c.CreateJournalEntryWithResponse(ctx, req)
+		res, err := c.CreateJournalEntryWithResponse(ctx, &api.CreateJournalEntryParams{Space: getSpace()}, req)
 		if err != nil {
 			return err
 		}
@@ -52,7 +69,11 @@ var journalCreateCommand = &cobra.Command{
 		log.Debug("Created journal entry", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		if err := openJournalEntry(e, res.JSON200); err != nil {
+			return err
 		}
 
 		log.Debug("Writing journal entry to stdout")
@@ -67,10 +88,13 @@ var journalCreateCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(journalCreateCommand.PersistentFlags())
+	addSpaceFlags(journalCreateCommand.PersistentFlags())
+	addEncryptionFlags(journalCreateCommand.PersistentFlags())
 
 	journalCreateCommand.PersistentFlags().String(titleKey, "", "Title for the journal entry")
 	journalCreateCommand.PersistentFlags().String(bodyKey, "", "Body for the journal entry")
 	journalCreateCommand.PersistentFlags().Int32(ratingKey, 0, "Rating for the journal entry (between 1 and 3)")
+	journalCreateCommand.PersistentFlags().Bool(encryptKey, false, "Whether to end-to-end encrypt the title and body of the journal entry")
 
 	viper.AutomaticEnv()
 
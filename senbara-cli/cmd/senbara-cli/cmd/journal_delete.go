@@ -2,11 +2,11 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 	"strconv"
 
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -26,7 +26,7 @@ var journalDeleteCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -38,7 +38,7 @@ var journalDeleteCommand = &cobra.Command{
 
 		log.Debug("Deleting journal entry", "id", id)
 
-		res, err := c.DeleteJournalEntryWithResponse(ctx, int64(id))
+		res, err := c.DeleteJournalEntryWithResponse(ctx, int64(id), &api.DeleteJournalEntryParams{Space: getSpace()})
 		if err != nil {
 			return err
 		}
@@ -46,7 +46,7 @@ var journalDeleteCommand = &cobra.Command{
 		log.Debug("Deleted journal entry", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		log.Debug("Writing journal entry to stdout")
@@ -61,6 +61,7 @@ var journalDeleteCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(journalDeleteCommand.PersistentFlags())
+	addSpaceFlags(journalDeleteCommand.PersistentFlags())
 
 	journalDeleteCommand.PersistentFlags().Int64(idKey, 0, "ID of the journal entry")
 
@@ -2,11 +2,11 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 	"strconv"
 
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -30,7 +30,7 @@ var journalGetCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -40,9 +40,14 @@ var journalGetCommand = &cobra.Command{
 			return err
 		}
 
+		e, err := createEncrypter(false)
+		if err != nil {
+			return err
+		}
+
 		log.Debug("Getting journal entry", "id", id)
 
-		res, err := c.GetJournalEntryWithResponse(ctx, int64(id))
+		res, err := c.GetJournalEntryWithResponse(ctx, int64(id), &api.GetJournalEntryParams{Space: getSpace()})
 		if err != nil {
 			return err
 		}
@@ -50,7 +55,11 @@ var journalGetCommand = &cobra.Command{
 		log.Debug("Got journal entry", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		if err := openJournalEntry(e, res.JSON200); err != nil {
+			return err
 		}
 
 		log.Debug("Writing journal entry to stdout")
@@ -65,6 +74,8 @@ var journalGetCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(journalGetCommand.PersistentFlags())
+	addSpaceFlags(journalGetCommand.PersistentFlags())
+	addEncryptionFlags(journalGetCommand.PersistentFlags())
 
 	journalGetCommand.PersistentFlags().Int64(idKey, 0, "ID of the journal entry")
 
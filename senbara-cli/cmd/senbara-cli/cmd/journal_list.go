@@ -2,10 +2,10 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -24,14 +24,19 @@ var journalListCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		e, err := createEncrypter(false)
 		if err != nil {
 			return err
 		}
 
 		log.Debug("Listing journal entries")
 
-		res, err := c.GetJournalEntriesWithResponse(ctx)
+		res, err := c.GetJournalEntriesWithResponse(ctx, &api.GetJournalEntriesParams{Space: getSpace()})
 		if err != nil {
 			return err
 		}
@@ -39,7 +44,15 @@ var journalListCommand = &cobra.Command{
 		log.Debug("Got journal entries", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		if res.JSON200 != nil {
+			for i := range *res.JSON200 {
+				if err := openJournalEntry(e, &(*res.JSON200)[i]); err != nil {
+					return err
+				}
+			}
 		}
 
 		log.Debug("Writing journal entries to stdout")
@@ -54,6 +67,8 @@ var journalListCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(journalListCommand.PersistentFlags())
+	addSpaceFlags(journalListCommand.PersistentFlags())
+	addEncryptionFlags(journalListCommand.PersistentFlags())
 
 	viper.AutomaticEnv()
 
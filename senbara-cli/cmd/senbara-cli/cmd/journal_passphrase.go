@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var journalPassphraseCommand = &cobra.Command{
+	Use:     "passphrase",
+	Aliases: []string{"pas", "p"},
+	Short:   "Journal encryption passphrase operations",
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	journalCommand.AddCommand(journalPassphraseCommand)
+}
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zalando/go-keyring"
+)
+
+var journalPassphraseDeleteCommand = &cobra.Command{
+	Use:     "delete",
+	Aliases: []string{"del", "rm", "d"},
+	Short:   "Delete the journal encryption passphrase from the keyring",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		log.Debug("Deleting passphrase from keyring")
+
+		if err := keyring.Delete(keyringService, keyringPassphraseUser); err != nil && !errors.Is(err, keyring.ErrNotFound) {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	journalPassphraseCommand.AddCommand(journalPassphraseDeleteCommand)
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zalando/go-keyring"
+)
+
+var journalPassphraseSetCommand = &cobra.Command{
+	Use:     "set",
+	Aliases: []string{"s"},
+	Short:   "Store the journal encryption passphrase in the keyring",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		passphrase := viper.GetString(passphraseKey)
+		if passphrase == "" {
+			return errMissingPassphrase
+		}
+
+		log.Debug("Storing passphrase in keyring")
+
+		if err := keyring.Set(keyringService, keyringPassphraseUser, passphrase); err != nil {
+			return err
+		}
+
+		if _, err := getSalt(true); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addEncryptionFlags(journalPassphraseSetCommand.PersistentFlags())
+
+	viper.AutomaticEnv()
+
+	journalPassphraseCommand.AddCommand(journalPassphraseSetCommand)
+}
@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 	"strconv"
@@ -27,7 +26,7 @@ var journalUpdateCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -37,15 +36,92 @@ var journalUpdateCommand = &cobra.Command{
 			return err
 		}
 
-		req := api.UpdateJournalEntryJSONRequestBody{
-			Body:   viper.GetString(bodyKey),
-			Rating: viper.GetInt32(ratingKey),
-			Title:  viper.GetString(titleKey),
+		// Only the fields of the flags that were set are sent, so all other fields of the journal entry are kept
+		req := api.JournalEntryPatch{}
+		if viper.IsSet(ratingKey) {
+			req["rating"] = viper.GetInt32(ratingKey)
+		}
+
+		var (
+			current *api.JournalEntry
+			seal    bool
+		)
+		if viper.IsSet(titleKey) || viper.IsSet(bodyKey) || viper.IsSet(encryptKey) {
+			log.Debug("Getting journal entry", "id", id)
+
+			res, err := c.GetJournalEntryWithResponse(ctx, int64(id), &api.GetJournalEntryParams{Space: getSpace()})
+			if err != nil {
+				return err
+			}
+
+			log.Debug("Got journal entry", "status", res.StatusCode())
+
+			if res.StatusCode() != http.StatusOK {
+				return getResponseError(res.HTTPResponse, res.Body)
+			}
+
+			current = res.JSON200
+
+			// Encrypted journal entries stay encrypted unless encryption is disabled explicitly with `--encrypt=false`
+			seal = current.Encrypted != nil && *current.Encrypted
+			if viper.IsSet(encryptKey) {
+				seal = viper.GetBool(encryptKey)
+			}
+		}
+
+		e, err := createEncrypter(seal)
+		if err != nil {
+			return err
+		}
+
+		if current != nil {
+			title, body := viper.GetString(titleKey), viper.GetString(bodyKey)
+
+			// The title and body are always encrypted together, so if only one of them is changed,
+			// the current value of the other one is sent again
+			if !viper.IsSet(titleKey) || !viper.IsSet(bodyKey) {
+				if current.Encrypted != nil && *current.Encrypted && e == nil {
+					return errMissingPassphrase
+				}
+
+				if err := openJournalEntry(e, current); err != nil {
+					return err
+				}
+
+				if v := current.Title; !viper.IsSet(titleKey) && v != nil {
+					title = *v
+				}
+
+				if v := current.Body; !viper.IsSet(bodyKey) && v != nil {
+					body = *v
+				}
+			}
+
+			// Journal entries that aren't encrypted are sent without an encryption envelope, which removes it
+			req["encryption"] = nil
+			if seal {
+				if e == nil {
+					return errMissingPassphrase
+				}
+
+				log.Debug("Encrypting journal entry")
+
+				var envelope *api.EncryptionEnvelope
+				title, body, envelope, err = sealJournalEntry(e, title, body)
+				if err != nil {
+					return err
+				}
+
+				req["encryption"] = envelope
+			}
+
+			req["title"] = title
+			req["body"] = body
 		}
 
 		log.Debug("Updating journal entry", "id", id, "request", req)
 
-		res, err := c.UpdateJournalEntryWithResponse(ctx, int64(id), req)
+		res, err := c.PatchJournalEntryWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, int64(id), &api.PatchJournalEntryParams{Space: getSpace()}, req)
 		if err != nil {
 			return err
 		}
@@ -53,7 +129,11 @@ var journalUpdateCommand = &cobra.Command{
 		log.Debug("Updated journal entry", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		if err := openJournalEntry(e, res.JSON200); err != nil {
+			return err
 		}
 
 		log.Debug("Writing journal entry to stdout")
@@ -68,10 +148,13 @@ var journalUpdateCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(journalUpdateCommand.PersistentFlags())
+	addSpaceFlags(journalUpdateCommand.PersistentFlags())
+	addEncryptionFlags(journalUpdateCommand.PersistentFlags())
 
 	journalUpdateCommand.PersistentFlags().String(titleKey, "", "Title for the journal entry")
 	journalUpdateCommand.PersistentFlags().String(bodyKey, "", "Body for the journal entry")
 	journalUpdateCommand.PersistentFlags().Int32(ratingKey, 0, "Rating for the journal entry (between 1 and 3)")
+	journalUpdateCommand.PersistentFlags().Bool(encryptKey, false, "Whether to end-to-end encrypt the title and body of the journal entry (by default, encrypted journal entries stay encrypted)")
 
 	viper.AutomaticEnv()
 
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	errCouldNotExchange = errors.New("could not exchange the OIDC auth code and state for refresh and ID token")
+)
+
+const (
+	callbackLaddrKey      = "callback-laddr"
+	initialAccessTokenKey = "initial-access-token"
+	clientIDKey           = "client-id"
+)
+
+func openURL(url string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", url).Start()
+
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Start()
+
+	default:
+		return exec.Command("xdg-open", url).Start()
+	}
+}
+
+var loginCommand = &cobra.Command{
+	Use:     "login",
+	Aliases: []string{"log", "li"},
+	Short:   "Log in with the OIDC provider of the Senbara server",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		raddr := viper.GetString(raddrKey)
+
+		o, audience, err := discoverOIDCProvider(ctx)
+		if err != nil {
+			return err
+		}
+
+		s, err := loadSession(raddr)
+		if err != nil {
+			return err
+		}
+
+		redirectURL := getRedirectURL()
+
+		if clientID := viper.GetString(clientIDKey); clientID != "" {
+			s = &session{
+				Issuer:             o.Issuer,
+				EndSessionEndpoint: o.EndSessionEndpoint,
+
+				ClientID:    clientID,
+				RedirectURL: redirectURL,
+			}
+		} else if s == nil || s.Issuer != o.Issuer || s.RedirectURL != redirectURL || s.RegistrationClientURI != "" {
+			// Sessions with a client ID, but without a registration client URI use a client that
+			// was passed with `--client-id`, which we can't manage and thus use as-is
+			s, err = ensureClientRegistration(ctx, raddr, o, s, redirectURL, viper.GetString(initialAccessTokenKey))
+			if err != nil {
+				return err
+			}
+		}
+
+		s.Audience = audience
+
+		a, err := createAuthner(ctx, s)
+		if err != nil {
+			return err
+		}
+
+		// Drop the tokens of the previous session so that we always sign in again instead of refreshing them
+		s.RefreshToken, s.RefreshTokenExpiry = "", time.Time{}
+		s.IDToken, s.IDTokenExpiry = "", time.Time{}
+		s.AccessToken, s.AccessTokenExpiry = "", time.Time{}
+
+		store := newSessionTokenStore(raddr, s)
+
+		as, err := a.Authorize(
+			ctx,
+
+			store,
+
+			true,
+
+			"",
+			"",
+		)
+		if err != nil {
+			return err
+		}
+
+		lis, err := net.Listen("tcp", viper.GetString(callbackLaddrKey))
+		if err != nil {
+			return err
+		}
+		defer lis.Close()
+
+		errs := make(chan error, 1)
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /authorize", func(w http.ResponseWriter, r *http.Request) {
+			log.Debug("Handling OIDC callback")
+
+			if _, err := a.Exchange(
+				r.Context(),
+
+				store,
+
+				r.URL.Query().Get("code"),
+				r.URL.Query().Get("state"),
+			); err != nil {
+				http.Error(w, errCouldNotExchange.Error(), http.StatusInternalServerError)
+
+				errs <- errors.Join(errCouldNotExchange, err)
+
+				return
+			}
+
+			fmt.Fprintln(w, "Logged in, you can close this window now.")
+
+			errs <- nil
+		})
+
+		srv := &http.Server{Handler: mux}
+		go func() {
+			if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errs <- err
+			}
+		}()
+		defer srv.Shutdown(context.Background())
+
+		fmt.Fprintln(os.Stderr, "Open the following URL in your browser to log in:", as.NextURL)
+
+		if err := openURL(as.NextURL); err != nil {
+			log.Debug("Could not open browser", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err := <-errs:
+			if err != nil {
+				return err
+			}
+		}
+
+		log.Debug("Saving session")
+
+		if err := saveSession(raddr, s); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(os.Stderr, "Logged in.")
+
+		return nil
+	},
+}
+
+func init() {
+	loginCommand.PersistentFlags().String(callbackLaddrKey, "localhost:1338", "Listen address for the loopback OIDC redirect")
+	loginCommand.PersistentFlags().String(initialAccessTokenKey, "", "Initial access token to use for OIDC dynamic client registration (if required by the OIDC provider)")
+	loginCommand.PersistentFlags().String(clientIDKey, "", "OIDC client ID to use (by default a client is registered with OIDC dynamic client registration)")
+
+	viper.AutomaticEnv()
+
+	indexCommand.AddCommand(loginCommand)
+}
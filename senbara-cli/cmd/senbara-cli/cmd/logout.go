@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var logoutCommand = &cobra.Command{
+	Use:     "logout",
+	Aliases: []string{"lo"},
+	Short:   "Log out by deleting the stored session",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		_, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		raddr := viper.GetString(raddrKey)
+
+		s, err := loadSession(raddr)
+		if err != nil {
+			return err
+		}
+
+		if s == nil {
+			return errNotLoggedIn
+		}
+
+		log.Debug("Deleting tokens from session")
+
+		// We keep the OIDC client registration so that logging in again doesn't register another client
+		s.RefreshToken, s.RefreshTokenExpiry = "", time.Time{}
+		s.IDToken, s.IDTokenExpiry = "", time.Time{}
+		s.AccessToken, s.AccessTokenExpiry = "", time.Time{}
+
+		if err := saveSession(raddr, s); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(os.Stderr, "Logged out.")
+
+		return nil
+	},
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	indexCommand.AddCommand(logoutCommand)
+}
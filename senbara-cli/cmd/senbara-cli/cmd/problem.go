@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+)
+
+// problemError is returned if the Senbara server responds with problem details
+type problemError struct {
+	problem api.Problem
+}
+
+func (e *problemError) Error() string {
+	msg := fmt.Sprintf("%v (%v)", e.problem.Title, e.problem.Status)
+	if e.problem.Detail != nil && *e.problem.Detail != "" {
+		msg += ": " + *e.problem.Detail
+	}
+
+	switch e.problem.Type {
+	case api.ProblemTypeUnauthorized:
+		msg += "; run `senbara-cli login` to sign in again"
+
+	case api.ProblemTypeForbidden:
+		msg += "; check that your token has the required scope and your role in the space allows the operation"
+	}
+
+	return msg
+}
+
+// getResponseError returns the problem details of an unsuccessful response, or its status if it doesn't contain any
+func getResponseError(res *http.Response, body []byte) error {
+	if mediaType, _, err := mime.ParseMediaType(res.Header.Get("Content-Type")); err == nil && mediaType == "application/problem+json" {
+		var p api.Problem
+		if err := json.Unmarshal(body, &p); err == nil {
+			return &problemError{p}
+		}
+	}
+
+	return errors.New(res.Status)
+}
+
+// readResponseError reads the body of an unsuccessful response that hasn't been read yet and returns its error
+func readResponseError(res *http.Response) error {
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.Join(errors.New(res.Status), err)
+	}
+
+	return getResponseError(res, body)
+}
@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/adrg/xdg"
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/spf13/viper"
+	"github.com/zalando/go-keyring"
+)
+
+var (
+	errNotLoggedIn = errors.New("not logged in, log in with `login` or set --token")
+)
+
+const (
+	keyringSessionUserPrefix = "session:"
+)
+
+// session holds the OIDC client registration and tokens for a Senbara server
+type session struct {
+	Issuer             string `json:"issuer"`
+	EndSessionEndpoint string `json:"end_session_endpoint"`
+
+	ClientID                string `json:"client_id"`
+	RedirectURL             string `json:"redirect_url"`
+	RegistrationClientURI   string `json:"registration_client_uri,omitempty"`
+	RegistrationAccessToken string `json:"registration_access_token,omitempty"`
+
+	// Audience is the audience to request access tokens for if the server validates access tokens instead of ID tokens
+	Audience string `json:"audience,omitempty"`
+
+	RefreshToken       string    `json:"refresh_token,omitempty"`
+	RefreshTokenExpiry time.Time `json:"refresh_token_expiry,omitzero"`
+	IDToken            string    `json:"id_token,omitempty"`
+	IDTokenExpiry      time.Time `json:"id_token_expiry,omitzero"`
+	AccessToken        string    `json:"access_token,omitempty"`
+	AccessTokenExpiry  time.Time `json:"access_token_expiry,omitzero"`
+}
+
+// sessionTokenStore persists the refresh, ID and access token in the session and keeps the nonces, which are
+// only required during login, in memory
+type sessionTokenStore struct {
+	*authn.MemoryTokenStore
+
+	raddr string
+	s     *session
+}
+
+func newSessionTokenStore(raddr string, s *session) *sessionTokenStore {
+	return &sessionTokenStore{
+		MemoryTokenStore: authn.NewMemoryTokenStore(),
+
+		raddr: raddr,
+		s:     s,
+	}
+}
+
+func (t *sessionTokenStore) GetToken(key authn.TokenKey) (string, error) {
+	var (
+		value  string
+		expiry time.Time
+	)
+	switch key {
+	case authn.TokenKeyRefreshToken:
+		value, expiry = t.s.RefreshToken, t.s.RefreshTokenExpiry
+
+	case authn.TokenKeyIDToken:
+		value, expiry = t.s.IDToken, t.s.IDTokenExpiry
+
+	case authn.TokenKeyAccessToken:
+		value, expiry = t.s.AccessToken, t.s.AccessTokenExpiry
+
+	default:
+		return t.MemoryTokenStore.GetToken(key)
+	}
+
+	if value == "" || (!expiry.IsZero() && time.Now().After(expiry)) {
+		return "", authn.ErrTokenNotFound
+	}
+
+	return value, nil
+}
+
+func (t *sessionTokenStore) SetToken(key authn.TokenKey, value string, expiry time.Time) error {
+	switch key {
+	case authn.TokenKeyRefreshToken:
+		log.Debug("Setting refresh token")
+
+		t.s.RefreshToken, t.s.RefreshTokenExpiry = value, expiry
+
+	case authn.TokenKeyIDToken:
+		log.Debug("Setting ID token")
+
+		t.s.IDToken, t.s.IDTokenExpiry = value, expiry
+
+	case authn.TokenKeyAccessToken:
+		log.Debug("Setting access token")
+
+		t.s.AccessToken, t.s.AccessTokenExpiry = value, expiry
+
+	default:
+		return t.MemoryTokenStore.SetToken(key, value, expiry)
+	}
+
+	return saveSession(t.raddr, t.s)
+}
+
+func (t *sessionTokenStore) DeleteToken(key authn.TokenKey) error {
+	switch key {
+	case authn.TokenKeyRefreshToken:
+		t.s.RefreshToken, t.s.RefreshTokenExpiry = "", time.Time{}
+
+	case authn.TokenKeyIDToken:
+		t.s.IDToken, t.s.IDTokenExpiry = "", time.Time{}
+
+	case authn.TokenKeyAccessToken:
+		t.s.AccessToken, t.s.AccessTokenExpiry = "", time.Time{}
+
+	default:
+		return t.MemoryTokenStore.DeleteToken(key)
+	}
+
+	return saveSession(t.raddr, t.s)
+}
+
+// getSessionPath returns the path of the file the session for the server is stored in if the keyring is not available
+func getSessionPath(raddr string) string {
+	return filepath.Join(xdg.StateHome, indexCommand.Use, "sessions", url.PathEscape(raddr)+".json")
+}
+
+// loadSession returns nil if there is no session for the server
+func loadSession(raddr string) (*session, error) {
+	rawSession, err := keyring.Get(keyringService, keyringSessionUserPrefix+raddr)
+	if err != nil {
+		if errors.Is(err, keyring.ErrNotFound) {
+			return nil, nil
+		}
+
+		log.Debug("Could not read session from keyring, falling back to file", "err", err)
+
+		b, err := os.ReadFile(getSessionPath(raddr))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		rawSession = string(b)
+	}
+
+	var s session
+	if err := json.Unmarshal([]byte(rawSession), &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func saveSession(raddr string, s *session) error {
+	rawSession, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	if err := keyring.Set(keyringService, keyringSessionUserPrefix+raddr, string(rawSession)); err != nil {
+		log.Debug("Could not write session to keyring, falling back to file", "err", err)
+
+		sessionPath := getSessionPath(raddr)
+		if err := os.MkdirAll(filepath.Dir(sessionPath), 0700); err != nil {
+			return err
+		}
+
+		return os.WriteFile(sessionPath, rawSession, 0600)
+	}
+
+	return nil
+}
+
+func deleteSession(raddr string) error {
+	if err := keyring.Delete(keyringService, keyringSessionUserPrefix+raddr); err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		log.Debug("Could not delete session from keyring, falling back to file", "err", err)
+	}
+
+	if err := os.Remove(getSessionPath(raddr)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func createAuthner(ctx context.Context, s *session) (*authn.Authner, error) {
+	a := authn.NewAuthner(
+		slog.New(log.Handler().WithGroup("authner")),
+
+		s.Issuer,
+		s.EndSessionEndpoint,
+
+		s.ClientID,
+		s.RedirectURL,
+	)
+
+	if s.Audience != "" {
+		a.RequestAccessTokens(s.Audience)
+	}
+
+	if err := a.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// authorizeSession verifies the ID token of the stored session, refreshes it if it has expired and returns the
+// token to send to the server, which is the access token if the server validates access tokens
+func authorizeSession(ctx context.Context) (string, authn.Identity, error) {
+	raddr := viper.GetString(raddrKey)
+
+	s, err := loadSession(raddr)
+	if err != nil {
+		return "", authn.Identity{}, err
+	}
+
+	if s == nil || s.RefreshToken == "" {
+		log.Debug("Missing session")
+
+		return "", authn.Identity{}, errNotLoggedIn
+	}
+
+	a, err := createAuthner(ctx, s)
+	if err != nil {
+		return "", authn.Identity{}, err
+	}
+
+	as, err := a.Authorize(
+		ctx,
+
+		newSessionTokenStore(raddr, s),
+
+		false,
+
+		"",
+		"",
+	)
+	if err != nil {
+		return "", authn.Identity{}, err
+	}
+
+	if strings.TrimSpace(as.Identity.Email) == "" {
+		log.Debug("Could not refresh session")
+
+		return "", authn.Identity{}, errNotLoggedIn
+	}
+
+	return as.BearerToken(), as.Identity, nil
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var spaceCommand = &cobra.Command{
+	Use:     "space",
+	Aliases: []string{"spa", "sp"},
+	Short:   "Space operations",
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	indexCommand.AddCommand(spaceCommand)
+}
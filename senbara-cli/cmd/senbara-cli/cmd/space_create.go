@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+var spaceCreateCommand = &cobra.Command{
+	Use:     "create",
+	Aliases: []string{"cre", "c"},
+	Short:   "Create a new space",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		req := api.CreateSpaceJSONRequestBody{
+			Name: viper.GetString(nameKey),
+		}
+
+		log.Debug("Creating space", "request", req)
+
+		res, err := c.CreateSpaceWithResponse(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Created space", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		log.Debug("Writing space to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(res.JSON200); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addAuthFlags(spaceCreateCommand.PersistentFlags())
+
+	spaceCreateCommand.PersistentFlags().String(nameKey, "", "Name for the space")
+
+	viper.AutomaticEnv()
+
+	spaceCommand.AddCommand(spaceCreateCommand)
+}
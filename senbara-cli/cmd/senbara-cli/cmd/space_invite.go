@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	roleKey = "role"
+)
+
+var spaceInviteCommand = &cobra.Command{
+	Use:     "invite <space-id>",
+	Aliases: []string{"inv", "i"},
+	Short:   "Invite someone to a space",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return err
+		}
+
+		req := api.CreateSpaceInvitationJSONRequestBody{
+			Email: (types.Email)(viper.GetString(emailKey)),
+			Role:  api.SpaceInvitationRole(viper.GetString(roleKey)),
+		}
+
+		log.Debug("Inviting to space", "id", id, "request", req)
+
+		res, err := c.CreateSpaceInvitationWithResponse(ctx, int64(id), req)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Invited to space", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		log.Debug("Writing space invitation to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(res.JSON200); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addAuthFlags(spaceInviteCommand.PersistentFlags())
+
+	spaceInviteCommand.PersistentFlags().String(emailKey, "", "Email of the account to invite")
+	spaceInviteCommand.PersistentFlags().String(roleKey, string(api.SpaceInvitationRoleViewer), "Role to invite the account with (editor or viewer)")
+
+	viper.AutomaticEnv()
+
+	spaceCommand.AddCommand(spaceInviteCommand)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+var spaceListCommand = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"lis", "ls", "l"},
+	Short:   "List all spaces",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Listing spaces")
+
+		res, err := c.GetSpacesWithResponse(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Got spaces", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		log.Debug("Writing spaces to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(res.JSON200); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addAuthFlags(spaceListCommand.PersistentFlags())
+
+	viper.AutomaticEnv()
+
+	spaceCommand.AddCommand(spaceListCommand)
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var spaceMemberCommand = &cobra.Command{
+	Use:     "member",
+	Aliases: []string{"mem", "m"},
+	Short:   "Space member operations",
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	spaceCommand.AddCommand(spaceMemberCommand)
+}
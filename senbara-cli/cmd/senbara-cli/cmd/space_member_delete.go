@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+var spaceMemberDeleteCommand = &cobra.Command{
+	Use:     "delete <space-id> <member-id>",
+	Aliases: []string{"del", "rm", "d"},
+	Short:   "Remove a member from a space",
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return err
+		}
+
+		memberID, err := strconv.Atoi(args[1])
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Removing space member", "id", id, "memberID", memberID)
+
+		res, err := c.DeleteSpaceMemberWithResponse(ctx, int64(id), int64(memberID))
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Removed space member", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		log.Debug("Writing space member ID to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(res.JSON200); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addAuthFlags(spaceMemberDeleteCommand.PersistentFlags())
+
+	viper.AutomaticEnv()
+
+	spaceMemberCommand.AddCommand(spaceMemberDeleteCommand)
+}
@@ -2,10 +2,10 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"os"
 
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -24,14 +24,14 @@ var summaryGetCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
 
 		log.Debug("Getting summary")
 
-		res, err := c.GetSummaryWithResponse(ctx)
+		res, err := c.GetSummaryWithResponse(ctx, &api.GetSummaryParams{Space: getSpace()})
 		if err != nil {
 			return err
 		}
@@ -39,7 +39,7 @@ var summaryGetCommand = &cobra.Command{
 		log.Debug("Got summary", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		log.Debug("Writing summary to stdout")
@@ -54,6 +54,7 @@ var summaryGetCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(summaryGetCommand.PersistentFlags())
+	addSpaceFlags(summaryGetCommand.PersistentFlags())
 
 	viper.AutomaticEnv()
 
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var tokenCommand = &cobra.Command{
+	Use:     "token",
+	Aliases: []string{"tok", "t"},
+	Short:   "Personal access token operations",
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	indexCommand.AddCommand(tokenCommand)
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	scopeKey = "scope"
+)
+
+var tokenCreateCommand = &cobra.Command{
+	Use:     "create",
+	Aliases: []string{"cre", "c"},
+	Short:   "Create a new personal access token",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		req := api.CreateAccessTokenJSONRequestBody{
+			Name:  viper.GetString(nameKey),
+			Scope: api.AccessTokenScope(viper.GetString(scopeKey)),
+		}
+
+		log.Debug("Creating access token", "request", req)
+
+		res, err := c.CreateAccessTokenWithResponse(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Created access token", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		log.Debug("Writing access token to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(res.JSON200); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addAuthFlags(tokenCreateCommand.PersistentFlags())
+
+	tokenCreateCommand.PersistentFlags().String(nameKey, "", "Name for the access token")
+	tokenCreateCommand.PersistentFlags().String(scopeKey, string(api.Read), "Scope for the access token (read or write)")
+
+	viper.AutomaticEnv()
+
+	tokenCommand.AddCommand(tokenCreateCommand)
+}
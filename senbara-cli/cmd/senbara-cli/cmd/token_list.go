@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+var tokenListCommand = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"lis", "ls", "l"},
+	Short:   "List all personal access tokens",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		c, err := createClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Listing access tokens")
+
+		res, err := c.GetAccessTokensWithResponse(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Got access tokens", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return getResponseError(res.HTTPResponse, res.Body)
+		}
+
+		log.Debug("Writing access tokens to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(res.JSON200); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addAuthFlags(tokenListCommand.PersistentFlags())
+
+	viper.AutomaticEnv()
+
+	tokenCommand.AddCommand(tokenListCommand)
+}
@@ -2,9 +2,9 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -21,14 +21,14 @@ var userDataDeleteCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
 
 		log.Debug("Deleting user data")
 
-		res, err := c.DeleteUserDataWithResponse(ctx)
+		res, err := c.DeleteUserDataWithResponse(ctx, &api.DeleteUserDataParams{Space: getSpace()})
 		if err != nil {
 			return err
 		}
@@ -36,7 +36,7 @@ var userDataDeleteCommand = &cobra.Command{
 		log.Debug("Deleted user data", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		return nil
@@ -45,6 +45,7 @@ var userDataDeleteCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(userDataDeleteCommand.PersistentFlags())
+	addSpaceFlags(userDataDeleteCommand.PersistentFlags())
 
 	viper.AutomaticEnv()
 
@@ -2,11 +2,11 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"io"
 	"net/http"
 	"os"
 
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -23,14 +23,14 @@ var userDataExportCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
 
 		log.Debug("Exporting user data")
 
-		res, err := c.ExportUserData(ctx)
+		res, err := c.ExportUserData(ctx, &api.ExportUserDataParams{Space: getSpace()})
 		if err != nil {
 			return err
 		}
@@ -39,7 +39,7 @@ var userDataExportCommand = &cobra.Command{
 		log.Debug("Exported user data", "status", res.StatusCode)
 
 		if res.StatusCode != http.StatusOK {
-			return errors.New(res.Status)
+			return readResponseError(res)
 		}
 
 		log.Debug("Writing user data to stdout")
@@ -54,6 +54,7 @@ var userDataExportCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(userDataExportCommand.PersistentFlags())
+	addSpaceFlags(userDataExportCommand.PersistentFlags())
 
 	viper.AutomaticEnv()
 
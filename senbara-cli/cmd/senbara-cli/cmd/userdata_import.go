@@ -2,12 +2,12 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"os"
 
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -24,7 +24,7 @@ var userDataImportCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
-		c, err := createClient(true)
+		c, err := createClient(ctx, true)
 		if err != nil {
 			return err
 		}
@@ -60,7 +60,7 @@ var userDataImportCommand = &cobra.Command{
 			}
 		}()
 
-		res, err := c.ImportUserDataWithBodyWithResponse(ctx, enc.FormDataContentType(), reader)
+		res, err := c.ImportUserDataWithBodyWithResponse(ctx, &api.ImportUserDataParams{Space: getSpace()}, enc.FormDataContentType(), reader)
 		if err != nil {
 			return err
 		}
@@ -68,7 +68,7 @@ var userDataImportCommand = &cobra.Command{
 		log.Debug("Imported user data", "status", res.StatusCode())
 
 		if res.StatusCode() != http.StatusOK {
-			return errors.New(res.Status())
+			return getResponseError(res.HTTPResponse, res.Body)
 		}
 
 		return nil
@@ -77,6 +77,7 @@ var userDataImportCommand = &cobra.Command{
 
 func init() {
 	addAuthFlags(userDataImportCommand.PersistentFlags())
+	addSpaceFlags(userDataImportCommand.PersistentFlags())
 
 	viper.AutomaticEnv()
 
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"gopkg.in/yaml.v3"
+)
+
+var whoamiCommand = &cobra.Command{
+	Use:     "whoami",
+	Aliases: []string{"who", "w"},
+	Short:   "Get the identity of the logged in user",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		log.Debug("Authorizing session")
+
+		_, identity, err := authorizeSession(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Writing identity to stdout")
+
+		if err := yaml.NewEncoder(os.Stdout).Encode(map[string]string{
+			"email":   identity.Email,
+			"issuer":  identity.Issuer,
+			"subject": identity.Subject,
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	indexCommand.AddCommand(whoamiCommand)
+}
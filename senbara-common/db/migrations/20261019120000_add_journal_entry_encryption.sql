@@ -0,0 +1,9 @@
+-- +goose Up
+alter table journal_entries
+add column encryption_algorithm text not null default '',
+    add column encryption_kdf text not null default '',
+    add column encryption_salt text not null default '';
+-- +goose Down
+alter table journal_entries drop column encryption_algorithm,
+    drop column encryption_kdf,
+    drop column encryption_salt;
@@ -0,0 +1,26 @@
+-- +goose Up
+create table accounts (
+    id serial primary key,
+    issuer text not null,
+    subject text not null,
+    email text not null,
+    namespace text not null unique
+);
+create unique index accounts_issuer_subject_key on accounts (issuer, subject)
+where subject <> '';
+-- Namespaces used to be the verified email, so seed one unclaimed account per existing namespace;
+-- it is claimed by issuer and subject the next time a user with that email signs in
+insert into accounts (issuer, subject, email, namespace)
+select '',
+    '',
+    namespace,
+    namespace
+from (
+        select namespace
+        from contacts
+        union
+        select namespace
+        from journal_entries
+    ) as namespaces;
+-- +goose Down
+drop table accounts;
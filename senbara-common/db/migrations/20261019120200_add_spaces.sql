@@ -0,0 +1,39 @@
+-- +goose Up
+create table spaces (
+    id serial primary key,
+    name text not null,
+    namespace text not null unique,
+    personal boolean not null default false
+);
+create table space_members (
+    space_id integer not null,
+    account_id integer not null,
+    role text not null check (role in ('owner', 'editor', 'viewer')),
+    primary key (space_id, account_id),
+    foreign key (space_id) references spaces (id) on delete cascade,
+    foreign key (account_id) references accounts (id) on delete cascade
+);
+create table space_invitations (
+    id serial primary key,
+    space_id integer not null,
+    email text not null,
+    role text not null check (role in ('editor', 'viewer')),
+    unique (space_id, email),
+    foreign key (space_id) references spaces (id) on delete cascade
+);
+-- Every account's namespace becomes its personal space
+insert into spaces (name, namespace, personal)
+select email,
+    namespace,
+    true
+from accounts;
+insert into space_members (space_id, account_id, role)
+select spaces.id,
+    accounts.id,
+    'owner'
+from spaces
+    join accounts on accounts.namespace = spaces.namespace;
+-- +goose Down
+drop table space_invitations;
+drop table space_members;
+drop table spaces;
@@ -0,0 +1,12 @@
+-- +goose Up
+create table access_tokens (
+    id serial primary key,
+    account_id integer not null references accounts (id) on delete cascade,
+    name text not null,
+    scope text not null check (scope in ('read', 'write')),
+    token_hash text not null unique,
+    created_at timestamptz not null default current_timestamp,
+    last_used_at timestamptz
+);
+-- +goose Down
+drop table access_tokens;
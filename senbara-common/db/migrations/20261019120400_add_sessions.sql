@@ -0,0 +1,16 @@
+-- +goose Up
+create table sessions (
+    id serial primary key,
+    account_id integer not null references accounts (id) on delete cascade,
+    session_key text not null unique,
+    oidc_session_id text not null,
+    client_id text not null,
+    user_agent text not null,
+    created_at timestamptz not null default current_timestamp,
+    last_seen_at timestamptz not null default current_timestamp,
+    revoked_at timestamptz
+);
+create index sessions_oidc_session_id_idx on sessions (oidc_session_id)
+where oidc_session_id <> '';
+-- +goose Down
+drop table sessions;
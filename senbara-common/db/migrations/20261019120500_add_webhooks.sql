@@ -0,0 +1,42 @@
+-- +goose Up
+create table webhooks (
+    id serial primary key,
+    namespace text not null,
+    url text not null,
+    secret text not null,
+    event_types text [] not null,
+    created_at timestamptz not null default current_timestamp
+);
+create index webhooks_namespace_idx on webhooks (namespace);
+-- Events are written to the outbox in the same transaction as the change that caused them,
+-- once for every webhook that is subscribed to them
+create table webhook_outbox (
+    id bigserial primary key,
+    webhook_id integer not null references webhooks (id) on delete cascade,
+    event_type text not null,
+    payload jsonb not null,
+    created_at timestamptz not null default current_timestamp,
+    attempts integer not null default 0,
+    next_attempt_at timestamptz not null default current_timestamp,
+    delivered_at timestamptz,
+    failed_at timestamptz
+);
+create index webhook_outbox_pending_idx on webhook_outbox (next_attempt_at)
+where delivered_at is null
+    and failed_at is null;
+create table webhook_deliveries (
+    id bigserial primary key,
+    webhook_id integer not null references webhooks (id) on delete cascade,
+    outbox_id bigint not null references webhook_outbox (id) on delete cascade,
+    event_type text not null,
+    attempt integer not null,
+    status_code integer not null,
+    error text not null,
+    duration_ms integer not null,
+    created_at timestamptz not null default current_timestamp
+);
+create index webhook_deliveries_webhook_id_idx on webhook_deliveries (webhook_id, created_at desc);
+-- +goose Down
+drop table webhook_deliveries;
+drop table webhook_outbox;
+drop table webhooks;
@@ -0,0 +1,135 @@
+-- +goose Up
+-- Every change to an entity is assigned the next number of a global sequence, which clients
+-- use as a cursor to fetch only the entities that changed since they last synced
+create sequence change_sequence;
+alter table contacts
+add column change_sequence bigint not null default 0;
+alter table journal_entries
+add column change_sequence bigint not null default 0;
+alter table debts
+add column change_sequence bigint not null default 0;
+alter table activities
+add column change_sequence bigint not null default 0;
+update contacts
+set change_sequence = nextval('change_sequence');
+update journal_entries
+set change_sequence = nextval('change_sequence');
+update debts
+set change_sequence = nextval('change_sequence');
+update activities
+set change_sequence = nextval('change_sequence');
+create index contacts_change_sequence_idx on contacts (namespace, change_sequence);
+create index journal_entries_change_sequence_idx on journal_entries (namespace, change_sequence);
+-- Deleted entities are remembered so that clients can remove them too
+create table tombstones (
+    id bigserial primary key,
+    namespace text not null,
+    entity_type text not null,
+    entity_id integer not null,
+    change_sequence bigint not null,
+    deleted_at timestamptz not null default current_timestamp
+);
+create index tombstones_change_sequence_idx on tombstones (namespace, change_sequence);
+-- Changes to a namespace are serialized until their transaction commits, so change sequence
+-- numbers become visible in order and clients never skip a change that was committed after
+-- they synced; sequence numbers must only be taken after the lock is held, which is why they
+-- are set by triggers instead of column defaults
+-- +goose StatementBegin
+create function next_change_sequence(change_namespace text) returns bigint as $$
+begin
+    perform pg_advisory_xact_lock(hashtext(change_namespace));
+
+    return nextval('change_sequence');
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+-- +goose StatementBegin
+create function set_change_sequence() returns trigger as $$
+begin
+    new.change_sequence := next_change_sequence(new.namespace);
+
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+-- Debts and activities belong to the namespace of their contact
+-- +goose StatementBegin
+create function set_contact_change_sequence() returns trigger as $$
+begin
+    new.change_sequence := next_change_sequence(
+        (
+            select namespace
+            from contacts
+            where id = new.contact_id
+        )
+    );
+
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+-- +goose StatementBegin
+create function create_tombstone() returns trigger as $$
+begin
+    insert into tombstones (namespace, entity_type, entity_id, change_sequence)
+    values (
+            old.namespace,
+            tg_argv [0],
+            old.id,
+            next_change_sequence(old.namespace)
+        );
+
+    return old;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+-- +goose StatementBegin
+create function create_contact_tombstone() returns trigger as $$
+declare
+    tombstone_namespace text := (
+        select namespace
+        from contacts
+        where id = old.contact_id
+    );
+begin
+    insert into tombstones (namespace, entity_type, entity_id, change_sequence)
+    values (
+            tombstone_namespace,
+            tg_argv [0],
+            old.id,
+            next_change_sequence(tombstone_namespace)
+        );
+
+    return old;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+create trigger contacts_change_sequence before insert or update on contacts for each row execute function set_change_sequence();
+create trigger journal_entries_change_sequence before insert or update on journal_entries for each row execute function set_change_sequence();
+create trigger debts_change_sequence before insert or update on debts for each row execute function set_contact_change_sequence();
+create trigger activities_change_sequence before insert or update on activities for each row execute function set_contact_change_sequence();
+create trigger contacts_tombstone after delete on contacts for each row execute function create_tombstone('contact');
+create trigger journal_entries_tombstone after delete on journal_entries for each row execute function create_tombstone('journal_entry');
+-- Debts and activities are deleted before their contact, so its namespace can still be looked up
+create trigger debts_tombstone after delete on debts for each row execute function create_contact_tombstone('debt');
+create trigger activities_tombstone after delete on activities for each row execute function create_contact_tombstone('activity');
+-- +goose Down
+drop trigger activities_tombstone on activities;
+drop trigger debts_tombstone on debts;
+drop trigger journal_entries_tombstone on journal_entries;
+drop trigger contacts_tombstone on contacts;
+drop trigger activities_change_sequence on activities;
+drop trigger debts_change_sequence on debts;
+drop trigger journal_entries_change_sequence on journal_entries;
+drop trigger contacts_change_sequence on contacts;
+drop function create_contact_tombstone;
+drop function create_tombstone;
+drop function set_contact_change_sequence;
+drop function set_change_sequence;
+drop function next_change_sequence;
+drop table tombstones;
+alter table activities drop column change_sequence;
+alter table debts drop column change_sequence;
+alter table journal_entries drop column change_sequence;
+alter table contacts drop column change_sequence;
+drop sequence change_sequence;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Debts didn't record when they were created, so existing debts are dated to when this migration runs
+alter table debts
+add column created_at timestamptz not null default current_timestamp;
+-- +goose Down
+alter table debts drop column created_at;
@@ -0,0 +1,42 @@
+-- name: CreateAccessToken :one
+insert into access_tokens (account_id, name, scope, token_hash)
+select accounts.id,
+    $2,
+    $3,
+    $4
+from accounts
+where accounts.namespace = $1
+returning access_tokens.id,
+    access_tokens.name,
+    access_tokens.scope,
+    access_tokens.created_at,
+    access_tokens.last_used_at;
+
+-- name: GetAccessTokens :many
+select access_tokens.id,
+    access_tokens.name,
+    access_tokens.scope,
+    access_tokens.created_at,
+    access_tokens.last_used_at
+from access_tokens
+    join accounts on accounts.id = access_tokens.account_id
+where accounts.namespace = $1
+order by access_tokens.created_at desc;
+
+-- name: DeleteAccessToken :one
+delete from access_tokens using accounts
+where access_tokens.id = $1
+    and access_tokens.account_id = accounts.id
+    and accounts.namespace = $2
+returning access_tokens.id;
+
+-- name: GetAccountForAccessToken :one
+update access_tokens
+set last_used_at = current_timestamp
+from accounts
+where access_tokens.token_hash = $1
+    and accounts.id = access_tokens.account_id
+returning accounts.issuer,
+    accounts.subject,
+    accounts.email,
+    access_tokens.scope;
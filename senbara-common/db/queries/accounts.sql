@@ -0,0 +1,28 @@
+-- name: GetAccount :one
+select *
+from accounts
+where issuer = $1
+    and subject = $2;
+
+-- name: UpdateAccountEmail :one
+update accounts
+set email = $3
+where issuer = $1
+    and subject = $2
+returning *;
+
+-- name: ClaimAccount :one
+update accounts
+set issuer = $1,
+    subject = $2
+where subject = ''
+    and email = $3
+returning *;
+
+-- name: CreateAccount :one
+insert into accounts (issuer, subject, email, namespace)
+values ($1, $2, $3, gen_random_uuid()::text) on conflict (issuer, subject)
+where subject <> '' do
+update
+set email = excluded.email
+returning *;
@@ -44,11 +44,12 @@ where activities.id = $1
     and contacts.namespace = $2
 returning activities.id;
 
--- name: DeleteActivitesForContact :exec
+-- name: DeleteActivitesForContact :many
 delete from activities using contacts
 where activities.contact_id = contacts.id
     and contacts.id = $1
-    and contacts.namespace = $2;
+    and contacts.namespace = $2
+returning activities.id;
 
 -- name: GetActivityAndContact :one
 select activities.id as activity_id,
@@ -63,6 +64,20 @@ from contacts
 where activities.id = $1
     and contacts.namespace = $2;
 
+-- name: GetActivityAndContactForUpdate :one
+select activities.id as activity_id,
+    activities.name,
+    activities.date,
+    activities.description,
+    contacts.id as contact_id,
+    contacts.first_name,
+    contacts.last_name
+from contacts
+    inner join activities on activities.contact_id = contacts.id
+where activities.id = $1
+    and contacts.namespace = $2
+for update of activities;
+
 -- name: UpdateActivity :one
 update activities
 set name = $3,
@@ -92,4 +107,38 @@ where contacts.namespace = $1;
 delete from activities using contacts
 where activities.contact_id = contacts.id
     and contacts.namespace = $1
-returning activities.id;
\ No newline at end of file
+returning activities.id;
+
+-- name: GetActivitiesForNamespace :many
+select activities.id as activity_id,
+    activities.name,
+    activities.date,
+    activities.description,
+    contacts.id as contact_id,
+    contacts.first_name,
+    contacts.last_name
+from contacts
+    inner join activities on activities.contact_id = contacts.id
+where contacts.namespace = sqlc.arg(namespace)
+    and (
+        sqlc.arg(contact_id)::integer = 0
+        or contacts.id = sqlc.arg(contact_id)
+    )
+    and (
+        sqlc.narg(start_date)::date is null
+        or activities.date::date >= sqlc.narg(start_date)
+    )
+    and (
+        sqlc.narg(end_date)::date is null
+        or activities.date::date <= sqlc.narg(end_date)
+    )
+    and (
+        sqlc.arg(query)::text = ''
+        or position(
+            lower(sqlc.arg(query)) in lower(
+                activities.name || ' ' || activities.description || ' ' || contacts.first_name || ' ' || contacts.last_name
+            )
+        ) > 0
+    )
+order by activities.date desc,
+    activities.id desc;
@@ -0,0 +1,2 @@
+-- name: NotifyChange :exec
+select pg_notify('senbara_changes', sqlc.arg(change)::text);
@@ -28,6 +28,13 @@ from contacts
 where id = $1
     and namespace = $2;
 
+-- name: GetContactForUpdate :one
+select *
+from contacts
+where id = $1
+    and namespace = $2
+for update;
+
 -- name: UpdateContact :one
 update contacts
 set first_name = $3,
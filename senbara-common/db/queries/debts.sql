@@ -44,11 +44,12 @@ where debts.id = $1
     and contacts.namespace = $2
 returning debts.id;
 
--- name: DeleteDebtsForContact :exec
+-- name: DeleteDebtsForContact :many
 delete from debts using contacts
 where debts.contact_id = contacts.id
     and contacts.id = $1
-    and contacts.namespace = $2;
+    and contacts.namespace = $2
+returning debts.id;
 
 -- name: GetDebtAndContact :one
 select debts.id as debt_id,
@@ -63,6 +64,20 @@ from contacts
 where debts.id = $1
     and contacts.namespace = $2;
 
+-- name: GetDebtAndContactForUpdate :one
+select debts.id as debt_id,
+    debts.amount,
+    debts.currency,
+    debts.description,
+    contacts.id as contact_id,
+    contacts.first_name,
+    contacts.last_name
+from contacts
+    inner join debts on debts.contact_id = contacts.id
+where debts.id = $1
+    and contacts.namespace = $2
+for update of debts;
+
 -- name: UpdateDebt :one
 update debts
 set amount = $3,
@@ -92,4 +107,46 @@ where contacts.namespace = $1;
 delete from debts using contacts
 where debts.contact_id = contacts.id
     and contacts.namespace = $1
-returning debts.id;
\ No newline at end of file
+returning debts.id;
+
+-- name: GetDebtsForNamespace :many
+select debts.id as debt_id,
+    debts.amount,
+    debts.currency,
+    debts.description,
+    debts.created_at,
+    contacts.id as contact_id,
+    contacts.first_name,
+    contacts.last_name
+from contacts
+    inner join debts on debts.contact_id = contacts.id
+where contacts.namespace = sqlc.arg(namespace)
+    and (
+        sqlc.arg(contact_id)::integer = 0
+        or contacts.id = sqlc.arg(contact_id)
+    )
+    and (
+        sqlc.arg(currency)::text = ''
+        or lower(debts.currency) = lower(sqlc.arg(currency))
+    )
+    and (
+        sqlc.narg(you_owe)::boolean is null
+        or (debts.amount <= 0) = sqlc.narg(you_owe)
+    )
+    and (
+        sqlc.narg(start_date)::date is null
+        or debts.created_at::date >= sqlc.narg(start_date)
+    )
+    and (
+        sqlc.narg(end_date)::date is null
+        or debts.created_at::date <= sqlc.narg(end_date)
+    )
+    and (
+        sqlc.arg(query)::text = ''
+        or position(
+            lower(sqlc.arg(query)) in lower(
+                debts.description || ' ' || contacts.first_name || ' ' || contacts.last_name
+            )
+        ) > 0
+    )
+order by debts.id desc;
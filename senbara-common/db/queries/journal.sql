@@ -10,9 +10,24 @@ from journal_entries
 where id = $1
     and namespace = $2;
 
+-- name: GetJournalEntryForUpdate :one
+select *
+from journal_entries
+where id = $1
+    and namespace = $2
+for update;
+
 -- name: CreateJournalEntry :one
-insert into journal_entries (title, body, rating, namespace)
-values ($1, $2, $3, $4)
+insert into journal_entries (
+        title,
+        body,
+        rating,
+        namespace,
+        encryption_algorithm,
+        encryption_kdf,
+        encryption_salt
+    )
+values ($1, $2, $3, $4, $5, $6, $7)
 returning *;
 
 -- name: DeleteJournalEntry :one
@@ -25,7 +40,10 @@ returning id;
 update journal_entries
 set title = $3,
     body = $4,
-    rating = $5
+    rating = $5,
+    encryption_algorithm = $6,
+    encryption_kdf = $7,
+    encryption_salt = $8
 where id = $1
     and namespace = $2
 returning *;
@@ -0,0 +1,68 @@
+-- name: TouchSession :one
+insert into sessions (
+        account_id,
+        session_key,
+        oidc_session_id,
+        client_id,
+        user_agent
+    )
+select accounts.id,
+    $2,
+    $3,
+    $4,
+    $5
+from accounts
+where accounts.namespace = $1 on conflict (session_key) do
+update
+set user_agent = excluded.user_agent,
+    last_seen_at = current_timestamp
+returning sessions.id,
+    sessions.revoked_at;
+
+-- name: GetSessions :many
+select sessions.id,
+    sessions.client_id,
+    sessions.user_agent,
+    sessions.created_at,
+    sessions.last_seen_at
+from sessions
+    join accounts on accounts.id = sessions.account_id
+where accounts.namespace = $1
+    and sessions.revoked_at is null
+order by sessions.last_seen_at desc;
+
+-- name: RevokeSession :one
+update sessions
+set revoked_at = current_timestamp
+from accounts
+where sessions.id = $1
+    and sessions.account_id = accounts.id
+    and accounts.namespace = $2
+    and sessions.revoked_at is null
+returning sessions.id;
+
+-- name: RevokeSessions :many
+update sessions
+set revoked_at = current_timestamp
+from accounts
+where sessions.account_id = accounts.id
+    and accounts.namespace = $1
+    and sessions.revoked_at is null
+returning sessions.id;
+
+-- name: RevokeOIDCSessions :many
+update sessions
+set revoked_at = current_timestamp
+from accounts
+where sessions.account_id = accounts.id
+    and accounts.issuer = sqlc.arg(issuer)
+    and (
+        sqlc.arg(subject)::text = ''
+        or accounts.subject = sqlc.arg(subject)
+    )
+    and (
+        sqlc.arg(oidc_session_id)::text = ''
+        or sessions.oidc_session_id = sqlc.arg(oidc_session_id)
+    )
+    and sessions.revoked_at is null
+returning sessions.id;
@@ -0,0 +1,116 @@
+-- name: CreatePersonalSpace :one
+with space as (
+    insert into spaces (name, namespace, personal)
+    values ($1, $2, true)
+    returning id
+)
+insert into space_members (space_id, account_id, role)
+select space.id,
+    accounts.id,
+    'owner'
+from space,
+    accounts
+where accounts.namespace = $2
+returning space_id;
+
+-- name: CreateSpace :one
+insert into spaces (name, namespace)
+values ($1, gen_random_uuid()::text)
+returning *;
+
+-- name: CreateSpaceMember :exec
+insert into space_members (space_id, account_id, role)
+select $1,
+    accounts.id,
+    $3
+from accounts
+where accounts.namespace = $2 on conflict (space_id, account_id) do nothing;
+
+-- name: GetSpaces :many
+select spaces.id,
+    spaces.name,
+    spaces.personal,
+    space_members.role
+from spaces
+    join space_members on space_members.space_id = spaces.id
+    join accounts on accounts.id = space_members.account_id
+where accounts.namespace = $1
+order by spaces.personal desc,
+    spaces.name asc;
+
+-- name: GetSpace :one
+select spaces.id,
+    spaces.name,
+    spaces.namespace,
+    spaces.personal,
+    space_members.role
+from spaces
+    join space_members on space_members.space_id = spaces.id
+    join accounts on accounts.id = space_members.account_id
+where spaces.id = $1
+    and accounts.namespace = $2;
+
+-- name: DeleteSpace :one
+delete from spaces
+where id = $1
+    and personal = false
+returning id;
+
+-- name: GetSpaceMembers :many
+select accounts.id,
+    accounts.email,
+    space_members.role
+from space_members
+    join accounts on accounts.id = space_members.account_id
+where space_members.space_id = $1
+order by accounts.email asc;
+
+-- name: DeleteSpaceMember :one
+delete from space_members
+where space_id = $1
+    and account_id = $2
+    and role <> 'owner'
+returning account_id;
+
+-- name: DeleteSpaceMemberForNamespace :one
+delete from space_members
+where space_id = $1
+    and account_id = (
+        select accounts.id
+        from accounts
+        where accounts.namespace = $2
+    )
+    and role <> 'owner'
+returning account_id;
+
+-- name: CreateSpaceInvitation :one
+insert into space_invitations (space_id, email, role)
+values ($1, $2, $3) on conflict (space_id, email) do
+update
+set role = excluded.role
+returning *;
+
+-- name: GetSpaceInvitations :many
+select space_invitations.id,
+    space_invitations.space_id,
+    spaces.name as space_name,
+    space_invitations.email,
+    space_invitations.role
+from space_invitations
+    join spaces on spaces.id = space_invitations.space_id
+where space_invitations.email = (
+        select accounts.email
+        from accounts
+        where accounts.namespace = $1
+    )
+order by spaces.name asc;
+
+-- name: DeleteSpaceInvitation :one
+delete from space_invitations
+where space_invitations.id = $1
+    and space_invitations.email = (
+        select accounts.email
+        from accounts
+        where accounts.namespace = $2
+    )
+returning *;
@@ -0,0 +1,81 @@
+-- name: LockChanges :exec
+select pg_advisory_xact_lock(hashtext(sqlc.arg(namespace)::text));
+
+-- name: GetContactsChangedSince :many
+select *
+from contacts
+where namespace = $1
+    and change_sequence > sqlc.arg(since)::bigint
+order by change_sequence;
+
+-- name: GetJournalEntriesChangedSince :many
+select *
+from journal_entries
+where namespace = $1
+    and change_sequence > sqlc.arg(since)::bigint
+order by change_sequence;
+
+-- name: GetDebtsChangedSince :many
+select debts.id,
+    debts.amount,
+    debts.currency,
+    debts.description,
+    debts.contact_id,
+    debts.change_sequence
+from contacts
+    inner join debts on debts.contact_id = contacts.id
+where contacts.namespace = $1
+    and debts.change_sequence > sqlc.arg(since)::bigint
+order by debts.change_sequence;
+
+-- name: GetActivitiesChangedSince :many
+select activities.id,
+    activities.name,
+    activities.date,
+    activities.description,
+    activities.contact_id,
+    activities.change_sequence
+from contacts
+    inner join activities on activities.contact_id = contacts.id
+where contacts.namespace = $1
+    and activities.change_sequence > sqlc.arg(since)::bigint
+order by activities.change_sequence;
+
+-- name: GetTombstonesSince :many
+select entity_type,
+    entity_id,
+    change_sequence
+from tombstones
+where namespace = $1
+    and change_sequence > sqlc.arg(since)::bigint
+order by change_sequence;
+
+-- name: DeleteTombstonesForNamespace :exec
+delete from tombstones
+where namespace = $1;
+
+-- name: GetContactChangeSequence :one
+select change_sequence
+from contacts
+where id = $1
+    and namespace = $2;
+
+-- name: GetJournalEntryChangeSequence :one
+select change_sequence
+from journal_entries
+where id = $1
+    and namespace = $2;
+
+-- name: GetDebtChangeSequence :one
+select debts.change_sequence
+from contacts
+    inner join debts on debts.contact_id = contacts.id
+where debts.id = $1
+    and contacts.namespace = $2;
+
+-- name: GetActivityChangeSequence :one
+select activities.change_sequence
+from contacts
+    inner join activities on activities.contact_id = contacts.id
+where activities.id = $1
+    and contacts.namespace = $2;
@@ -0,0 +1,113 @@
+-- name: CreateWebhook :one
+insert into webhooks (namespace, url, secret, event_types)
+values ($1, $2, $3, $4)
+returning id,
+    url,
+    event_types,
+    created_at;
+
+-- name: GetWebhooks :many
+select id,
+    url,
+    event_types,
+    created_at
+from webhooks
+where namespace = $1
+order by created_at desc;
+
+-- name: GetWebhook :one
+select id,
+    url,
+    event_types,
+    created_at
+from webhooks
+where id = $1
+    and namespace = $2;
+
+-- name: DeleteWebhook :one
+delete from webhooks
+where id = $1
+    and namespace = $2
+returning id;
+
+-- name: DeleteWebhooksForNamespace :many
+delete from webhooks
+where namespace = $1
+returning id;
+
+-- name: GetWebhookDeliveries :many
+select webhook_deliveries.id,
+    webhook_deliveries.outbox_id,
+    webhook_deliveries.event_type,
+    webhook_deliveries.attempt,
+    webhook_deliveries.status_code,
+    webhook_deliveries.error,
+    webhook_deliveries.duration_ms,
+    webhook_deliveries.created_at
+from webhook_deliveries
+    join webhooks on webhooks.id = webhook_deliveries.webhook_id
+where webhooks.id = $1
+    and webhooks.namespace = $2
+order by webhook_deliveries.created_at desc,
+    webhook_deliveries.id desc
+limit sqlc.arg(max_deliveries);
+
+-- name: CreateWebhookOutboxEntries :exec
+insert into webhook_outbox (webhook_id, event_type, payload)
+select webhooks.id,
+    sqlc.arg(event_type),
+    sqlc.arg(payload)
+from webhooks
+where webhooks.namespace = sqlc.arg(namespace)
+    and sqlc.arg(event_type)::text = any(webhooks.event_types);
+
+-- name: ClaimWebhookOutboxEntries :many
+update webhook_outbox
+set next_attempt_at = current_timestamp + sqlc.arg(lease_seconds)::integer * interval '1 second'
+from webhooks
+where webhook_outbox.id in (
+        select pending.id
+        from webhook_outbox as pending
+        where pending.delivered_at is null
+            and pending.failed_at is null
+            and pending.next_attempt_at <= current_timestamp
+        order by pending.next_attempt_at
+        limit sqlc.arg(max_entries) for update skip locked
+    )
+    and webhooks.id = webhook_outbox.webhook_id
+returning webhook_outbox.id,
+    webhook_outbox.webhook_id,
+    webhook_outbox.event_type,
+    webhook_outbox.payload,
+    webhook_outbox.created_at,
+    webhook_outbox.attempts,
+    webhooks.url,
+    webhooks.secret;
+
+-- name: CreateWebhookDelivery :exec
+insert into webhook_deliveries (
+        webhook_id,
+        outbox_id,
+        event_type,
+        attempt,
+        status_code,
+        error,
+        duration_ms
+    )
+values ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: UpdateWebhookOutboxEntry :exec
+update webhook_outbox
+set attempts = attempts + 1,
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    delivered_at = case
+        when sqlc.arg(delivered)::boolean then current_timestamp
+    end,
+    failed_at = case
+        when sqlc.arg(failed)::boolean then current_timestamp
+    end
+where id = sqlc.arg(id);
+
+-- name: DeleteExpiredWebhookOutboxEntries :execrows
+delete from webhook_outbox
+where coalesce(delivered_at, failed_at) < sqlc.arg(expired_before)::timestamptz;
@@ -0,0 +1,118 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: accounts.sql
+
+package tables
+
+import (
+	"context"
+)
+
+const claimAccount = `-- name: ClaimAccount :one
+update accounts
+set issuer = $1,
+    subject = $2
+where subject = ''
+    and email = $3
+returning id, issuer, subject, email, namespace
+`
+
+type ClaimAccountParams struct {
+	Issuer  string
+	Subject string
+	Email   string
+}
+
+func (q *Queries) ClaimAccount(ctx context.Context, arg ClaimAccountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, claimAccount, arg.Issuer, arg.Subject, arg.Email)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Issuer,
+		&i.Subject,
+		&i.Email,
+		&i.Namespace,
+	)
+	return i, err
+}
+
+const createAccount = `-- name: CreateAccount :one
+insert into accounts (issuer, subject, email, namespace)
+values ($1, $2, $3, gen_random_uuid()::text) on conflict (issuer, subject)
+where subject <> '' do
+update
+set email = excluded.email
+returning id, issuer, subject, email, namespace
+`
+
+type CreateAccountParams struct {
+	Issuer  string
+	Subject string
+	Email   string
+}
+
+func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, createAccount, arg.Issuer, arg.Subject, arg.Email)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Issuer,
+		&i.Subject,
+		&i.Email,
+		&i.Namespace,
+	)
+	return i, err
+}
+
+const getAccount = `-- name: GetAccount :one
+select id, issuer, subject, email, namespace
+from accounts
+where issuer = $1
+    and subject = $2
+`
+
+type GetAccountParams struct {
+	Issuer  string
+	Subject string
+}
+
+func (q *Queries) GetAccount(ctx context.Context, arg GetAccountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getAccount, arg.Issuer, arg.Subject)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Issuer,
+		&i.Subject,
+		&i.Email,
+		&i.Namespace,
+	)
+	return i, err
+}
+
+const updateAccountEmail = `-- name: UpdateAccountEmail :one
+update accounts
+set email = $3
+where issuer = $1
+    and subject = $2
+returning id, issuer, subject, email, namespace
+`
+
+type UpdateAccountEmailParams struct {
+	Issuer  string
+	Subject string
+	Email   string
+}
+
+func (q *Queries) UpdateAccountEmail(ctx context.Context, arg UpdateAccountEmailParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountEmail, arg.Issuer, arg.Subject, arg.Email)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Issuer,
+		&i.Subject,
+		&i.Email,
+		&i.Namespace,
+	)
+	return i, err
+}
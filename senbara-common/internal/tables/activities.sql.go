@@ -74,11 +74,12 @@ func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams)
 	return i, err
 }
 
-const deleteActivitesForContact = `-- name: DeleteActivitesForContact :exec
+const deleteActivitesForContact = `-- name: DeleteActivitesForContact :many
 delete from activities using contacts
 where activities.contact_id = contacts.id
     and contacts.id = $1
     and contacts.namespace = $2
+returning activities.id
 `
 
 type DeleteActivitesForContactParams struct {
@@ -86,9 +87,27 @@ type DeleteActivitesForContactParams struct {
 	Namespace string
 }
 
-func (q *Queries) DeleteActivitesForContact(ctx context.Context, arg DeleteActivitesForContactParams) error {
-	_, err := q.db.ExecContext(ctx, deleteActivitesForContact, arg.ID, arg.Namespace)
-	return err
+func (q *Queries) DeleteActivitesForContact(ctx context.Context, arg DeleteActivitesForContactParams) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, deleteActivitesForContact, arg.ID, arg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const deleteActivitiesForNamespace = `-- name: DeleteActivitiesForNamespace :many
@@ -243,6 +262,96 @@ func (q *Queries) GetActivitiesExportForNamespace(ctx context.Context, namespace
 	return items, nil
 }
 
+const getActivitiesForNamespace = `-- name: GetActivitiesForNamespace :many
+select activities.id as activity_id,
+    activities.name,
+    activities.date,
+    activities.description,
+    contacts.id as contact_id,
+    contacts.first_name,
+    contacts.last_name
+from contacts
+    inner join activities on activities.contact_id = contacts.id
+where contacts.namespace = $1
+    and (
+        $2::integer = 0
+        or contacts.id = $2
+    )
+    and (
+        $3::date is null
+        or activities.date::date >= $3
+    )
+    and (
+        $4::date is null
+        or activities.date::date <= $4
+    )
+    and (
+        $5::text = ''
+        or position(
+            lower($5) in lower(
+                activities.name || ' ' || activities.description || ' ' || contacts.first_name || ' ' || contacts.last_name
+            )
+        ) > 0
+    )
+order by activities.date desc,
+    activities.id desc
+`
+
+type GetActivitiesForNamespaceParams struct {
+	Namespace string
+	ContactID int32
+	StartDate sql.NullTime
+	EndDate   sql.NullTime
+	Query     string
+}
+
+type GetActivitiesForNamespaceRow struct {
+	ActivityID  int32
+	Name        string
+	Date        time.Time
+	Description string
+	ContactID   int32
+	FirstName   string
+	LastName    string
+}
+
+func (q *Queries) GetActivitiesForNamespace(ctx context.Context, arg GetActivitiesForNamespaceParams) ([]GetActivitiesForNamespaceRow, error) {
+	rows, err := q.db.QueryContext(ctx, getActivitiesForNamespace,
+		arg.Namespace,
+		arg.ContactID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.Query,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetActivitiesForNamespaceRow
+	for rows.Next() {
+		var i GetActivitiesForNamespaceRow
+		if err := rows.Scan(
+			&i.ActivityID,
+			&i.Name,
+			&i.Date,
+			&i.Description,
+			&i.ContactID,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getActivityAndContact = `-- name: GetActivityAndContact :one
 select activities.id as activity_id,
     activities.name,
@@ -287,6 +396,51 @@ func (q *Queries) GetActivityAndContact(ctx context.Context, arg GetActivityAndC
 	return i, err
 }
 
+const getActivityAndContactForUpdate = `-- name: GetActivityAndContactForUpdate :one
+select activities.id as activity_id,
+    activities.name,
+    activities.date,
+    activities.description,
+    contacts.id as contact_id,
+    contacts.first_name,
+    contacts.last_name
+from contacts
+    inner join activities on activities.contact_id = contacts.id
+where activities.id = $1
+    and contacts.namespace = $2
+for update of activities
+`
+
+type GetActivityAndContactForUpdateParams struct {
+	ID        int32
+	Namespace string
+}
+
+type GetActivityAndContactForUpdateRow struct {
+	ActivityID  int32
+	Name        string
+	Date        time.Time
+	Description string
+	ContactID   int32
+	FirstName   string
+	LastName    string
+}
+
+func (q *Queries) GetActivityAndContactForUpdate(ctx context.Context, arg GetActivityAndContactForUpdateParams) (GetActivityAndContactForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, getActivityAndContactForUpdate, arg.ID, arg.Namespace)
+	var i GetActivityAndContactForUpdateRow
+	err := row.Scan(
+		&i.ActivityID,
+		&i.Name,
+		&i.Date,
+		&i.Description,
+		&i.ContactID,
+		&i.FirstName,
+		&i.LastName,
+	)
+	return i, err
+}
+
 const updateActivity = `-- name: UpdateActivity :one
 update activities
 set name = $3,
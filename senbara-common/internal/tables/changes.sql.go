@@ -0,0 +1,19 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: changes.sql
+
+package tables
+
+import (
+	"context"
+)
+
+const notifyChange = `-- name: NotifyChange :exec
+select pg_notify('senbara_changes', $1::text)
+`
+
+func (q *Queries) NotifyChange(ctx context.Context, change string) error {
+	_, err := q.db.ExecContext(ctx, notifyChange, change)
+	return err
+}
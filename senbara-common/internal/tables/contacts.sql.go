@@ -20,7 +20,7 @@ insert into contacts (
         namespace
     )
 values ($1, $2, $3, $4, $5, $6)
-returning id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes
+returning id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes, change_sequence
 `
 
 type CreateContactParams struct {
@@ -53,6 +53,7 @@ func (q *Queries) CreateContact(ctx context.Context, arg CreateContactParams) (C
 		&i.Birthday,
 		&i.Address,
 		&i.Notes,
+		&i.ChangeSequence,
 	)
 	return i, err
 }
@@ -106,7 +107,7 @@ func (q *Queries) DeleteContactsForNamespace(ctx context.Context, namespace stri
 }
 
 const getContact = `-- name: GetContact :one
-select id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes
+select id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes, change_sequence
 from contacts
 where id = $1
     and namespace = $2
@@ -131,12 +132,45 @@ func (q *Queries) GetContact(ctx context.Context, arg GetContactParams) (Contact
 		&i.Birthday,
 		&i.Address,
 		&i.Notes,
+		&i.ChangeSequence,
+	)
+	return i, err
+}
+
+const getContactForUpdate = `-- name: GetContactForUpdate :one
+select id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes, change_sequence
+from contacts
+where id = $1
+    and namespace = $2
+for update
+`
+
+type GetContactForUpdateParams struct {
+	ID        int32
+	Namespace string
+}
+
+func (q *Queries) GetContactForUpdate(ctx context.Context, arg GetContactForUpdateParams) (Contact, error) {
+	row := q.db.QueryRowContext(ctx, getContactForUpdate, arg.ID, arg.Namespace)
+	var i Contact
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Nickname,
+		&i.Email,
+		&i.Pronouns,
+		&i.Namespace,
+		&i.Birthday,
+		&i.Address,
+		&i.Notes,
+		&i.ChangeSequence,
 	)
 	return i, err
 }
 
 const getContacts = `-- name: GetContacts :many
-select id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes
+select id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes, change_sequence
 from contacts
 where namespace = $1
 order by first_name desc
@@ -162,6 +196,7 @@ func (q *Queries) GetContacts(ctx context.Context, namespace string) ([]Contact,
 			&i.Birthday,
 			&i.Address,
 			&i.Notes,
+			&i.ChangeSequence,
 		); err != nil {
 			return nil, err
 		}
@@ -178,24 +213,25 @@ func (q *Queries) GetContacts(ctx context.Context, namespace string) ([]Contact,
 
 const getContactsExportForNamespace = `-- name: GetContactsExportForNamespace :many
 select 'contacts' as table_name,
-    id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes
+    id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes, change_sequence
 from contacts
 where namespace = $1
 order by first_name desc
 `
 
 type GetContactsExportForNamespaceRow struct {
-	TableName string
-	ID        int32
-	FirstName string
-	LastName  string
-	Nickname  string
-	Email     string
-	Pronouns  string
-	Namespace string
-	Birthday  sql.NullTime
-	Address   string
-	Notes     string
+	TableName      string
+	ID             int32
+	FirstName      string
+	LastName       string
+	Nickname       string
+	Email          string
+	Pronouns       string
+	Namespace      string
+	Birthday       sql.NullTime
+	Address        string
+	Notes          string
+	ChangeSequence int64
 }
 
 func (q *Queries) GetContactsExportForNamespace(ctx context.Context, namespace string) ([]GetContactsExportForNamespaceRow, error) {
@@ -219,6 +255,7 @@ func (q *Queries) GetContactsExportForNamespace(ctx context.Context, namespace s
 			&i.Birthday,
 			&i.Address,
 			&i.Notes,
+			&i.ChangeSequence,
 		); err != nil {
 			return nil, err
 		}
@@ -245,7 +282,7 @@ set first_name = $3,
     notes = $10
 where id = $1
     and namespace = $2
-returning id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes
+returning id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes, change_sequence
 `
 
 type UpdateContactParams struct {
@@ -286,6 +323,7 @@ func (q *Queries) UpdateContact(ctx context.Context, arg UpdateContactParams) (C
 		&i.Birthday,
 		&i.Address,
 		&i.Notes,
+		&i.ChangeSequence,
 	)
 	return i, err
 }
@@ -8,6 +8,7 @@ package tables
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 const createDebt = `-- name: CreateDebt :one
@@ -73,11 +74,12 @@ func (q *Queries) CreateDebt(ctx context.Context, arg CreateDebtParams) (CreateD
 	return i, err
 }
 
-const deleteDebtsForContact = `-- name: DeleteDebtsForContact :exec
+const deleteDebtsForContact = `-- name: DeleteDebtsForContact :many
 delete from debts using contacts
 where debts.contact_id = contacts.id
     and contacts.id = $1
     and contacts.namespace = $2
+returning debts.id
 `
 
 type DeleteDebtsForContactParams struct {
@@ -85,9 +87,27 @@ type DeleteDebtsForContactParams struct {
 	Namespace string
 }
 
-func (q *Queries) DeleteDebtsForContact(ctx context.Context, arg DeleteDebtsForContactParams) error {
-	_, err := q.db.ExecContext(ctx, deleteDebtsForContact, arg.ID, arg.Namespace)
-	return err
+func (q *Queries) DeleteDebtsForContact(ctx context.Context, arg DeleteDebtsForContactParams) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, deleteDebtsForContact, arg.ID, arg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const deleteDebtsForNamespace = `-- name: DeleteDebtsForNamespace :many
@@ -164,6 +184,51 @@ func (q *Queries) GetDebtAndContact(ctx context.Context, arg GetDebtAndContactPa
 	return i, err
 }
 
+const getDebtAndContactForUpdate = `-- name: GetDebtAndContactForUpdate :one
+select debts.id as debt_id,
+    debts.amount,
+    debts.currency,
+    debts.description,
+    contacts.id as contact_id,
+    contacts.first_name,
+    contacts.last_name
+from contacts
+    inner join debts on debts.contact_id = contacts.id
+where debts.id = $1
+    and contacts.namespace = $2
+for update of debts
+`
+
+type GetDebtAndContactForUpdateParams struct {
+	ID        int32
+	Namespace string
+}
+
+type GetDebtAndContactForUpdateRow struct {
+	DebtID      int32
+	Amount      float64
+	Currency    string
+	Description string
+	ContactID   int32
+	FirstName   string
+	LastName    string
+}
+
+func (q *Queries) GetDebtAndContactForUpdate(ctx context.Context, arg GetDebtAndContactForUpdateParams) (GetDebtAndContactForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, getDebtAndContactForUpdate, arg.ID, arg.Namespace)
+	var i GetDebtAndContactForUpdateRow
+	err := row.Scan(
+		&i.DebtID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.ContactID,
+		&i.FirstName,
+		&i.LastName,
+	)
+	return i, err
+}
+
 const getDebts = `-- name: GetDebts :many
 select debts.id,
     debts.amount,
@@ -266,6 +331,110 @@ func (q *Queries) GetDebtsExportForNamespace(ctx context.Context, namespace stri
 	return items, nil
 }
 
+const getDebtsForNamespace = `-- name: GetDebtsForNamespace :many
+select debts.id as debt_id,
+    debts.amount,
+    debts.currency,
+    debts.description,
+    debts.created_at,
+    contacts.id as contact_id,
+    contacts.first_name,
+    contacts.last_name
+from contacts
+    inner join debts on debts.contact_id = contacts.id
+where contacts.namespace = $1
+    and (
+        $2::integer = 0
+        or contacts.id = $2
+    )
+    and (
+        $3::text = ''
+        or lower(debts.currency) = lower($3)
+    )
+    and (
+        $4::boolean is null
+        or (debts.amount <= 0) = $4
+    )
+    and (
+        $5::date is null
+        or debts.created_at::date >= $5
+    )
+    and (
+        $6::date is null
+        or debts.created_at::date <= $6
+    )
+    and (
+        $7::text = ''
+        or position(
+            lower($7) in lower(
+                debts.description || ' ' || contacts.first_name || ' ' || contacts.last_name
+            )
+        ) > 0
+    )
+order by debts.id desc
+`
+
+type GetDebtsForNamespaceParams struct {
+	Namespace string
+	ContactID int32
+	Currency  string
+	YouOwe    sql.NullBool
+	StartDate sql.NullTime
+	EndDate   sql.NullTime
+	Query     string
+}
+
+type GetDebtsForNamespaceRow struct {
+	DebtID      int32
+	Amount      float64
+	Currency    string
+	Description string
+	CreatedAt   time.Time
+	ContactID   int32
+	FirstName   string
+	LastName    string
+}
+
+func (q *Queries) GetDebtsForNamespace(ctx context.Context, arg GetDebtsForNamespaceParams) ([]GetDebtsForNamespaceRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDebtsForNamespace,
+		arg.Namespace,
+		arg.ContactID,
+		arg.Currency,
+		arg.YouOwe,
+		arg.StartDate,
+		arg.EndDate,
+		arg.Query,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDebtsForNamespaceRow
+	for rows.Next() {
+		var i GetDebtsForNamespaceRow
+		if err := rows.Scan(
+			&i.DebtID,
+			&i.Amount,
+			&i.Currency,
+			&i.Description,
+			&i.CreatedAt,
+			&i.ContactID,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const settleDebt = `-- name: SettleDebt :one
 delete from debts using contacts
 where debts.id = $1
@@ -11,16 +11,27 @@ import (
 )
 
 const createJournalEntry = `-- name: CreateJournalEntry :one
-insert into journal_entries (title, body, rating, namespace)
-values ($1, $2, $3, $4)
-returning id, title, date, body, rating, namespace
+insert into journal_entries (
+        title,
+        body,
+        rating,
+        namespace,
+        encryption_algorithm,
+        encryption_kdf,
+        encryption_salt
+    )
+values ($1, $2, $3, $4, $5, $6, $7)
+returning id, title, date, body, rating, namespace, encryption_algorithm, encryption_kdf, encryption_salt, change_sequence
 `
 
 type CreateJournalEntryParams struct {
-	Title     string
-	Body      string
-	Rating    int32
-	Namespace string
+	Title               string
+	Body                string
+	Rating              int32
+	Namespace           string
+	EncryptionAlgorithm string
+	EncryptionKdf       string
+	EncryptionSalt      string
 }
 
 func (q *Queries) CreateJournalEntry(ctx context.Context, arg CreateJournalEntryParams) (JournalEntry, error) {
@@ -29,6 +40,9 @@ func (q *Queries) CreateJournalEntry(ctx context.Context, arg CreateJournalEntry
 		arg.Body,
 		arg.Rating,
 		arg.Namespace,
+		arg.EncryptionAlgorithm,
+		arg.EncryptionKdf,
+		arg.EncryptionSalt,
 	)
 	var i JournalEntry
 	err := row.Scan(
@@ -38,6 +52,10 @@ func (q *Queries) CreateJournalEntry(ctx context.Context, arg CreateJournalEntry
 		&i.Body,
 		&i.Rating,
 		&i.Namespace,
+		&i.EncryptionAlgorithm,
+		&i.EncryptionKdf,
+		&i.EncryptionSalt,
+		&i.ChangeSequence,
 	)
 	return i, err
 }
@@ -91,7 +109,7 @@ func (q *Queries) DeleteJournalEntry(ctx context.Context, arg DeleteJournalEntry
 }
 
 const getJournalEntries = `-- name: GetJournalEntries :many
-select id, title, date, body, rating, namespace
+select id, title, date, body, rating, namespace, encryption_algorithm, encryption_kdf, encryption_salt, change_sequence
 from journal_entries
 where namespace = $1
 order by date desc
@@ -113,6 +131,10 @@ func (q *Queries) GetJournalEntries(ctx context.Context, namespace string) ([]Jo
 			&i.Body,
 			&i.Rating,
 			&i.Namespace,
+			&i.EncryptionAlgorithm,
+			&i.EncryptionKdf,
+			&i.EncryptionSalt,
+			&i.ChangeSequence,
 		); err != nil {
 			return nil, err
 		}
@@ -129,20 +151,24 @@ func (q *Queries) GetJournalEntries(ctx context.Context, namespace string) ([]Jo
 
 const getJournalEntriesExportForNamespace = `-- name: GetJournalEntriesExportForNamespace :many
 select 'journal_entries' as table_name,
-    id, title, date, body, rating, namespace
+    id, title, date, body, rating, namespace, encryption_algorithm, encryption_kdf, encryption_salt, change_sequence
 from journal_entries
 where namespace = $1
 order by date desc
 `
 
 type GetJournalEntriesExportForNamespaceRow struct {
-	TableName string
-	ID        int32
-	Title     string
-	Date      time.Time
-	Body      string
-	Rating    int32
-	Namespace string
+	TableName           string
+	ID                  int32
+	Title               string
+	Date                time.Time
+	Body                string
+	Rating              int32
+	Namespace           string
+	EncryptionAlgorithm string
+	EncryptionKdf       string
+	EncryptionSalt      string
+	ChangeSequence      int64
 }
 
 func (q *Queries) GetJournalEntriesExportForNamespace(ctx context.Context, namespace string) ([]GetJournalEntriesExportForNamespaceRow, error) {
@@ -162,6 +188,10 @@ func (q *Queries) GetJournalEntriesExportForNamespace(ctx context.Context, names
 			&i.Body,
 			&i.Rating,
 			&i.Namespace,
+			&i.EncryptionAlgorithm,
+			&i.EncryptionKdf,
+			&i.EncryptionSalt,
+			&i.ChangeSequence,
 		); err != nil {
 			return nil, err
 		}
@@ -177,7 +207,7 @@ func (q *Queries) GetJournalEntriesExportForNamespace(ctx context.Context, names
 }
 
 const getJournalEntry = `-- name: GetJournalEntry :one
-select id, title, date, body, rating, namespace
+select id, title, date, body, rating, namespace, encryption_algorithm, encryption_kdf, encryption_salt, change_sequence
 from journal_entries
 where id = $1
     and namespace = $2
@@ -198,6 +228,41 @@ func (q *Queries) GetJournalEntry(ctx context.Context, arg GetJournalEntryParams
 		&i.Body,
 		&i.Rating,
 		&i.Namespace,
+		&i.EncryptionAlgorithm,
+		&i.EncryptionKdf,
+		&i.EncryptionSalt,
+		&i.ChangeSequence,
+	)
+	return i, err
+}
+
+const getJournalEntryForUpdate = `-- name: GetJournalEntryForUpdate :one
+select id, title, date, body, rating, namespace, encryption_algorithm, encryption_kdf, encryption_salt, change_sequence
+from journal_entries
+where id = $1
+    and namespace = $2
+for update
+`
+
+type GetJournalEntryForUpdateParams struct {
+	ID        int32
+	Namespace string
+}
+
+func (q *Queries) GetJournalEntryForUpdate(ctx context.Context, arg GetJournalEntryForUpdateParams) (JournalEntry, error) {
+	row := q.db.QueryRowContext(ctx, getJournalEntryForUpdate, arg.ID, arg.Namespace)
+	var i JournalEntry
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Date,
+		&i.Body,
+		&i.Rating,
+		&i.Namespace,
+		&i.EncryptionAlgorithm,
+		&i.EncryptionKdf,
+		&i.EncryptionSalt,
+		&i.ChangeSequence,
 	)
 	return i, err
 }
@@ -206,18 +271,24 @@ const updateJournalEntry = `-- name: UpdateJournalEntry :one
 update journal_entries
 set title = $3,
     body = $4,
-    rating = $5
+    rating = $5,
+    encryption_algorithm = $6,
+    encryption_kdf = $7,
+    encryption_salt = $8
 where id = $1
     and namespace = $2
-returning id, title, date, body, rating, namespace
+returning id, title, date, body, rating, namespace, encryption_algorithm, encryption_kdf, encryption_salt, change_sequence
 `
 
 type UpdateJournalEntryParams struct {
-	ID        int32
-	Namespace string
-	Title     string
-	Body      string
-	Rating    int32
+	ID                  int32
+	Namespace           string
+	Title               string
+	Body                string
+	Rating              int32
+	EncryptionAlgorithm string
+	EncryptionKdf       string
+	EncryptionSalt      string
 }
 
 func (q *Queries) UpdateJournalEntry(ctx context.Context, arg UpdateJournalEntryParams) (JournalEntry, error) {
@@ -227,6 +298,9 @@ func (q *Queries) UpdateJournalEntry(ctx context.Context, arg UpdateJournalEntry
 		arg.Title,
 		arg.Body,
 		arg.Rating,
+		arg.EncryptionAlgorithm,
+		arg.EncryptionKdf,
+		arg.EncryptionSalt,
 	)
 	var i JournalEntry
 	err := row.Scan(
@@ -236,6 +310,10 @@ func (q *Queries) UpdateJournalEntry(ctx context.Context, arg UpdateJournalEntry
 		&i.Body,
 		&i.Rating,
 		&i.Namespace,
+		&i.EncryptionAlgorithm,
+		&i.EncryptionKdf,
+		&i.EncryptionSalt,
+		&i.ChangeSequence,
 	)
 	return i, err
 }
@@ -6,43 +6,144 @@ package tables
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
-type Activity struct {
-	ID          int32
-	Name        string
-	Date        time.Time
-	ContactID   int32
-	Description string
+type AccessToken struct {
+	ID         int32
+	AccountID  int32
+	Name       string
+	Scope      string
+	TokenHash  string
+	CreatedAt  time.Time
+	LastUsedAt sql.NullTime
 }
 
-type Contact struct {
+type Account struct {
 	ID        int32
-	FirstName string
-	LastName  string
-	Nickname  string
+	Issuer    string
+	Subject   string
 	Email     string
-	Pronouns  string
 	Namespace string
-	Birthday  sql.NullTime
-	Address   string
-	Notes     string
+}
+
+type Activity struct {
+	ID             int32
+	Name           string
+	Date           time.Time
+	ContactID      int32
+	Description    string
+	ChangeSequence int64
+}
+
+type Contact struct {
+	ID             int32
+	FirstName      string
+	LastName       string
+	Nickname       string
+	Email          string
+	Pronouns       string
+	Namespace      string
+	Birthday       sql.NullTime
+	Address        string
+	Notes          string
+	ChangeSequence int64
 }
 
 type Debt struct {
-	ID          int32
-	Amount      float64
-	Currency    string
-	ContactID   int32
-	Description string
+	ID             int32
+	Amount         float64
+	Currency       string
+	ContactID      int32
+	Description    string
+	ChangeSequence int64
+	CreatedAt      time.Time
 }
 
 type JournalEntry struct {
+	ID                  int32
+	Title               string
+	Date                time.Time
+	Body                string
+	Rating              int32
+	Namespace           string
+	EncryptionAlgorithm string
+	EncryptionKdf       string
+	EncryptionSalt      string
+	ChangeSequence      int64
+}
+
+type Session struct {
+	ID            int32
+	AccountID     int32
+	SessionKey    string
+	OidcSessionID string
+	ClientID      string
+	UserAgent     string
+	CreatedAt     time.Time
+	LastSeenAt    time.Time
+	RevokedAt     sql.NullTime
+}
+
+type Space struct {
 	ID        int32
-	Title     string
-	Date      time.Time
-	Body      string
-	Rating    int32
+	Name      string
 	Namespace string
+	Personal  bool
+}
+
+type SpaceInvitation struct {
+	ID      int32
+	SpaceID int32
+	Email   string
+	Role    string
+}
+
+type SpaceMember struct {
+	SpaceID   int32
+	AccountID int32
+	Role      string
+}
+
+type Tombstone struct {
+	ID             int64
+	Namespace      string
+	EntityType     string
+	EntityID       int32
+	ChangeSequence int64
+	DeletedAt      time.Time
+}
+
+type Webhook struct {
+	ID         int32
+	Namespace  string
+	Url        string
+	Secret     string
+	EventTypes []string
+	CreatedAt  time.Time
+}
+
+type WebhookDelivery struct {
+	ID         int64
+	WebhookID  int32
+	OutboxID   int64
+	EventType  string
+	Attempt    int32
+	StatusCode int32
+	Error      string
+	DurationMs int32
+	CreatedAt  time.Time
+}
+
+type WebhookOutbox struct {
+	ID            int64
+	WebhookID     int32
+	EventType     string
+	Payload       json.RawMessage
+	CreatedAt     time.Time
+	Attempts      int32
+	NextAttemptAt time.Time
+	DeliveredAt   sql.NullTime
+	FailedAt      sql.NullTime
 }
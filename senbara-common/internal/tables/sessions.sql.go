@@ -0,0 +1,213 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: sessions.sql
+
+package tables
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const getSessions = `-- name: GetSessions :many
+select sessions.id,
+    sessions.client_id,
+    sessions.user_agent,
+    sessions.created_at,
+    sessions.last_seen_at
+from sessions
+    join accounts on accounts.id = sessions.account_id
+where accounts.namespace = $1
+    and sessions.revoked_at is null
+order by sessions.last_seen_at desc
+`
+
+type GetSessionsRow struct {
+	ID         int32
+	ClientID   string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+}
+
+func (q *Queries) GetSessions(ctx context.Context, namespace string) ([]GetSessionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSessions, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSessionsRow
+	for rows.Next() {
+		var i GetSessionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientID,
+			&i.UserAgent,
+			&i.CreatedAt,
+			&i.LastSeenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeOIDCSessions = `-- name: RevokeOIDCSessions :many
+update sessions
+set revoked_at = current_timestamp
+from accounts
+where sessions.account_id = accounts.id
+    and accounts.issuer = $1
+    and (
+        $2::text = ''
+        or accounts.subject = $2
+    )
+    and (
+        $3::text = ''
+        or sessions.oidc_session_id = $3
+    )
+    and sessions.revoked_at is null
+returning sessions.id
+`
+
+type RevokeOIDCSessionsParams struct {
+	Issuer        string
+	Subject       string
+	OidcSessionID string
+}
+
+func (q *Queries) RevokeOIDCSessions(ctx context.Context, arg RevokeOIDCSessionsParams) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, revokeOIDCSessions, arg.Issuer, arg.Subject, arg.OidcSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeSession = `-- name: RevokeSession :one
+update sessions
+set revoked_at = current_timestamp
+from accounts
+where sessions.id = $1
+    and sessions.account_id = accounts.id
+    and accounts.namespace = $2
+    and sessions.revoked_at is null
+returning sessions.id
+`
+
+type RevokeSessionParams struct {
+	ID        int32
+	Namespace string
+}
+
+func (q *Queries) RevokeSession(ctx context.Context, arg RevokeSessionParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, revokeSession, arg.ID, arg.Namespace)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const revokeSessions = `-- name: RevokeSessions :many
+update sessions
+set revoked_at = current_timestamp
+from accounts
+where sessions.account_id = accounts.id
+    and accounts.namespace = $1
+    and sessions.revoked_at is null
+returning sessions.id
+`
+
+func (q *Queries) RevokeSessions(ctx context.Context, namespace string) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, revokeSessions, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const touchSession = `-- name: TouchSession :one
+insert into sessions (
+        account_id,
+        session_key,
+        oidc_session_id,
+        client_id,
+        user_agent
+    )
+select accounts.id,
+    $2,
+    $3,
+    $4,
+    $5
+from accounts
+where accounts.namespace = $1 on conflict (session_key) do
+update
+set user_agent = excluded.user_agent,
+    last_seen_at = current_timestamp
+returning sessions.id,
+    sessions.revoked_at
+`
+
+type TouchSessionParams struct {
+	Namespace     string
+	SessionKey    string
+	OidcSessionID string
+	ClientID      string
+	UserAgent     string
+}
+
+type TouchSessionRow struct {
+	ID        int32
+	RevokedAt sql.NullTime
+}
+
+func (q *Queries) TouchSession(ctx context.Context, arg TouchSessionParams) (TouchSessionRow, error) {
+	row := q.db.QueryRowContext(ctx, touchSession,
+		arg.Namespace,
+		arg.SessionKey,
+		arg.OidcSessionID,
+		arg.ClientID,
+		arg.UserAgent,
+	)
+	var i TouchSessionRow
+	err := row.Scan(&i.ID, &i.RevokedAt)
+	return i, err
+}
@@ -0,0 +1,366 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: spaces.sql
+
+package tables
+
+import (
+	"context"
+)
+
+const createPersonalSpace = `-- name: CreatePersonalSpace :one
+with space as (
+    insert into spaces (name, namespace, personal)
+    values ($1, $2, true)
+    returning id
+)
+insert into space_members (space_id, account_id, role)
+select space.id,
+    accounts.id,
+    'owner'
+from space,
+    accounts
+where accounts.namespace = $2
+returning space_id
+`
+
+type CreatePersonalSpaceParams struct {
+	Name      string
+	Namespace string
+}
+
+func (q *Queries) CreatePersonalSpace(ctx context.Context, arg CreatePersonalSpaceParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, createPersonalSpace, arg.Name, arg.Namespace)
+	var space_id int32
+	err := row.Scan(&space_id)
+	return space_id, err
+}
+
+const createSpace = `-- name: CreateSpace :one
+insert into spaces (name, namespace)
+values ($1, gen_random_uuid()::text)
+returning id, name, namespace, personal
+`
+
+func (q *Queries) CreateSpace(ctx context.Context, name string) (Space, error) {
+	row := q.db.QueryRowContext(ctx, createSpace, name)
+	var i Space
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Namespace,
+		&i.Personal,
+	)
+	return i, err
+}
+
+const createSpaceInvitation = `-- name: CreateSpaceInvitation :one
+insert into space_invitations (space_id, email, role)
+values ($1, $2, $3) on conflict (space_id, email) do
+update
+set role = excluded.role
+returning id, space_id, email, role
+`
+
+type CreateSpaceInvitationParams struct {
+	SpaceID int32
+	Email   string
+	Role    string
+}
+
+func (q *Queries) CreateSpaceInvitation(ctx context.Context, arg CreateSpaceInvitationParams) (SpaceInvitation, error) {
+	row := q.db.QueryRowContext(ctx, createSpaceInvitation, arg.SpaceID, arg.Email, arg.Role)
+	var i SpaceInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.SpaceID,
+		&i.Email,
+		&i.Role,
+	)
+	return i, err
+}
+
+const createSpaceMember = `-- name: CreateSpaceMember :exec
+insert into space_members (space_id, account_id, role)
+select $1,
+    accounts.id,
+    $3
+from accounts
+where accounts.namespace = $2 on conflict (space_id, account_id) do nothing
+`
+
+type CreateSpaceMemberParams struct {
+	SpaceID   int32
+	Namespace string
+	Role      string
+}
+
+func (q *Queries) CreateSpaceMember(ctx context.Context, arg CreateSpaceMemberParams) error {
+	_, err := q.db.ExecContext(ctx, createSpaceMember, arg.SpaceID, arg.Namespace, arg.Role)
+	return err
+}
+
+const deleteSpace = `-- name: DeleteSpace :one
+delete from spaces
+where id = $1
+    and personal = false
+returning id
+`
+
+func (q *Queries) DeleteSpace(ctx context.Context, id int32) (int32, error) {
+	row := q.db.QueryRowContext(ctx, deleteSpace, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteSpaceInvitation = `-- name: DeleteSpaceInvitation :one
+delete from space_invitations
+where space_invitations.id = $1
+    and space_invitations.email = (
+        select accounts.email
+        from accounts
+        where accounts.namespace = $2
+    )
+returning id, space_id, email, role
+`
+
+type DeleteSpaceInvitationParams struct {
+	ID        int32
+	Namespace string
+}
+
+func (q *Queries) DeleteSpaceInvitation(ctx context.Context, arg DeleteSpaceInvitationParams) (SpaceInvitation, error) {
+	row := q.db.QueryRowContext(ctx, deleteSpaceInvitation, arg.ID, arg.Namespace)
+	var i SpaceInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.SpaceID,
+		&i.Email,
+		&i.Role,
+	)
+	return i, err
+}
+
+const deleteSpaceMember = `-- name: DeleteSpaceMember :one
+delete from space_members
+where space_id = $1
+    and account_id = $2
+    and role <> 'owner'
+returning account_id
+`
+
+type DeleteSpaceMemberParams struct {
+	SpaceID   int32
+	AccountID int32
+}
+
+func (q *Queries) DeleteSpaceMember(ctx context.Context, arg DeleteSpaceMemberParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, deleteSpaceMember, arg.SpaceID, arg.AccountID)
+	var account_id int32
+	err := row.Scan(&account_id)
+	return account_id, err
+}
+
+const deleteSpaceMemberForNamespace = `-- name: DeleteSpaceMemberForNamespace :one
+delete from space_members
+where space_id = $1
+    and account_id = (
+        select accounts.id
+        from accounts
+        where accounts.namespace = $2
+    )
+    and role <> 'owner'
+returning account_id
+`
+
+type DeleteSpaceMemberForNamespaceParams struct {
+	SpaceID   int32
+	Namespace string
+}
+
+func (q *Queries) DeleteSpaceMemberForNamespace(ctx context.Context, arg DeleteSpaceMemberForNamespaceParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, deleteSpaceMemberForNamespace, arg.SpaceID, arg.Namespace)
+	var account_id int32
+	err := row.Scan(&account_id)
+	return account_id, err
+}
+
+const getSpace = `-- name: GetSpace :one
+select spaces.id,
+    spaces.name,
+    spaces.namespace,
+    spaces.personal,
+    space_members.role
+from spaces
+    join space_members on space_members.space_id = spaces.id
+    join accounts on accounts.id = space_members.account_id
+where spaces.id = $1
+    and accounts.namespace = $2
+`
+
+type GetSpaceParams struct {
+	ID        int32
+	Namespace string
+}
+
+type GetSpaceRow struct {
+	ID        int32
+	Name      string
+	Namespace string
+	Personal  bool
+	Role      string
+}
+
+func (q *Queries) GetSpace(ctx context.Context, arg GetSpaceParams) (GetSpaceRow, error) {
+	row := q.db.QueryRowContext(ctx, getSpace, arg.ID, arg.Namespace)
+	var i GetSpaceRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Namespace,
+		&i.Personal,
+		&i.Role,
+	)
+	return i, err
+}
+
+const getSpaceInvitations = `-- name: GetSpaceInvitations :many
+select space_invitations.id,
+    space_invitations.space_id,
+    spaces.name as space_name,
+    space_invitations.email,
+    space_invitations.role
+from space_invitations
+    join spaces on spaces.id = space_invitations.space_id
+where space_invitations.email = (
+        select accounts.email
+        from accounts
+        where accounts.namespace = $1
+    )
+order by spaces.name asc
+`
+
+type GetSpaceInvitationsRow struct {
+	ID        int32
+	SpaceID   int32
+	SpaceName string
+	Email     string
+	Role      string
+}
+
+func (q *Queries) GetSpaceInvitations(ctx context.Context, namespace string) ([]GetSpaceInvitationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSpaceInvitations, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSpaceInvitationsRow
+	for rows.Next() {
+		var i GetSpaceInvitationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SpaceID,
+			&i.SpaceName,
+			&i.Email,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSpaceMembers = `-- name: GetSpaceMembers :many
+select accounts.id,
+    accounts.email,
+    space_members.role
+from space_members
+    join accounts on accounts.id = space_members.account_id
+where space_members.space_id = $1
+order by accounts.email asc
+`
+
+type GetSpaceMembersRow struct {
+	ID    int32
+	Email string
+	Role  string
+}
+
+func (q *Queries) GetSpaceMembers(ctx context.Context, spaceID int32) ([]GetSpaceMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSpaceMembers, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSpaceMembersRow
+	for rows.Next() {
+		var i GetSpaceMembersRow
+		if err := rows.Scan(&i.ID, &i.Email, &i.Role); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSpaces = `-- name: GetSpaces :many
+select spaces.id,
+    spaces.name,
+    spaces.personal,
+    space_members.role
+from spaces
+    join space_members on space_members.space_id = spaces.id
+    join accounts on accounts.id = space_members.account_id
+where accounts.namespace = $1
+order by spaces.personal desc,
+    spaces.name asc
+`
+
+type GetSpacesRow struct {
+	ID       int32
+	Name     string
+	Personal bool
+	Role     string
+}
+
+func (q *Queries) GetSpaces(ctx context.Context, namespace string) ([]GetSpacesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSpaces, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSpacesRow
+	for rows.Next() {
+		var i GetSpacesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Personal,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,363 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: sync.sql
+
+package tables
+
+import (
+	"context"
+	"time"
+)
+
+const deleteTombstonesForNamespace = `-- name: DeleteTombstonesForNamespace :exec
+delete from tombstones
+where namespace = $1
+`
+
+func (q *Queries) DeleteTombstonesForNamespace(ctx context.Context, namespace string) error {
+	_, err := q.db.ExecContext(ctx, deleteTombstonesForNamespace, namespace)
+	return err
+}
+
+const getActivitiesChangedSince = `-- name: GetActivitiesChangedSince :many
+select activities.id,
+    activities.name,
+    activities.date,
+    activities.description,
+    activities.contact_id,
+    activities.change_sequence
+from contacts
+    inner join activities on activities.contact_id = contacts.id
+where contacts.namespace = $1
+    and activities.change_sequence > $2::bigint
+order by activities.change_sequence
+`
+
+type GetActivitiesChangedSinceParams struct {
+	Namespace string
+	Since     int64
+}
+
+type GetActivitiesChangedSinceRow struct {
+	ID             int32
+	Name           string
+	Date           time.Time
+	Description    string
+	ContactID      int32
+	ChangeSequence int64
+}
+
+func (q *Queries) GetActivitiesChangedSince(ctx context.Context, arg GetActivitiesChangedSinceParams) ([]GetActivitiesChangedSinceRow, error) {
+	rows, err := q.db.QueryContext(ctx, getActivitiesChangedSince, arg.Namespace, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetActivitiesChangedSinceRow
+	for rows.Next() {
+		var i GetActivitiesChangedSinceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Date,
+			&i.Description,
+			&i.ContactID,
+			&i.ChangeSequence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActivityChangeSequence = `-- name: GetActivityChangeSequence :one
+select activities.change_sequence
+from contacts
+    inner join activities on activities.contact_id = contacts.id
+where activities.id = $1
+    and contacts.namespace = $2
+`
+
+type GetActivityChangeSequenceParams struct {
+	ID        int32
+	Namespace string
+}
+
+func (q *Queries) GetActivityChangeSequence(ctx context.Context, arg GetActivityChangeSequenceParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getActivityChangeSequence, arg.ID, arg.Namespace)
+	var change_sequence int64
+	err := row.Scan(&change_sequence)
+	return change_sequence, err
+}
+
+const getContactChangeSequence = `-- name: GetContactChangeSequence :one
+select change_sequence
+from contacts
+where id = $1
+    and namespace = $2
+`
+
+type GetContactChangeSequenceParams struct {
+	ID        int32
+	Namespace string
+}
+
+func (q *Queries) GetContactChangeSequence(ctx context.Context, arg GetContactChangeSequenceParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getContactChangeSequence, arg.ID, arg.Namespace)
+	var change_sequence int64
+	err := row.Scan(&change_sequence)
+	return change_sequence, err
+}
+
+const getContactsChangedSince = `-- name: GetContactsChangedSince :many
+select id, first_name, last_name, nickname, email, pronouns, namespace, birthday, address, notes, change_sequence
+from contacts
+where namespace = $1
+    and change_sequence > $2::bigint
+order by change_sequence
+`
+
+type GetContactsChangedSinceParams struct {
+	Namespace string
+	Since     int64
+}
+
+func (q *Queries) GetContactsChangedSince(ctx context.Context, arg GetContactsChangedSinceParams) ([]Contact, error) {
+	rows, err := q.db.QueryContext(ctx, getContactsChangedSince, arg.Namespace, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Contact
+	for rows.Next() {
+		var i Contact
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Nickname,
+			&i.Email,
+			&i.Pronouns,
+			&i.Namespace,
+			&i.Birthday,
+			&i.Address,
+			&i.Notes,
+			&i.ChangeSequence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDebtChangeSequence = `-- name: GetDebtChangeSequence :one
+select debts.change_sequence
+from contacts
+    inner join debts on debts.contact_id = contacts.id
+where debts.id = $1
+    and contacts.namespace = $2
+`
+
+type GetDebtChangeSequenceParams struct {
+	ID        int32
+	Namespace string
+}
+
+func (q *Queries) GetDebtChangeSequence(ctx context.Context, arg GetDebtChangeSequenceParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getDebtChangeSequence, arg.ID, arg.Namespace)
+	var change_sequence int64
+	err := row.Scan(&change_sequence)
+	return change_sequence, err
+}
+
+const getDebtsChangedSince = `-- name: GetDebtsChangedSince :many
+select debts.id,
+    debts.amount,
+    debts.currency,
+    debts.description,
+    debts.contact_id,
+    debts.change_sequence
+from contacts
+    inner join debts on debts.contact_id = contacts.id
+where contacts.namespace = $1
+    and debts.change_sequence > $2::bigint
+order by debts.change_sequence
+`
+
+type GetDebtsChangedSinceParams struct {
+	Namespace string
+	Since     int64
+}
+
+type GetDebtsChangedSinceRow struct {
+	ID             int32
+	Amount         float64
+	Currency       string
+	Description    string
+	ContactID      int32
+	ChangeSequence int64
+}
+
+func (q *Queries) GetDebtsChangedSince(ctx context.Context, arg GetDebtsChangedSinceParams) ([]GetDebtsChangedSinceRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDebtsChangedSince, arg.Namespace, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDebtsChangedSinceRow
+	for rows.Next() {
+		var i GetDebtsChangedSinceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Amount,
+			&i.Currency,
+			&i.Description,
+			&i.ContactID,
+			&i.ChangeSequence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJournalEntriesChangedSince = `-- name: GetJournalEntriesChangedSince :many
+select id, title, date, body, rating, namespace, encryption_algorithm, encryption_kdf, encryption_salt, change_sequence
+from journal_entries
+where namespace = $1
+    and change_sequence > $2::bigint
+order by change_sequence
+`
+
+type GetJournalEntriesChangedSinceParams struct {
+	Namespace string
+	Since     int64
+}
+
+func (q *Queries) GetJournalEntriesChangedSince(ctx context.Context, arg GetJournalEntriesChangedSinceParams) ([]JournalEntry, error) {
+	rows, err := q.db.QueryContext(ctx, getJournalEntriesChangedSince, arg.Namespace, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []JournalEntry
+	for rows.Next() {
+		var i JournalEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Date,
+			&i.Body,
+			&i.Rating,
+			&i.Namespace,
+			&i.EncryptionAlgorithm,
+			&i.EncryptionKdf,
+			&i.EncryptionSalt,
+			&i.ChangeSequence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJournalEntryChangeSequence = `-- name: GetJournalEntryChangeSequence :one
+select change_sequence
+from journal_entries
+where id = $1
+    and namespace = $2
+`
+
+type GetJournalEntryChangeSequenceParams struct {
+	ID        int32
+	Namespace string
+}
+
+func (q *Queries) GetJournalEntryChangeSequence(ctx context.Context, arg GetJournalEntryChangeSequenceParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getJournalEntryChangeSequence, arg.ID, arg.Namespace)
+	var change_sequence int64
+	err := row.Scan(&change_sequence)
+	return change_sequence, err
+}
+
+const getTombstonesSince = `-- name: GetTombstonesSince :many
+select entity_type,
+    entity_id,
+    change_sequence
+from tombstones
+where namespace = $1
+    and change_sequence > $2::bigint
+order by change_sequence
+`
+
+type GetTombstonesSinceParams struct {
+	Namespace string
+	Since     int64
+}
+
+type GetTombstonesSinceRow struct {
+	EntityType     string
+	EntityID       int32
+	ChangeSequence int64
+}
+
+func (q *Queries) GetTombstonesSince(ctx context.Context, arg GetTombstonesSinceParams) ([]GetTombstonesSinceRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTombstonesSince, arg.Namespace, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTombstonesSinceRow
+	for rows.Next() {
+		var i GetTombstonesSinceRow
+		if err := rows.Scan(&i.EntityType, &i.EntityID, &i.ChangeSequence); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockChanges = `-- name: LockChanges :exec
+select pg_advisory_xact_lock(hashtext($1::text))
+`
+
+func (q *Queries) LockChanges(ctx context.Context, namespace string) error {
+	_, err := q.db.ExecContext(ctx, lockChanges, namespace)
+	return err
+}
@@ -0,0 +1,154 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// checkTimeout is how long a readiness check may take before its dependency is considered unavailable
+	checkTimeout = time.Second * 5
+
+	checkStatusOK    = "ok"
+	checkStatusError = "error"
+)
+
+var (
+	errCouldNotEncodeResponse = errors.New("could not encode response")
+)
+
+// check is a dependency that has to be available for requests to be handled
+type check struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+type checkResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type readiness struct {
+	Status string                 `json:"status"`
+	Checks map[string]checkResult `json:"checks"`
+}
+
+// Admin serves health checks and metrics, which should only be reachable by operators
+type Admin struct {
+	log *slog.Logger
+
+	registry *prometheus.Registry
+	checks   []check
+
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+func NewAdmin(log *slog.Logger) *Admin {
+	return &Admin{
+		log: log,
+
+		registry: prometheus.NewRegistry(),
+
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "senbara_http_requests_total",
+			Help: "Number of handled HTTP requests",
+		}, []string{"operation", "method", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "senbara_http_request_duration_seconds",
+			Help:    "Duration of handled HTTP requests",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"operation", "method"}),
+	}
+}
+
+// Registerer returns the registerer that other components can register their metrics with
+func (a *Admin) Registerer() prometheus.Registerer {
+	return a.registry
+}
+
+// AddCheck adds a dependency that is checked before reporting that requests can be handled. Must be called before `Init`.
+func (a *Admin) AddCheck(name string, c func(ctx context.Context) error) {
+	a.checks = append(a.checks, check{name, c})
+}
+
+func (a *Admin) Init(ctx context.Context) error {
+	for _, c := range []prometheus.Collector{
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+
+		a.requests,
+		a.requestDuration,
+	} {
+		if err := a.registry.Register(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Handler returns the handler for the admin endpoints:
+//   - `/healthz` reports whether the process is able to handle requests at all
+//   - `/readyz` reports whether all dependencies (e.g. the database) are available
+//   - `/metrics` exposes metrics in the Prometheus format
+func (a *Admin) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /healthz", a.handleHealthz)
+	mux.HandleFunc("GET /readyz", a.handleReadyz)
+	mux.Handle("GET /metrics", promhttp.HandlerFor(a.registry, promhttp.HandlerOpts{
+		ErrorLog: slog.NewLogLogger(a.log.Handler(), slog.LevelWarn),
+	}))
+
+	return mux
+}
+
+func (a *Admin) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	a.writeJSON(w, http.StatusOK, checkResult{Status: checkStatusOK})
+}
+
+func (a *Admin) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	res := readiness{
+		Status: checkStatusOK,
+		Checks: map[string]checkResult{},
+	}
+
+	status := http.StatusOK
+	for _, c := range a.checks {
+		ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+
+		if err := c.check(ctx); err != nil {
+			a.log.Warn("Readiness check failed", "check", c.name, "err", err)
+
+			res.Status = checkStatusError
+			res.Checks[c.name] = checkResult{Status: checkStatusError, Error: err.Error()}
+
+			status = http.StatusServiceUnavailable
+		} else {
+			res.Checks[c.name] = checkResult{Status: checkStatusOK}
+		}
+
+		cancel()
+	}
+
+	a.writeJSON(w, status, res)
+}
+
+func (a *Admin) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		a.log.Warn("Could not encode admin response", "err", errors.Join(errCouldNotEncodeResponse, err))
+	}
+}
@@ -0,0 +1,80 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type contextKey int
+
+const (
+	contextKeyOperation contextKey = iota
+)
+
+// OperationUnknown is used for requests that don't match any operation
+const OperationUnknown = "unknown"
+
+// statusRecorder records the status code that a handler responds with
+type statusRecorder struct {
+	http.ResponseWriter
+
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	if s.status == 0 {
+		s.status = status
+	}
+
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+
+	return s.ResponseWriter.Write(b)
+}
+
+// Unwrap allows `http.ResponseController` to access the underlying response writer, e.g. to flush streamed responses
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// SetOperation sets the name of the operation that a request is handled by
+func SetOperation(ctx context.Context, operation string) {
+	if o, ok := ctx.Value(contextKeyOperation).(*string); ok {
+		*o = operation
+	}
+}
+
+// Instrument records the number and duration of the requests handled by the handler for each operation
+func (a *Admin) Instrument(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		var operation string
+		r = r.WithContext(context.WithValue(r.Context(), contextKeyOperation, &operation))
+
+		rw := &statusRecorder{ResponseWriter: w}
+
+		defer func() {
+			if operation == "" {
+				operation = OperationUnknown
+			}
+
+			status := rw.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			a.requests.WithLabelValues(operation, r.Method, strconv.Itoa(status)).Inc()
+			a.requestDuration.WithLabelValues(operation, r.Method).Observe(time.Since(start).Seconds())
+		}()
+
+		next.ServeHTTP(rw, r)
+	})
+}
@@ -0,0 +1,266 @@
+package authn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
+)
+
+const (
+	ScopeRead  = "senbara:read"
+	ScopeWrite = "senbara:write"
+)
+
+var (
+	ErrAccessTokenInactive = errors.New("access token is not active")
+
+	errInvalidAudience               = errors.New("invalid audience")
+	errIntrospectionDisabled         = errors.New("introspection is not enabled, can't validate opaque access token")
+	errMissingIntrospectionEndpoint  = errors.New("missing introspection endpoint, can't validate opaque access token")
+	errUnexpectedIntrospectionStatus = errors.New("unexpected introspection status")
+	errUserInfoSubjectMismatch       = errors.New("subject of user info does not match access token")
+)
+
+// ScopesForAccessTokenScope returns the OAuth2 scopes that a personal access token scope grants
+func ScopesForAccessTokenScope(scope string) []string {
+	if scope == models.AccessTokenScopeWrite {
+		return []string{ScopeRead, ScopeWrite}
+	}
+
+	return []string{ScopeRead}
+}
+
+// HasScopes checks if granted scopes include all required scopes. Since writing
+// without being able to read is not useful, the write scope implies the read scope.
+func HasScopes(granted, required []string) bool {
+	for _, scope := range required {
+		if slices.Contains(granted, scope) {
+			continue
+		}
+
+		if scope == ScopeRead && slices.Contains(granted, ScopeWrite) {
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// audience is either a single string or an array of strings,
+// see https://www.rfc-editor.org/rfc/rfc7519#section-4.1.3
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = audience{s}
+
+		return nil
+	}
+
+	var ss []string
+	if err := json.Unmarshal(b, &ss); err != nil {
+		return err
+	}
+
+	*a = audience(ss)
+
+	return nil
+}
+
+type accessTokenClaims struct {
+	sessionClaims
+
+	Issuer        string   `json:"iss"`
+	Subject       string   `json:"sub"`
+	Audience      audience `json:"aud"`
+	Scope         string   `json:"scope"`
+	Scopes        []string `json:"scp"`
+	Email         string   `json:"email"`
+	EmailVerified bool     `json:"email_verified"`
+}
+
+type introspectionResponse struct {
+	accessTokenClaims
+
+	Active bool  `json:"active"`
+	Expiry int64 `json:"exp"`
+}
+
+func (c accessTokenClaims) getScopes() []string {
+	if len(c.Scopes) > 0 {
+		return c.Scopes
+	}
+
+	return strings.Fields(c.Scope)
+}
+
+// EnableAccessTokens makes the authner validate bearer tokens as OAuth2 access tokens for `audience` instead of ID
+// tokens. JWT access tokens are verified with the JWKS of the issuer they claim to be issued by; opaque access tokens
+// can only be validated if introspection is enabled with `EnableIntrospection`. Must be called before `Init`.
+func (a *Authner) EnableAccessTokens(audience string) {
+	a.audience = audience
+}
+
+// EnableIntrospection makes the authner validate opaque access tokens with RFC 7662 token introspection at the trusted
+// issuer `oidcIssuer`, using the given client credentials. Since opaque access tokens don't identify their issuer, they
+// are only ever introspected with this issuer, so that neither tokens nor credentials are sent to other issuers. Must
+// be called after adding the issuer and before `Init`.
+func (a *Authner) EnableIntrospection(
+	oidcIssuer,
+
+	introspectionClientID,
+	introspectionClientSecret string,
+) error {
+	i, err := a.getIssuer(oidcIssuer)
+	if err != nil {
+		return err
+	}
+
+	i.introspectionClientID = introspectionClientID
+	i.introspectionClientSecret = introspectionClientSecret
+
+	a.introspectionIssuer = i
+
+	return nil
+}
+
+// RequestAccessTokens makes the authner request OAuth2 access tokens for `audience` with the `senbara:read` and
+// `senbara:write` scopes when users sign in, which clients need if the server they connect to validates access tokens
+// instead of ID tokens. The sessions it returns include the access token. Must be called before `Init`.
+func (a *Authner) RequestAccessTokens(audience string) {
+	a.requestedAudience = audience
+}
+
+// isJWT checks if a token looks like a JWS in compact serialization, see https://www.rfc-editor.org/rfc/rfc7515#section-7.1
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+func (a *Authner) introspect(ctx context.Context, i *trustedIssuer, token string) (accessTokenClaims, error) {
+	ctx, span := tracer.Start(ctx, "Authner.IntrospectAccessToken", trace.WithAttributes(issuerAttribute(i.issuer)))
+	defer span.End()
+
+	if i.introspectionEndpoint == "" {
+		return accessTokenClaims{}, errMissingIntrospectionEndpoint
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, i.introspectionEndpoint, strings.NewReader(url.Values{
+		"token":           {token},
+		"token_type_hint": {"access_token"},
+	}.Encode()))
+	if err != nil {
+		return accessTokenClaims{}, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(i.introspectionClientID), url.QueryEscape(i.introspectionClientSecret))
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return accessTokenClaims{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return accessTokenClaims{}, errors.Join(errUnexpectedIntrospectionStatus, errors.New(res.Status))
+	}
+
+	var r introspectionResponse
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return accessTokenClaims{}, err
+	}
+
+	if !r.Active || (r.Expiry != 0 && time.Now().After(time.Unix(r.Expiry, 0))) {
+		return accessTokenClaims{}, ErrAccessTokenInactive
+	}
+
+	// The audience is optional in introspection responses, but if it's set it needs to match
+	if len(r.Audience) > 0 && !slices.Contains(r.Audience, a.audience) {
+		return accessTokenClaims{}, errInvalidAudience
+	}
+
+	if r.Issuer == "" {
+		r.Issuer = i.issuer
+	} else if r.Issuer != i.issuer {
+		return accessTokenClaims{}, ErrUntrustedIssuer
+	}
+
+	return r.accessTokenClaims, nil
+}
+
+// verifyAccessToken validates an access token and returns the identity and scopes it grants
+func (a *Authner) verifyAccessToken(ctx context.Context, token string) (Identity, error) {
+	var (
+		i      *trustedIssuer
+		claims accessTokenClaims
+		err    error
+	)
+	if isJWT(token) {
+		i, err = a.getIssuerForToken(token)
+		if err != nil {
+			return Identity{}, err
+		}
+
+		t, err := i.accessTokenVerifier.Verify(ctx, token)
+		if err != nil {
+			return Identity{}, err
+		}
+
+		if err := t.Claims(&claims); err != nil {
+			return Identity{}, err
+		}
+	} else {
+		if a.introspectionIssuer == nil {
+			return Identity{}, errIntrospectionDisabled
+		}
+
+		i = a.introspectionIssuer
+
+		claims, err = a.introspect(ctx, i, token)
+		if err != nil {
+			return Identity{}, err
+		}
+	}
+
+	// Access tokens usually don't include the user's email, so we fetch it with the access token
+	if claims.Email == "" {
+		u, err := i.provider.UserInfo(withHTTPClient(ctx), oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: token,
+		}))
+		if err != nil {
+			return Identity{}, err
+		}
+
+		if u.Subject != claims.Subject {
+			return Identity{}, errUserInfoSubjectMismatch
+		}
+
+		claims.Email = u.Email
+		claims.EmailVerified = u.EmailVerified
+	}
+
+	if !claims.EmailVerified {
+		return Identity{}, errEmailNotVerified
+	}
+
+	return claims.withSession(Identity{
+		Issuer:  claims.Issuer,
+		Subject: claims.Subject,
+		Email:   claims.Email,
+
+		Scopes: claims.getScopes(),
+	}, nil), nil
+}
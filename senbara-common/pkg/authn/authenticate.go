@@ -5,8 +5,10 @@ import (
 	"errors"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 )
 
@@ -14,10 +16,70 @@ type contextKey int
 
 const (
 	ContextKeyNamespace contextKey = iota
+	ContextKeyIdentity
+	contextKeyAuthentication
 )
 
-// AuthenticateRequest reads the OIDC token from the request headers, verifies it, and returns the user's verified email
-func (c *Authner) AuthenticateRequest(r *http.Request) (string, error) {
+// Identity identifies a user by their issuer and subject, which are stable, and their verified email, which can change
+type Identity struct {
+	Issuer  string
+	Subject string
+	Email   string
+
+	// Scopes are the OAuth2 scopes granted to the credential the user authenticated with
+	Scopes []string
+
+	// ClientID, SessionID and AuthTime identify the OIDC client and the sign in with the OIDC provider that the credential
+	// was issued for; they are empty for personal access tokens
+	ClientID  string
+	SessionID string
+	AuthTime  time.Time
+}
+
+// authentication is the result of authenticating a request, which is shared by everything that handles the request
+// so that its credential is only verified once
+type authentication struct {
+	identity Identity
+	err      error
+	done     bool
+}
+
+// WithAuthentication returns a shallow copy of the request whose context stores the result of authenticating it,
+// so that e.g. the request validator and the handler don't both have to verify the credential
+func WithAuthentication(r *http.Request) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), contextKeyAuthentication, &authentication{}))
+}
+
+// AuthenticateRequest reads the OIDC ID token or personal access token from the request headers, verifies it, and returns the user's identity.
+// If the request was prepared with `WithAuthentication`, the credential is only verified the first time and the result is reused afterwards.
+func (c *Authner) AuthenticateRequest(
+	r *http.Request,
+
+	getIdentityForAccessToken func(ctx context.Context, token string) (Identity, error),
+) (Identity, error) {
+	a, ok := r.Context().Value(contextKeyAuthentication).(*authentication)
+	if !ok {
+		return c.authenticateRequest(r, getIdentityForAccessToken)
+	}
+
+	if !a.done {
+		a.identity, a.err = c.authenticateRequest(r, getIdentityForAccessToken)
+		a.done = true
+	} else {
+		c.log.Debug("Reusing authentication of request",
+			"method", r.Method,
+			"path", r.URL.Path,
+		)
+	}
+
+	return a.identity, a.err
+}
+
+func (c *Authner) authenticateRequest(
+	r *http.Request,
+
+	getIdentityForAccessToken func(ctx context.Context, token string) (Identity, error),
+) (Identity, error) {
 	idToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
 
 	c.log.Debug("Starting authentication",
@@ -25,53 +87,115 @@ func (c *Authner) AuthenticateRequest(r *http.Request) (string, error) {
 		"path", r.URL.Path,
 	)
 
-	id, err := c.verifier.Verify(r.Context(), idToken)
+	if strings.HasPrefix(idToken, models.AccessTokenPrefix) {
+		identity, err := getIdentityForAccessToken(r.Context(), idToken)
+		if err != nil {
+			c.log.Debug("Access token verification failed", "error", errors.Join(ErrCouldNotLogin, err))
+
+			return Identity{}, ErrCouldNotLogin
+		}
+
+		c.log.Debug("Authentication with access token successful", "subject", identity.Subject, "email", identity.Email, "scopes", identity.Scopes)
+
+		return identity, nil
+	}
+
+	if c.audience != "" {
+		identity, err := c.verifyAccessToken(r.Context(), idToken)
+		if err != nil {
+			c.log.Debug("Access token verification failed", "error", errors.Join(ErrCouldNotLogin, err))
+
+			return Identity{}, errors.Join(ErrCouldNotLogin, err)
+		}
+
+		c.log.Debug("Authentication with OAuth2 access token successful", "subject", identity.Subject, "email", identity.Email, "scopes", identity.Scopes)
+
+		return identity, nil
+	}
+
+	// Without an audience, we fall back to accepting ID tokens, which grant full access
+	i, err := c.getIssuerForToken(idToken)
+	if err != nil {
+		c.log.Debug("Could not get issuer for ID token", "error", errors.Join(ErrCouldNotLogin, err))
+
+		return Identity{}, ErrCouldNotLogin
+	}
+
+	id, err := i.bearerVerifier.Verify(r.Context(), idToken)
 	if err != nil {
 		c.log.Debug("ID token verification failed", "error", errors.Join(ErrCouldNotLogin, err))
 
-		return "", ErrCouldNotLogin
+		return Identity{}, ErrCouldNotLogin
 	}
 
 	var claims struct {
+		sessionClaims
+
 		Email         string `json:"email"`
 		EmailVerified bool   `json:"email_verified"`
 	}
 	if err := id.Claims(&claims); err != nil {
 		c.log.Debug("Failed to parse ID token claims", "error", errors.Join(ErrCouldNotLogin, err))
 
-		return "", ErrCouldNotLogin
+		return Identity{}, ErrCouldNotLogin
 	}
 
 	if !claims.EmailVerified {
 		c.log.Debug("Email from ID token claims not verified, user is unauthenticated", "email", claims.Email, "error", errors.Join(ErrCouldNotLogin, errEmailNotVerified))
 
-		return "", errors.Join(ErrCouldNotLogin, errEmailNotVerified)
+		return Identity{}, errors.Join(ErrCouldNotLogin, errEmailNotVerified)
 	}
 
-	c.log.Debug("Authentication successful", "email", claims.Email)
+	c.log.Debug("Authentication successful", "subject", id.Subject, "email", claims.Email)
 
-	return claims.Email, nil
+	return claims.withSession(Identity{
+		Issuer:  id.Issuer,
+		Subject: id.Subject,
+		Email:   claims.Email,
+
+		Scopes: []string{ScopeRead, ScopeWrite},
+	}, id.Audience), nil
 }
 
-// AuthorizeRequest checks if a route requires authentication, authenticates the user, and adds the user's verified email to the context
-func (c *Authner) AuthorizeRequest(f nethttp.StrictHTTPHandlerFunc, operationID string) nethttp.StrictHTTPHandlerFunc {
+// AuthorizeRequest checks if a route requires authentication, authenticates the user, checks if they have been granted the scopes the route
+// requires, and adds the namespace of the user's account and their identity to the context
+func (c *Authner) AuthorizeRequest(
+	f nethttp.StrictHTTPHandlerFunc,
+	operationID string,
+
+	getIdentityForAccessToken func(ctx context.Context, token string) (Identity, error),
+	getNamespace func(ctx context.Context, identity Identity) (string, error),
+) nethttp.StrictHTTPHandlerFunc {
 	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (response interface{}, err error) {
-		if _, ok := r.Context().Value(api.OidcScopes).([]string); ok {
+		if requiredScopes, ok := r.Context().Value(api.OidcScopes).([]string); ok {
 			c.log.Debug("Starting authorization",
 				"method", r.Method,
 				"path", r.URL.Path,
 			)
 
-			namespace, err := c.AuthenticateRequest(r)
+			identity, err := c.AuthenticateRequest(r, getIdentityForAccessToken)
+			if err != nil {
+				c.log.Debug("Could not authenticate to extract identity", "error", errors.Join(ErrCouldNotLogin, err))
+
+				return struct{}{}, ErrCouldNotLogin
+			}
+
+			if !HasScopes(identity.Scopes, requiredScopes) {
+				c.log.Debug("Granted scopes do not include the required scopes", "scopes", identity.Scopes, "requiredScopes", requiredScopes, "error", ErrInsufficientScope)
+
+				return struct{}{}, ErrInsufficientScope
+			}
+
+			namespace, err := getNamespace(r.Context(), identity)
 			if err != nil {
-				c.log.Debug("Could not re-authenticate to extract namespace", "error", errors.Join(ErrCouldNotLogin, err))
+				c.log.Debug("Could not get namespace for identity", "error", errors.Join(ErrCouldNotLogin, err))
 
 				return struct{}{}, ErrCouldNotLogin
 			}
 
-			ctx = context.WithValue(r.Context(), ContextKeyNamespace, namespace)
+			ctx = context.WithValue(context.WithValue(r.Context(), ContextKeyNamespace, namespace), ContextKeyIdentity, identity)
 
-			c.log.Debug("Authorization successful", "email", namespace)
+			c.log.Debug("Authorization successful", "email", identity.Email, "namespace", namespace)
 		} else {
 			c.log.Debug("Authorization skipped since route doesn't require it",
 				"method", r.Method,
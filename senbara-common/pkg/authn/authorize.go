@@ -2,50 +2,31 @@ package authn
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"net/url"
 	"strings"
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
 )
 
-type oidcState struct {
-	Nonce   string `json:"nonce"`
-	NextURL string `json:"nextURL"`
-}
-
-// Authorize authorizes a user based on a request and returns their data if it's available. If a user has been
-// previously signed in, but their session has expired, authorize refresh their session. If `loginIfSignedOut` is set
-// and a user has not signed in, authorize will redirect the user to the sign in URL instead - else, authorize will return
-// only the data is has available on the user, without signing them in.
+// Authorize authorizes a user based on the tokens in their token store and returns their session. If a user has been
+// previously signed in, but their session has expired, authorize refreshes their session. If `loginIfSignedOut` is set
+// and a user has not signed in, the returned session's `NextURL` will point to the sign in URL instead - else, authorize will
+// return only the data is has available on the user, without signing them in.
 func (a *Authner) Authorize(
 	ctx context.Context,
 
+	store TokenStore,
+
 	loginIfSignedOut bool,
 
 	returnURL string,
 	currentURL string,
-
-	refreshToken,
-	idToken *string,
-
-	setRefreshToken,
-	setIDToken func(string, time.Time) error,
-
-	setStateNonce,
-	setPKCECodeVerifier,
-	setOIDCNonce func(string) error,
-) (
-	nextURL string,
-
-	email,
-	logoutURL string,
-
-	err error,
-) {
+) (Session, error) {
 	log := a.log.With("loginIfSignedOut", loginIfSignedOut)
 
 	if strings.TrimSpace(returnURL) == "" {
@@ -56,119 +37,155 @@ func (a *Authner) Authorize(
 
 	log.Debug("Checking auth state")
 
+	rawIssuer, err := getOptionalToken(store, TokenKeyIssuer)
+	if err != nil {
+		log.Warn("Could not get issuer", "err", err)
+
+		return Session{}, errors.Join(ErrCouldNotLogin, err)
+	}
+
+	i, err := a.getIssuer(rawIssuer)
+	if err != nil {
+		// The user's tokens can't be refreshed with the default issuer, so they will be asked to sign in again
+		log.Debug("Issuer is not trusted anymore, falling back to default issuer", "issuer", rawIssuer, "error", err)
+
+		i = a.issuers[0]
+	}
+
+	log = log.With("issuer", i.issuer)
+
 	getAuthCodeURL := func(returnURL string) (string, error) {
+		if !a.isAllowedReturnURL(returnURL) {
+			log.Debug("Return URL is not allowed, returning to root instead", "returnURL", returnURL)
+
+			returnURL = "/"
+		}
+
 		var (
 			stateNonce       = oauth2.GenerateVerifier()
 			pkceCodeVerifier = oauth2.GenerateVerifier()
 			oidcNonce        = oauth2.GenerateVerifier()
+
+			// The nonces and the PKCE code verifier are only valid for as long as the state is
+			expiry = time.Now().Add(stateLifetime)
 		)
 
-		if err := setStateNonce(stateNonce); err != nil {
+		if err := store.SetToken(TokenKeyStateNonce, stateNonce, expiry); err != nil {
 			log.Warn("Could not set state nonce", "err", errors.Join(errCouldNotSetStateNonce, err))
 
 			return "", errCouldNotSetStateNonce
 		}
 
-		if err := setPKCECodeVerifier(pkceCodeVerifier); err != nil {
+		if err := store.SetToken(TokenKeyPKCECodeVerifier, pkceCodeVerifier, expiry); err != nil {
 			log.Warn("Could not set PKCE code verifier", "err", errors.Join(errCouldNotSetPKCECodeVerifier, err))
 
 			return "", errCouldNotSetPKCECodeVerifier
 		}
 
-		if err := setOIDCNonce(oidcNonce); err != nil {
+		if err := store.SetToken(TokenKeyOIDCNonce, oidcNonce, expiry); err != nil {
 			log.Warn("Could not set OIDC nonce", "err", errors.Join(errCouldNotSetOIDCNonce, err))
 
 			return "", errCouldNotSetOIDCNonce
 		}
 
-		rawOIDCState := oidcState{
+		state, err := a.signState(oidcState{
 			Nonce:   stateNonce,
 			NextURL: returnURL,
-		}
-
-		jsonOIDCState, err := json.Marshal(rawOIDCState)
+			Issuer:  i.issuer,
+			Expiry:  expiry.Unix(),
+		})
 		if err != nil {
-			log.Debug("Failed to marshal OIDC state", "error", errors.Join(ErrCouldNotLogin, err))
+			log.Debug("Failed to sign OIDC state", "error", errors.Join(ErrCouldNotLogin, err))
 
 			return "", ErrCouldNotLogin
 		}
 
-		state := url.QueryEscape(string(jsonOIDCState))
+		opts := []oauth2.AuthCodeOption{oidc.Nonce(oidcNonce), oauth2.S256ChallengeOption(pkceCodeVerifier)}
+		if a.requestedAudience != "" {
+			// Some OIDC providers (e.g. Auth0) only issue access tokens for an API if its audience is requested explicitly
+			opts = append(opts, oauth2.SetAuthURLParam("audience", a.requestedAudience))
+		}
 
-		return a.config.AuthCodeURL(state, oidc.Nonce(oidcNonce), oauth2.S256ChallengeOption(pkceCodeVerifier)), nil
+		return i.config.AuthCodeURL(state, opts...), nil
 	}
 
-	if loginIfSignedOut {
-		if refreshToken == nil {
-			log.Debug("Refresh token cookie is missing, reauthenticating with auth provider")
-
-			authCodeURL, err := getAuthCodeURL(returnURL)
-			if err != nil {
-				log.Warn("Could not get auth code URL", "err", errors.Join(errCouldNotGetAuthCodeURL, err))
+	reauthenticate := func(returnURL string) (Session, error) {
+		if !loginIfSignedOut {
+			log.Debug("Logging in the user if the they are signed out is not requested, continuing without auth")
 
-				return "", "", "", errCouldNotGetAuthCodeURL
-			}
+			return Session{}, nil
+		}
 
-			return authCodeURL, "", "", nil
+		authCodeURL, err := getAuthCodeURL(returnURL)
+		if err != nil {
+			log.Warn("Could not get auth code URL", "err", errors.Join(errCouldNotGetAuthCodeURL, err))
 
+			return Session{}, errCouldNotGetAuthCodeURL
 		}
 
-		if idToken == nil {
-			// Here, the user has still got a refresh token, so they've accepted the privacy policy already,
-			// meaning we can re-authorize them immediately without redirecting them back to the consent page.
-			// For updating privacy policies this is not an issue since we can simply invalidate the refresh
-			// tokens in Auth0, which requires users to re-read and re-accept the privacy policy.
-			// Here, we don't use the HTTP Referer header, but instead the current URL, since we don't redirect
-			// with "redirect.html"
+		return Session{
+			NextURL: authCodeURL,
+		}, nil
+	}
 
-			log.Debug("ID token cookie is missing, reauthenticating with auth provider")
+	refreshToken, err := getOptionalToken(store, TokenKeyRefreshToken)
+	if err != nil {
+		log.Warn("Could not get refresh token", "err", err)
 
-			authCodeURL, err := getAuthCodeURL(currentURL)
-			if err != nil {
-				log.Warn("Could not get auth code URL", "err", errors.Join(errCouldNotGetAuthCodeURL, err))
+		return Session{}, errors.Join(ErrCouldNotLogin, err)
+	}
 
-				return "", "", "", errCouldNotGetAuthCodeURL
-			}
+	idToken, err := getOptionalToken(store, TokenKeyIDToken)
+	if err != nil {
+		log.Warn("Could not get ID token", "err", err)
 
-			return authCodeURL, "", "", nil
-		}
-	} else {
-		if refreshToken == nil {
-			log.Debug("Refresh token cookie is missing, but logging in the user if the they are signed out is not requested, continuing without auth")
+		return Session{}, errors.Join(ErrCouldNotLogin, err)
+	}
 
-			return "", "", "", nil
+	accessToken := ""
+	if a.requestedAudience != "" {
+		accessToken, err = getOptionalToken(store, TokenKeyAccessToken)
+		if err != nil {
+			log.Warn("Could not get access token", "err", err)
+
+			return Session{}, errors.Join(ErrCouldNotLogin, err)
 		}
+	}
 
-		if idToken == nil {
-			// Here, the user has still got a refresh token, so they've accepted the privacy policy already,
-			// meaning we can re-authorize them immediately without redirecting them back to the consent page.
-			// For updating privacy policies this is not an issue since we can simply invalidate the refresh
-			// tokens in Auth0, which requires users to re-read and re-accept the privacy policy.
-			// Here, we don't use the HTTP Referer header, but instead the current URL, since we don't redirect
-			// with "redirect.html"
+	if refreshToken == "" {
+		log.Debug("Refresh token is missing, reauthenticating with auth provider")
 
-			log.Debug("ID token cookie is missing, reauthenticating with auth provider")
+		return reauthenticate(returnURL)
+	}
 
-			authCodeURL, err := getAuthCodeURL(currentURL)
-			if err != nil {
-				log.Warn("Could not get auth code URL", "err", errors.Join(errCouldNotGetAuthCodeURL, err))
+	// If the ID token has expired or is missing, but the user has still got a refresh token, they've accepted the
+	// privacy policy already, meaning we can re-authorize them immediately without redirecting them back to the consent page.
+	// For updating privacy policies this is not an issue since we can simply invalidate the refresh
+	// tokens in Auth0, which requires users to re-read and re-accept the privacy policy.
+	// Here, we don't use the HTTP Referer header, but instead the current URL, since we don't redirect
+	// with "redirect.html"
+	fallbackURL := returnURL
+	if idToken == "" {
+		fallbackURL = currentURL
+	}
 
-				return "", "", "", errCouldNotGetAuthCodeURL
-			}
+	var id *oidc.IDToken
+	if idToken != "" {
+		log.Debug("Verifying tokens")
 
-			return authCodeURL, "", "", nil
-		}
+		id, err = i.verifier.Verify(ctx, idToken)
 	}
 
-	log.Debug("Verifying tokens")
+	if idToken == "" || err != nil || (a.requestedAudience != "" && accessToken == "") {
+		log.Debug("ID token missing or verification failed or access token missing, attempting refresh", "error", err)
 
-	id, err := a.verifier.Verify(ctx, *idToken)
-	if err != nil {
-		log.Debug("ID token verification failed, attempting refresh", "error", err)
+		refreshCtx, span := tracer.Start(ctx, "Authner.RefreshTokens", trace.WithAttributes(issuerAttribute(i.issuer)))
 
-		oauth2Token, err := a.config.TokenSource(ctx, &oauth2.Token{
-			RefreshToken: *refreshToken,
+		oauth2Token, err := i.config.TokenSource(withHTTPClient(refreshCtx), &oauth2.Token{
+			RefreshToken: refreshToken,
 		}).Token()
+		telemetry.RecordError(span, err)
+		span.End()
 		if err != nil {
 			// If we get an error during token refresh (or other errors below that
 			// could potentially be recovered from with a retry), but the user hasn't
@@ -176,85 +193,60 @@ func (a *Authner) Authorize(
 			// instead drop the user into the anonymous view. This is necessary since we can't
 			// refresh a token if it was issued by a different provider, e.g. if the OIDC provider
 			// that the Senbara server is configured for has changed
-			if !loginIfSignedOut {
-				log.Debug("Token refresh failed, but logging in the user if the they are signed out is not requested, continuing without auth")
-
-				return "", "", "", nil
-			}
-
 			log.Debug("Token refresh failed, reauthenticating with auth provider", "error", err)
 
-			authCodeURL, err := getAuthCodeURL(returnURL)
-			if err != nil {
-				log.Warn("Could not get auth code URL", "err", errors.Join(errCouldNotGetAuthCodeURL, err))
-
-				return "", "", "", errCouldNotGetAuthCodeURL
-			}
-
-			return authCodeURL, "", "", nil
+			return reauthenticate(fallbackURL)
 		}
 
 		var ok bool
-		*idToken, ok = oauth2Token.Extra("id_token").(string)
+		idToken, ok = oauth2Token.Extra("id_token").(string)
 		if !ok {
-			if !loginIfSignedOut {
-				log.Debug("ID token missing from refreshed refresh token, but logging in the user if the they are signed out is not requested, continuing without auth")
-
-				return "", "", "", nil
-			}
-
 			log.Debug("ID token missing from refreshed refresh token, reauthenticating with auth provider")
 
-			authCodeURL, err := getAuthCodeURL(returnURL)
-			if err != nil {
-				log.Warn("Could not get auth code URL", "err", errors.Join(errCouldNotGetAuthCodeURL, err))
-
-				return "", "", "", errCouldNotGetAuthCodeURL
-			}
-
-			return authCodeURL, "", "", nil
+			return reauthenticate(fallbackURL)
 		}
 
-		id, err = a.verifier.Verify(ctx, *idToken)
+		id, err = i.verifier.Verify(ctx, idToken)
 		if err != nil {
-			if !loginIfSignedOut {
-				log.Debug("Refresh token verification failed, but logging in the user if the they are signed out is not requested, continuing without auth")
+			log.Debug("Refreshed ID token verification failed, reauthenticating with auth provider", "error", err)
 
-				return "", "", "", nil
-			}
+			return reauthenticate(fallbackURL)
+		}
 
-			log.Debug("Refresh token verification failed, attempting refresh", "error", err)
+		if refreshToken = oauth2Token.RefreshToken; refreshToken != "" {
+			log.Debug("Setting new refresh token, expires in one year")
 
-			authCodeURL, err := getAuthCodeURL(returnURL)
-			if err != nil {
-				log.Warn("Could not get auth code URL", "err", errors.Join(errCouldNotGetAuthCodeURL, err))
+			if err := store.SetToken(TokenKeyRefreshToken, refreshToken, time.Now().Add(time.Hour*24*365)); err != nil {
+				log.Warn("Could not set refresh token", "err", errors.Join(errCouldNotSetRefreshToken, err))
 
-				return "", "", "", errCouldNotGetAuthCodeURL
+				return Session{}, errCouldNotSetRefreshToken
 			}
-
-			return authCodeURL, "", "", nil
 		}
 
-		if *refreshToken = oauth2Token.RefreshToken; *refreshToken != "" {
-			log.Debug("Setting new refresh token cookie, expires in one year")
+		log.Debug("Setting new ID token", "expiry", oauth2Token.Expiry)
 
-			if err := setRefreshToken(*refreshToken, time.Now().Add(time.Hour*24*365)); err != nil {
-				log.Warn("Could not set refresh token", "err", errors.Join(errCouldNotSetRefreshToken, err))
+		if err := store.SetToken(TokenKeyIDToken, idToken, oauth2Token.Expiry); err != nil {
+			log.Warn("Could not set ID token", "err", errors.Join(errCouldNotSetIDToken, err))
 
-				return "", "", "", errCouldNotSetRefreshToken
-			}
+			return Session{}, errCouldNotSetIDToken
 		}
 
-		log.Debug("Setting new ID token cookie", "expiry", oauth2Token.Expiry)
+		if a.requestedAudience != "" {
+			accessToken = oauth2Token.AccessToken
 
-		if err := setIDToken(*idToken, oauth2Token.Expiry); err != nil {
-			log.Warn("Could not set ID token", "err", errors.Join(errCouldNotSetIDToken, err))
+			log.Debug("Setting new access token", "expiry", oauth2Token.Expiry)
+
+			if err := store.SetToken(TokenKeyAccessToken, accessToken, oauth2Token.Expiry); err != nil {
+				log.Warn("Could not set access token", "err", errors.Join(errCouldNotSetAccessToken, err))
 
-			return "", "", "", errCouldNotSetIDToken
+				return Session{}, errCouldNotSetAccessToken
+			}
 		}
 	}
 
 	var claims struct {
+		sessionClaims
+
 		Email         string `json:"email"`
 		EmailVerified bool   `json:"email_verified"`
 	}
@@ -262,39 +254,48 @@ func (a *Authner) Authorize(
 		if !loginIfSignedOut {
 			log.Debug("Failed to parse ID token claims, but logging in the user if the they are signed out is not requested, continuing without auth")
 
-			return "", "", "", nil
+			return Session{}, nil
 		}
 
 		log.Debug("Failed to parse ID token claims", "error", errors.Join(ErrCouldNotLogin, err))
 
-		return "", "", "", ErrCouldNotLogin
+		return Session{}, ErrCouldNotLogin
 	}
 
 	if !claims.EmailVerified {
 		if !loginIfSignedOut {
 			log.Debug("Email from ID token claims not verified, user is unauthorized, but logging in the user if the they are signed out is not requested, continuing without auth")
 
-			return "", "", "", nil
+			return Session{}, nil
 		}
 
 		log.Debug("Email from ID token claims not verified, user is unauthorized", "email", claims.Email, "error", errors.Join(ErrCouldNotLogin, errEmailNotVerified))
 
-		return "", "", "", errors.Join(ErrCouldNotLogin, errEmailNotVerified)
+		return Session{}, errors.Join(ErrCouldNotLogin, errEmailNotVerified)
 	}
 
-	lu, err := url.Parse(a.oidcEndSessionEndpoint)
+	lu, err := url.Parse(i.endSessionEndpoint)
 	if err != nil {
 		log.Debug("Could not parse OIDC issuer URL", "error", errors.Join(ErrCouldNotLogin, err))
 
-		return "", "", "", ErrCouldNotLogin
+		return Session{}, ErrCouldNotLogin
 	}
 
 	q := lu.Query()
-	q.Set("id_token_hint", *idToken)
-	q.Set("post_logout_redirect_uri", a.oidcRedirectURL)
+	q.Set("id_token_hint", idToken)
+	q.Set("post_logout_redirect_uri", i.redirectURL)
 	lu.RawQuery = q.Encode()
 
 	log.Debug("Auth successful", "email", claims.Email)
 
-	return "", claims.Email, lu.String(), nil
+	return Session{
+		Identity: claims.withSession(Identity{
+			Issuer:  id.Issuer,
+			Subject: id.Subject,
+			Email:   claims.Email,
+		}, id.Audience),
+		IDToken:     idToken,
+		AccessToken: accessToken,
+		LogoutURL:   lu.String(),
+	}, nil
 }
@@ -0,0 +1,79 @@
+package authn
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// CookieTokenStore is a TokenStore which keeps tokens in HTTP-only cookies. Since cookies that
+// are set during a request are only sent by the browser with the next request, tokens set with
+// the store are also remembered for the remainder of the current request.
+type CookieTokenStore struct {
+	w http.ResponseWriter
+	r *http.Request
+
+	tokens map[TokenKey]*string
+}
+
+func NewCookieTokenStore(w http.ResponseWriter, r *http.Request) *CookieTokenStore {
+	return &CookieTokenStore{
+		w: w,
+		r: r,
+
+		tokens: map[TokenKey]*string{},
+	}
+}
+
+func (s *CookieTokenStore) GetToken(key TokenKey) (string, error) {
+	if v, ok := s.tokens[key]; ok {
+		if v == nil {
+			return "", ErrTokenNotFound
+		}
+
+		return *v, nil
+	}
+
+	c, err := s.r.Cookie(string(key))
+	if err != nil {
+		if errors.Is(err, http.ErrNoCookie) {
+			return "", ErrTokenNotFound
+		}
+
+		return "", err
+	}
+
+	return c.Value, nil
+}
+
+func (s *CookieTokenStore) SetToken(key TokenKey, value string, expiry time.Time) error {
+	http.SetCookie(s.w, &http.Cookie{
+		Name:     string(key),
+		Value:    value,
+		Expires:  expiry,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+		Path:     "/",
+	})
+
+	s.tokens[key] = &value
+
+	return nil
+}
+
+func (s *CookieTokenStore) DeleteToken(key TokenKey) error {
+	http.SetCookie(s.w, &http.Cookie{
+		Name:     string(key),
+		Value:    "",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+		Path:     "/",
+	})
+
+	s.tokens[key] = nil
+
+	return nil
+}
@@ -2,7 +2,6 @@ package authn
 
 import (
 	"context"
-	"errors"
 	"log/slog"
 	"net/http"
 )
@@ -22,28 +21,20 @@ func DeregisterOIDCClient(
 
 	l.Debug("Starting OIDC client deregistration")
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, registrationClientURI, nil)
-	if err != nil {
-		l.Debug("Could not create OIDC client deregistration request", "error", err)
+	if _, err := sendOIDCClientRegistrationRequest(
+		ctx,
 
-		return err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+registrationAccessToken)
+		l,
 
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		l.Debug("Could not send OIDC client deregistration request", "error", err)
+		http.MethodDelete,
+		registrationClientURI,
+		registrationAccessToken,
 
+		nil,
+		http.StatusNoContent,
+	); err != nil {
 		return err
 	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusNoContent {
-		l.Debug("OIDC client deregistration request returned an unexpected status", "statusCode", res.StatusCode)
-
-		return errors.New(res.Status)
-	}
 
 	return nil
 }
@@ -7,6 +7,10 @@ import (
 	"log/slog"
 	"net/http"
 	"path"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -27,7 +31,10 @@ func DiscoverOIDCProviderConfiguration(
 
 	wellKnownURL string,
 ) (*OIDCProviderConfiguration, error) {
-	l := log.With("wellKnownURL", wellKnownURL)
+	ctx, span := tracer.Start(ctx, "DiscoverOIDCProviderConfiguration", trace.WithAttributes(attribute.String("oidc.well_known_url", wellKnownURL)))
+	defer span.End()
+
+	l := telemetry.Logger(ctx, log).With("wellKnownURL", wellKnownURL)
 
 	l.Debug("Starting OIDC provider configuration discovery")
 
@@ -38,7 +45,7 @@ func DiscoverOIDCProviderConfiguration(
 		return nil, err
 	}
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := httpClient.Do(req)
 	if err != nil {
 		l.Debug("Could not send OIDC provider configuration discovery request", "error", err)
 
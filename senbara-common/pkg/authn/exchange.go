@@ -2,43 +2,26 @@ package authn
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"net/url"
 	"strings"
 	"time"
 
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
 )
 
-// Exchange exchanges the OIDC auth code and state for a user's refresh token and ID token.
+// Exchange exchanges the OIDC auth code and state for a user's refresh token and ID token and stores them in the token store.
 // If authCode is an empty string, it will sign out the user.
 func (a *Authner) Exchange(
 	ctx context.Context,
 
+	store TokenStore,
+
 	authCode,
 	state string,
-
-	stateNonce,
-	pkceCodeVerifier,
-	oidcNonce string,
-
-	setRefreshToken,
-	setIDToken func(string, time.Time) error,
-
-	clearRefreshToken,
-	clearIDToken func() error,
-
-	clearStateNonce,
-	clearPKCECodeVerifier,
-	clearOIDCNonce func() error,
-) (
-	nextURL string,
-
-	signedOut bool,
-
-	err error,
-) {
+) (Session, error) {
 	log := a.log.With(
 		"authCode", authCode != "",
 		"state", state,
@@ -50,54 +33,76 @@ func (a *Authner) Exchange(
 	if strings.TrimSpace(authCode) == "" {
 		log.Debug("Signing out user")
 
-		nextURL = state
+		nextURL := state
 		if strings.TrimSpace(nextURL) == "" {
 			nextURL = "/"
 		}
 
-		if err := clearRefreshToken(); err != nil {
+		if err := store.DeleteToken(TokenKeyRefreshToken); err != nil {
 			log.Warn("Could not clear refresh token", "err", errors.Join(errCouldNotClearRefreshToken, err))
 
-			return "", false, errCouldNotClearRefreshToken
+			return Session{}, errCouldNotClearRefreshToken
 		}
 
-		if err := clearIDToken(); err != nil {
+		if err := store.DeleteToken(TokenKeyIDToken); err != nil {
 			log.Warn("Could not clear ID token", "err", errors.Join(errCouldNotClearIDToken, err))
 
-			return "", false, errCouldNotClearIDToken
+			return Session{}, errCouldNotClearIDToken
 		}
 
-		return nextURL, true, nil
+		if err := store.DeleteToken(TokenKeyAccessToken); err != nil {
+			log.Warn("Could not clear access token", "err", errors.Join(errCouldNotClearAccessToken, err))
+
+			return Session{}, errCouldNotClearAccessToken
+		}
+
+		if err := store.DeleteToken(TokenKeyIssuer); err != nil {
+			log.Warn("Could not clear issuer", "err", errors.Join(errCouldNotClearIssuer, err))
+
+			return Session{}, errCouldNotClearIssuer
+		}
+
+		return Session{
+			NextURL:   nextURL,
+			SignedOut: true,
+		}, nil
 	}
 
-	jsonOIDCState, err := url.QueryUnescape(state)
+	rawOIDCState, err := a.verifyState(state)
 	if err != nil {
-		log.Warn("Could not parse OIDC state", "err", errors.Join(ErrCouldNotLogin, err))
+		log.Debug("Could not verify OIDC state", "error", errors.Join(ErrCouldNotLogin, err))
 
-		return "", false, ErrCouldNotLogin
+		return Session{}, ErrCouldNotLogin
 	}
 
-	var rawOIDCState oidcState
-	if err := json.Unmarshal([]byte(jsonOIDCState), &rawOIDCState); err != nil {
-		log.Debug("Failed to unmarshal OIDC state", "error", errors.Join(ErrCouldNotLogin, err))
+	stateNonce, err := getOptionalToken(store, TokenKeyStateNonce)
+	if err != nil {
+		log.Warn("Could not get state nonce", "err", err)
 
-		return "", false, ErrCouldNotLogin
+		return Session{}, errors.Join(ErrCouldNotLogin, err)
 	}
 
-	if rawOIDCState.Nonce != stateNonce {
+	i, err := a.getIssuer(rawOIDCState.Issuer)
+	if err != nil {
+		log.Debug("Issuer from OIDC state is not trusted", "issuer", rawOIDCState.Issuer, "error", errors.Join(ErrCouldNotLogin, err))
+
+		return Session{}, ErrCouldNotLogin
+	}
+
+	if stateNonce == "" || rawOIDCState.Nonce != stateNonce {
 		log.Debug("State nonce not valid, user is unauthorized")
 
-		return "", false, ErrCouldNotLogin
+		return Session{}, ErrCouldNotLogin
 	}
 
-	if err := clearStateNonce(); err != nil {
+	if err := store.DeleteToken(TokenKeyStateNonce); err != nil {
 		log.Warn("Could not clear state nonce", "err", errors.Join(errCouldNotClearStateNonce, err))
 
-		return "", false, errCouldNotClearStateNonce
+		return Session{}, errCouldNotClearStateNonce
 	}
 
-	nextURL = rawOIDCState.NextURL
-	if strings.TrimSpace(nextURL) == "" {
+	nextURL := rawOIDCState.NextURL
+	if strings.TrimSpace(nextURL) == "" || !a.isAllowedReturnURL(nextURL) {
 		nextURL = "/"
 	}
 
@@ -105,7 +110,7 @@ func (a *Authner) Exchange(
 	if err != nil {
 		log.Warn("Could not parse return URL", "err", errors.Join(ErrCouldNotLogin, err))
 
-		return "", false, ErrCouldNotLogin
+		return Session{}, ErrCouldNotLogin
 	}
 
 	// If the return URL points to login or authorize endpoints, redirect to root instead
@@ -116,62 +121,127 @@ func (a *Authner) Exchange(
 	// Sign in
 	log.Debug("Exchanging auth code for tokens")
 
-	oauth2Token, err := a.config.Exchange(ctx, authCode, oauth2.VerifierOption(pkceCodeVerifier))
+	pkceCodeVerifier, err := getOptionalToken(store, TokenKeyPKCECodeVerifier)
 	if err != nil {
-		log.Warn("Could not exchange auth code", "err", errors.Join(ErrCouldNotLogin, err))
+		log.Warn("Could not get PKCE code verifier", "err", err)
 
-		return "", false, ErrCouldNotLogin
+		return Session{}, errors.Join(ErrCouldNotLogin, err)
 	}
 
-	if err := clearPKCECodeVerifier(); err != nil {
-		log.Warn("Could not clear PKCE code verifier", "err", errors.Join(errCouldNotClearPKCECodeVerifier, err))
+	exchangeCtx, span := tracer.Start(ctx, "Authner.ExchangeAuthCode", trace.WithAttributes(issuerAttribute(i.issuer)))
 
-		return "", false, errCouldNotClearPKCECodeVerifier
-	}
+	oauth2Token, err := i.config.Exchange(withHTTPClient(exchangeCtx), authCode, oauth2.VerifierOption(pkceCodeVerifier))
+	telemetry.RecordError(span, err)
+	span.End()
+	if err != nil {
+		log.Warn("Could not exchange auth code", "err", errors.Join(ErrCouldNotLogin, err))
 
-	log.Debug("Setting refresh token, expires in one year")
+		return Session{}, ErrCouldNotLogin
+	}
 
-	if err := setRefreshToken(oauth2Token.RefreshToken, time.Now().Add(time.Hour*24*365)); err != nil {
-		log.Warn("Could not set refresh token", "err", errors.Join(errCouldNotSetRefreshToken, err))
+	if err := store.DeleteToken(TokenKeyPKCECodeVerifier); err != nil {
+		log.Warn("Could not clear PKCE code verifier", "err", errors.Join(errCouldNotClearPKCECodeVerifier, err))
 
-		return "", false, errCouldNotSetRefreshToken
+		return Session{}, errCouldNotClearPKCECodeVerifier
 	}
 
 	idToken, ok := oauth2Token.Extra("id_token").(string)
 	if !ok {
 		log.Warn("Could not extract ID token", "err", errors.Join(ErrCouldNotLogin, err))
 
-		return "", false, ErrCouldNotLogin
+		return Session{}, ErrCouldNotLogin
 	}
 
 	log.Debug("Verifying tokens")
 
-	id, err := a.verifier.Verify(ctx, idToken)
+	id, err := i.verifier.Verify(ctx, idToken)
 	if err != nil {
 		log.Warn("Could not parse verify token", "err", errors.Join(ErrCouldNotLogin, err))
 
-		return "", false, ErrCouldNotLogin
+		return Session{}, ErrCouldNotLogin
 	}
 
-	if id.Nonce != oidcNonce {
+	oidcNonce, err := getOptionalToken(store, TokenKeyOIDCNonce)
+	if err != nil {
+		log.Warn("Could not get OIDC nonce", "err", err)
+
+		return Session{}, errors.Join(ErrCouldNotLogin, err)
+	}
+
+	if oidcNonce == "" || id.Nonce != oidcNonce {
 		log.Debug("OIDC nonce not valid, user is unauthorized")
 
-		return "", false, ErrCouldNotLogin
+		return Session{}, ErrCouldNotLogin
 	}
 
-	if err := clearOIDCNonce(); err != nil {
+	if err := store.DeleteToken(TokenKeyOIDCNonce); err != nil {
 		log.Warn("Could not clear OIDC nonce", "err", errors.Join(errCouldNotClearOIDCNonce, err))
 
-		return "", false, errCouldNotClearOIDCNonce
+		return Session{}, errCouldNotClearOIDCNonce
+	}
+
+	// The tokens are only stored once the ID token and nonce have been verified, since the
+	// user would otherwise be signed in with the refresh token even if the exchange failed
+	log.Debug("Setting refresh token, expires in one year")
+
+	if err := store.SetToken(TokenKeyRefreshToken, oauth2Token.RefreshToken, time.Now().Add(time.Hour*24*365)); err != nil {
+		log.Warn("Could not set refresh token", "err", errors.Join(errCouldNotSetRefreshToken, err))
+
+		return Session{}, errCouldNotSetRefreshToken
 	}
 
 	log.Debug("Setting ID token", "expiry", oauth2Token.Expiry)
 
-	if err := setIDToken(idToken, oauth2Token.Expiry); err != nil {
+	if err := store.SetToken(TokenKeyIDToken, idToken, oauth2Token.Expiry); err != nil {
 		log.Warn("Could not set ID token", "err", errors.Join(errCouldNotSetIDToken, err))
 
-		return "", false, errCouldNotSetIDToken
+		return Session{}, errCouldNotSetIDToken
 	}
 
-	return nextURL, false, nil
+	accessToken := ""
+	if a.requestedAudience != "" {
+		accessToken = oauth2Token.AccessToken
+
+		log.Debug("Setting access token", "expiry", oauth2Token.Expiry)
+
+		if err := store.SetToken(TokenKeyAccessToken, accessToken, oauth2Token.Expiry); err != nil {
+			log.Warn("Could not set access token", "err", errors.Join(errCouldNotSetAccessToken, err))
+
+			return Session{}, errCouldNotSetAccessToken
+		}
+	}
+
+	// With a single issuer, the default issuer is always used, so we don't need to remember it
+	if len(a.issuers) > 1 {
+		log.Debug("Setting issuer, expires in one year", "issuer", i.issuer)
+
+		if err := store.SetToken(TokenKeyIssuer, i.issuer, time.Now().Add(time.Hour*24*365)); err != nil {
+			log.Warn("Could not set issuer", "err", errors.Join(errCouldNotSetIssuer, err))
+
+			return Session{}, errCouldNotSetIssuer
+		}
+	}
+
+	var claims struct {
+		sessionClaims
+
+		Email string `json:"email"`
+	}
+	if err := id.Claims(&claims); err != nil {
+		log.Debug("Failed to parse ID token claims", "error", errors.Join(ErrCouldNotLogin, err))
+
+		return Session{}, ErrCouldNotLogin
+	}
+
+	return Session{
+		NextURL: nextURL,
+
+		Identity: claims.withSession(Identity{
+			Issuer:  id.Issuer,
+			Subject: id.Subject,
+			Email:   claims.Email,
+		}, id.Audience),
+		IDToken:     idToken,
+		AccessToken: accessToken,
+	}, nil
 }
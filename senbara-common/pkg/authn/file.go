@@ -0,0 +1,198 @@
+package authn
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/encryption"
+)
+
+type fileToken struct {
+	Value  string    `json:"value"`
+	Expiry time.Time `json:"expiry"`
+}
+
+type encryptedTokenFile struct {
+	Algorithm string `json:"algorithm"`
+	KDF       string `json:"kdf"`
+	Salt      []byte `json:"salt"`
+	Tokens    string `json:"tokens"`
+}
+
+// EncryptedFileTokenStore is a TokenStore which keeps tokens in a file that is encrypted with a
+// key derived from a passphrase, e.g. for systems where no keyring is available
+type EncryptedFileTokenStore struct {
+	path       string
+	passphrase string
+
+	// Key derivation is intentionally slow, so we re-use the encrypter (which caches derived keys)
+	encrypter *encryption.Encrypter
+
+	fileLock sync.Mutex
+}
+
+func NewEncryptedFileTokenStore(path, passphrase string) *EncryptedFileTokenStore {
+	return &EncryptedFileTokenStore{
+		path:       path,
+		passphrase: passphrase,
+	}
+}
+
+func (s *EncryptedFileTokenStore) getEncrypter(salt []byte) (*encryption.Encrypter, error) {
+	if s.encrypter != nil && (salt == nil || bytes.Equal(s.encrypter.Envelope().Salt, salt)) {
+		return s.encrypter, nil
+	}
+
+	if salt == nil {
+		var err error
+		salt, err = encryption.NewSalt()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	e, err := encryption.NewEncrypter(s.passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	s.encrypter = e
+
+	return e, nil
+}
+
+func (s *EncryptedFileTokenStore) read() (map[TokenKey]fileToken, *encryption.Encrypter, error) {
+	b, err := os.ReadFile(s.path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, nil, err
+		}
+
+		e, err := s.getEncrypter(nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return map[TokenKey]fileToken{}, e, nil
+	}
+
+	var f encryptedTokenFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := s.getEncrypter(f.Salt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rawTokens, err := e.Open(encryption.Envelope{
+		Algorithm: f.Algorithm,
+		KDF:       f.KDF,
+		Salt:      f.Salt,
+	}, f.Tokens)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens := map[TokenKey]fileToken{}
+	if err := json.Unmarshal([]byte(rawTokens), &tokens); err != nil {
+		return nil, nil, err
+	}
+
+	return tokens, e, nil
+}
+
+func (s *EncryptedFileTokenStore) write(tokens map[TokenKey]fileToken, e *encryption.Encrypter) error {
+	rawTokens, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+
+	envelope := e.Envelope()
+
+	sealedTokens, err := e.Seal(envelope, string(rawTokens))
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(encryptedTokenFile{
+		Algorithm: envelope.Algorithm,
+		KDF:       envelope.KDF,
+		Salt:      envelope.Salt,
+		Tokens:    sealedTokens,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that the store can't be corrupted by partial writes
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.path)
+}
+
+func (s *EncryptedFileTokenStore) GetToken(key TokenKey) (string, error) {
+	s.fileLock.Lock()
+	defer s.fileLock.Unlock()
+
+	tokens, _, err := s.read()
+	if err != nil {
+		return "", err
+	}
+
+	t, ok := tokens[key]
+	if !ok || isExpired(t.Expiry) {
+		return "", ErrTokenNotFound
+	}
+
+	return t.Value, nil
+}
+
+func (s *EncryptedFileTokenStore) SetToken(key TokenKey, value string, expiry time.Time) error {
+	s.fileLock.Lock()
+	defer s.fileLock.Unlock()
+
+	tokens, e, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	tokens[key] = fileToken{
+		Value:  value,
+		Expiry: expiry,
+	}
+
+	return s.write(tokens, e)
+}
+
+func (s *EncryptedFileTokenStore) DeleteToken(key TokenKey) error {
+	s.fileLock.Lock()
+	defer s.fileLock.Unlock()
+
+	tokens, e, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := tokens[key]; !ok {
+		return nil
+	}
+
+	delete(tokens, key)
+
+	return s.write(tokens, e)
+}
@@ -4,17 +4,24 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"sync"
+	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
 )
 
 var (
-	ErrCouldNotLogin = errors.New("could not login")
+	ErrCouldNotLogin     = errors.New("could not login")
+	ErrInsufficientScope = errors.New("insufficient access token scope")
 
 	errEmailNotVerified        = errors.New("email not verified")
 	errCouldNotSetRefreshToken = errors.New("could not set refresh token")
 	errCouldNotSetIDToken      = errors.New("could not set ID token")
+	errCouldNotSetIssuer       = errors.New("could not set issuer")
+	errCouldNotSetAccessToken  = errors.New("could not set access token")
 
 	errCouldNotSetStateNonce       = errors.New("could not set state nonce")
 	errCouldNotSetPKCECodeVerifier = errors.New("could not set PKCE code verifier")
@@ -22,6 +29,8 @@ var (
 
 	errCouldNotClearRefreshToken = errors.New("could not clear refresh token")
 	errCouldNotClearIDToken      = errors.New("could not clear ID token")
+	errCouldNotClearIssuer       = errors.New("could not clear issuer")
+	errCouldNotClearAccessToken  = errors.New("could not clear access token")
 
 	errCouldNotClearStateNonce       = errors.New("could not clear state nonce")
 	errCouldNotClearPKCECodeVerifier = errors.New("could not clear PKCE code verifier")
@@ -30,17 +39,46 @@ var (
 	errCouldNotGetAuthCodeURL = errors.New("could not get auth code URL")
 )
 
+// trustedIssuer is an OIDC issuer that users can sign in with
+type trustedIssuer struct {
+	issuer,
+	endSessionEndpoint,
+
+	clientID,
+	redirectURL string
+
+	// introspectionEndpoint is only discovered for the issuer that introspects opaque access tokens,
+	// which authenticates with its own client credentials
+	introspectionEndpoint,
+	introspectionClientID,
+	introspectionClientSecret string
+
+	provider            *oidc.Provider
+	config              *oauth2.Config
+	verifier            *oidc.IDTokenVerifier
+	bearerVerifier      *oidc.IDTokenVerifier
+	accessTokenVerifier *oidc.IDTokenVerifier
+}
+
 type Authner struct {
 	log *slog.Logger
 
-	oidcIssuer,
-	oidcEndSessionEndpoint,
+	// issuers are the trusted OIDC issuers; the first one is the default issuer
+	issuers []*trustedIssuer
 
-	oidcClientID,
-	oidcRedirectURL string
+	// requestedAudience is the API that users sign in to get access tokens for
+	requestedAudience string
+
+	audience string
 
-	config   *oauth2.Config
-	verifier *oidc.IDTokenVerifier
+	// introspectionIssuer is the only issuer that opaque access tokens are introspected with
+	introspectionIssuer *trustedIssuer
+
+	stateKey []byte
+
+	// logoutTokenIDs are the IDs of the logout tokens that have been used, which are kept until the tokens expire
+	logoutTokenIDs     map[string]time.Time
+	logoutTokenIDsLock sync.Mutex
 }
 
 func NewAuthner(
@@ -52,40 +90,121 @@ func NewAuthner(
 	oidcClientID,
 	oidcRedirectURL string,
 ) *Authner {
-	return &Authner{
+	a := &Authner{
 		log: log,
 
-		oidcIssuer:             oidcIssuer,
-		oidcEndSessionEndpoint: oidcEndSessionEndpoint,
+		logoutTokenIDs: map[string]time.Time{},
+	}
+
+	a.AddIssuer(oidcIssuer, oidcEndSessionEndpoint, oidcClientID, oidcRedirectURL)
+
+	return a
+}
+
+// AddIssuer adds another OIDC issuer that users can sign in with. Accounts are identified by issuer and subject, so
+// a user signing in with different issuers gets a different account for each issuer. Must be called before `Init`.
+func (a *Authner) AddIssuer(
+	oidcIssuer,
+	oidcEndSessionEndpoint,
 
-		oidcClientID:    oidcClientID,
-		oidcRedirectURL: oidcRedirectURL,
+	oidcClientID,
+	oidcRedirectURL string,
+) {
+	a.issuers = append(a.issuers, &trustedIssuer{
+		issuer:             oidcIssuer,
+		endSessionEndpoint: oidcEndSessionEndpoint,
+
+		clientID:    oidcClientID,
+		redirectURL: oidcRedirectURL,
+	})
+}
+
+// Issuers returns the URLs of the OIDC issuers that users can sign in with, starting with the default issuer
+func (a *Authner) Issuers() []string {
+	issuers := []string{}
+	for _, i := range a.issuers {
+		issuers = append(issuers, i.issuer)
 	}
+
+	return issuers
 }
 
 func (a *Authner) Init(ctx context.Context) error {
-	log := a.log.With("oidcIssuer", a.oidcIssuer)
+	if err := a.initStateKey(); err != nil {
+		return err
+	}
+
+	for _, i := range a.issuers {
+		if err := a.initIssuer(ctx, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *Authner) initIssuer(ctx context.Context, i *trustedIssuer) error {
+	ctx, span := tracer.Start(ctx, "Authner.DiscoverIssuer", trace.WithAttributes(issuerAttribute(i.issuer)))
+	defer span.End()
+
+	log := telemetry.Logger(ctx, a.log).With("oidcIssuer", i.issuer)
 
 	log.Info("Connecting to OIDC issuer")
 
-	provider, err := oidc.NewProvider(ctx, a.oidcIssuer)
+	provider, err := oidc.NewProvider(withHTTPClient(ctx), i.issuer)
 	if err != nil {
 		log.Debug("Could not create OIDC provider", "error", err)
 
+		telemetry.RecordError(span, err)
+
 		return err
 	}
 
-	a.config = &oauth2.Config{
-		ClientID:    a.oidcClientID,
-		RedirectURL: a.oidcRedirectURL,
+	i.config = &oauth2.Config{
+		ClientID:    i.clientID,
+		RedirectURL: i.redirectURL,
 		Endpoint:    provider.Endpoint(),
 		Scopes:      []string{oidc.ScopeOpenID, oidc.ScopeOfflineAccess, "email", "email_verified"},
 	}
 
-	a.verifier = provider.Verifier(&oidc.Config{
-		ClientID:          a.oidcClientID,
-		SkipClientIDCheck: a.oidcClientID == "",
+	if a.requestedAudience != "" {
+		i.config.Scopes = append(i.config.Scopes, ScopeRead, ScopeWrite)
+	}
+
+	i.verifier = provider.Verifier(&oidc.Config{
+		ClientID:          i.clientID,
+		SkipClientIDCheck: i.clientID == "",
+	})
+
+	// ID tokens that are sent as bearer tokens can be issued to any client of the issuer (e.g. the CLI or the GNOME
+	// app), even if this authner also signs users in with its own client
+	i.bearerVerifier = provider.Verifier(&oidc.Config{
+		SkipClientIDCheck: true,
 	})
 
+	i.provider = provider
+
+	if a.audience != "" {
+		log.Info("Validating access tokens", "audience", a.audience)
+
+		// JWT access tokens are signed with the same keys as ID tokens, so we can use the same verifier with a different audience
+		i.accessTokenVerifier = provider.Verifier(&oidc.Config{
+			ClientID: a.audience,
+		})
+
+		if i == a.introspectionIssuer {
+			var claims struct {
+				IntrospectionEndpoint string `json:"introspection_endpoint"`
+			}
+			if err := provider.Claims(&claims); err != nil {
+				log.Debug("Could not parse OIDC provider claims", "error", err)
+
+				return err
+			}
+
+			i.introspectionEndpoint = claims.IntrospectionEndpoint
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,63 @@
+package authn
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrUntrustedIssuer = errors.New("untrusted OIDC issuer")
+
+	errMalformedJWT = errors.New("malformed JWT")
+)
+
+// getIssuer returns the trusted OIDC issuer with the URL, or the default issuer if the URL is empty
+func (a *Authner) getIssuer(issuer string) (*trustedIssuer, error) {
+	if issuer == "" {
+		return a.issuers[0], nil
+	}
+
+	for _, i := range a.issuers {
+		if i.issuer == issuer {
+			return i, nil
+		}
+	}
+
+	return nil, ErrUntrustedIssuer
+}
+
+// getIssuerForToken returns the trusted OIDC issuer which a JWT claims to be issued by. The `iss`
+// claim is read without verifying the token, so the token must still be verified with the issuer.
+func (a *Authner) getIssuerForToken(token string) (*trustedIssuer, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errMalformedJWT
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.Join(errMalformedJWT, err)
+	}
+
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.Join(errMalformedJWT, err)
+	}
+
+	// An empty issuer would select the default issuer
+	if claims.Issuer == "" {
+		return nil, ErrUntrustedIssuer
+	}
+
+	return a.getIssuer(claims.Issuer)
+}
+
+// IsDefaultIssuer returns whether the issuer is the default issuer, which is the only issuer that can claim accounts
+// created before there were multiple issuers
+func (a *Authner) IsDefaultIssuer(issuer string) bool {
+	return issuer == a.issuers[0].issuer
+}
@@ -0,0 +1,106 @@
+package authn
+
+import (
+	"errors"
+	"time"
+
+	"github.com/zalando/go-keyring"
+)
+
+const (
+	keyringExpirySuffix = "-expiry"
+)
+
+// KeyringTokenStore is a TokenStore which keeps tokens in the system keyring. Since the keyring
+// has no concept of expiry, expiry times are stored in separate secrets next to the tokens.
+type KeyringTokenStore struct {
+	service string
+	names   map[TokenKey]string
+}
+
+// NewKeyringTokenStore creates a keyring-backed TokenStore for a service. `names` optionally maps
+// token keys to the names of the secrets in the keyring; keys without a name are stored as-is.
+func NewKeyringTokenStore(service string, names map[TokenKey]string) *KeyringTokenStore {
+	return &KeyringTokenStore{
+		service: service,
+		names:   names,
+	}
+}
+
+func (s *KeyringTokenStore) getName(key TokenKey) string {
+	if name, ok := s.names[key]; ok {
+		return name
+	}
+
+	return string(key)
+}
+
+func (s *KeyringTokenStore) GetToken(key TokenKey) (string, error) {
+	name := s.getName(key)
+
+	v, err := keyring.Get(s.service, name)
+	if err != nil {
+		if errors.Is(err, keyring.ErrNotFound) {
+			return "", ErrTokenNotFound
+		}
+
+		return "", err
+	}
+
+	rawExpiry, err := keyring.Get(s.service, name+keyringExpirySuffix)
+	if err != nil {
+		// Tokens without an expiry never expire
+		if errors.Is(err, keyring.ErrNotFound) {
+			return v, nil
+		}
+
+		return "", err
+	}
+
+	expiry, err := time.Parse(time.RFC3339, rawExpiry)
+	if err != nil {
+		return "", err
+	}
+
+	if isExpired(expiry) {
+		if err := s.DeleteToken(key); err != nil {
+			return "", err
+		}
+
+		return "", ErrTokenNotFound
+	}
+
+	return v, nil
+}
+
+func (s *KeyringTokenStore) SetToken(key TokenKey, value string, expiry time.Time) error {
+	name := s.getName(key)
+
+	if err := keyring.Set(s.service, name, value); err != nil {
+		return err
+	}
+
+	if expiry.IsZero() {
+		if err := keyring.Delete(s.service, name+keyringExpirySuffix); err != nil && !errors.Is(err, keyring.ErrNotFound) {
+			return err
+		}
+
+		return nil
+	}
+
+	return keyring.Set(s.service, name+keyringExpirySuffix, expiry.Format(time.RFC3339))
+}
+
+func (s *KeyringTokenStore) DeleteToken(key TokenKey) error {
+	name := s.getName(key)
+
+	if err := keyring.Delete(s.service, name); err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return err
+	}
+
+	if err := keyring.Delete(s.service, name+keyringExpirySuffix); err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
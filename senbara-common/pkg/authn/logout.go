@@ -0,0 +1,135 @@
+package authn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+)
+
+const (
+	backchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+)
+
+var (
+	ErrInvalidLogoutToken = errors.New("invalid logout token")
+
+	errInvalidLogoutTokenAudience = errors.New("logout token was not issued for this client or API")
+	errMissingLogoutTokenID       = errors.New("logout token is missing its ID")
+	errReplayedLogoutToken        = errors.New("logout token has already been used")
+)
+
+// LogoutToken identifies the user or the session with the OIDC provider that has been signed out
+type LogoutToken struct {
+	Issuer    string
+	Subject   string
+	SessionID string
+}
+
+// VerifyLogoutToken verifies a logout token sent by the OIDC provider with OIDC back-channel logout,
+// see https://openid.net/specs/openid-connect-backchannel-1_0.html#Validation
+func (a *Authner) VerifyLogoutToken(ctx context.Context, rawLogoutToken string) (LogoutToken, error) {
+	log := a.log.With("logoutToken", rawLogoutToken != "")
+
+	log.Debug("Verifying logout token")
+
+	i, err := a.getIssuerForToken(rawLogoutToken)
+	if err != nil {
+		log.Debug("Could not get issuer for logout token", "error", errors.Join(ErrInvalidLogoutToken, err))
+
+		return LogoutToken{}, errors.Join(ErrInvalidLogoutToken, err)
+	}
+
+	// Logout tokens are signed and validated like ID tokens, but since the verifier for bearer tokens accepts
+	// any client of the issuer, we check the audience below
+	t, err := i.bearerVerifier.Verify(ctx, rawLogoutToken)
+	if err != nil {
+		log.Debug("Logout token verification failed", "error", errors.Join(ErrInvalidLogoutToken, err))
+
+		return LogoutToken{}, errors.Join(ErrInvalidLogoutToken, err)
+	}
+
+	// Logout tokens are addressed to the client that the user signed in with or the API they used; if neither
+	// is configured, we can't tell whether a logout token is meant for us, so we reject all of them
+	if !slices.ContainsFunc(t.Audience, func(aud string) bool {
+		return aud != "" && (aud == i.clientID || aud == a.audience)
+	}) {
+		log.Debug("Logout token audience does not match", "audience", t.Audience, "error", errors.Join(ErrInvalidLogoutToken, errInvalidLogoutTokenAudience))
+
+		return LogoutToken{}, errors.Join(ErrInvalidLogoutToken, errInvalidLogoutTokenAudience)
+	}
+
+	var claims struct {
+		ID        string                     `json:"jti"`
+		SessionID string                     `json:"sid"`
+		Events    map[string]json.RawMessage `json:"events"`
+	}
+	if err := t.Claims(&claims); err != nil {
+		log.Debug("Failed to parse logout token claims", "error", errors.Join(ErrInvalidLogoutToken, err))
+
+		return LogoutToken{}, errors.Join(ErrInvalidLogoutToken, err)
+	}
+
+	if _, ok := claims.Events[backchannelLogoutEvent]; !ok {
+		log.Debug("Logout token is missing the back-channel logout event", "error", ErrInvalidLogoutToken)
+
+		return LogoutToken{}, ErrInvalidLogoutToken
+	}
+
+	// Logout tokens must not contain a nonce so that they can't be used as ID tokens
+	if t.Nonce != "" {
+		log.Debug("Logout token contains a nonce", "error", ErrInvalidLogoutToken)
+
+		return LogoutToken{}, ErrInvalidLogoutToken
+	}
+
+	if t.Subject == "" && claims.SessionID == "" {
+		log.Debug("Logout token contains neither a subject nor a session ID", "error", ErrInvalidLogoutToken)
+
+		return LogoutToken{}, ErrInvalidLogoutToken
+	}
+
+	if claims.ID == "" {
+		log.Debug("Logout token is missing its ID", "error", errors.Join(ErrInvalidLogoutToken, errMissingLogoutTokenID))
+
+		return LogoutToken{}, errors.Join(ErrInvalidLogoutToken, errMissingLogoutTokenID)
+	}
+
+	if !a.useLogoutTokenID(t.Issuer+" "+claims.ID, t.Expiry) {
+		log.Debug("Logout token has already been used", "error", errors.Join(ErrInvalidLogoutToken, errReplayedLogoutToken))
+
+		return LogoutToken{}, errors.Join(ErrInvalidLogoutToken, errReplayedLogoutToken)
+	}
+
+	log.Debug("Logout token verification successful", "subject", t.Subject, "sessionID", claims.SessionID)
+
+	return LogoutToken{
+		Issuer:    t.Issuer,
+		Subject:   t.Subject,
+		SessionID: claims.SessionID,
+	}, nil
+}
+
+// useLogoutTokenID marks a logout token ID as used and returns false if it has been used before. IDs are kept until
+// their token expires, after which the token itself is rejected, and only in memory, so replays are only detected
+// by the instance that received the logout token first.
+func (a *Authner) useLogoutTokenID(id string, expiry time.Time) bool {
+	a.logoutTokenIDsLock.Lock()
+	defer a.logoutTokenIDsLock.Unlock()
+
+	now := time.Now()
+	for usedID, usedExpiry := range a.logoutTokenIDs {
+		if now.After(usedExpiry) {
+			delete(a.logoutTokenIDs, usedID)
+		}
+	}
+
+	if _, ok := a.logoutTokenIDs[id]; ok {
+		return false
+	}
+
+	a.logoutTokenIDs[id] = expiry
+
+	return true
+}
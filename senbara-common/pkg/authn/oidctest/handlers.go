@@ -0,0 +1,729 @@
+package oidctest
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+var (
+	usersTemplate = template.Must(template.New("users").Parse(`<!DOCTYPE html>
+<html>
+	<head>
+		<title>Development OIDC Issuer</title>
+	</head>
+	<body>
+		<h1>Sign in as</h1>
+		<ul>
+			{{ range . }}
+				<li><a href="{{ .URL }}">{{ .Email }}</a>{{ if not .EmailVerified }} (unverified){{ end }}</li>
+			{{ end }}
+		</ul>
+	</body>
+</html>
+`))
+)
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	IDToken      string `json:"id_token"`
+}
+
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+type clientRegistration struct {
+	ClientID                string   `json:"client_id"`
+	ClientName              string   `json:"client_name"`
+	RedirectURIs            []string `json:"redirect_uris"`
+	PostLogoutRedirectURIs  []string `json:"post_logout_redirect_uris,omitempty"`
+	BackchannelLogoutURI    string   `json:"backchannel_logout_uri,omitempty"`
+	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method"`
+	RegistrationAccessToken string   `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string   `json:"registration_client_uri"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, description string) {
+	writeJSON(w, status, errorResponse{
+		Error:            code,
+		ErrorDescription: description,
+	})
+}
+
+func (i *Issuer) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                i.url,
+		"authorization_endpoint":                i.url + "/authorize",
+		"token_endpoint":                        i.url + "/token",
+		"jwks_uri":                              i.url + "/jwks",
+		"introspection_endpoint":                i.url + "/introspect",
+		"userinfo_endpoint":                     i.url + "/userinfo",
+		"end_session_endpoint":                  i.url + "/logout",
+		"registration_endpoint":                 i.url + "/register",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{string(jose.RS256)},
+		"scopes_supported":                      []string{"openid", "offline_access", "email", "email_verified", "senbara:read", "senbara:write"},
+		"grant_types_supported":                 []string{"authorization_code", "refresh_token"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"token_endpoint_auth_methods_supported": []string{"none"},
+		"backchannel_logout_supported":          true,
+		"backchannel_logout_session_supported":  true,
+	})
+}
+
+func (i *Issuer) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{
+				Key:       &i.key.PublicKey,
+				KeyID:     i.keyID,
+				Algorithm: string(jose.RS256),
+				Use:       "sig",
+			},
+		},
+	})
+}
+
+func (i *Issuer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	i.lock.Lock()
+	c, ok := i.clients[q.Get("client_id")]
+	i.lock.Unlock()
+
+	if !ok {
+		http.Error(w, "unknown client", http.StatusBadRequest)
+
+		return
+	}
+
+	redirectURI := q.Get("redirect_uri")
+	if !slices.Contains(c.RedirectURIs, redirectURI) {
+		http.Error(w, "invalid redirect URI", http.StatusBadRequest)
+
+		return
+	}
+
+	if q.Get("response_type") != "code" {
+		http.Error(w, "unsupported response type", http.StatusBadRequest)
+
+		return
+	}
+
+	if m := q.Get("code_challenge_method"); q.Get("code_challenge") != "" && m != "S256" {
+		http.Error(w, "unsupported code challenge method", http.StatusBadRequest)
+
+		return
+	}
+
+	// Without a selected user, list the users to sign in as
+	email := q.Get("user")
+	if email == "" {
+		type userLink struct {
+			User
+			URL string
+		}
+
+		links := []userLink{}
+		for _, u := range i.users {
+			uq := r.URL.Query()
+			uq.Set("user", u.Email)
+
+			links = append(links, userLink{
+				User: u,
+				URL:  r.URL.Path + "?" + uq.Encode(),
+			})
+		}
+
+		if err := usersTemplate.Execute(w, links); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	idx := slices.IndexFunc(i.users, func(u User) bool {
+		return u.Email == email
+	})
+	if idx < 0 {
+		http.Error(w, "unknown user", http.StatusBadRequest)
+
+		return
+	}
+
+	user := i.users[idx]
+
+	code := generateToken()
+
+	i.lock.Lock()
+	// Clients share the user's session until they sign out, but since the user
+	// signs in again every time, the authentication time is updated
+	session, ok := i.userSessions[user.Subject]
+	if !ok {
+		session.id = generateToken()
+	}
+	session.authTime = time.Now()
+	i.userSessions[user.Subject] = session
+
+	i.authCodes[code] = authCode{
+		clientID:    c.ID,
+		redirectURI: redirectURI,
+
+		user:    user,
+		session: session,
+
+		scope:         q.Get("scope"),
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+
+		expiry: time.Now().Add(authCodeLifetime),
+	}
+	i.lock.Unlock()
+
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	rq := u.Query()
+	rq.Set("code", code)
+	rq.Set("state", q.Get("state"))
+	u.RawQuery = rq.Encode()
+
+	i.log.Debug("Issued auth code", "clientID", c.ID, "email", email)
+
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+func (i *Issuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+
+		return
+	}
+
+	clientID := r.PostForm.Get("client_id")
+	if id, _, ok := r.BasicAuth(); ok {
+		clientID = id
+	}
+
+	var (
+		user    User
+		session userSession
+		scope   string
+		nonce   string
+	)
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		i.lock.Lock()
+		code, ok := i.authCodes[r.PostForm.Get("code")]
+		delete(i.authCodes, r.PostForm.Get("code"))
+		i.lock.Unlock()
+
+		if !ok || time.Now().After(code.expiry) || code.clientID != clientID || code.redirectURI != r.PostForm.Get("redirect_uri") {
+			writeError(w, http.StatusBadRequest, "invalid_grant", "invalid auth code")
+
+			return
+		}
+
+		if code.codeChallenge != "" {
+			challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+
+			if subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(challenge[:])), []byte(code.codeChallenge)) != 1 {
+				writeError(w, http.StatusBadRequest, "invalid_grant", "invalid code verifier")
+
+				return
+			}
+		}
+
+		user = code.user
+		session = code.session
+		scope = code.scope
+		nonce = code.nonce
+
+	case "refresh_token":
+		i.lock.Lock()
+		rt, ok := i.refreshTokens[r.PostForm.Get("refresh_token")]
+		delete(i.refreshTokens, r.PostForm.Get("refresh_token"))
+		i.lock.Unlock()
+
+		if !ok || rt.clientID != clientID {
+			writeError(w, http.StatusBadRequest, "invalid_grant", "invalid refresh token")
+
+			return
+		}
+
+		user = rt.user
+		session = rt.session
+		scope = rt.scope
+
+	default:
+		writeError(w, http.StatusBadRequest, "unsupported_grant_type", "")
+
+		return
+	}
+
+	idToken, err := i.signIDToken(clientID, user, session, nonce)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "server_error", err.Error())
+
+		return
+	}
+
+	// Refresh tokens are rotated on every use
+	var (
+		rt = generateToken()
+		at = generateToken()
+	)
+
+	i.lock.Lock()
+	i.refreshTokens[rt] = refreshToken{
+		clientID: clientID,
+		scope:    scope,
+
+		user:    user,
+		session: session,
+	}
+	i.accessTokens[at] = accessToken{
+		clientID: clientID,
+		scope:    scope,
+
+		user:    user,
+		session: session,
+
+		expiry: time.Now().Add(i.idTokenLifetime),
+	}
+	i.lock.Unlock()
+
+	i.log.Debug("Issued tokens", "clientID", clientID, "email", user.Email, "grantType", r.PostForm.Get("grant_type"))
+
+	writeJSON(w, http.StatusOK, tokenResponse{
+		AccessToken:  at,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(i.idTokenLifetime.Seconds()),
+		RefreshToken: rt,
+		IDToken:      idToken,
+	})
+}
+
+func (i *Issuer) sign(typ jose.ContentType, claims map[string]any) (string, error) {
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.RS256,
+		Key: jose.JSONWebKey{
+			Key:   i.key,
+			KeyID: i.keyID,
+		},
+	}, (&jose.SignerOptions{}).WithType(typ))
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	s, err := signer.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return s.CompactSerialize()
+}
+
+func (i *Issuer) signIDToken(clientID string, user User, session userSession, nonce string) (string, error) {
+	now := time.Now()
+	claims := map[string]any{
+		"iss":            i.url,
+		"sub":            user.Subject,
+		"aud":            clientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(i.idTokenLifetime).Unix(),
+		"auth_time":      session.authTime.Unix(),
+		"sid":            session.id,
+		"email":          user.Email,
+		"email_verified": user.EmailVerified,
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+
+	return i.sign("JWT", claims)
+}
+
+// signLogoutToken signs a logout token for OIDC back-channel logout,
+// see https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken
+func (i *Issuer) signLogoutToken(clientID, subject, sessionID string) (string, error) {
+	now := time.Now()
+
+	return i.sign("logout+jwt", map[string]any{
+		"iss": i.url,
+		"sub": subject,
+		"aud": clientID,
+		"iat": now.Unix(),
+		"exp": now.Add(logoutTokenLifetime).Unix(),
+		"jti": generateToken(),
+		"sid": sessionID,
+		"events": map[string]any{
+			"http://schemas.openid.net/event/backchannel-logout": map[string]any{},
+		},
+	})
+}
+
+// getAccessToken returns the access token if it exists and hasn't expired yet
+func (i *Issuer) getAccessToken(token string) (accessToken, bool) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	at, ok := i.accessTokens[token]
+	if !ok {
+		return accessToken{}, false
+	}
+
+	if time.Now().After(at.expiry) {
+		delete(i.accessTokens, token)
+
+		return accessToken{}, false
+	}
+
+	return at, true
+}
+
+func (i *Issuer) handleIntrospect(w http.ResponseWriter, r *http.Request) {
+	// Since the issuer doesn't issue client secrets, we only check that the client is known
+	clientID, _, ok := r.BasicAuth()
+	if ok {
+		clientID, _ = url.QueryUnescape(clientID)
+
+		i.lock.Lock()
+		_, ok = i.clients[clientID]
+		i.lock.Unlock()
+	}
+
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "invalid_client", "")
+
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+
+		return
+	}
+
+	at, ok := i.getAccessToken(r.PostForm.Get("token"))
+	if !ok {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"active": false,
+		})
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"active":         true,
+		"iss":            i.url,
+		"sub":            at.user.Subject,
+		"client_id":      at.clientID,
+		"sid":            at.session.id,
+		"auth_time":      at.session.authTime.Unix(),
+		"scope":          at.scope,
+		"exp":            at.expiry.Unix(),
+		"email":          at.user.Email,
+		"email_verified": at.user.EmailVerified,
+	})
+}
+
+func (i *Issuer) handleUserInfo(w http.ResponseWriter, r *http.Request) {
+	at, ok := i.getAccessToken(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "invalid_token", "")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"sub":            at.user.Subject,
+		"email":          at.user.Email,
+		"email_verified": at.user.EmailVerified,
+	})
+}
+
+func (i *Issuer) handleLogout(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	// Revoke the user's refresh tokens if we can identify them
+	if hint := q.Get("id_token_hint"); hint != "" {
+		if s, err := jose.ParseSigned(hint, []jose.SignatureAlgorithm{jose.RS256}); err == nil {
+			if payload, err := s.Verify(&i.key.PublicKey); err == nil {
+				var claims struct {
+					Subject   string `json:"sub"`
+					SessionID string `json:"sid"`
+				}
+				if err := json.Unmarshal(payload, &claims); err == nil {
+					i.lock.Lock()
+					delete(i.userSessions, claims.Subject)
+					for token, rt := range i.refreshTokens {
+						if rt.user.Subject == claims.Subject {
+							delete(i.refreshTokens, token)
+						}
+					}
+					for token, at := range i.accessTokens {
+						if at.user.Subject == claims.Subject {
+							delete(i.accessTokens, token)
+						}
+					}
+					i.lock.Unlock()
+
+					i.log.Debug("Revoked refresh and access tokens", "subject", claims.Subject)
+
+					i.notifyLogout(r.Context(), claims.Subject, claims.SessionID)
+				}
+			}
+		}
+	}
+
+	if u := q.Get("post_logout_redirect_uri"); u != "" {
+		http.Redirect(w, r, u, http.StatusFound)
+
+		return
+	}
+
+	_, _ = w.Write([]byte("Signed out"))
+}
+
+// sendLogoutToken sends a logout token to a client's back-channel logout URI
+func (i *Issuer) sendLogoutToken(ctx context.Context, c Client, subject, sessionID string) error {
+	logoutToken, err := i.signLogoutToken(c.ID, subject, sessionID)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, backchannelLogoutTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BackchannelLogoutURI, strings.NewReader(url.Values{
+		"logout_token": {logoutToken},
+	}.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.New(res.Status)
+	}
+
+	return nil
+}
+
+// notifyLogout sends a logout token to all clients that have registered a back-channel logout URI
+func (i *Issuer) notifyLogout(ctx context.Context, subject, sessionID string) {
+	i.lock.Lock()
+	clients := []Client{}
+	for _, c := range i.clients {
+		if c.BackchannelLogoutURI != "" {
+			clients = append(clients, c)
+		}
+	}
+	i.lock.Unlock()
+
+	for _, c := range clients {
+		if err := i.sendLogoutToken(ctx, c, subject, sessionID); err != nil {
+			i.log.Warn("Could not send back-channel logout request", "clientID", c.ID, "backchannelLogoutURI", c.BackchannelLogoutURI, "err", err)
+
+			continue
+		}
+
+		i.log.Debug("Sent back-channel logout request", "clientID", c.ID, "backchannelLogoutURI", c.BackchannelLogoutURI)
+	}
+}
+
+func (i *Issuer) handleRegister(w http.ResponseWriter, r *http.Request) {
+	var req clientRegistration
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_client_metadata", err.Error())
+
+		return
+	}
+
+	if len(req.RedirectURIs) == 0 {
+		writeError(w, http.StatusBadRequest, "invalid_redirect_uri", "missing redirect URIs")
+
+		return
+	}
+
+	c := Client{
+		ID:           generateToken(),
+		Name:         req.ClientName,
+		RedirectURIs: req.RedirectURIs,
+
+		BackchannelLogoutURI: req.BackchannelLogoutURI,
+
+		registrationAccessToken: generateToken(),
+	}
+
+	i.lock.Lock()
+	i.clients[c.ID] = c
+	i.lock.Unlock()
+
+	i.log.Debug("Registered client", "clientID", c.ID, "clientName", c.Name)
+
+	writeJSON(w, http.StatusCreated, clientRegistration{
+		ClientID:                c.ID,
+		ClientName:              c.Name,
+		RedirectURIs:            c.RedirectURIs,
+		PostLogoutRedirectURIs:  req.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:    c.BackchannelLogoutURI,
+		TokenEndpointAuthMethod: "none",
+		RegistrationAccessToken: c.registrationAccessToken,
+		RegistrationClientURI:   i.url + "/register/" + c.ID,
+	})
+}
+
+// getRegisteredClient returns the client if the request has got a valid registration access token for it
+func (i *Issuer) getRegisteredClient(r *http.Request) (Client, bool) {
+	i.lock.Lock()
+	c, ok := i.clients[r.PathValue("id")]
+	i.lock.Unlock()
+
+	if !ok || c.registrationAccessToken == "" {
+		return Client{}, false
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	return c, subtle.ConstantTimeCompare([]byte(token), []byte(c.registrationAccessToken)) == 1
+}
+
+func (i *Issuer) handleGetRegistration(w http.ResponseWriter, r *http.Request) {
+	c, ok := i.getRegisteredClient(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "invalid_token", "")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, clientRegistration{
+		ClientID:                c.ID,
+		ClientName:              c.Name,
+		RedirectURIs:            c.RedirectURIs,
+		BackchannelLogoutURI:    c.BackchannelLogoutURI,
+		TokenEndpointAuthMethod: "none",
+		RegistrationClientURI:   i.url + "/register/" + c.ID,
+	})
+}
+
+func (i *Issuer) handleUpdateRegistration(w http.ResponseWriter, r *http.Request) {
+	c, ok := i.getRegisteredClient(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "invalid_token", "")
+
+		return
+	}
+
+	var req clientRegistration
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_client_metadata", err.Error())
+
+		return
+	}
+
+	if req.ClientID != c.ID {
+		writeError(w, http.StatusBadRequest, "invalid_client_metadata", "client ID does not match")
+
+		return
+	}
+
+	if len(req.RedirectURIs) == 0 {
+		writeError(w, http.StatusBadRequest, "invalid_redirect_uri", "missing redirect URIs")
+
+		return
+	}
+
+	c.Name = req.ClientName
+	c.RedirectURIs = req.RedirectURIs
+	c.BackchannelLogoutURI = req.BackchannelLogoutURI
+
+	// Rotate the registration access token so that clients have to handle rotation
+	c.registrationAccessToken = generateToken()
+
+	i.lock.Lock()
+	i.clients[c.ID] = c
+	i.lock.Unlock()
+
+	i.log.Debug("Updated client", "clientID", c.ID, "clientName", c.Name)
+
+	writeJSON(w, http.StatusOK, clientRegistration{
+		ClientID:                c.ID,
+		ClientName:              c.Name,
+		RedirectURIs:            c.RedirectURIs,
+		PostLogoutRedirectURIs:  req.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:    c.BackchannelLogoutURI,
+		TokenEndpointAuthMethod: "none",
+		RegistrationAccessToken: c.registrationAccessToken,
+		RegistrationClientURI:   i.url + "/register/" + c.ID,
+	})
+}
+
+func (i *Issuer) handleDeleteRegistration(w http.ResponseWriter, r *http.Request) {
+	c, ok := i.getRegisteredClient(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "invalid_token", "")
+
+		return
+	}
+
+	i.lock.Lock()
+	delete(i.clients, c.ID)
+	for token, rt := range i.refreshTokens {
+		if rt.clientID == c.ID {
+			delete(i.refreshTokens, token)
+		}
+	}
+	for token, at := range i.accessTokens {
+		if at.clientID == c.ID {
+			delete(i.accessTokens, token)
+		}
+	}
+	i.lock.Unlock()
+
+	i.log.Debug("Deregistered client", "clientID", c.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
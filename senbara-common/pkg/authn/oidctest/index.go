@@ -0,0 +1,314 @@
+// Package oidctest provides a self-contained OIDC issuer for local development and tests. It supports
+// discovery, JWKS, the authorization code flow with PKCE, refresh tokens, opaque access tokens with
+// introspection and user info, dynamic client registration, end-session and back-channel logout. Instead of a sign in form, the authorization endpoint lists the configured test users.
+package oidctest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrMissingUsers = errors.New("missing test users")
+	ErrInvalidUser  = errors.New("invalid test user")
+
+	ErrCouldNotSignIn = errors.New("could not sign in")
+)
+
+const (
+	defaultIDTokenLifetime = time.Hour
+	authCodeLifetime       = time.Minute * 10
+	logoutTokenLifetime    = time.Minute * 2
+
+	backchannelLogoutTimeout = time.Second * 5
+
+	rsaKeyBits = 2048
+
+	userUnverifiedSuffix = ":unverified"
+)
+
+// User is a test user that can sign in with the issuer
+type User struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+// ParseUser parses a test user in the format `email[:unverified]`. The subject is derived
+// from the email so that the user keeps their account between restarts of the issuer.
+func ParseUser(s string) (User, error) {
+	email, unverified := strings.CutSuffix(strings.TrimSpace(s), userUnverifiedSuffix)
+	if !strings.Contains(email, "@") {
+		return User{}, ErrInvalidUser
+	}
+
+	return User{
+		Subject:       "dev|" + email,
+		Email:         email,
+		EmailVerified: !unverified,
+	}, nil
+}
+
+// Client is an OIDC client that is registered with the issuer
+type Client struct {
+	ID           string
+	Name         string
+	RedirectURIs []string
+
+	// BackchannelLogoutURI is notified with a logout token if a user signs out
+	BackchannelLogoutURI string
+
+	registrationAccessToken string
+}
+
+// userSession is a user's single sign-on session with the issuer, which is shared by all clients
+type userSession struct {
+	id       string
+	authTime time.Time
+}
+
+type authCode struct {
+	clientID    string
+	redirectURI string
+
+	user    User
+	session userSession
+
+	scope         string
+	nonce         string
+	codeChallenge string
+
+	expiry time.Time
+}
+
+type refreshToken struct {
+	clientID string
+	scope    string
+
+	user    User
+	session userSession
+}
+
+type accessToken struct {
+	clientID string
+	scope    string
+
+	user    User
+	session userSession
+
+	expiry time.Time
+}
+
+type Issuer struct {
+	log *slog.Logger
+
+	url             string
+	idTokenLifetime time.Duration
+
+	users []User
+
+	key   *rsa.PrivateKey
+	keyID string
+
+	clients       map[string]Client
+	userSessions  map[string]userSession
+	authCodes     map[string]authCode
+	refreshTokens map[string]refreshToken
+	accessTokens  map[string]accessToken
+	lock          sync.Mutex
+
+	mux *http.ServeMux
+	srv *http.Server
+}
+
+// NewIssuer creates an issuer that is reachable at `issuerURL`. Clients can be registered
+// ahead of time, e.g. for web apps with a static client ID, or with dynamic client registration.
+// If `idTokenLifetime` is 0, ID tokens expire after one hour.
+func NewIssuer(
+	log *slog.Logger,
+
+	issuerURL string,
+	idTokenLifetime time.Duration,
+
+	users []User,
+	clients []Client,
+) (*Issuer, error) {
+	if len(users) == 0 {
+		return nil, ErrMissingUsers
+	}
+
+	if idTokenLifetime <= 0 {
+		idTokenLifetime = defaultIDTokenLifetime
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &Issuer{
+		log: log,
+
+		url:             strings.TrimSuffix(issuerURL, "/"),
+		idTokenLifetime: idTokenLifetime,
+
+		users: users,
+
+		key:   key,
+		keyID: generateToken(),
+
+		clients:       map[string]Client{},
+		userSessions:  map[string]userSession{},
+		authCodes:     map[string]authCode{},
+		refreshTokens: map[string]refreshToken{},
+		accessTokens:  map[string]accessToken{},
+
+		mux: http.NewServeMux(),
+	}
+
+	for _, c := range clients {
+		i.clients[c.ID] = c
+	}
+
+	i.mux.HandleFunc("GET /.well-known/openid-configuration", i.handleDiscovery)
+	i.mux.HandleFunc("GET /jwks", i.handleJWKS)
+	i.mux.HandleFunc("GET /authorize", i.handleAuthorize)
+	i.mux.HandleFunc("POST /token", i.handleToken)
+	i.mux.HandleFunc("POST /introspect", i.handleIntrospect)
+	i.mux.HandleFunc("GET /userinfo", i.handleUserInfo)
+	i.mux.HandleFunc("GET /logout", i.handleLogout)
+	i.mux.HandleFunc("POST /register", i.handleRegister)
+	i.mux.HandleFunc("GET /register/{id}", i.handleGetRegistration)
+	i.mux.HandleFunc("PUT /register/{id}", i.handleUpdateRegistration)
+	i.mux.HandleFunc("DELETE /register/{id}", i.handleDeleteRegistration)
+
+	return i, nil
+}
+
+// Listen starts an issuer on `laddr` (e.g. `localhost:0` for a random port in tests) and serves
+// it in the background. It returns once the issuer is accepting connections.
+func Listen(
+	log *slog.Logger,
+
+	laddr string,
+	idTokenLifetime time.Duration,
+
+	users []User,
+	clients []Client,
+) (*Issuer, error) {
+	lis, err := net.Listen("tcp", laddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the actual port (e.g. if a random port was requested) and fall back to localhost for wildcard
+	// hosts so that the issuer URL is reachable
+	host, _, err := net.SplitHostPort(laddr)
+	if err != nil {
+		_ = lis.Close()
+
+		return nil, err
+	}
+
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+
+	i, err := NewIssuer(log, "http://"+net.JoinHostPort(host, strconv.Itoa(lis.Addr().(*net.TCPAddr).Port)), idTokenLifetime, users, clients)
+	if err != nil {
+		_ = lis.Close()
+
+		return nil, err
+	}
+
+	i.srv = &http.Server{Handler: i}
+
+	go func() {
+		if err := i.srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Warn("Could not serve OIDC issuer", "err", err)
+		}
+	}()
+
+	log.Info("Listening with development OIDC issuer", "issuer", i.url)
+
+	return i, nil
+}
+
+// URL returns the issuer URL
+func (i *Issuer) URL() string {
+	return i.url
+}
+
+// Close stops an issuer started with Listen
+func (i *Issuer) Close() error {
+	if i.srv == nil {
+		return nil
+	}
+
+	return i.srv.Close()
+}
+
+// SignIn signs in as the user with `email` on the authorization URL `authURL` instead of a browser,
+// e.g. in tests. It returns the redirect URL with the auth code and state for the client's callback.
+func (i *Issuer) SignIn(ctx context.Context, authURL, email string) (*url.URL, error) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	q.Set("user", email)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusFound {
+		body, _ := io.ReadAll(res.Body)
+
+		return nil, errors.Join(ErrCouldNotSignIn, errors.New(res.Status+": "+strings.TrimSpace(string(body))))
+	}
+
+	return res.Location()
+}
+
+func (i *Issuer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	i.log.Debug("Handling OIDC request", "method", r.Method, "path", r.URL.Path)
+
+	i.mux.ServeHTTP(w, r)
+}
+
+func generateToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,270 @@
+package oidctest_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn/oidctest"
+	"golang.org/x/oauth2"
+)
+
+const (
+	testClientID    = "senbara-test"
+	testRedirectURL = "http://localhost/authorize"
+	testEmail       = "jean@example.com"
+)
+
+func listen(t *testing.T, clients []oidctest.Client) *oidctest.Issuer {
+	t.Helper()
+
+	user, err := oidctest.ParseUser(testEmail)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i, err := oidctest.Listen(slog.New(slog.DiscardHandler), "localhost:0", time.Minute, []oidctest.User{user}, clients)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = i.Close()
+	})
+
+	return i
+}
+
+func TestCodeFlow(t *testing.T) {
+	ctx := context.Background()
+
+	i := listen(t, []oidctest.Client{
+		{
+			ID:           testClientID,
+			RedirectURIs: []string{testRedirectURL},
+		},
+	})
+
+	provider, err := oidc.NewProvider(ctx, i.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &oauth2.Config{
+		ClientID:    testClientID,
+		RedirectURL: testRedirectURL,
+		Endpoint:    provider.Endpoint(),
+		Scopes:      []string{oidc.ScopeOpenID, oidc.ScopeOfflineAccess, "email"},
+	}
+
+	verifier := provider.Verifier(&oidc.Config{ClientID: testClientID})
+
+	tests := []struct {
+		name         string
+		codeVerifier func(pkceCodeVerifier string) string
+		wantErr      bool
+	}{
+		{
+			name: "matching PKCE code verifier",
+			codeVerifier: func(pkceCodeVerifier string) string {
+				return pkceCodeVerifier
+			},
+		},
+		{
+			name: "PKCE code verifier mismatch",
+			codeVerifier: func(string) string {
+				return oauth2.GenerateVerifier()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkceCodeVerifier := oauth2.GenerateVerifier()
+
+			redirect, err := i.SignIn(ctx, config.AuthCodeURL("state", oidc.Nonce("nonce"), oauth2.S256ChallengeOption(pkceCodeVerifier)), testEmail)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := redirect.Query().Get("state"); got != "state" {
+				t.Fatalf("state = %q, want %q", got, "state")
+			}
+
+			token, err := config.Exchange(ctx, redirect.Query().Get("code"), oauth2.VerifierOption(tt.codeVerifier(pkceCodeVerifier)))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Exchange() succeeded, want error")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rawIDToken, ok := token.Extra("id_token").(string)
+			if !ok {
+				t.Fatal("missing ID token")
+			}
+
+			idToken, err := verifier.Verify(ctx, rawIDToken)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if idToken.Nonce != "nonce" {
+				t.Fatalf("nonce = %q, want %q", idToken.Nonce, "nonce")
+			}
+
+			userInfo, err := provider.UserInfo(ctx, oauth2.StaticTokenSource(token))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if userInfo.Email != testEmail {
+				t.Fatalf("email = %q, want %q", userInfo.Email, testEmail)
+			}
+
+			// Auth codes can only be used once
+			if _, err := config.Exchange(ctx, redirect.Query().Get("code"), oauth2.VerifierOption(pkceCodeVerifier)); err == nil {
+				t.Fatal("Exchange() with a used auth code succeeded, want error")
+			}
+
+			// Force a refresh, which rotates the refresh token
+			token.Expiry = time.Now().Add(-time.Minute)
+
+			refreshedToken, err := config.TokenSource(ctx, token).Token()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if refreshedToken.RefreshToken == token.RefreshToken {
+				t.Fatal("refresh token was not rotated")
+			}
+
+			if _, err := verifier.Verify(ctx, refreshedToken.Extra("id_token").(string)); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := config.TokenSource(ctx, token).Token(); err == nil {
+				t.Fatal("refresh with a rotated refresh token succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestClientRegistration(t *testing.T) {
+	ctx := context.Background()
+
+	i := listen(t, nil)
+
+	var registration struct {
+		ClientID                string   `json:"client_id"`
+		ClientName              string   `json:"client_name"`
+		RedirectURIs            []string `json:"redirect_uris"`
+		RegistrationAccessToken string   `json:"registration_access_token"`
+		RegistrationClientURI   string   `json:"registration_client_uri"`
+	}
+
+	do := func(method, u, registrationAccessToken string, body any, wantStatus int) {
+		t.Helper()
+
+		var reqBody io.Reader
+		if body != nil {
+			b, err := json.Marshal(body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			reqBody = bytes.NewReader(b)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req.Header.Set("Content-Type", "application/json")
+		if registrationAccessToken != "" {
+			req.Header.Set("Authorization", "Bearer "+registrationAccessToken)
+		}
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode != wantStatus {
+			t.Fatalf("%v %v = %v, want %v", method, u, res.StatusCode, wantStatus)
+		}
+
+		if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusCreated {
+			if err := json.NewDecoder(res.Body).Decode(&registration); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	do(http.MethodPost, i.URL()+"/register", "", map[string]any{
+		"client_name":   "Senbara Test",
+		"redirect_uris": []string{testRedirectURL},
+	}, http.StatusCreated)
+
+	config := &oauth2.Config{
+		ClientID:    registration.ClientID,
+		RedirectURL: testRedirectURL,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  i.URL() + "/authorize",
+			TokenURL: i.URL() + "/token",
+		},
+		Scopes: []string{oidc.ScopeOpenID},
+	}
+
+	pkceCodeVerifier := oauth2.GenerateVerifier()
+
+	redirect, err := i.SignIn(ctx, config.AuthCodeURL("state", oauth2.S256ChallengeOption(pkceCodeVerifier)), testEmail)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := config.Exchange(ctx, redirect.Query().Get("code"), oauth2.VerifierOption(pkceCodeVerifier)); err != nil {
+		t.Fatal(err)
+	}
+
+	registrationClientURI := registration.RegistrationClientURI
+	registrationAccessToken := registration.RegistrationAccessToken
+
+	do(http.MethodGet, registrationClientURI, "", nil, http.StatusUnauthorized)
+	do(http.MethodGet, registrationClientURI, registrationAccessToken, nil, http.StatusOK)
+
+	// Updates rotate the registration access token
+	do(http.MethodPut, registrationClientURI, registrationAccessToken, map[string]any{
+		"client_id":     registration.ClientID,
+		"client_name":   "Senbara Test (Updated)",
+		"redirect_uris": []string{testRedirectURL},
+	}, http.StatusOK)
+
+	if registration.ClientName != "Senbara Test (Updated)" {
+		t.Fatalf("client name = %q, want %q", registration.ClientName, "Senbara Test (Updated)")
+	}
+
+	if registration.RegistrationAccessToken == registrationAccessToken {
+		t.Fatal("registration access token was not rotated")
+	}
+
+	do(http.MethodGet, registrationClientURI, registrationAccessToken, nil, http.StatusUnauthorized)
+	do(http.MethodDelete, registrationClientURI, registration.RegistrationAccessToken, nil, http.StatusNoContent)
+
+	// Deregistered clients can't sign in anymore
+	if _, err := i.SignIn(ctx, config.AuthCodeURL("state"), testEmail); err == nil {
+		t.Fatal("SignIn() with a deregistered client succeeded, want error")
+	}
+}
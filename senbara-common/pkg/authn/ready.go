@@ -0,0 +1,61 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+var (
+	errIssuerNotInitialized   = errors.New("OIDC issuer is not initialized")
+	errMissingJWKSURI         = errors.New("OIDC issuer has no JWKS URI")
+	errCouldNotFetchJWKS      = errors.New("could not fetch OIDC issuer's JWKS")
+	errUnexpectedJWKSResponse = errors.New("unexpected response when fetching OIDC issuer's JWKS")
+)
+
+// Ready checks if tokens can be verified for all trusted OIDC issuers, which requires their keys to be reachable
+func (a *Authner) Ready(ctx context.Context) error {
+	for _, i := range a.issuers {
+		if err := a.checkIssuerReady(ctx, i); err != nil {
+			a.log.With("oidcIssuer", i.issuer).Debug("OIDC issuer is not ready", "error", err)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *Authner) checkIssuerReady(ctx context.Context, i *trustedIssuer) error {
+	if i.provider == nil || i.verifier == nil {
+		return errIssuerNotInitialized
+	}
+
+	var claims struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := i.provider.Claims(&claims); err != nil {
+		return err
+	}
+
+	if claims.JWKSURI == "" {
+		return errMissingJWKSURI
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, claims.JWKSURI, nil)
+	if err != nil {
+		return errors.Join(errCouldNotFetchJWKS, err)
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return errors.Join(errCouldNotFetchJWKS, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Join(errUnexpectedJWKSResponse, errors.New(res.Status))
+	}
+
+	return nil
+}
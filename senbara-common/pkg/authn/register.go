@@ -5,17 +5,27 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 )
 
+var (
+	// ErrOIDCClientRegistrationInvalid is returned if the OIDC provider doesn't accept a client's registration (anymore),
+	// e.g. because the client or its registration access token have been revoked or have expired
+	ErrOIDCClientRegistrationInvalid = errors.New("OIDC client registration is invalid or has expired")
+)
+
 type OIDCClientRegistrationResponse struct {
-	ClientID                string `json:"client_id"`
-	RegistrationAccessToken string `json:"registration_access_token"`
-	RegistrationClientURI   string `json:"registration_client_uri"`
+	ClientID                string   `json:"client_id"`
+	ClientName              string   `json:"client_name,omitempty"`
+	RedirectURIs            []string `json:"redirect_uris,omitempty"`
+	RegistrationAccessToken string   `json:"registration_access_token"`
+	RegistrationClientURI   string   `json:"registration_client_uri"`
 }
 
 type oidcClientRegistrationRequest struct {
+	ClientID                string   `json:"client_id,omitempty"`
 	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method"`
 	ClientName              string   `json:"client_name"`
 	RedirectURIs            []string `json:"redirect_uris"`
@@ -47,31 +57,71 @@ func RegisterOIDCClient(
 
 	l.Debug("Starting OIDC client registration")
 
-	b, err := json.Marshal(oidcClientRegistrationRequest{
+	return sendOIDCClientRegistrationRequest(
+		ctx,
+
+		l,
+
+		http.MethodPost,
+		providerConfiguration.RegistrationEndpoint,
+		initialAccessToken,
+
+		newOIDCClientRegistrationRequest("", clientName, redirectURL),
+		http.StatusCreated,
+	)
+}
+
+func newOIDCClientRegistrationRequest(clientID, clientName, redirectURL string) *oidcClientRegistrationRequest {
+	return &oidcClientRegistrationRequest{
+		ClientID:                clientID,
 		TokenEndpointAuthMethod: "none",
 		ClientName:              clientName,
 		RedirectURIs:            []string{redirectURL},
 		PostLogoutRedirectURIs:  []string{redirectURL},
 		GrantTypes:              []string{"authorization_code", "implicit", "refresh_token"},
-		Scopes:                  []string{"offline_access", "offline", "openid", "email", "email_verified"},
-	})
-	if err != nil {
-		return nil, err
+		Scopes:                  []string{"offline_access", "offline", "openid", "email", "email_verified", ScopeRead, ScopeWrite},
 	}
+}
+
+// sendOIDCClientRegistrationRequest sends a request to the OIDC client registration endpoint or a client's registration client URI
+func sendOIDCClientRegistrationRequest(
+	ctx context.Context,
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, providerConfiguration.RegistrationEndpoint, bytes.NewBuffer(b))
+	l *slog.Logger,
+
+	method,
+	url,
+	bearerToken string,
+
+	body *oidcClientRegistrationRequest,
+	expectedStatusCode int,
+) (*OIDCClientRegistrationResponse, error) {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		reqBody = bytes.NewBuffer(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		l.Debug("Could not create OIDC client registration request", "error", err)
 
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	if initialAccessToken != "" {
-		req.Header.Set("Authorization", "Bearer "+initialAccessToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
 	}
 
-	res, err := http.DefaultClient.Do(req)
+	if bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+
+	res, err := httpClient.Do(req)
 	if err != nil {
 		l.Debug("Could not send OIDC client registration request", "error", err)
 
@@ -79,12 +129,23 @@ func RegisterOIDCClient(
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusCreated {
+	// See https://www.rfc-editor.org/rfc/rfc7592#section-2.1
+	if method != http.MethodPost && (res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden || res.StatusCode == http.StatusNotFound) {
+		l.Debug("OIDC client registration is invalid", "statusCode", res.StatusCode)
+
+		return nil, ErrOIDCClientRegistrationInvalid
+	}
+
+	if res.StatusCode != expectedStatusCode {
 		l.Debug("OIDC client registration request returned an unexpected status", "statusCode", res.StatusCode)
 
 		return nil, errors.New(res.Status)
 	}
 
+	if res.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+
 	var r OIDCClientRegistrationResponse
 	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
 		l.Debug("Could not decode OIDC client registration response", "error", err)
@@ -92,5 +153,151 @@ func RegisterOIDCClient(
 		return nil, err
 	}
 
+	// The OIDC provider can rotate the registration access token with every response, but if
+	// it doesn't return a new one or a new registration client URI, the old ones stay valid,
+	// see https://www.rfc-editor.org/rfc/rfc7592#section-3
+	if r.RegistrationAccessToken == "" && method != http.MethodPost {
+		r.RegistrationAccessToken = bearerToken
+	}
+
+	if r.RegistrationClientURI == "" && method != http.MethodPost {
+		r.RegistrationClientURI = url
+	}
+
 	return &r, nil
 }
+
+// GetOIDCClient reads a client's registration from its registration client URI
+func GetOIDCClient(
+	ctx context.Context,
+
+	log *slog.Logger,
+
+	registrationAccessToken,
+	registrationClientURI string,
+) (*OIDCClientRegistrationResponse, error) {
+	l := log.With(
+		"registrationAccessToken", registrationAccessToken != "",
+		"registrationClientURI", registrationClientURI,
+	)
+
+	l.Debug("Getting OIDC client registration")
+
+	return sendOIDCClientRegistrationRequest(
+		ctx,
+
+		l,
+
+		http.MethodGet,
+		registrationClientURI,
+		registrationAccessToken,
+
+		nil,
+		http.StatusOK,
+	)
+}
+
+// UpdateOIDCClient replaces a client's registration with a new client name and redirect URL
+func UpdateOIDCClient(
+	ctx context.Context,
+
+	log *slog.Logger,
+
+	registrationAccessToken,
+	registrationClientURI string,
+
+	clientID,
+	clientName,
+	redirectURL string,
+) (*OIDCClientRegistrationResponse, error) {
+	l := log.With(
+		"registrationAccessToken", registrationAccessToken != "",
+		"registrationClientURI", registrationClientURI,
+
+		"clientID", clientID,
+		"clientName", clientName,
+		"redirectURL", redirectURL,
+	)
+
+	l.Debug("Updating OIDC client registration")
+
+	return sendOIDCClientRegistrationRequest(
+		ctx,
+
+		l,
+
+		http.MethodPut,
+		registrationClientURI,
+		registrationAccessToken,
+
+		newOIDCClientRegistrationRequest(clientID, clientName, redirectURL),
+		http.StatusOK,
+	)
+}
+
+// EnsureOIDCClient makes sure that a client is registered with the OIDC provider. If there is no registration yet
+// or the OIDC provider doesn't accept it anymore, it registers a new client; if the client name or redirect URL have
+// changed, it updates the registration. `changed` is set if the registration needs to be persisted again, e.g. because
+// a new client has been registered or the registration access token has been rotated.
+func EnsureOIDCClient(
+	ctx context.Context,
+
+	log *slog.Logger,
+
+	providerConfiguration *OIDCProviderConfiguration,
+	registration *OIDCClientRegistrationResponse,
+
+	clientName,
+	redirectURL string,
+
+	initialAccessToken string,
+) (r *OIDCClientRegistrationResponse, changed bool, err error) {
+	register := func() (*OIDCClientRegistrationResponse, bool, error) {
+		r, err := RegisterOIDCClient(ctx, log, providerConfiguration, clientName, redirectURL, initialAccessToken)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return r, true, nil
+	}
+
+	if registration == nil || registration.RegistrationClientURI == "" || registration.RegistrationAccessToken == "" {
+		log.Debug("Missing OIDC client registration, registering client")
+
+		return register()
+	}
+
+	r, err = GetOIDCClient(ctx, log, registration.RegistrationAccessToken, registration.RegistrationClientURI)
+	if err != nil {
+		if errors.Is(err, ErrOIDCClientRegistrationInvalid) {
+			log.Debug("OIDC client registration is invalid, re-registering client")
+
+			return register()
+		}
+
+		return nil, false, err
+	}
+
+	if r.ClientID != registration.ClientID {
+		log.Debug("OIDC client registration returned a different client, re-registering client")
+
+		return register()
+	}
+
+	if r.ClientName != clientName || len(r.RedirectURIs) != 1 || r.RedirectURIs[0] != redirectURL {
+		log.Debug("OIDC client registration is outdated, updating client")
+
+		r, err = UpdateOIDCClient(ctx, log, r.RegistrationAccessToken, r.RegistrationClientURI, r.ClientID, clientName, redirectURL)
+		if err != nil {
+			if errors.Is(err, ErrOIDCClientRegistrationInvalid) {
+				return register()
+			}
+
+			return nil, false, err
+		}
+
+		return r, true, nil
+	}
+
+	return r, r.RegistrationAccessToken != registration.RegistrationAccessToken || r.RegistrationClientURI != registration.RegistrationClientURI, nil
+}
@@ -0,0 +1,58 @@
+package authn
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sessionClaims are the claims that bind a token to a client and a sign in with the OIDC provider
+type sessionClaims struct {
+	AuthorizedParty string `json:"azp"`
+	ClientID        string `json:"client_id"`
+	SessionID       string `json:"sid"`
+	AuthTime        int64  `json:"auth_time"`
+}
+
+// withSession adds the client and sign in that a token was issued for to an identity. For ID tokens, the audience
+// is the client; access tokens are issued for the API instead, so their audience must not be passed here.
+func (c sessionClaims) withSession(identity Identity, audience []string) Identity {
+	switch {
+	case c.ClientID != "":
+		identity.ClientID = c.ClientID
+
+	case c.AuthorizedParty != "":
+		identity.ClientID = c.AuthorizedParty
+
+	case len(audience) == 1:
+		identity.ClientID = audience[0]
+	}
+
+	identity.SessionID = c.SessionID
+
+	if c.AuthTime > 0 {
+		identity.AuthTime = time.Unix(c.AuthTime, 0)
+	}
+
+	return identity
+}
+
+// SessionKey returns a key for the sign in that the user's credential was issued for. Since refreshed tokens keep the OIDC
+// session ID and authentication time, the key stays the same until the user signs in again. It is empty if the credential
+// isn't bound to a sign in, e.g. for personal access tokens, or if the OIDC provider includes neither claim in its tokens.
+func (i Identity) SessionKey() string {
+	if i.SessionID == "" && i.AuthTime.IsZero() {
+		return ""
+	}
+
+	var authTime string
+	if !i.AuthTime.IsZero() {
+		authTime = strconv.FormatInt(i.AuthTime.Unix(), 10)
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join([]string{i.Issuer, i.Subject, i.ClientID, i.SessionID, authTime}, "\n")))
+
+	return hex.EncodeToString(hash[:])
+}
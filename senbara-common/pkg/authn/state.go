@@ -0,0 +1,127 @@
+package authn
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// stateLifetime is how long users have to sign in with the OIDC provider after the auth flow has been started
+	stateLifetime = time.Minute * 10
+
+	stateKeyLength = 32
+)
+
+var (
+	errInvalidState = errors.New("invalid OIDC state")
+	errExpiredState = errors.New("expired OIDC state")
+)
+
+type oidcState struct {
+	Nonce   string `json:"nonce"`
+	NextURL string `json:"nextURL"`
+	Issuer  string `json:"issuer,omitempty"`
+	Expiry  int64  `json:"exp"`
+}
+
+// SetStateKey sets the key that the OIDC state is signed with. If no key is set, a random key is generated during `Init`,
+// so sign ins that were started before a restart or on another instance fail. Must be called before `Init`.
+func (a *Authner) SetStateKey(key []byte) {
+	a.stateKey = key
+}
+
+func (a *Authner) initStateKey() error {
+	if len(a.stateKey) > 0 {
+		return nil
+	}
+
+	a.log.Debug("No OIDC state key set, generating random key")
+
+	a.stateKey = make([]byte, stateKeyLength)
+	if _, err := rand.Read(a.stateKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *Authner) getStateSignature(payload string) string {
+	mac := hmac.New(sha256.New, a.stateKey)
+	mac.Write([]byte(payload))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// signState encodes the OIDC state and signs it so that it can't be tampered with while the user signs in
+func (a *Authner) signState(s oidcState) (string, error) {
+	jsonOIDCState, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(jsonOIDCState)
+
+	return payload + "." + a.getStateSignature(payload), nil
+}
+
+// verifyState verifies the OIDC state's signature and expiry and decodes it
+func (a *Authner) verifyState(state string) (oidcState, error) {
+	payload, signature, ok := strings.Cut(state, ".")
+	if !ok {
+		return oidcState{}, errInvalidState
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(a.getStateSignature(payload))) {
+		return oidcState{}, errInvalidState
+	}
+
+	jsonOIDCState, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return oidcState{}, errors.Join(errInvalidState, err)
+	}
+
+	var s oidcState
+	if err := json.Unmarshal(jsonOIDCState, &s); err != nil {
+		return oidcState{}, errors.Join(errInvalidState, err)
+	}
+
+	if isExpired(time.Unix(s.Expiry, 0)) {
+		return oidcState{}, errExpiredState
+	}
+
+	return s, nil
+}
+
+// isAllowedReturnURL checks if users may be redirected to the return URL after signing in. Only paths and URLs on
+// the origin of one of the OIDC redirect URLs are allowed so that the return URL can't be used as an open redirect.
+func (a *Authner) isAllowedReturnURL(returnURL string) bool {
+	u, err := url.Parse(returnURL)
+	if err != nil {
+		return false
+	}
+
+	// Protocol-relative URLs (e.g. `//example.com`) and backslashes, which some browsers treat as slashes, aren't paths
+	if !u.IsAbs() {
+		return u.Host == "" && strings.HasPrefix(returnURL, "/") && !strings.HasPrefix(returnURL, "//") && !strings.Contains(returnURL, "\\")
+	}
+
+	for _, i := range a.issuers {
+		ru, err := url.Parse(i.redirectURL)
+		if err != nil {
+			continue
+		}
+
+		if ru.Host != "" && strings.EqualFold(u.Scheme, ru.Scheme) && strings.EqualFold(u.Host, ru.Host) {
+			return true
+		}
+	}
+
+	return false
+}
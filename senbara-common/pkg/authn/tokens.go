@@ -0,0 +1,139 @@
+package authn
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	ErrTokenNotFound = errors.New("token not found")
+
+	errCouldNotGetToken = errors.New("could not get token")
+)
+
+// TokenKey identifies a token or nonce in a TokenStore
+type TokenKey string
+
+const (
+	TokenKeyRefreshToken TokenKey = "refresh_token"
+	TokenKeyIDToken      TokenKey = "id_token"
+
+	// TokenKeyAccessToken is only set if the authner requests access tokens for an API
+	TokenKeyAccessToken TokenKey = "access_token"
+
+	// TokenKeyIssuer is the URL of the OIDC issuer that the user signs in with if
+	// there are multiple trusted issuers; if it is missing, the default issuer is used
+	TokenKeyIssuer TokenKey = "oidc_issuer"
+
+	TokenKeyStateNonce       TokenKey = "state_nonce"
+	TokenKeyPKCECodeVerifier TokenKey = "pkce_code_verifier"
+	TokenKeyOIDCNonce        TokenKey = "oidc_nonce"
+)
+
+// TokenStore persists the tokens and nonces of a user's OIDC session. Implementations
+// must return ErrTokenNotFound for tokens that don't exist or have expired, treat a zero
+// expiry time as "never expires" and ignore deletes of tokens that don't exist.
+type TokenStore interface {
+	GetToken(key TokenKey) (string, error)
+	SetToken(key TokenKey, value string, expiry time.Time) error
+	DeleteToken(key TokenKey) error
+}
+
+// Session is the result of authorizing a user or exchanging their OIDC auth code
+type Session struct {
+	// NextURL is the URL to redirect the user to, e.g. the OIDC provider's sign in page
+	// during authorization or the return URL after an exchange
+	NextURL string
+
+	Identity    Identity
+	IDToken     string
+	AccessToken string
+	LogoutURL   string
+
+	SignedOut bool
+}
+
+// BearerToken returns the token to authenticate with the Senbara API, which is
+// the access token if the authner requests access tokens and the ID token otherwise
+func (s Session) BearerToken() string {
+	if s.AccessToken != "" {
+		return s.AccessToken
+	}
+
+	return s.IDToken
+}
+
+// getOptionalToken returns an empty string if the token does not exist
+func getOptionalToken(store TokenStore, key TokenKey) (string, error) {
+	v, err := store.GetToken(key)
+	if err != nil {
+		if errors.Is(err, ErrTokenNotFound) {
+			return "", nil
+		}
+
+		return "", errors.Join(errCouldNotGetToken, err)
+	}
+
+	return v, nil
+}
+
+func isExpired(expiry time.Time) bool {
+	return !expiry.IsZero() && !time.Now().Before(expiry)
+}
+
+type memoryToken struct {
+	value  string
+	expiry time.Time
+}
+
+// MemoryTokenStore is a TokenStore which keeps tokens in memory only
+type MemoryTokenStore struct {
+	tokens     map[TokenKey]memoryToken
+	tokensLock sync.Mutex
+}
+
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{
+		tokens: map[TokenKey]memoryToken{},
+	}
+}
+
+func (s *MemoryTokenStore) GetToken(key TokenKey) (string, error) {
+	s.tokensLock.Lock()
+	defer s.tokensLock.Unlock()
+
+	t, ok := s.tokens[key]
+	if !ok {
+		return "", ErrTokenNotFound
+	}
+
+	if isExpired(t.expiry) {
+		delete(s.tokens, key)
+
+		return "", ErrTokenNotFound
+	}
+
+	return t.value, nil
+}
+
+func (s *MemoryTokenStore) SetToken(key TokenKey, value string, expiry time.Time) error {
+	s.tokensLock.Lock()
+	defer s.tokensLock.Unlock()
+
+	s.tokens[key] = memoryToken{
+		value:  value,
+		expiry: expiry,
+	}
+
+	return nil
+}
+
+func (s *MemoryTokenStore) DeleteToken(key TokenKey) error {
+	s.tokensLock.Lock()
+	defer s.tokensLock.Unlock()
+
+	delete(s.tokens, key)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package authn
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	tracer = otel.Tracer("github.com/pojntfx/senbara/senbara-common/pkg/authn")
+
+	// httpClient is used for all requests to OIDC issuers so that they are traced
+	httpClient = &http.Client{
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}
+)
+
+// withHTTPClient returns a context that makes the OIDC and OAuth2 libraries use the traced HTTP client. The OIDC
+// provider keeps using the client of the context that it was created with, e.g. to fetch the issuer's keys.
+func withHTTPClient(ctx context.Context) context.Context {
+	return oidc.ClientContext(ctx, httpClient)
+}
+
+func issuerAttribute(issuer string) attribute.KeyValue {
+	return attribute.String("oidc.issuer", issuer)
+}
@@ -0,0 +1,199 @@
+package bootstrap
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	VerboseKey                            = "verbose"
+	PgaddrKey                             = "pgaddr"
+	OIDCIssuerKey                         = "oidc-issuer"
+	OIDCClientIDKey                       = "oidc-client-id"
+	OIDCRedirectURLKey                    = "oidc-redirect-url"
+	OIDCStateKeyKey                       = "oidc-state-key"
+	OIDCAudienceKey                       = "oidc-audience"
+	OIDCIntrospectionIssuerKey            = "oidc-introspection-issuer"
+	OIDCIntrospectionClientIDKey          = "oidc-introspection-client-id"
+	OIDCIntrospectionClientSecretKey      = "oidc-introspection-client-secret"
+	OIDCDcrInitialAccessTokenPortalUrlKey = "oidc-dcr-initial-access-token-portal-url"
+	CORSOriginsKey                        = "cors-origins"
+	PrivacyURLKey                         = "privacy-url"
+	TOSURLKey                             = "tos-url"
+	ImprintURLKey                         = "imprint-url"
+	ContactNameKey                        = "contact-name"
+	ContactEmailKey                       = "contact-email"
+	ServerURLKey                          = "server-url"
+	ServerDescriptionKey                  = "server-description"
+)
+
+// Defaults are used as flag defaults by the CLIs and if an env variable isn't set by the serverless handlers
+const (
+	DefaultPgaddr            = "postgresql://postgres@localhost:5432/senbara?sslmode=disable"
+	DefaultOIDCRedirectURL   = "http://localhost:1337/authorize"
+	DefaultContactName       = "Felicitas Pojtinger"
+	DefaultContactEmail      = "felicitas@pojtinger.com"
+	DefaultServerURL         = "http://localhost:1337/"
+	DefaultServerDescription = "Local development server"
+)
+
+var (
+	ErrMissingOIDCIssuer              = errors.New("missing OIDC issuer")
+	ErrMismatchedOIDCClientID         = errors.New("number of OIDC client IDs does not match number of OIDC issuers")
+	ErrMissingOIDCStateKey            = errors.New("missing OIDC state key, which is required to sign users in if there are multiple instances")
+	ErrUnknownOIDCIntrospectionIssuer = errors.New("OIDC introspection issuer is not one of the OIDC issuers")
+	ErrMissingPrivacyURL              = errors.New("missing privacy policy URL")
+	ErrMissingTOSURL                  = errors.New("missing terms of service URL")
+	ErrMissingImprintURL              = errors.New("missing imprint URL")
+)
+
+// Config configures the services that are shared by the web app and the REST API. The CLIs read it from their flags,
+// config files and env variables, the serverless handlers from the same env variables (e.g. `OIDC_ISSUER` for
+// `oidc-issuer`), so both are configured the same way.
+type Config struct {
+	Verbose bool
+
+	Pgaddr string
+
+	// OIDCIssuers are the issuers that users can sign in with, the first one is the default issuer
+	OIDCIssuers []string
+	// OIDCClientIDs are the client IDs to sign users in with, one for each issuer in the same order; they are only
+	// required by the web app
+	OIDCClientIDs   []string
+	OIDCRedirectURL string
+	OIDCStateKey    string
+	// RequireOIDCStateKey is set for the serverless handlers, which run as many instances, so a sign in that is
+	// started on one instance fails on another one unless all of them sign the OIDC state with the same key
+	RequireOIDCStateKey bool
+
+	OIDCAudience string
+	// OIDCIntrospectionIssuer is the only issuer that opaque access tokens are introspected with, using the
+	// introspection client credentials; if it is empty, the default issuer is used
+	OIDCIntrospectionIssuer,
+	OIDCIntrospectionClientID,
+	OIDCIntrospectionClientSecret string
+
+	OIDCDcrInitialAccessTokenPortalURL string
+
+	CORSOrigins []string
+
+	PrivacyURL,
+	TOSURL,
+	ImprintURL string
+
+	ContactName,
+	ContactEmail string
+
+	ServerURL,
+	ServerDescription string
+}
+
+// getList returns the values of a list, which can also be set as a comma-separated string (e.g. in env variables)
+func getList(v *viper.Viper, key string) []string {
+	list := []string{}
+	for _, value := range v.GetStringSlice(key) {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+	}
+
+	return list
+}
+
+// LoadConfig reads the configuration from viper, e.g. the CLI's flags, config file and env variables
+func LoadConfig(v *viper.Viper) Config {
+	return Config{
+		Verbose: v.GetBool(VerboseKey),
+
+		Pgaddr: v.GetString(PgaddrKey),
+
+		OIDCIssuers:     getList(v, OIDCIssuerKey),
+		OIDCClientIDs:   getList(v, OIDCClientIDKey),
+		OIDCRedirectURL: v.GetString(OIDCRedirectURLKey),
+		OIDCStateKey:    v.GetString(OIDCStateKeyKey),
+
+		OIDCAudience:                  v.GetString(OIDCAudienceKey),
+		OIDCIntrospectionIssuer:       v.GetString(OIDCIntrospectionIssuerKey),
+		OIDCIntrospectionClientID:     v.GetString(OIDCIntrospectionClientIDKey),
+		OIDCIntrospectionClientSecret: v.GetString(OIDCIntrospectionClientSecretKey),
+
+		OIDCDcrInitialAccessTokenPortalURL: v.GetString(OIDCDcrInitialAccessTokenPortalUrlKey),
+
+		CORSOrigins: getList(v, CORSOriginsKey),
+
+		PrivacyURL: v.GetString(PrivacyURLKey),
+		TOSURL:     v.GetString(TOSURLKey),
+		ImprintURL: v.GetString(ImprintURLKey),
+
+		ContactName:  v.GetString(ContactNameKey),
+		ContactEmail: v.GetString(ContactEmailKey),
+
+		ServerURL:         v.GetString(ServerURLKey),
+		ServerDescription: v.GetString(ServerDescriptionKey),
+	}
+}
+
+// LoadEnvConfig reads the configuration from env variables only, e.g. for serverless handlers
+func LoadEnvConfig() Config {
+	v := viper.New()
+
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+
+	// Vercel's Postgres integration provides the database address as `POSTGRES_URL`
+	_ = v.BindEnv(PgaddrKey, "PGADDR", "POSTGRES_URL")
+
+	v.SetDefault(PgaddrKey, DefaultPgaddr)
+	v.SetDefault(OIDCRedirectURLKey, DefaultOIDCRedirectURL)
+	v.SetDefault(ContactNameKey, DefaultContactName)
+	v.SetDefault(ContactEmailKey, DefaultContactEmail)
+	v.SetDefault(ServerURLKey, DefaultServerURL)
+	v.SetDefault(ServerDescriptionKey, DefaultServerDescription)
+
+	c := LoadConfig(v)
+	c.RequireOIDCStateKey = true
+
+	return c
+}
+
+// Validate checks if the configuration is complete; unlike errors while connecting to the database or
+// OIDC issuers, retrying doesn't fix these errors
+func (c Config) Validate() error {
+	if len(c.OIDCIssuers) == 0 {
+		return ErrMissingOIDCIssuer
+	}
+
+	if len(c.OIDCClientIDs) > 0 && len(c.OIDCClientIDs) != len(c.OIDCIssuers) {
+		return ErrMismatchedOIDCClientID
+	}
+
+	// Without client IDs, users can't sign in, so the OIDC state is never used
+	if c.RequireOIDCStateKey && len(c.OIDCClientIDs) > 0 && c.OIDCStateKey == "" {
+		return ErrMissingOIDCStateKey
+	}
+
+	if c.OIDCIntrospectionIssuer != "" && !slices.ContainsFunc(c.OIDCIssuers, func(oidcIssuer string) bool {
+		return strings.TrimSuffix(oidcIssuer, "/") == strings.TrimSuffix(c.OIDCIntrospectionIssuer, "/")
+	}) {
+		return ErrUnknownOIDCIntrospectionIssuer
+	}
+
+	if c.PrivacyURL == "" {
+		return ErrMissingPrivacyURL
+	}
+
+	if c.TOSURL == "" {
+		return ErrMissingTOSURL
+	}
+
+	if c.ImprintURL == "" {
+		return ErrMissingImprintURL
+	}
+
+	return nil
+}
@@ -0,0 +1,291 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	initialBackoff = time.Second
+	maxBackoff     = time.Minute
+
+	// buildTimeout limits how long connecting to the database, running the migrations and discovering the OIDC
+	// issuers may take, so that a hanging dependency is retried after a backoff instead of blocking forever
+	buildTimeout = time.Second * 30
+)
+
+// Services are the services that are shared by the controllers of the web app and the REST API
+type Services struct {
+	Persister *persisters.Persister
+	Authner   *authn.Authner
+}
+
+// Bootstrapper builds the shared services and a controller of type `C` from them exactly once, no matter how many
+// requests need them concurrently. If building fails or times out, e.g. because the database isn't reachable yet,
+// it tries again after an exponential backoff instead of failing forever.
+type Bootstrapper[C any] struct {
+	log    *slog.Logger
+	config Config
+
+	newController func(ctx context.Context, services Services) (C, error)
+
+	registerer prometheus.Registerer
+
+	lock       sync.Mutex
+	ready      bool
+	services   Services
+	controller C
+
+	// building is closed once the current build has finished; it is nil if nothing is being built
+	building chan struct{}
+
+	err     error
+	backoff time.Duration
+	retryAt time.Time
+}
+
+func NewBootstrapper[C any](
+	log *slog.Logger,
+	config Config,
+
+	newController func(ctx context.Context, services Services) (C, error),
+) *Bootstrapper[C] {
+	return &Bootstrapper[C]{
+		log:    log,
+		config: config,
+
+		newController: newController,
+	}
+}
+
+// EnableMetrics registers the metrics of the services with the registerer. Must be called before `Get` or `Init`.
+func (b *Bootstrapper[C]) EnableMetrics(registerer prometheus.Registerer) {
+	b.registerer = registerer
+}
+
+// Get returns the controller and the services it uses, building them if that hasn't happened yet. Only one build
+// runs at a time while all callers, including the one that started it, wait for it until their context is done.
+// If building failed recently, the error is returned until the backoff has passed.
+func (b *Bootstrapper[C]) Get(ctx context.Context) (C, Services, error) {
+	var controller C
+	for {
+		b.lock.Lock()
+
+		if b.ready {
+			b.lock.Unlock()
+
+			return b.controller, b.services, nil
+		}
+
+		if b.building == nil && time.Now().Before(b.retryAt) {
+			err := b.err
+
+			b.lock.Unlock()
+
+			return controller, Services{}, err
+		}
+
+		building := b.building
+		if building == nil {
+			building = make(chan struct{})
+			b.building = building
+
+			go b.buildInBackground(ctx, building)
+		}
+
+		b.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return controller, Services{}, ctx.Err()
+
+		case <-building:
+		}
+	}
+}
+
+// buildInBackground builds the services and the controller under the build timeout and closes `done` once it has finished
+func (b *Bootstrapper[C]) buildInBackground(ctx context.Context, done chan struct{}) {
+	// The services outlive the request that happens to build them, so they are built without its cancellation
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), buildTimeout)
+	defer cancel()
+
+	err := b.build(ctx)
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.building = nil
+	defer close(done)
+
+	if err != nil {
+		if b.backoff == 0 {
+			b.backoff = initialBackoff
+		} else {
+			b.backoff = min(b.backoff*2, maxBackoff)
+		}
+
+		b.err = err
+		b.retryAt = time.Now().Add(b.backoff)
+
+		b.log.Warn("Could not initialize services, retrying after backoff", "err", err, "backoff", b.backoff)
+
+		return
+	}
+
+	b.ready = true
+	b.err = nil
+}
+
+// Init builds the controller and the services it uses like `Get`, but retries until it succeeds or the context is
+// cancelled, which is useful for servers that can't do anything without them
+func (b *Bootstrapper[C]) Init(ctx context.Context) (C, Services, error) {
+	var controller C
+
+	// Retrying doesn't fix an incomplete configuration
+	if err := b.config.Validate(); err != nil {
+		return controller, Services{}, err
+	}
+
+	for {
+		controller, services, err := b.Get(ctx)
+		if err == nil {
+			return controller, services, nil
+		}
+
+		b.lock.Lock()
+		backoff := time.Until(b.retryAt)
+		b.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return controller, Services{}, errors.Join(ctx.Err(), err)
+
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// build creates the services that haven't been created yet and the controller. Services that were created
+// successfully are kept, so a failing OIDC issuer doesn't cause the database migrations to run again.
+func (b *Bootstrapper[C]) build(ctx context.Context) error {
+	if err := b.config.Validate(); err != nil {
+		return err
+	}
+
+	if b.services.Persister == nil {
+		p := persisters.NewPersister(slog.New(b.log.Handler().WithGroup("persister")), b.config.Pgaddr)
+		if b.registerer != nil {
+			p.EnableMetrics(b.registerer)
+		}
+
+		if err := p.Init(ctx); err != nil {
+			return err
+		}
+
+		b.services.Persister = p
+	}
+
+	if b.services.Authner == nil {
+		a, err := b.newAuthner(ctx)
+		if err != nil {
+			return err
+		}
+
+		b.services.Authner = a
+	}
+
+	controller, err := b.newController(ctx, b.services)
+	if err != nil {
+		return err
+	}
+
+	b.controller = controller
+
+	return nil
+}
+
+func (b *Bootstrapper[C]) newAuthner(ctx context.Context) (*authn.Authner, error) {
+	var (
+		a *authn.Authner
+
+		// If no introspection issuer is configured, the default issuer is used
+		introspectionIssuer string
+	)
+	for i, oidcIssuer := range b.config.OIDCIssuers {
+		o, err := authn.DiscoverOIDCProviderConfiguration(
+			ctx,
+
+			slog.New(b.log.Handler().WithGroup("oidcDiscovery")),
+
+			strings.TrimSuffix(oidcIssuer, "/")+authn.OIDCWellKnownURLSuffix,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSuffix(oidcIssuer, "/") == strings.TrimSuffix(b.config.OIDCIntrospectionIssuer, "/") {
+			introspectionIssuer = o.Issuer
+		}
+
+		// Without client IDs, users can't sign in, but tokens issued to other clients are still accepted
+		oidcClientID := ""
+		if len(b.config.OIDCClientIDs) > 0 {
+			oidcClientID = b.config.OIDCClientIDs[i]
+		}
+
+		// The first issuer is the default issuer
+		if a == nil {
+			a = authn.NewAuthner(
+				slog.New(b.log.Handler().WithGroup("authner")),
+
+				o.Issuer,
+				o.EndSessionEndpoint,
+
+				oidcClientID,
+				b.config.OIDCRedirectURL,
+			)
+
+			continue
+		}
+
+		a.AddIssuer(
+			o.Issuer,
+			o.EndSessionEndpoint,
+
+			oidcClientID,
+			b.config.OIDCRedirectURL,
+		)
+	}
+
+	if b.config.OIDCStateKey != "" {
+		a.SetStateKey([]byte(b.config.OIDCStateKey))
+	}
+
+	if b.config.OIDCAudience != "" {
+		a.EnableAccessTokens(b.config.OIDCAudience)
+
+		if err := a.EnableIntrospection(
+			introspectionIssuer,
+
+			b.config.OIDCIntrospectionClientID,
+			b.config.OIDCIntrospectionClientSecret,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := a.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
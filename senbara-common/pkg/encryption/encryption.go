@@ -0,0 +1,161 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	AlgorithmXChaCha20Poly1305 = "xchacha20-poly1305"
+	KDFArgon2id                = "argon2id"
+
+	saltLength = 16
+
+	// See https://www.rfc-editor.org/rfc/rfc9106.html#section-4
+	argon2idTime    = 3
+	argon2idMemory  = 64 * 1024
+	argon2idThreads = 4
+)
+
+var (
+	ErrMissingPassphrase     = errors.New("missing passphrase")
+	ErrUnsupportedAlgorithm  = errors.New("unsupported encryption algorithm")
+	ErrUnsupportedKDF        = errors.New("unsupported key derivation function")
+	ErrInvalidSalt           = errors.New("invalid salt")
+	ErrInvalidCiphertext     = errors.New("invalid ciphertext")
+	ErrCouldNotDecrypt       = errors.New("could not decrypt, is the passphrase correct?")
+	ErrCouldNotGenerateSalt  = errors.New("could not generate salt")
+	ErrCouldNotGenerateNonce = errors.New("could not generate nonce")
+)
+
+type Envelope struct {
+	Algorithm string
+	KDF       string
+	Salt      []byte
+}
+
+// NewSalt generates a random salt to derive a new key from a passphrase with
+func NewSalt() ([]byte, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Join(ErrCouldNotGenerateSalt, err)
+	}
+
+	return salt, nil
+}
+
+// Encrypter seals and opens values with keys derived from a passphrase. Since
+// key derivation is intentionally slow, derived keys are cached per salt.
+type Encrypter struct {
+	passphrase string
+	salt       []byte
+
+	keys     map[string][]byte
+	keysLock sync.Mutex
+}
+
+func NewEncrypter(passphrase string, salt []byte) (*Encrypter, error) {
+	if passphrase == "" {
+		return nil, ErrMissingPassphrase
+	}
+
+	if len(salt) == 0 {
+		return nil, ErrInvalidSalt
+	}
+
+	return &Encrypter{
+		passphrase: passphrase,
+		salt:       salt,
+
+		keys: map[string][]byte{},
+	}, nil
+}
+
+// Envelope returns the envelope to seal new values with
+func (e *Encrypter) Envelope() Envelope {
+	return Envelope{
+		Algorithm: AlgorithmXChaCha20Poly1305,
+		KDF:       KDFArgon2id,
+		Salt:      e.salt,
+	}
+}
+
+func (e *Encrypter) getKey(envelope Envelope) ([]byte, error) {
+	if envelope.Algorithm != AlgorithmXChaCha20Poly1305 {
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	if envelope.KDF != KDFArgon2id {
+		return nil, ErrUnsupportedKDF
+	}
+
+	if len(envelope.Salt) == 0 {
+		return nil, ErrInvalidSalt
+	}
+
+	e.keysLock.Lock()
+	defer e.keysLock.Unlock()
+
+	key, ok := e.keys[string(envelope.Salt)]
+	if !ok {
+		key = argon2.IDKey([]byte(e.passphrase), envelope.Salt, argon2idTime, argon2idMemory, argon2idThreads, chacha20poly1305.KeySize)
+
+		e.keys[string(envelope.Salt)] = key
+	}
+
+	return key, nil
+}
+
+// Seal encrypts plaintext and returns it as base64-encoded nonce and ciphertext
+func (e *Encrypter) Seal(envelope Envelope, plaintext string) (string, error) {
+	key, err := e.getKey(envelope)
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Join(ErrCouldNotGenerateNonce, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(plaintext), nil)), nil
+}
+
+// Open decrypts a value returned by Seal
+func (e *Encrypter) Open(envelope Envelope, ciphertext string) (string, error) {
+	key, err := e.getKey(envelope)
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return "", err
+	}
+
+	rawCiphertext, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", errors.Join(ErrInvalidCiphertext, err)
+	}
+
+	if len(rawCiphertext) < aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+
+	plaintext, err := aead.Open(nil, rawCiphertext[:aead.NonceSize()], rawCiphertext[aead.NonceSize():], nil)
+	if err != nil {
+		return "", errors.Join(ErrCouldNotDecrypt, err)
+	}
+
+	return string(plaintext), nil
+}
@@ -0,0 +1,24 @@
+package models
+
+import "github.com/pojntfx/senbara/senbara-common/internal/tables"
+
+const (
+	// AccessTokenPrefix is prepended to personal access tokens to tell them apart from OIDC ID tokens
+	AccessTokenPrefix = "senbara_pat_"
+
+	AccessTokenScopeRead  = "read"
+	AccessTokenScopeWrite = "write"
+)
+
+type (
+	CreateAccessTokenParams = tables.CreateAccessTokenParams
+	DeleteAccessTokenParams = tables.DeleteAccessTokenParams
+)
+
+type (
+	AccessToken = tables.AccessToken
+
+	CreateAccessTokenRow        = tables.CreateAccessTokenRow
+	GetAccessTokensRow          = tables.GetAccessTokensRow
+	GetAccountForAccessTokenRow = tables.GetAccountForAccessTokenRow
+)
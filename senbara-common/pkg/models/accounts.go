@@ -0,0 +1,14 @@
+package models
+
+import "github.com/pojntfx/senbara/senbara-common/internal/tables"
+
+type (
+	GetAccountParams         = tables.GetAccountParams
+	UpdateAccountEmailParams = tables.UpdateAccountEmailParams
+	ClaimAccountParams       = tables.ClaimAccountParams
+	CreateAccountParams      = tables.CreateAccountParams
+)
+
+type (
+	Account = tables.Account
+)
@@ -3,16 +3,20 @@ package models
 import "github.com/pojntfx/senbara/senbara-common/internal/tables"
 
 type (
-	CreateActivityParams        = tables.CreateActivityParams
-	GetActivitiesParams         = tables.GetActivitiesParams
-	DeleteActivityParams        = tables.DeleteActivityParams
-	GetActivityAndContactParams = tables.GetActivityAndContactParams
-	UpdateActivityParams        = tables.UpdateActivityParams
+	CreateActivityParams                 = tables.CreateActivityParams
+	GetActivitiesParams                  = tables.GetActivitiesParams
+	DeleteActivityParams                 = tables.DeleteActivityParams
+	GetActivityAndContactParams          = tables.GetActivityAndContactParams
+	GetActivityAndContactForUpdateParams = tables.GetActivityAndContactForUpdateParams
+	UpdateActivityParams                 = tables.UpdateActivityParams
+	GetActivitiesForNamespaceParams      = tables.GetActivitiesForNamespaceParams
 )
 
 type (
-	CreateActivityRow        = tables.CreateActivityRow
-	UpdateActivityRow        = tables.UpdateActivityRow
-	GetActivitiesRow         = tables.GetActivitiesRow
-	GetActivityAndContactRow = tables.GetActivityAndContactRow
+	CreateActivityRow                 = tables.CreateActivityRow
+	UpdateActivityRow                 = tables.UpdateActivityRow
+	GetActivitiesRow                  = tables.GetActivitiesRow
+	GetActivityAndContactRow          = tables.GetActivityAndContactRow
+	GetActivityAndContactForUpdateRow = tables.GetActivityAndContactForUpdateRow
+	GetActivitiesForNamespaceRow      = tables.GetActivitiesForNamespaceRow
 )
@@ -0,0 +1,13 @@
+package models
+
+// BatchOperation is an operation of a batch. Entities that were created by earlier operations of the same
+// batch can be referenced by the index of their operation, since their IDs aren't known before the batch is applied.
+type BatchOperation struct {
+	Mutation SyncMutation
+
+	// IDReference is the index of the operation that created the entity to update or delete, or nil if `Mutation.ID` is used
+	IDReference *int
+	// ContactIDReference is the index of the operation that created the contact of a debt or activity,
+	// or nil if the contact ID of the mutation is used
+	ContactIDReference *int
+}
@@ -0,0 +1,21 @@
+package models
+
+const (
+	ChangeEntityContact      = "contact"
+	ChangeEntityDebt         = "debt"
+	ChangeEntityActivity     = "activity"
+	ChangeEntityJournalEntry = "journal_entry"
+
+	ChangeOperationCreated = "created"
+	ChangeOperationUpdated = "updated"
+	ChangeOperationDeleted = "deleted"
+	ChangeOperationSettled = "settled"
+)
+
+// Change notifies about an entity of a namespace that was created, updated or deleted
+type Change struct {
+	Namespace  string `json:"namespace"`
+	EntityType string `json:"entity_type"`
+	ID         int32  `json:"id"`
+	Operation  string `json:"operation"`
+}
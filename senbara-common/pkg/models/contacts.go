@@ -3,11 +3,13 @@ package models
 import "github.com/pojntfx/senbara/senbara-common/internal/tables"
 
 type (
-	CreateContactParams         = tables.CreateContactParams
-	GetContactParams            = tables.GetContactParams
-	DeleteContactParams         = tables.DeleteContactParams
-	DeleteDebtsForContactParams = tables.DeleteDebtsForContactParams
-	UpdateContactParams         = tables.UpdateContactParams
+	CreateContactParams             = tables.CreateContactParams
+	GetContactParams                = tables.GetContactParams
+	GetContactForUpdateParams       = tables.GetContactForUpdateParams
+	DeleteContactParams             = tables.DeleteContactParams
+	DeleteDebtsForContactParams     = tables.DeleteDebtsForContactParams
+	DeleteActivitesForContactParams = tables.DeleteActivitesForContactParams
+	UpdateContactParams             = tables.UpdateContactParams
 )
 
 type (
@@ -3,16 +3,20 @@ package models
 import "github.com/pojntfx/senbara/senbara-common/internal/tables"
 
 type (
-	CreateDebtParams        = tables.CreateDebtParams
-	GetDebtsParams          = tables.GetDebtsParams
-	SettleDebtParams        = tables.SettleDebtParams
-	GetDebtAndContactParams = tables.GetDebtAndContactParams
-	UpdateDebtParams        = tables.UpdateDebtParams
+	CreateDebtParams                 = tables.CreateDebtParams
+	GetDebtsParams                   = tables.GetDebtsParams
+	SettleDebtParams                 = tables.SettleDebtParams
+	GetDebtAndContactParams          = tables.GetDebtAndContactParams
+	GetDebtAndContactForUpdateParams = tables.GetDebtAndContactForUpdateParams
+	UpdateDebtParams                 = tables.UpdateDebtParams
+	GetDebtsForNamespaceParams       = tables.GetDebtsForNamespaceParams
 )
 
 type (
-	CreateDebtRow        = tables.CreateDebtRow
-	UpdateDebtRow        = tables.UpdateDebtRow
-	GetDebtsRow          = tables.GetDebtsRow
-	GetDebtAndContactRow = tables.GetDebtAndContactRow
+	CreateDebtRow                 = tables.CreateDebtRow
+	UpdateDebtRow                 = tables.UpdateDebtRow
+	GetDebtsRow                   = tables.GetDebtsRow
+	GetDebtAndContactRow          = tables.GetDebtAndContactRow
+	GetDebtAndContactForUpdateRow = tables.GetDebtAndContactForUpdateRow
+	GetDebtsForNamespaceRow       = tables.GetDebtsForNamespaceRow
 )
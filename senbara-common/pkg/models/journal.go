@@ -3,10 +3,11 @@ package models
 import "github.com/pojntfx/senbara/senbara-common/internal/tables"
 
 type (
-	CreateJournalEntryParams = tables.CreateJournalEntryParams
-	DeleteJournalEntryParams = tables.DeleteJournalEntryParams
-	GetJournalEntryParams    = tables.GetJournalEntryParams
-	UpdateJournalEntryParams = tables.UpdateJournalEntryParams
+	CreateJournalEntryParams       = tables.CreateJournalEntryParams
+	DeleteJournalEntryParams       = tables.DeleteJournalEntryParams
+	GetJournalEntryParams          = tables.GetJournalEntryParams
+	GetJournalEntryForUpdateParams = tables.GetJournalEntryForUpdateParams
+	UpdateJournalEntryParams       = tables.UpdateJournalEntryParams
 )
 
 type (
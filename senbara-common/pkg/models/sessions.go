@@ -0,0 +1,16 @@
+package models
+
+import "github.com/pojntfx/senbara/senbara-common/internal/tables"
+
+type (
+	TouchSessionParams       = tables.TouchSessionParams
+	RevokeSessionParams      = tables.RevokeSessionParams
+	RevokeOIDCSessionsParams = tables.RevokeOIDCSessionsParams
+)
+
+type (
+	Session = tables.Session
+
+	TouchSessionRow = tables.TouchSessionRow
+	GetSessionsRow  = tables.GetSessionsRow
+)
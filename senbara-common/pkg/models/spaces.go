@@ -0,0 +1,29 @@
+package models
+
+import "github.com/pojntfx/senbara/senbara-common/internal/tables"
+
+const (
+	SpaceRoleOwner  = "owner"
+	SpaceRoleEditor = "editor"
+	SpaceRoleViewer = "viewer"
+)
+
+type (
+	CreatePersonalSpaceParams           = tables.CreatePersonalSpaceParams
+	CreateSpaceMemberParams             = tables.CreateSpaceMemberParams
+	GetSpaceParams                      = tables.GetSpaceParams
+	DeleteSpaceMemberParams             = tables.DeleteSpaceMemberParams
+	DeleteSpaceMemberForNamespaceParams = tables.DeleteSpaceMemberForNamespaceParams
+	CreateSpaceInvitationParams         = tables.CreateSpaceInvitationParams
+	DeleteSpaceInvitationParams         = tables.DeleteSpaceInvitationParams
+)
+
+type (
+	Space           = tables.Space
+	SpaceInvitation = tables.SpaceInvitation
+
+	GetSpacesRow           = tables.GetSpacesRow
+	GetSpaceRow            = tables.GetSpaceRow
+	GetSpaceMembersRow     = tables.GetSpaceMembersRow
+	GetSpaceInvitationsRow = tables.GetSpaceInvitationsRow
+)
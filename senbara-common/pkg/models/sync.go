@@ -0,0 +1,99 @@
+package models
+
+import (
+	"time"
+
+	"github.com/pojntfx/senbara/senbara-common/internal/tables"
+)
+
+const (
+	SyncOperationCreate = "create"
+	SyncOperationUpdate = "update"
+	SyncOperationDelete = "delete"
+)
+
+type (
+	GetContactsChangedSinceParams       = tables.GetContactsChangedSinceParams
+	GetJournalEntriesChangedSinceParams = tables.GetJournalEntriesChangedSinceParams
+	GetDebtsChangedSinceParams          = tables.GetDebtsChangedSinceParams
+	GetActivitiesChangedSinceParams     = tables.GetActivitiesChangedSinceParams
+	GetTombstonesSinceParams            = tables.GetTombstonesSinceParams
+
+	GetContactChangeSequenceParams      = tables.GetContactChangeSequenceParams
+	GetJournalEntryChangeSequenceParams = tables.GetJournalEntryChangeSequenceParams
+	GetDebtChangeSequenceParams         = tables.GetDebtChangeSequenceParams
+	GetActivityChangeSequenceParams     = tables.GetActivityChangeSequenceParams
+)
+
+type (
+	GetDebtsChangedSinceRow      = tables.GetDebtsChangedSinceRow
+	GetActivitiesChangedSinceRow = tables.GetActivitiesChangedSinceRow
+	GetTombstonesSinceRow        = tables.GetTombstonesSinceRow
+)
+
+// Changeset contains the entities of a namespace that were created, updated or deleted since a cursor
+type Changeset struct {
+	// Cursor is the change sequence number of the latest change in the changeset, or the cursor that
+	// the changeset was requested with if nothing changed since
+	Cursor int64
+
+	Contacts       []Contact
+	JournalEntries []JournalEntry
+	Debts          []GetDebtsChangedSinceRow
+	Activities     []GetActivitiesChangedSinceRow
+
+	Tombstones []GetTombstonesSinceRow
+}
+
+// SyncMutation is a change that a client made to an entity while it was offline. Updates and deletions are
+// only applied if the entity hasn't changed since `BaseChangeSequence`, which is the cursor that the client's
+// copy of the entity is based on. Only the fields of the mutation's entity type are used.
+type SyncMutation struct {
+	EntityType string
+	Operation  string
+
+	ID                 int32
+	BaseChangeSequence int64
+
+	Contact      SyncContact
+	JournalEntry SyncJournalEntry
+	Debt         SyncDebt
+	Activity     SyncActivity
+}
+
+type SyncContact struct {
+	FirstName string
+	LastName  string
+	Nickname  string
+	Email     string
+	Pronouns  string
+	Birthday  *time.Time
+	Address   string
+	Notes     string
+}
+
+type SyncJournalEntry struct {
+	Title  string
+	Body   string
+	Rating int32
+
+	EncryptionAlgorithm string
+	EncryptionKDF       string
+	EncryptionSalt      string
+}
+
+type SyncDebt struct {
+	ContactID int32
+
+	Amount      float64
+	Currency    string
+	Description string
+}
+
+type SyncActivity struct {
+	ContactID int32
+
+	Name        string
+	Date        time.Time
+	Description string
+}
@@ -15,12 +15,15 @@ type (
 	ExportedJournalEntry = struct {
 		ExportedEntityIdentifier
 
-		ID        int32     `json:"id"`
-		Title     string    `json:"title"`
-		Date      time.Time `json:"date"`
-		Body      string    `json:"body"`
-		Rating    int32     `json:"rating"`
-		Namespace string    `json:"namespace"`
+		ID                  int32     `json:"id"`
+		Title               string    `json:"title"`
+		Date                time.Time `json:"date"`
+		Body                string    `json:"body"`
+		Rating              int32     `json:"rating"`
+		Namespace           string    `json:"namespace"`
+		EncryptionAlgorithm string    `json:"encryptionAlgorithm,omitempty"`
+		EncryptionKDF       string    `json:"encryptionKdf,omitempty"`
+		EncryptionSalt      string    `json:"encryptionSalt,omitempty"`
 	}
 
 	ExportedContact = struct {
@@ -0,0 +1,60 @@
+package models
+
+import "github.com/pojntfx/senbara/senbara-common/internal/tables"
+
+// Webhook event types are named after the entity that changed and the operation
+const (
+	WebhookEventContactCreated = ChangeEntityContact + "." + ChangeOperationCreated
+	WebhookEventContactUpdated = ChangeEntityContact + "." + ChangeOperationUpdated
+	WebhookEventContactDeleted = ChangeEntityContact + "." + ChangeOperationDeleted
+
+	WebhookEventDebtCreated = ChangeEntityDebt + "." + ChangeOperationCreated
+	WebhookEventDebtUpdated = ChangeEntityDebt + "." + ChangeOperationUpdated
+	WebhookEventDebtSettled = ChangeEntityDebt + "." + ChangeOperationSettled
+
+	WebhookEventJournalEntryCreated = ChangeEntityJournalEntry + "." + ChangeOperationCreated
+	WebhookEventJournalEntryUpdated = ChangeEntityJournalEntry + "." + ChangeOperationUpdated
+	WebhookEventJournalEntryDeleted = ChangeEntityJournalEntry + "." + ChangeOperationDeleted
+)
+
+// WebhookEventTypes are the event types that webhooks can subscribe to
+var WebhookEventTypes = []string{
+	WebhookEventContactCreated,
+	WebhookEventContactUpdated,
+	WebhookEventContactDeleted,
+
+	WebhookEventDebtCreated,
+	WebhookEventDebtUpdated,
+	WebhookEventDebtSettled,
+
+	WebhookEventJournalEntryCreated,
+	WebhookEventJournalEntryUpdated,
+	WebhookEventJournalEntryDeleted,
+}
+
+// WebhookEventData is the data of a webhook event. It only identifies the entity that changed, so that no
+// personal data is stored in the outbox or sent to third parties; receivers can fetch the entity from the REST API.
+type WebhookEventData struct {
+	ID int32 `json:"id"`
+}
+
+type (
+	CreateWebhookParams              = tables.CreateWebhookParams
+	GetWebhookParams                 = tables.GetWebhookParams
+	DeleteWebhookParams              = tables.DeleteWebhookParams
+	GetWebhookDeliveriesParams       = tables.GetWebhookDeliveriesParams
+	CreateWebhookOutboxEntriesParams = tables.CreateWebhookOutboxEntriesParams
+	ClaimWebhookOutboxEntriesParams  = tables.ClaimWebhookOutboxEntriesParams
+	CreateWebhookDeliveryParams      = tables.CreateWebhookDeliveryParams
+	UpdateWebhookOutboxEntryParams   = tables.UpdateWebhookOutboxEntryParams
+)
+
+type (
+	Webhook = tables.Webhook
+
+	CreateWebhookRow             = tables.CreateWebhookRow
+	GetWebhookRow                = tables.GetWebhookRow
+	GetWebhooksRow               = tables.GetWebhooksRow
+	GetWebhookDeliveriesRow      = tables.GetWebhookDeliveriesRow
+	ClaimWebhookOutboxEntriesRow = tables.ClaimWebhookOutboxEntriesRow
+)
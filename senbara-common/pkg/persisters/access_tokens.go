@@ -0,0 +1,102 @@
+package persisters
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+var (
+	ErrAccessTokenDoesNotExist = errors.New("access token does not exist")
+)
+
+// hashAccessToken hashes a personal access token for storage; since tokens are random and long, a fast hash is sufficient
+func hashAccessToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// CreateAccessToken creates a personal access token for the account with the namespace. The token itself is only
+// returned here, only its hash is stored.
+func (p *Persister) CreateAccessToken(ctx context.Context, name, scope, namespace string) (models.CreateAccessTokenRow, string, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.CreateAccessToken")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating access token", "name", name, "scope", scope)
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return models.CreateAccessTokenRow{}, "", err
+	}
+
+	token := models.AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	accessToken, err := p.queries.CreateAccessToken(ctx, models.CreateAccessTokenParams{
+		Namespace: namespace,
+		Name:      name,
+		Scope:     scope,
+		TokenHash: hashAccessToken(token),
+	})
+	if err != nil {
+		return models.CreateAccessTokenRow{}, "", err
+	}
+
+	return accessToken, token, nil
+}
+
+func (p *Persister) GetAccessTokens(ctx context.Context, namespace string) ([]models.GetAccessTokensRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetAccessTokens")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting access tokens")
+
+	return p.queries.GetAccessTokens(ctx, namespace)
+}
+
+func (p *Persister) DeleteAccessToken(ctx context.Context, id int32, namespace string) (int32, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.DeleteAccessToken")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Deleting access token", "id", id)
+
+	deletedID, err := p.queries.DeleteAccessToken(ctx, models.DeleteAccessTokenParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, ErrAccessTokenDoesNotExist
+		}
+
+		return -1, err
+	}
+
+	return deletedID, nil
+}
+
+// GetAccountForAccessToken returns the account which created the personal access token and the token's scope
+func (p *Persister) GetAccountForAccessToken(ctx context.Context, token string) (models.GetAccountForAccessTokenRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetAccountForAccessToken")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).Debug("Getting account for access token")
+
+	account, err := p.queries.GetAccountForAccessToken(ctx, hashAccessToken(token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.GetAccountForAccessTokenRow{}, ErrAccessTokenDoesNotExist
+		}
+
+		return models.GetAccountForAccessTokenRow{}, err
+	}
+
+	return account, nil
+}
@@ -0,0 +1,91 @@
+package persisters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+// GetNamespaceForAccount returns the namespace of the account for the issuer and subject. If the account
+// doesn't exist yet and `claim` is set, an account which was created before namespaces were subject-based
+// is claimed by its email, and if there is none, a new account with its personal space is created.
+func (p *Persister) GetNamespaceForAccount(ctx context.Context, issuer, subject, email string, claim bool) (string, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetNamespaceForAccount")
+	defer span.End()
+
+	log := telemetry.Logger(ctx, p.log).With("issuer", issuer, "subject", subject)
+
+	log.Debug("Getting namespace for account", "email", email)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	account, err := qtx.GetAccount(ctx, models.GetAccountParams{
+		Issuer:  issuer,
+		Subject: subject,
+	})
+	if err == nil {
+		if account.Email != email {
+			log.Debug("Updating email for account", "oldEmail", account.Email, "newEmail", email)
+
+			if account, err = qtx.UpdateAccountEmail(ctx, models.UpdateAccountEmailParams{
+				Issuer:  issuer,
+				Subject: subject,
+				Email:   email,
+			}); err != nil {
+				return "", err
+			}
+		}
+	} else {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return "", err
+		}
+
+		// If the account may not be claimed, `err` stays `sql.ErrNoRows` and a new account is created
+		if claim {
+			account, err = qtx.ClaimAccount(ctx, models.ClaimAccountParams{
+				Issuer:  issuer,
+				Subject: subject,
+				Email:   email,
+			})
+		}
+		if err == nil {
+			log.Debug("Claimed existing account by email", "email", email)
+		} else {
+			if !errors.Is(err, sql.ErrNoRows) {
+				return "", err
+			}
+
+			log.Debug("Creating account", "email", email)
+
+			if account, err = qtx.CreateAccount(ctx, models.CreateAccountParams{
+				Issuer:  issuer,
+				Subject: subject,
+				Email:   email,
+			}); err != nil {
+				return "", err
+			}
+
+			if _, err := qtx.CreatePersonalSpace(ctx, models.CreatePersonalSpaceParams{
+				Name:      email,
+				Namespace: account.Namespace,
+			}); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return account.Namespace, nil
+}
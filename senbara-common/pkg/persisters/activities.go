@@ -2,9 +2,11 @@ package persisters
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 func (p *Persister) CreateActivity(
@@ -17,15 +19,39 @@ func (p *Persister) CreateActivity(
 	contactID int32,
 	namespace string,
 ) (models.CreateActivityRow, error) {
-	p.log.With("namespace", namespace).Debug("Creating activity", "name", name, "date", date, "contactID", contactID)
+	ctx, span := p.tracer.Start(ctx, "Persister.CreateActivity")
+	defer span.End()
 
-	return p.queries.CreateActivity(ctx, models.CreateActivityParams{
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating activity", "name", name, "date", date, "contactID", contactID)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.CreateActivityRow{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	activity, err := qtx.CreateActivity(ctx, models.CreateActivityParams{
 		ID:          contactID,
 		Namespace:   namespace,
 		Name:        name,
 		Date:        date,
 		Description: description,
 	})
+	if err != nil {
+		return models.CreateActivityRow{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityActivity, models.ChangeOperationCreated, activity.ID); err != nil {
+		return models.CreateActivityRow{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.CreateActivityRow{}, err
+	}
+
+	return activity, nil
 }
 
 func (p *Persister) GetActivities(
@@ -34,7 +60,10 @@ func (p *Persister) GetActivities(
 	contactID int32,
 	namespace string,
 ) ([]models.GetActivitiesRow, error) {
-	p.log.With("namespace", namespace).Debug("Getting activities", "contactID", contactID)
+	ctx, span := p.tracer.Start(ctx, "Persister.GetActivities")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting activities", "contactID", contactID)
 
 	return p.queries.GetActivities(ctx, models.GetActivitiesParams{
 		ID:        contactID,
@@ -42,6 +71,49 @@ func (p *Persister) GetActivities(
 	})
 }
 
+// GetActivitiesForNamespace returns the activities of all contacts in a namespace, with the latest activities
+// first. Filters that are set to their zero value (or nil for the dates) are ignored; the date range includes
+// both `startDate` and `endDate`, and `query` matches the name, description and the contact's name.
+func (p *Persister) GetActivitiesForNamespace(
+	ctx context.Context,
+
+	namespace string,
+
+	contactID int32,
+	startDate,
+	endDate *time.Time,
+	query string,
+) ([]models.GetActivitiesForNamespaceRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetActivitiesForNamespace")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting activities for namespace", "contactID", contactID, "startDate", startDate, "endDate", endDate, "query", query)
+
+	var startDateFilter sql.NullTime
+	if startDate != nil {
+		startDateFilter = sql.NullTime{
+			Time:  *startDate,
+			Valid: true,
+		}
+	}
+
+	var endDateFilter sql.NullTime
+	if endDate != nil {
+		endDateFilter = sql.NullTime{
+			Time:  *endDate,
+			Valid: true,
+		}
+	}
+
+	return p.queries.GetActivitiesForNamespace(ctx, models.GetActivitiesForNamespaceParams{
+		Namespace: namespace,
+		ContactID: contactID,
+		StartDate: startDateFilter,
+		EndDate:   endDateFilter,
+		Query:     query,
+	})
+}
+
 func (p *Persister) DeleteActivity(
 	ctx context.Context,
 
@@ -49,12 +121,36 @@ func (p *Persister) DeleteActivity(
 
 	namespace string,
 ) (int32, error) {
-	p.log.With("namespace", namespace).Debug("Deleting activity", "id", id)
+	ctx, span := p.tracer.Start(ctx, "Persister.DeleteActivity")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Deleting activity", "id", id)
 
-	return p.queries.DeleteActivity(ctx, models.DeleteActivityParams{
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	deletedActivityID, err := qtx.DeleteActivity(ctx, models.DeleteActivityParams{
 		ID:        id,
 		Namespace: namespace,
 	})
+	if err != nil {
+		return -1, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityActivity, models.ChangeOperationDeleted, deletedActivityID); err != nil {
+		return -1, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return -1, err
+	}
+
+	return deletedActivityID, nil
 }
 
 func (p *Persister) GetActivityAndContact(
@@ -64,7 +160,10 @@ func (p *Persister) GetActivityAndContact(
 
 	namespace string,
 ) (models.GetActivityAndContactRow, error) {
-	p.log.With("namespace", namespace).Debug("Getting activity and contact", "id", id)
+	ctx, span := p.tracer.Start(ctx, "Persister.GetActivityAndContact")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting activity and contact", "id", id)
 
 	return p.queries.GetActivityAndContact(ctx, models.GetActivityAndContactParams{
 		ID:        id,
@@ -83,13 +182,93 @@ func (p *Persister) UpdateActivity(
 	date time.Time,
 	description string,
 ) (models.UpdateActivityRow, error) {
-	p.log.With("namespace", namespace).Debug("Updating activity", "id", id, "name", name, "date", date)
+	ctx, span := p.tracer.Start(ctx, "Persister.UpdateActivity")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Updating activity", "id", id, "name", name, "date", date)
 
-	return p.queries.UpdateActivity(ctx, models.UpdateActivityParams{
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.UpdateActivityRow{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	activity, err := qtx.UpdateActivity(ctx, models.UpdateActivityParams{
 		ID:          id,
 		Namespace:   namespace,
 		Name:        name,
 		Date:        date,
 		Description: description,
 	})
+	if err != nil {
+		return models.UpdateActivityRow{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityActivity, models.ChangeOperationUpdated, activity.ID); err != nil {
+		return models.UpdateActivityRow{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.UpdateActivityRow{}, err
+	}
+
+	return activity, nil
+}
+
+// PatchActivity locks the activity and updates it with the params that `patch` returns for its current state in one
+// transaction, so that concurrent patches can't overwrite each other's changes
+func (p *Persister) PatchActivity(
+	ctx context.Context,
+
+	id int32,
+
+	namespace string,
+
+	patch func(activity models.GetActivityAndContactForUpdateRow) (models.UpdateActivityParams, error),
+) (models.UpdateActivityRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.PatchActivity")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Patching activity", "id", id)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.UpdateActivityRow{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	currentActivity, err := qtx.GetActivityAndContactForUpdate(ctx, models.GetActivityAndContactForUpdateParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return models.UpdateActivityRow{}, err
+	}
+
+	params, err := patch(currentActivity)
+	if err != nil {
+		return models.UpdateActivityRow{}, err
+	}
+
+	params.ID = id
+	params.Namespace = namespace
+
+	activity, err := qtx.UpdateActivity(ctx, params)
+	if err != nil {
+		return models.UpdateActivityRow{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityActivity, models.ChangeOperationUpdated, activity.ID); err != nil {
+		return models.UpdateActivityRow{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.UpdateActivityRow{}, err
+	}
+
+	return activity, nil
 }
@@ -0,0 +1,78 @@
+package persisters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+var (
+	ErrBatchEntityDoesNotExist = errors.New("entity does not exist")
+	ErrInvalidBatchReference   = errors.New("batch references must point to an earlier operation that created an entity of the referenced type")
+)
+
+// ApplyBatch applies the operations of a batch in order and in one transaction, and returns the IDs of their
+// entities. If an operation fails, none of the operations are applied and `failed` is the index of the operation.
+func (p *Persister) ApplyBatch(ctx context.Context, operations []models.BatchOperation, namespace string) (ids []int32, failed int, err error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.ApplyBatch")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Applying batch", "len", len(operations))
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, -1, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	ids = []int32{}
+	for i, operation := range operations {
+		mutation := operation.Mutation
+
+		if ref := operation.IDReference; ref != nil {
+			if !isBatchReference(operations, i, *ref, mutation.EntityType) {
+				return nil, i, ErrInvalidBatchReference
+			}
+
+			mutation.ID = ids[*ref]
+		}
+
+		if ref := operation.ContactIDReference; ref != nil {
+			if !isBatchReference(operations, i, *ref, models.ChangeEntityContact) {
+				return nil, i, ErrInvalidBatchReference
+			}
+
+			mutation.Debt.ContactID = ids[*ref]
+			mutation.Activity.ContactID = ids[*ref]
+		}
+
+		id, err := applySyncMutation(ctx, qtx, mutation, namespace)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, i, ErrBatchEntityDoesNotExist
+			}
+
+			return nil, i, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, -1, err
+	}
+
+	return ids, -1, nil
+}
+
+func isBatchReference(operations []models.BatchOperation, i, ref int, entityType string) bool {
+	return ref >= 0 &&
+		ref < i &&
+		operations[ref].Mutation.EntityType == entityType &&
+		operations[ref].Mutation.Operation == models.SyncOperationCreate
+}
@@ -0,0 +1,155 @@
+package persisters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pojntfx/senbara/senbara-common/internal/tables"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+const (
+	// changesChannel is the channel that changes are sent to by the `NotifyChange` query
+	changesChannel = "senbara_changes"
+
+	minListenerReconnectInterval = time.Second
+	maxListenerReconnectInterval = time.Minute
+
+	// changeSubscriptionBufferSize is the number of changes that are buffered for a subscriber before it is
+	// considered too slow and its subscription is closed
+	changeSubscriptionBufferSize = 64
+)
+
+type changeSubscription struct {
+	namespace string
+	changes   chan models.Change
+}
+
+// recordChange notifies subscribers about a change and writes the webhook event for it to the outbox. It must be
+// called with the transaction of the change, since notifications are only sent once the transaction is committed.
+func recordChange(ctx context.Context, qtx *tables.Queries, namespace, entityType, operation string, id int32) error {
+	if err := createWebhookEvent(ctx, qtx, namespace, entityType+"."+operation, id); err != nil {
+		return err
+	}
+
+	change, err := json.Marshal(models.Change{
+		Namespace:  namespace,
+		EntityType: entityType,
+		ID:         id,
+		Operation:  operation,
+	})
+	if err != nil {
+		return err
+	}
+
+	return qtx.NotifyChange(ctx, string(change))
+}
+
+// SubscribeToChanges returns the changes to the entities of the namespace until the context is cancelled. Changes
+// can be missed if the subscription is closed before the context is cancelled, which happens if the subscriber
+// doesn't keep up or the connection to the database was lost, so subscribers should reload their data if it is.
+func (p *Persister) SubscribeToChanges(ctx context.Context, namespace string) (<-chan models.Change, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.SubscribeToChanges")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Subscribing to changes")
+
+	p.changesLock.Lock()
+	defer p.changesLock.Unlock()
+
+	// All subscriptions share one connection that is only opened once the first subscriber needs it
+	if p.changesListener == nil {
+		listener := pq.NewListener(p.pgaddr, minListenerReconnectInterval, maxListenerReconnectInterval, func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				p.log.Warn("Could not listen for changes", "event", event, "err", err)
+			}
+		})
+
+		if err := listener.Listen(changesChannel); err != nil {
+			_ = listener.Close()
+
+			return nil, err
+		}
+
+		p.changesListener = listener
+		p.changeSubscriptions = map[*changeSubscription]struct{}{}
+
+		go p.dispatchChanges(listener)
+	}
+
+	subscription := &changeSubscription{
+		namespace: namespace,
+		changes:   make(chan models.Change, changeSubscriptionBufferSize),
+	}
+	p.changeSubscriptions[subscription] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+
+		p.changesLock.Lock()
+		defer p.changesLock.Unlock()
+
+		p.closeChangeSubscription(subscription)
+	}()
+
+	return subscription.changes, nil
+}
+
+// closeChangeSubscription closes a subscription if it hasn't been closed yet. Must be called with the changes lock held.
+func (p *Persister) closeChangeSubscription(subscription *changeSubscription) {
+	if _, ok := p.changeSubscriptions[subscription]; !ok {
+		return
+	}
+
+	delete(p.changeSubscriptions, subscription)
+
+	close(subscription.changes)
+}
+
+func (p *Persister) dispatchChanges(listener *pq.Listener) {
+	for notification := range listener.Notify {
+		p.changesLock.Lock()
+
+		// The listener sends `nil` after it reconnected, at which point changes might have been missed
+		if notification == nil {
+			p.log.Debug("Reconnected to listen for changes, closing subscriptions", "len", len(p.changeSubscriptions))
+
+			for subscription := range p.changeSubscriptions {
+				p.closeChangeSubscription(subscription)
+			}
+
+			p.changesLock.Unlock()
+
+			continue
+		}
+
+		var change models.Change
+		if err := json.Unmarshal([]byte(notification.Extra), &change); err != nil {
+			p.log.Warn("Could not parse change", "err", err)
+
+			p.changesLock.Unlock()
+
+			continue
+		}
+
+		for subscription := range p.changeSubscriptions {
+			if subscription.namespace != change.Namespace {
+				continue
+			}
+
+			select {
+			case subscription.changes <- change:
+
+			default:
+				p.log.Debug("Subscriber can't keep up with changes, closing subscription", "namespace", change.Namespace)
+
+				p.closeChangeSubscription(subscription)
+			}
+		}
+
+		p.changesLock.Unlock()
+	}
+}
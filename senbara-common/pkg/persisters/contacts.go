@@ -5,11 +5,16 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/pojntfx/senbara/senbara-common/internal/tables"
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 func (p *Persister) GetContacts(ctx context.Context, namespace string) ([]models.Contact, error) {
-	p.log.With("namespace", namespace).Debug("Getting contacts")
+	ctx, span := p.tracer.Start(ctx, "Persister.GetContacts")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting contacts")
 
 	return p.queries.GetContacts(ctx, namespace)
 }
@@ -23,9 +28,20 @@ func (p *Persister) CreateContact(
 	pronouns string,
 	namespace string,
 ) (models.Contact, error) {
-	p.log.With("namespace", namespace).Debug("Creating contact", "firstName", firstName, "lastName", lastName)
+	ctx, span := p.tracer.Start(ctx, "Persister.CreateContact")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating contact", "firstName", firstName, "lastName", lastName)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Contact{}, err
+	}
+	defer tx.Rollback()
 
-	return p.queries.CreateContact(ctx, models.CreateContactParams{
+	qtx := p.withTx(tx)
+
+	contact, err := qtx.CreateContact(ctx, models.CreateContactParams{
 		FirstName: firstName,
 		LastName:  lastName,
 		Nickname:  nickname,
@@ -33,10 +49,26 @@ func (p *Persister) CreateContact(
 		Pronouns:  pronouns,
 		Namespace: namespace,
 	})
+	if err != nil {
+		return models.Contact{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityContact, models.ChangeOperationCreated, contact.ID); err != nil {
+		return models.Contact{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Contact{}, err
+	}
+
+	return contact, nil
 }
 
 func (p *Persister) GetContact(ctx context.Context, id int32, namespace string) (models.Contact, error) {
-	p.log.With("namespace", namespace).Debug("Getting contact", "id", id)
+	ctx, span := p.tracer.Start(ctx, "Persister.GetContact")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting contact", "id", id)
 
 	return p.queries.GetContact(ctx, models.GetContactParams{
 		ID:        id,
@@ -45,20 +77,20 @@ func (p *Persister) GetContact(ctx context.Context, id int32, namespace string)
 }
 
 func (p *Persister) DeleteContact(ctx context.Context, id int32, namespace string) (int32, error) {
-	p.log.With("namespace", namespace).Debug("Deleting contact", "id", id)
+	ctx, span := p.tracer.Start(ctx, "Persister.DeleteContact")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Deleting contact", "id", id)
 
-	tx, err := p.db.Begin()
+	tx, err := p.db.BeginTx(ctx, nil)
 	if err != nil {
 		return -1, err
 	}
 	defer tx.Rollback()
 
-	qtx := p.queries.WithTx(tx)
+	qtx := p.withTx(tx)
 
-	if err := qtx.DeleteDebtsForContact(ctx, models.DeleteDebtsForContactParams{
-		ID:        id,
-		Namespace: namespace,
-	}); err != nil {
+	if err := deleteDebtsAndActivitiesForContact(ctx, qtx, id, namespace); err != nil {
 		return -1, err
 	}
 
@@ -70,6 +102,10 @@ func (p *Persister) DeleteContact(ctx context.Context, id int32, namespace strin
 		return -1, err
 	}
 
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityContact, models.ChangeOperationDeleted, deletedContactID); err != nil {
+		return -1, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return -1, err
 	}
@@ -77,6 +113,40 @@ func (p *Persister) DeleteContact(ctx context.Context, id int32, namespace strin
 	return deletedContactID, nil
 }
 
+// deleteDebtsAndActivitiesForContact deletes the debts and activities of a contact before it is deleted and
+// records the changes for them, since subscribers aren't notified about rows that are deleted with the contact
+func deleteDebtsAndActivitiesForContact(ctx context.Context, qtx *tables.Queries, id int32, namespace string) error {
+	debtIDs, err := qtx.DeleteDebtsForContact(ctx, models.DeleteDebtsForContactParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, debtID := range debtIDs {
+		if err := recordChange(ctx, qtx, namespace, models.ChangeEntityDebt, models.ChangeOperationSettled, debtID); err != nil {
+			return err
+		}
+	}
+
+	activityIDs, err := qtx.DeleteActivitesForContact(ctx, models.DeleteActivitesForContactParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, activityID := range activityIDs {
+		if err := recordChange(ctx, qtx, namespace, models.ChangeEntityActivity, models.ChangeOperationDeleted, activityID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (p *Persister) UpdateContact(
 	ctx context.Context,
 	id int32,
@@ -90,7 +160,10 @@ func (p *Persister) UpdateContact(
 	address,
 	notes string,
 ) (models.Contact, error) {
-	p.log.With("namespace", namespace).Debug("Updating contact", "id", id, "firstName", firstName, "lastName", lastName)
+	ctx, span := p.tracer.Start(ctx, "Persister.UpdateContact")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Updating contact", "id", id, "firstName", firstName, "lastName", lastName)
 
 	var birthdayDate sql.NullTime
 	if birthday != nil {
@@ -100,7 +173,15 @@ func (p *Persister) UpdateContact(
 		}
 	}
 
-	return p.queries.UpdateContact(ctx, models.UpdateContactParams{
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Contact{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	contact, err := qtx.UpdateContact(ctx, models.UpdateContactParams{
 		ID:        id,
 		Namespace: namespace,
 		FirstName: firstName,
@@ -112,4 +193,73 @@ func (p *Persister) UpdateContact(
 		Address:   address,
 		Notes:     notes,
 	})
+	if err != nil {
+		return models.Contact{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityContact, models.ChangeOperationUpdated, contact.ID); err != nil {
+		return models.Contact{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Contact{}, err
+	}
+
+	return contact, nil
+}
+
+// PatchContact locks the contact and updates it with the params that `patch` returns for its current state in one
+// transaction, so that concurrent patches can't overwrite each other's changes
+func (p *Persister) PatchContact(
+	ctx context.Context,
+
+	id int32,
+
+	namespace string,
+
+	patch func(contact models.Contact) (models.UpdateContactParams, error),
+) (models.Contact, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.PatchContact")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Patching contact", "id", id)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Contact{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	currentContact, err := qtx.GetContactForUpdate(ctx, models.GetContactForUpdateParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return models.Contact{}, err
+	}
+
+	params, err := patch(currentContact)
+	if err != nil {
+		return models.Contact{}, err
+	}
+
+	params.ID = id
+	params.Namespace = namespace
+
+	contact, err := qtx.UpdateContact(ctx, params)
+	if err != nil {
+		return models.Contact{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityContact, models.ChangeOperationUpdated, contact.ID); err != nil {
+		return models.Contact{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Contact{}, err
+	}
+
+	return contact, nil
 }
@@ -2,8 +2,11 @@ package persisters
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 func (p *Persister) CreateDebt(
@@ -16,15 +19,39 @@ func (p *Persister) CreateDebt(
 	contactID int32,
 	namespace string,
 ) (models.CreateDebtRow, error) {
-	p.log.With("namespace", namespace).Debug("Creating debt", "amount", amount, "currency", currency, "contactID", contactID)
+	ctx, span := p.tracer.Start(ctx, "Persister.CreateDebt")
+	defer span.End()
 
-	return p.queries.CreateDebt(ctx, models.CreateDebtParams{
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating debt", "amount", amount, "currency", currency, "contactID", contactID)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.CreateDebtRow{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	debt, err := qtx.CreateDebt(ctx, models.CreateDebtParams{
 		ID:          contactID,
 		Namespace:   namespace,
 		Amount:      amount,
 		Currency:    currency,
 		Description: description,
 	})
+	if err != nil {
+		return models.CreateDebtRow{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityDebt, models.ChangeOperationCreated, debt.ID); err != nil {
+		return models.CreateDebtRow{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.CreateDebtRow{}, err
+	}
+
+	return debt, nil
 }
 
 func (p *Persister) GetDebts(
@@ -33,7 +60,10 @@ func (p *Persister) GetDebts(
 	contactID int32,
 	namespace string,
 ) ([]models.GetDebtsRow, error) {
-	p.log.With("namespace", namespace).Debug("Getting debts", "contactID", contactID)
+	ctx, span := p.tracer.Start(ctx, "Persister.GetDebts")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting debts", "contactID", contactID)
 
 	return p.queries.GetDebts(ctx, models.GetDebtsParams{
 		ID:        contactID,
@@ -41,6 +71,61 @@ func (p *Persister) GetDebts(
 	})
 }
 
+// GetDebtsForNamespace returns the debts of all contacts in a namespace. Filters that are set to their zero value
+// (or nil for `youOwe`, `startDate` and `endDate`) are ignored; `query` matches the description and the contact's
+// name. The date range matches the day that a debt was created on.
+func (p *Persister) GetDebtsForNamespace(
+	ctx context.Context,
+
+	namespace string,
+
+	contactID int32,
+	currency string,
+	youOwe *bool,
+	startDate,
+	endDate *time.Time,
+	query string,
+) ([]models.GetDebtsForNamespaceRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetDebtsForNamespace")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting debts for namespace", "contactID", contactID, "currency", currency, "youOwe", youOwe, "startDate", startDate, "endDate", endDate, "query", query)
+
+	var youOweFilter sql.NullBool
+	if youOwe != nil {
+		youOweFilter = sql.NullBool{
+			Bool:  *youOwe,
+			Valid: true,
+		}
+	}
+
+	var startDateFilter sql.NullTime
+	if startDate != nil {
+		startDateFilter = sql.NullTime{
+			Time:  *startDate,
+			Valid: true,
+		}
+	}
+
+	var endDateFilter sql.NullTime
+	if endDate != nil {
+		endDateFilter = sql.NullTime{
+			Time:  *endDate,
+			Valid: true,
+		}
+	}
+
+	return p.queries.GetDebtsForNamespace(ctx, models.GetDebtsForNamespaceParams{
+		Namespace: namespace,
+		ContactID: contactID,
+		Currency:  currency,
+		YouOwe:    youOweFilter,
+		StartDate: startDateFilter,
+		EndDate:   endDateFilter,
+		Query:     query,
+	})
+}
+
 func (p *Persister) SettleDebt(
 	ctx context.Context,
 
@@ -48,12 +133,36 @@ func (p *Persister) SettleDebt(
 
 	namespace string,
 ) (int32, error) {
-	p.log.With("namespace", namespace).Debug("Settling debt", "id", id)
+	ctx, span := p.tracer.Start(ctx, "Persister.SettleDebt")
+	defer span.End()
 
-	return p.queries.SettleDebt(ctx, models.SettleDebtParams{
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Settling debt", "id", id)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	settledDebtID, err := qtx.SettleDebt(ctx, models.SettleDebtParams{
 		ID:        id,
 		Namespace: namespace,
 	})
+	if err != nil {
+		return -1, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityDebt, models.ChangeOperationSettled, settledDebtID); err != nil {
+		return -1, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return -1, err
+	}
+
+	return settledDebtID, nil
 }
 
 func (p *Persister) GetDebtAndContact(
@@ -63,7 +172,10 @@ func (p *Persister) GetDebtAndContact(
 
 	namespace string,
 ) (models.GetDebtAndContactRow, error) {
-	p.log.With("namespace", namespace).Debug("Getting debt and contact", "id", id)
+	ctx, span := p.tracer.Start(ctx, "Persister.GetDebtAndContact")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting debt and contact", "id", id)
 
 	return p.queries.GetDebtAndContact(ctx, models.GetDebtAndContactParams{
 		ID:        id,
@@ -82,13 +194,93 @@ func (p *Persister) UpdateDebt(
 	currency,
 	description string,
 ) (models.UpdateDebtRow, error) {
-	p.log.With("namespace", namespace).Debug("Updating debt", "id", id, "amount", amount, "currency", currency)
+	ctx, span := p.tracer.Start(ctx, "Persister.UpdateDebt")
+	defer span.End()
 
-	return p.queries.UpdateDebt(ctx, models.UpdateDebtParams{
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Updating debt", "id", id, "amount", amount, "currency", currency)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.UpdateDebtRow{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	debt, err := qtx.UpdateDebt(ctx, models.UpdateDebtParams{
 		ID:          id,
 		Namespace:   namespace,
 		Amount:      amount,
 		Currency:    currency,
 		Description: description,
 	})
+	if err != nil {
+		return models.UpdateDebtRow{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityDebt, models.ChangeOperationUpdated, debt.ID); err != nil {
+		return models.UpdateDebtRow{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.UpdateDebtRow{}, err
+	}
+
+	return debt, nil
+}
+
+// PatchDebt locks the debt and updates it with the params that `patch` returns for its current state in one
+// transaction, so that concurrent patches can't overwrite each other's changes
+func (p *Persister) PatchDebt(
+	ctx context.Context,
+
+	id int32,
+
+	namespace string,
+
+	patch func(debt models.GetDebtAndContactForUpdateRow) (models.UpdateDebtParams, error),
+) (models.UpdateDebtRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.PatchDebt")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Patching debt", "id", id)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.UpdateDebtRow{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	currentDebt, err := qtx.GetDebtAndContactForUpdate(ctx, models.GetDebtAndContactForUpdateParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return models.UpdateDebtRow{}, err
+	}
+
+	params, err := patch(currentDebt)
+	if err != nil {
+		return models.UpdateDebtRow{}, err
+	}
+
+	params.ID = id
+	params.Namespace = namespace
+
+	debt, err := qtx.UpdateDebt(ctx, params)
+	if err != nil {
+		return models.UpdateDebtRow{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityDebt, models.ChangeOperationUpdated, debt.ID); err != nil {
+		return models.UpdateDebtRow{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.UpdateDebtRow{}, err
+	}
+
+	return debt, nil
 }
@@ -6,11 +6,17 @@ import (
 	"context"
 	"database/sql"
 	"log/slog"
+	"sync"
 
+	"github.com/lib/pq"
 	"github.com/pojntfx/senbara/senbara-common/db/migrations"
 	"github.com/pojntfx/senbara/senbara-common/internal/tables"
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 	"github.com/pressly/goose/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Persister struct {
@@ -18,12 +24,25 @@ type Persister struct {
 	pgaddr  string
 	queries *tables.Queries
 	db      *sql.DB
+
+	metrics    *persisterMetrics
+	registerer prometheus.Registerer
+
+	changesLock         sync.Mutex
+	changesListener     *pq.Listener
+	changeSubscriptions map[*changeSubscription]struct{}
+
+	tracer trace.Tracer
 }
 
 func NewPersister(log *slog.Logger, pgaddr string) *Persister {
 	return &Persister{
 		log:    log,
 		pgaddr: pgaddr,
+
+		metrics: newPersisterMetrics(),
+
+		tracer: otel.Tracer("github.com/pojntfx/senbara/senbara-common/pkg/persisters"),
 	}
 }
 
@@ -45,23 +64,40 @@ func (p *Persister) Init(ctx context.Context) error {
 
 	p.log.Info("Running migrations")
 
-	if err := goose.Up(p.db, "."); err != nil {
+	// Close the database if initialization fails so that it can be retried with a new persister
+	if err := goose.UpContext(ctx, p.db, "."); err != nil {
+		_ = p.db.Close()
+
 		return err
 	}
 
-	p.queries = tables.New(p.db)
+	if p.registerer != nil {
+		if err := p.metrics.register(p.registerer); err != nil {
+			_ = p.db.Close()
+
+			return err
+		}
+	}
+
+	p.queries = tables.New(&instrumentedDB{p.db, p.metrics, p.tracer})
 
 	return nil
 }
 
 func (p *Persister) CountContactsAndJournalEntries(ctx context.Context, namespace string) (models.ContactsAndJournalEntriesCount, error) {
-	p.log.With("namespace", namespace).Debug("Counting contacts and journal entries")
+	ctx, span := p.tracer.Start(ctx, "Persister.CountContactsAndJournalEntries")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Counting contacts and journal entries")
 
 	return p.queries.CountContactsAndJournalEntries(ctx, namespace)
 }
 
 func (p *Persister) CountAllContactsAndJournalEntries(ctx context.Context) (models.ContactsAndJournalEntriesCount, error) {
-	p.log.Debug("Counting all contacts and journal entries")
+	ctx, span := p.tracer.Start(ctx, "Persister.CountAllContactsAndJournalEntries")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).Debug("Counting all contacts and journal entries")
 
 	allContactsAndJournalEntriesCount, err := p.queries.CountAllContactsAndJournalEntries(ctx)
 	if err != nil {
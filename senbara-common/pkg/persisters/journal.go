@@ -4,36 +4,94 @@ import (
 	"context"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 func (p *Persister) GetJournalEntries(ctx context.Context, namespace string) ([]models.JournalEntry, error) {
-	p.log.With("namespace", namespace).Debug("Getting journal entries")
+	ctx, span := p.tracer.Start(ctx, "Persister.GetJournalEntries")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting journal entries")
 
 	return p.queries.GetJournalEntries(ctx, namespace)
 }
 
-func (p *Persister) CreateJournalEntry(ctx context.Context, title, body string, rating int32, namespace string) (models.JournalEntry, error) {
-	p.log.With("namespace", namespace).Debug("Creating journal entry", "title", title, "rating", rating)
+func (p *Persister) CreateJournalEntry(ctx context.Context, title, body string, rating int32, namespace, encryptionAlgorithm, encryptionKDF, encryptionSalt string) (models.JournalEntry, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.CreateJournalEntry")
+	defer span.End()
 
-	return p.queries.CreateJournalEntry(ctx, models.CreateJournalEntryParams{
-		Title:     title,
-		Body:      body,
-		Rating:    rating,
-		Namespace: namespace,
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating journal entry", "title", title, "rating", rating, "encryptionAlgorithm", encryptionAlgorithm)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.JournalEntry{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	journalEntry, err := qtx.CreateJournalEntry(ctx, models.CreateJournalEntryParams{
+		Title:               title,
+		Body:                body,
+		Rating:              rating,
+		Namespace:           namespace,
+		EncryptionAlgorithm: encryptionAlgorithm,
+		EncryptionKdf:       encryptionKDF,
+		EncryptionSalt:      encryptionSalt,
 	})
+	if err != nil {
+		return models.JournalEntry{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityJournalEntry, models.ChangeOperationCreated, journalEntry.ID); err != nil {
+		return models.JournalEntry{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.JournalEntry{}, err
+	}
+
+	return journalEntry, nil
 }
 
 func (p *Persister) DeleteJournalEntry(ctx context.Context, id int32, namespace string) (int32, error) {
-	p.log.With("namespace", namespace).Debug("Deleting journal entry", "id", id)
+	ctx, span := p.tracer.Start(ctx, "Persister.DeleteJournalEntry")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Deleting journal entry", "id", id)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback()
 
-	return p.queries.DeleteJournalEntry(ctx, models.DeleteJournalEntryParams{
+	qtx := p.withTx(tx)
+
+	deletedJournalEntryID, err := qtx.DeleteJournalEntry(ctx, models.DeleteJournalEntryParams{
 		ID:        id,
 		Namespace: namespace,
 	})
+	if err != nil {
+		return -1, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityJournalEntry, models.ChangeOperationDeleted, deletedJournalEntryID); err != nil {
+		return -1, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return -1, err
+	}
+
+	return deletedJournalEntryID, nil
 }
 
 func (p *Persister) GetJournalEntry(ctx context.Context, id int32, namespace string) (models.JournalEntry, error) {
-	p.log.With("namespace", namespace).Debug("Getting journal entry", "id", id)
+	ctx, span := p.tracer.Start(ctx, "Persister.GetJournalEntry")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting journal entry", "id", id)
 
 	return p.queries.GetJournalEntry(ctx, models.GetJournalEntryParams{
 		ID:        id,
@@ -41,14 +99,97 @@ func (p *Persister) GetJournalEntry(ctx context.Context, id int32, namespace str
 	})
 }
 
-func (p *Persister) UpdateJournalEntry(ctx context.Context, id int32, title, body string, rating int32, namespace string) (models.JournalEntry, error) {
-	p.log.With("namespace", namespace).Debug("Updating journal entry", "id", id, "title", title, "rating", rating)
+func (p *Persister) UpdateJournalEntry(ctx context.Context, id int32, title, body string, rating int32, namespace, encryptionAlgorithm, encryptionKDF, encryptionSalt string) (models.JournalEntry, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.UpdateJournalEntry")
+	defer span.End()
 
-	return p.queries.UpdateJournalEntry(ctx, models.UpdateJournalEntryParams{
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Updating journal entry", "id", id, "title", title, "rating", rating, "encryptionAlgorithm", encryptionAlgorithm)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.JournalEntry{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	journalEntry, err := qtx.UpdateJournalEntry(ctx, models.UpdateJournalEntryParams{
+		ID:                  id,
+		Namespace:           namespace,
+		Title:               title,
+		Body:                body,
+		Rating:              rating,
+		EncryptionAlgorithm: encryptionAlgorithm,
+		EncryptionKdf:       encryptionKDF,
+		EncryptionSalt:      encryptionSalt,
+	})
+	if err != nil {
+		return models.JournalEntry{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityJournalEntry, models.ChangeOperationUpdated, journalEntry.ID); err != nil {
+		return models.JournalEntry{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.JournalEntry{}, err
+	}
+
+	return journalEntry, nil
+}
+
+// PatchJournalEntry locks the journal entry and updates it with the params that `patch` returns for its current state in one
+// transaction, so that concurrent patches can't overwrite each other's changes
+func (p *Persister) PatchJournalEntry(
+	ctx context.Context,
+
+	id int32,
+
+	namespace string,
+
+	patch func(journalEntry models.JournalEntry) (models.UpdateJournalEntryParams, error),
+) (models.JournalEntry, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.PatchJournalEntry")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Patching journal entry", "id", id)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.JournalEntry{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	currentJournalEntry, err := qtx.GetJournalEntryForUpdate(ctx, models.GetJournalEntryForUpdateParams{
 		ID:        id,
 		Namespace: namespace,
-		Title:     title,
-		Body:      body,
-		Rating:    rating,
 	})
+	if err != nil {
+		return models.JournalEntry{}, err
+	}
+
+	params, err := patch(currentJournalEntry)
+	if err != nil {
+		return models.JournalEntry{}, err
+	}
+
+	params.ID = id
+	params.Namespace = namespace
+
+	journalEntry, err := qtx.UpdateJournalEntry(ctx, params)
+	if err != nil {
+		return models.JournalEntry{}, err
+	}
+
+	if err := recordChange(ctx, qtx, namespace, models.ChangeEntityJournalEntry, models.ChangeOperationUpdated, journalEntry.ID); err != nil {
+		return models.JournalEntry{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.JournalEntry{}, err
+	}
+
+	return journalEntry, nil
 }
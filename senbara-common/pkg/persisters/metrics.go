@@ -0,0 +1,151 @@
+package persisters
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/pojntfx/senbara/senbara-common/internal/tables"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	userDataEntityJournalEntry = "journal_entry"
+	userDataEntityContact      = "contact"
+	userDataEntityDebt         = "debt"
+	userDataEntityActivity     = "activity"
+
+	// queryNamePrefix is the comment that sqlc prefixes the generated queries with
+	queryNamePrefix = "-- name: "
+	queryNameOther  = "other"
+)
+
+type persisterMetrics struct {
+	queryDuration *prometheus.HistogramVec
+
+	exportedUserData *prometheus.CounterVec
+	importedUserData *prometheus.CounterVec
+}
+
+func newPersisterMetrics() *persisterMetrics {
+	return &persisterMetrics{
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "senbara_db_query_duration_seconds",
+			Help:    "Duration of database queries",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"query"}),
+
+		exportedUserData: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "senbara_userdata_exported_entities_total",
+			Help: "Number of entities that have been exported from user data",
+		}, []string{"entity"}),
+		importedUserData: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "senbara_userdata_imported_entities_total",
+			Help: "Number of entities that have been imported from user data",
+		}, []string{"entity"}),
+	}
+}
+
+func (m *persisterMetrics) register(registerer prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{
+		m.queryDuration,
+
+		m.exportedUserData,
+		m.importedUserData,
+	} {
+		if err := registerer.Register(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EnableMetrics registers the database query duration and user data import/export metrics with the registerer.
+// Must be called before `Init`.
+func (p *Persister) EnableMetrics(registerer prometheus.Registerer) {
+	p.registerer = registerer
+}
+
+// Ping checks if the database can be reached
+func (p *Persister) Ping(ctx context.Context) error {
+	return p.db.PingContext(ctx)
+}
+
+// getQueryName returns the name of a query generated by sqlc, which is used instead of the query itself
+// so that the number of metric labels stays bounded
+func getQueryName(query string) string {
+	if !strings.HasPrefix(query, queryNamePrefix) {
+		return queryNameOther
+	}
+
+	name, _, _ := strings.Cut(strings.TrimPrefix(query, queryNamePrefix), " ")
+
+	return name
+}
+
+// instrumentedDB records the duration of the queries run on the underlying database or transaction and traces them
+type instrumentedDB struct {
+	db      tables.DBTX
+	metrics *persisterMetrics
+	tracer  trace.Tracer
+}
+
+func (i *instrumentedDB) start(ctx context.Context, query string) (context.Context, func(err error)) {
+	var (
+		name  = getQueryName(query)
+		start = time.Now()
+	)
+
+	ctx, span := i.tracer.Start(ctx, "Query."+name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("db.system.name", "postgresql"),
+		attribute.String("db.operation.name", name),
+	))
+
+	return ctx, func(err error) {
+		i.metrics.queryDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+
+		telemetry.RecordError(span, err)
+		span.End()
+	}
+}
+
+func (i *instrumentedDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ctx, end := i.start(ctx, query)
+
+	res, err := i.db.ExecContext(ctx, query, args...)
+	end(err)
+
+	return res, err
+}
+
+func (i *instrumentedDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return i.db.PrepareContext(ctx, query)
+}
+
+// QueryContext only records the duration until the first rows are available, not the time it takes to read all rows
+func (i *instrumentedDB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	ctx, end := i.start(ctx, query)
+
+	rows, err := i.db.QueryContext(ctx, query, args...)
+	end(err)
+
+	return rows, err
+}
+
+// QueryRowContext doesn't record errors since they are only returned once the row is scanned
+func (i *instrumentedDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	ctx, end := i.start(ctx, query)
+	defer end(nil)
+
+	return i.db.QueryRowContext(ctx, query, args...)
+}
+
+// withTx returns queries that run in the transaction and are instrumented
+func (p *Persister) withTx(tx *sql.Tx) *tables.Queries {
+	return tables.New(&instrumentedDB{tx, p.metrics, p.tracer})
+}
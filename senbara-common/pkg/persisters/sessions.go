@@ -0,0 +1,116 @@
+package persisters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+var (
+	ErrSessionDoesNotExist = errors.New("session does not exist")
+	ErrSessionRevoked      = errors.New("session has been revoked")
+
+	errMissingSubjectOrOIDCSessionID = errors.New("missing subject or OIDC session ID")
+)
+
+// TouchSession records that the account with the namespace has used the session identified by the session key and
+// returns its ID. If the session has been revoked, ErrSessionRevoked is returned.
+func (p *Persister) TouchSession(
+	ctx context.Context,
+
+	sessionKey,
+	oidcSessionID,
+	clientID,
+	userAgent,
+
+	namespace string,
+) (int32, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.TouchSession")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Touching session", "oidcSessionID", oidcSessionID, "clientID", clientID, "userAgent", userAgent)
+
+	session, err := p.queries.TouchSession(ctx, models.TouchSessionParams{
+		Namespace:     namespace,
+		SessionKey:    sessionKey,
+		OidcSessionID: oidcSessionID,
+		ClientID:      clientID,
+		UserAgent:     userAgent,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, ErrSessionDoesNotExist
+		}
+
+		return -1, err
+	}
+
+	if session.RevokedAt.Valid {
+		return -1, ErrSessionRevoked
+	}
+
+	return session.ID, nil
+}
+
+func (p *Persister) GetSessions(ctx context.Context, namespace string) ([]models.GetSessionsRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetSessions")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting sessions")
+
+	return p.queries.GetSessions(ctx, namespace)
+}
+
+func (p *Persister) RevokeSession(ctx context.Context, id int32, namespace string) (int32, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.RevokeSession")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Revoking session", "id", id)
+
+	revokedID, err := p.queries.RevokeSession(ctx, models.RevokeSessionParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, ErrSessionDoesNotExist
+		}
+
+		return -1, err
+	}
+
+	return revokedID, nil
+}
+
+// RevokeSessions revokes all sessions of the account with the namespace, signing it out everywhere
+func (p *Persister) RevokeSessions(ctx context.Context, namespace string) ([]int32, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.RevokeSessions")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Revoking sessions")
+
+	return p.queries.RevokeSessions(ctx, namespace)
+}
+
+// RevokeOIDCSessions revokes the sessions that belong to a session with the OIDC provider. If `oidcSessionID`
+// is empty, all sessions of the subject are revoked; if `subject` is empty, all sessions with the OIDC session ID are revoked.
+func (p *Persister) RevokeOIDCSessions(ctx context.Context, issuer, subject, oidcSessionID string) ([]int32, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.RevokeOIDCSessions")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).Debug("Revoking OIDC sessions", "issuer", issuer, "subject", subject, "oidcSessionID", oidcSessionID)
+
+	// Without either, we would revoke the sessions of all users of the issuer
+	if subject == "" && oidcSessionID == "" {
+		return nil, errMissingSubjectOrOIDCSessionID
+	}
+
+	return p.queries.RevokeOIDCSessions(ctx, models.RevokeOIDCSessionsParams{
+		Issuer:        issuer,
+		Subject:       subject,
+		OidcSessionID: oidcSessionID,
+	})
+}
@@ -0,0 +1,317 @@
+package persisters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+var (
+	ErrSpaceDoesNotExist      = errors.New("space does not exist")
+	ErrInsufficientSpaceRole  = errors.New("insufficient role in space")
+	ErrCannotDeletePersonal   = errors.New("cannot delete personal space")
+	ErrInvitationDoesNotExist = errors.New("invitation does not exist")
+)
+
+func (p *Persister) GetSpaces(ctx context.Context, namespace string) ([]models.GetSpacesRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetSpaces")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting spaces")
+
+	return p.queries.GetSpaces(ctx, namespace)
+}
+
+// GetSpace returns the space if the account with the namespace is a member of it
+func (p *Persister) GetSpace(ctx context.Context, id int32, namespace string) (models.GetSpaceRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetSpace")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting space", "id", id)
+
+	space, err := p.queries.GetSpace(ctx, models.GetSpaceParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.GetSpaceRow{}, ErrSpaceDoesNotExist
+		}
+
+		return models.GetSpaceRow{}, err
+	}
+
+	return space, nil
+}
+
+func (p *Persister) CreateSpace(ctx context.Context, name, namespace string) (models.GetSpacesRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.CreateSpace")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating space", "name", name)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.GetSpacesRow{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	space, err := qtx.CreateSpace(ctx, name)
+	if err != nil {
+		return models.GetSpacesRow{}, err
+	}
+
+	if err := qtx.CreateSpaceMember(ctx, models.CreateSpaceMemberParams{
+		SpaceID:   space.ID,
+		Namespace: namespace,
+		Role:      models.SpaceRoleOwner,
+	}); err != nil {
+		return models.GetSpacesRow{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.GetSpacesRow{}, err
+	}
+
+	return models.GetSpacesRow{
+		ID:       space.ID,
+		Name:     space.Name,
+		Personal: space.Personal,
+		Role:     models.SpaceRoleOwner,
+	}, nil
+}
+
+func (p *Persister) DeleteSpace(ctx context.Context, id int32, namespace string) (int32, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.DeleteSpace")
+	defer span.End()
+
+	log := telemetry.Logger(ctx, p.log).With("namespace", namespace)
+
+	log.Debug("Deleting space", "id", id)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	space, err := qtx.GetSpace(ctx, models.GetSpaceParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, ErrSpaceDoesNotExist
+		}
+
+		return -1, err
+	}
+
+	if space.Role != models.SpaceRoleOwner {
+		return -1, ErrInsufficientSpaceRole
+	}
+
+	if space.Personal {
+		return -1, ErrCannotDeletePersonal
+	}
+
+	if err := deleteDataForNamespace(ctx, log, qtx, space.Namespace); err != nil {
+		return -1, err
+	}
+
+	// Unlike when only the data of a namespace is deleted, no client can sync the space anymore
+	if err := qtx.DeleteTombstonesForNamespace(ctx, space.Namespace); err != nil {
+		return -1, err
+	}
+
+	deletedSpaceID, err := qtx.DeleteSpace(ctx, id)
+	if err != nil {
+		return -1, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return -1, err
+	}
+
+	return deletedSpaceID, nil
+}
+
+func (p *Persister) GetSpaceMembers(ctx context.Context, id int32, namespace string) ([]models.GetSpaceMembersRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetSpaceMembers")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting space members", "id", id)
+
+	if _, err := p.GetSpace(ctx, id, namespace); err != nil {
+		return nil, err
+	}
+
+	return p.queries.GetSpaceMembers(ctx, id)
+}
+
+// DeleteSpaceMember removes a member from a space if the account with the namespace owns it, or
+// lets the account leave the space if the member is the account itself. Owners can't be removed.
+func (p *Persister) DeleteSpaceMember(ctx context.Context, id, memberID int32, namespace string) (int32, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.DeleteSpaceMember")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Deleting space member", "id", id, "memberID", memberID)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	space, err := qtx.GetSpace(ctx, models.GetSpaceParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, ErrSpaceDoesNotExist
+		}
+
+		return -1, err
+	}
+
+	var deletedMemberID int32
+	if space.Role == models.SpaceRoleOwner {
+		deletedMemberID, err = qtx.DeleteSpaceMember(ctx, models.DeleteSpaceMemberParams{
+			SpaceID:   id,
+			AccountID: memberID,
+		})
+	} else {
+		deletedMemberID, err = qtx.DeleteSpaceMemberForNamespace(ctx, models.DeleteSpaceMemberForNamespaceParams{
+			SpaceID:   id,
+			Namespace: namespace,
+		})
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, ErrInsufficientSpaceRole
+		}
+
+		return -1, err
+	}
+
+	if deletedMemberID != memberID {
+		return -1, ErrInsufficientSpaceRole
+	}
+
+	if err := tx.Commit(); err != nil {
+		return -1, err
+	}
+
+	return deletedMemberID, nil
+}
+
+func (p *Persister) CreateSpaceInvitation(ctx context.Context, id int32, email, role, namespace string) (models.SpaceInvitation, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.CreateSpaceInvitation")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating space invitation", "id", id, "email", email, "role", role)
+
+	space, err := p.GetSpace(ctx, id, namespace)
+	if err != nil {
+		return models.SpaceInvitation{}, err
+	}
+
+	if space.Role != models.SpaceRoleOwner || space.Personal {
+		return models.SpaceInvitation{}, ErrInsufficientSpaceRole
+	}
+
+	return p.queries.CreateSpaceInvitation(ctx, models.CreateSpaceInvitationParams{
+		SpaceID: id,
+		Email:   email,
+		Role:    role,
+	})
+}
+
+func (p *Persister) GetSpaceInvitations(ctx context.Context, namespace string) ([]models.GetSpaceInvitationsRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetSpaceInvitations")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting space invitations")
+
+	return p.queries.GetSpaceInvitations(ctx, namespace)
+}
+
+func (p *Persister) AcceptSpaceInvitation(ctx context.Context, id int32, namespace string) (models.GetSpaceRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.AcceptSpaceInvitation")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Accepting space invitation", "id", id)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.GetSpaceRow{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	invitation, err := qtx.DeleteSpaceInvitation(ctx, models.DeleteSpaceInvitationParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.GetSpaceRow{}, ErrInvitationDoesNotExist
+		}
+
+		return models.GetSpaceRow{}, err
+	}
+
+	if err := qtx.CreateSpaceMember(ctx, models.CreateSpaceMemberParams{
+		SpaceID:   invitation.SpaceID,
+		Namespace: namespace,
+		Role:      invitation.Role,
+	}); err != nil {
+		return models.GetSpaceRow{}, err
+	}
+
+	space, err := qtx.GetSpace(ctx, models.GetSpaceParams{
+		ID:        invitation.SpaceID,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return models.GetSpaceRow{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.GetSpaceRow{}, err
+	}
+
+	return space, nil
+}
+
+func (p *Persister) DeclineSpaceInvitation(ctx context.Context, id int32, namespace string) (int32, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.DeclineSpaceInvitation")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Declining space invitation", "id", id)
+
+	invitation, err := p.queries.DeleteSpaceInvitation(ctx, models.DeleteSpaceInvitationParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, ErrInvitationDoesNotExist
+		}
+
+		return -1, err
+	}
+
+	return invitation.ID, nil
+}
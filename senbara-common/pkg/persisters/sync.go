@@ -0,0 +1,406 @@
+package persisters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/pojntfx/senbara/senbara-common/internal/tables"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+var (
+	ErrSyncConflict           = errors.New("entity was changed since the mutation's base change sequence")
+	ErrSyncEntityDoesNotExist = errors.New("entity does not exist")
+	ErrUnknownSyncMutation    = errors.New("unknown entity type or operation")
+)
+
+// GetChangesSince returns the entities of the namespace that were created, updated or deleted after the
+// cursor. A cursor of 0 returns all entities and no tombstones, which is how clients sync for the first time.
+func (p *Persister) GetChangesSince(ctx context.Context, since int64, namespace string) (models.Changeset, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetChangesSince")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting changes", "since", since)
+
+	// All entities are read from the same snapshot, so that the cursor covers exactly the changes in the changeset
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+		ReadOnly:  true,
+	})
+	if err != nil {
+		return models.Changeset{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	changeset := models.Changeset{
+		Cursor: since,
+	}
+
+	changeset.Contacts, err = qtx.GetContactsChangedSince(ctx, models.GetContactsChangedSinceParams{
+		Namespace: namespace,
+		Since:     since,
+	})
+	if err != nil {
+		return models.Changeset{}, err
+	}
+
+	for _, contact := range changeset.Contacts {
+		changeset.Cursor = max(changeset.Cursor, contact.ChangeSequence)
+	}
+
+	changeset.JournalEntries, err = qtx.GetJournalEntriesChangedSince(ctx, models.GetJournalEntriesChangedSinceParams{
+		Namespace: namespace,
+		Since:     since,
+	})
+	if err != nil {
+		return models.Changeset{}, err
+	}
+
+	for _, journalEntry := range changeset.JournalEntries {
+		changeset.Cursor = max(changeset.Cursor, journalEntry.ChangeSequence)
+	}
+
+	changeset.Debts, err = qtx.GetDebtsChangedSince(ctx, models.GetDebtsChangedSinceParams{
+		Namespace: namespace,
+		Since:     since,
+	})
+	if err != nil {
+		return models.Changeset{}, err
+	}
+
+	for _, debt := range changeset.Debts {
+		changeset.Cursor = max(changeset.Cursor, debt.ChangeSequence)
+	}
+
+	changeset.Activities, err = qtx.GetActivitiesChangedSince(ctx, models.GetActivitiesChangedSinceParams{
+		Namespace: namespace,
+		Since:     since,
+	})
+	if err != nil {
+		return models.Changeset{}, err
+	}
+
+	for _, activity := range changeset.Activities {
+		changeset.Cursor = max(changeset.Cursor, activity.ChangeSequence)
+	}
+
+	// Clients that sync for the first time don't have any entities that they would have to delete
+	if since > 0 {
+		changeset.Tombstones, err = qtx.GetTombstonesSince(ctx, models.GetTombstonesSinceParams{
+			Namespace: namespace,
+			Since:     since,
+		})
+		if err != nil {
+			return models.Changeset{}, err
+		}
+
+		for _, tombstone := range changeset.Tombstones {
+			changeset.Cursor = max(changeset.Cursor, tombstone.ChangeSequence)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Changeset{}, err
+	}
+
+	return changeset, nil
+}
+
+// ApplySyncMutation applies a mutation that a client made while it was offline and returns the ID of the
+// created, updated or deleted entity. Updates and deletions fail with `ErrSyncConflict` if the entity was
+// changed after the mutation's base change sequence, in which case the client has to resolve the conflict.
+func (p *Persister) ApplySyncMutation(ctx context.Context, mutation models.SyncMutation, namespace string) (int32, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.ApplySyncMutation")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Applying sync mutation", "entityType", mutation.EntityType, "operation", mutation.Operation, "id", mutation.ID)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	// Changes to the namespace are blocked until the transaction commits, so the entity can't be
+	// changed by someone else between the conflict check and the mutation
+	if err := qtx.LockChanges(ctx, namespace); err != nil {
+		return -1, err
+	}
+
+	if mutation.Operation != models.SyncOperationCreate {
+		changeSequence, err := getChangeSequence(ctx, qtx, mutation.EntityType, mutation.ID, namespace)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return -1, ErrSyncEntityDoesNotExist
+			}
+
+			return -1, err
+		}
+
+		if changeSequence > mutation.BaseChangeSequence {
+			return -1, ErrSyncConflict
+		}
+	}
+
+	id, err := applySyncMutation(ctx, qtx, mutation, namespace)
+	if err != nil {
+		return -1, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return -1, err
+	}
+
+	return id, nil
+}
+
+func getChangeSequence(ctx context.Context, qtx *tables.Queries, entityType string, id int32, namespace string) (int64, error) {
+	switch entityType {
+	case models.ChangeEntityContact:
+		return qtx.GetContactChangeSequence(ctx, models.GetContactChangeSequenceParams{
+			ID:        id,
+			Namespace: namespace,
+		})
+
+	case models.ChangeEntityJournalEntry:
+		return qtx.GetJournalEntryChangeSequence(ctx, models.GetJournalEntryChangeSequenceParams{
+			ID:        id,
+			Namespace: namespace,
+		})
+
+	case models.ChangeEntityDebt:
+		return qtx.GetDebtChangeSequence(ctx, models.GetDebtChangeSequenceParams{
+			ID:        id,
+			Namespace: namespace,
+		})
+
+	case models.ChangeEntityActivity:
+		return qtx.GetActivityChangeSequence(ctx, models.GetActivityChangeSequenceParams{
+			ID:        id,
+			Namespace: namespace,
+		})
+
+	default:
+		return -1, ErrUnknownSyncMutation
+	}
+}
+
+// applySyncMutation runs the queries of a mutation; conflicts must have been checked before
+func applySyncMutation(ctx context.Context, qtx *tables.Queries, mutation models.SyncMutation, namespace string) (int32, error) {
+	var (
+		id        int32
+		operation string
+	)
+	switch mutation.EntityType + "." + mutation.Operation {
+	case models.ChangeEntityContact + "." + models.SyncOperationCreate:
+		contact, err := qtx.CreateContact(ctx, models.CreateContactParams{
+			FirstName: mutation.Contact.FirstName,
+			LastName:  mutation.Contact.LastName,
+			Nickname:  mutation.Contact.Nickname,
+			Email:     mutation.Contact.Email,
+			Pronouns:  mutation.Contact.Pronouns,
+			Namespace: namespace,
+		})
+		if err != nil {
+			return -1, err
+		}
+
+		// Contacts that are created offline can already have the fields that are otherwise only set by updates
+		if _, err := qtx.UpdateContact(ctx, getUpdateContactParams(contact.ID, mutation.Contact, namespace)); err != nil {
+			return -1, err
+		}
+
+		id, operation = contact.ID, models.ChangeOperationCreated
+
+	case models.ChangeEntityContact + "." + models.SyncOperationUpdate:
+		contact, err := qtx.UpdateContact(ctx, getUpdateContactParams(mutation.ID, mutation.Contact, namespace))
+		if err != nil {
+			return -1, err
+		}
+
+		id, operation = contact.ID, models.ChangeOperationUpdated
+
+	case models.ChangeEntityContact + "." + models.SyncOperationDelete:
+		if err := deleteDebtsAndActivitiesForContact(ctx, qtx, mutation.ID, namespace); err != nil {
+			return -1, err
+		}
+
+		deletedContactID, err := qtx.DeleteContact(ctx, models.DeleteContactParams{
+			ID:        mutation.ID,
+			Namespace: namespace,
+		})
+		if err != nil {
+			return -1, err
+		}
+
+		id, operation = deletedContactID, models.ChangeOperationDeleted
+
+	case models.ChangeEntityJournalEntry + "." + models.SyncOperationCreate:
+		journalEntry, err := qtx.CreateJournalEntry(ctx, models.CreateJournalEntryParams{
+			Title:               mutation.JournalEntry.Title,
+			Body:                mutation.JournalEntry.Body,
+			Rating:              mutation.JournalEntry.Rating,
+			Namespace:           namespace,
+			EncryptionAlgorithm: mutation.JournalEntry.EncryptionAlgorithm,
+			EncryptionKdf:       mutation.JournalEntry.EncryptionKDF,
+			EncryptionSalt:      mutation.JournalEntry.EncryptionSalt,
+		})
+		if err != nil {
+			return -1, err
+		}
+
+		id, operation = journalEntry.ID, models.ChangeOperationCreated
+
+	case models.ChangeEntityJournalEntry + "." + models.SyncOperationUpdate:
+		journalEntry, err := qtx.UpdateJournalEntry(ctx, models.UpdateJournalEntryParams{
+			ID:                  mutation.ID,
+			Namespace:           namespace,
+			Title:               mutation.JournalEntry.Title,
+			Body:                mutation.JournalEntry.Body,
+			Rating:              mutation.JournalEntry.Rating,
+			EncryptionAlgorithm: mutation.JournalEntry.EncryptionAlgorithm,
+			EncryptionKdf:       mutation.JournalEntry.EncryptionKDF,
+			EncryptionSalt:      mutation.JournalEntry.EncryptionSalt,
+		})
+		if err != nil {
+			return -1, err
+		}
+
+		id, operation = journalEntry.ID, models.ChangeOperationUpdated
+
+	case models.ChangeEntityJournalEntry + "." + models.SyncOperationDelete:
+		deletedJournalEntryID, err := qtx.DeleteJournalEntry(ctx, models.DeleteJournalEntryParams{
+			ID:        mutation.ID,
+			Namespace: namespace,
+		})
+		if err != nil {
+			return -1, err
+		}
+
+		id, operation = deletedJournalEntryID, models.ChangeOperationDeleted
+
+	case models.ChangeEntityDebt + "." + models.SyncOperationCreate:
+		debt, err := qtx.CreateDebt(ctx, models.CreateDebtParams{
+			ID:          mutation.Debt.ContactID,
+			Namespace:   namespace,
+			Amount:      mutation.Debt.Amount,
+			Currency:    mutation.Debt.Currency,
+			Description: mutation.Debt.Description,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return -1, ErrContactDoesNotExist
+			}
+
+			return -1, err
+		}
+
+		id, operation = debt.ID, models.ChangeOperationCreated
+
+	case models.ChangeEntityDebt + "." + models.SyncOperationUpdate:
+		debt, err := qtx.UpdateDebt(ctx, models.UpdateDebtParams{
+			ID:          mutation.ID,
+			Namespace:   namespace,
+			Amount:      mutation.Debt.Amount,
+			Currency:    mutation.Debt.Currency,
+			Description: mutation.Debt.Description,
+		})
+		if err != nil {
+			return -1, err
+		}
+
+		id, operation = debt.ID, models.ChangeOperationUpdated
+
+	case models.ChangeEntityDebt + "." + models.SyncOperationDelete:
+		settledDebtID, err := qtx.SettleDebt(ctx, models.SettleDebtParams{
+			ID:        mutation.ID,
+			Namespace: namespace,
+		})
+		if err != nil {
+			return -1, err
+		}
+
+		id, operation = settledDebtID, models.ChangeOperationSettled
+
+	case models.ChangeEntityActivity + "." + models.SyncOperationCreate:
+		activity, err := qtx.CreateActivity(ctx, models.CreateActivityParams{
+			ID:          mutation.Activity.ContactID,
+			Namespace:   namespace,
+			Name:        mutation.Activity.Name,
+			Date:        mutation.Activity.Date,
+			Description: mutation.Activity.Description,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return -1, ErrContactDoesNotExist
+			}
+
+			return -1, err
+		}
+
+		id, operation = activity.ID, models.ChangeOperationCreated
+
+	case models.ChangeEntityActivity + "." + models.SyncOperationUpdate:
+		activity, err := qtx.UpdateActivity(ctx, models.UpdateActivityParams{
+			ID:          mutation.ID,
+			Namespace:   namespace,
+			Name:        mutation.Activity.Name,
+			Date:        mutation.Activity.Date,
+			Description: mutation.Activity.Description,
+		})
+		if err != nil {
+			return -1, err
+		}
+
+		id, operation = activity.ID, models.ChangeOperationUpdated
+
+	case models.ChangeEntityActivity + "." + models.SyncOperationDelete:
+		deletedActivityID, err := qtx.DeleteActivity(ctx, models.DeleteActivityParams{
+			ID:        mutation.ID,
+			Namespace: namespace,
+		})
+		if err != nil {
+			return -1, err
+		}
+
+		id, operation = deletedActivityID, models.ChangeOperationDeleted
+
+	default:
+		return -1, ErrUnknownSyncMutation
+	}
+
+	if err := recordChange(ctx, qtx, namespace, mutation.EntityType, operation, id); err != nil {
+		return -1, err
+	}
+
+	return id, nil
+}
+
+func getUpdateContactParams(id int32, contact models.SyncContact, namespace string) models.UpdateContactParams {
+	var birthday sql.NullTime
+	if contact.Birthday != nil {
+		birthday = sql.NullTime{
+			Time:  *contact.Birthday,
+			Valid: true,
+		}
+	}
+
+	return models.UpdateContactParams{
+		ID:        id,
+		Namespace: namespace,
+		FirstName: contact.FirstName,
+		LastName:  contact.LastName,
+		Nickname:  contact.Nickname,
+		Email:     contact.Email,
+		Pronouns:  contact.Pronouns,
+		Birthday:  birthday,
+		Address:   contact.Address,
+		Notes:     contact.Notes,
+	}
+}
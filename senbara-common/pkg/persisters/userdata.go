@@ -4,9 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"log/slog"
 	"sync"
 
+	"github.com/pojntfx/senbara/senbara-common/internal/tables"
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 var (
@@ -23,15 +26,18 @@ func (p *Persister) GetUserData(
 	onDebt func(debt models.ExportedDebt) error,
 	onActivity func(activity models.ExportedActivity) error,
 ) error {
-	p.log.With("namespace", namespace).Debug("Getting user data")
+	ctx, span := p.tracer.Start(ctx, "Persister.GetUserData")
+	defer span.End()
 
-	tx, err := p.db.Begin()
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting user data")
+
+	tx, err := p.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	qtx := p.queries.WithTx(tx)
+	qtx := p.withTx(tx)
 
 	journalEntries, err := qtx.GetJournalEntriesExportForNamespace(ctx, namespace)
 	if err != nil {
@@ -39,18 +45,23 @@ func (p *Persister) GetUserData(
 	}
 
 	for _, journalEntry := range journalEntries {
-		p.log.With("namespace", namespace).Debug("Fetched journal entry", "journalEntryID", journalEntry.ID, "title", journalEntry.Title, "date", journalEntry.Date, "rating", journalEntry.Rating)
+		telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Fetched journal entry", "journalEntryID", journalEntry.ID, "title", journalEntry.Title, "date", journalEntry.Date, "rating", journalEntry.Rating)
 
 		if err := onJournalEntry(models.ExportedJournalEntry{
-			ID:        journalEntry.ID,
-			Title:     journalEntry.Title,
-			Date:      journalEntry.Date,
-			Body:      journalEntry.Body,
-			Rating:    journalEntry.Rating,
-			Namespace: journalEntry.Namespace,
+			ID:                  journalEntry.ID,
+			Title:               journalEntry.Title,
+			Date:                journalEntry.Date,
+			Body:                journalEntry.Body,
+			Rating:              journalEntry.Rating,
+			Namespace:           journalEntry.Namespace,
+			EncryptionAlgorithm: journalEntry.EncryptionAlgorithm,
+			EncryptionKDF:       journalEntry.EncryptionKdf,
+			EncryptionSalt:      journalEntry.EncryptionSalt,
 		}); err != nil {
 			return err
 		}
+
+		p.metrics.exportedUserData.WithLabelValues(userDataEntityJournalEntry).Inc()
 	}
 
 	contacts, err := qtx.GetContactsExportForNamespace(ctx, namespace)
@@ -59,7 +70,7 @@ func (p *Persister) GetUserData(
 	}
 
 	for _, contact := range contacts {
-		p.log.With("namespace", namespace).Debug("Fetched contact", "contactID", contact.ID, "firstName", contact.FirstName, "lastName", contact.LastName, "email", contact.Email)
+		telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Fetched contact", "contactID", contact.ID, "firstName", contact.FirstName, "lastName", contact.LastName, "email", contact.Email)
 
 		if err := onContact(models.ExportedContact{
 			ID:        contact.ID,
@@ -75,6 +86,8 @@ func (p *Persister) GetUserData(
 		}); err != nil {
 			return err
 		}
+
+		p.metrics.exportedUserData.WithLabelValues(userDataEntityContact).Inc()
 	}
 
 	debts, err := qtx.GetDebtsExportForNamespace(ctx, namespace)
@@ -83,7 +96,7 @@ func (p *Persister) GetUserData(
 	}
 
 	for _, debt := range debts {
-		p.log.With("namespace", namespace).Debug("Fetched debt", "debtID", debt.ID, "amount", debt.Amount, "currency", debt.Currency, "contactID", debt.ContactID)
+		telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Fetched debt", "debtID", debt.ID, "amount", debt.Amount, "currency", debt.Currency, "contactID", debt.ContactID)
 
 		if err := onDebt(models.ExportedDebt{
 			ID:          debt.ID,
@@ -94,6 +107,8 @@ func (p *Persister) GetUserData(
 		}); err != nil {
 			return err
 		}
+
+		p.metrics.exportedUserData.WithLabelValues(userDataEntityDebt).Inc()
 	}
 
 	activities, err := qtx.GetActivitiesExportForNamespace(ctx, namespace)
@@ -102,7 +117,7 @@ func (p *Persister) GetUserData(
 	}
 
 	for _, activity := range activities {
-		p.log.With("namespace", namespace).Debug("Fetched activity", "activityID", activity.ID, "name", activity.Name, "date", activity.Date, "contactID", activity.ContactID)
+		telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Fetched activity", "activityID", activity.ID, "name", activity.Name, "date", activity.Date, "contactID", activity.ContactID)
 
 		if err := onActivity(models.ExportedActivity{
 			ID:          activity.ID,
@@ -113,24 +128,35 @@ func (p *Persister) GetUserData(
 		}); err != nil {
 			return err
 		}
+
+		p.metrics.exportedUserData.WithLabelValues(userDataEntityActivity).Inc()
 	}
 
 	return nil
 }
 
 func (p *Persister) DeleteUserData(ctx context.Context, namespace string) error {
-	log := p.log.With("namespace", namespace)
+	ctx, span := p.tracer.Start(ctx, "Persister.DeleteUserData")
+	defer span.End()
+
+	log := telemetry.Logger(ctx, p.log).With("namespace", namespace)
 
 	log.Debug("Deleting user data")
 
-	tx, err := p.db.Begin()
+	tx, err := p.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	qtx := p.queries.WithTx(tx)
+	if err := deleteDataForNamespace(ctx, log, p.withTx(tx), namespace); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
 
+func deleteDataForNamespace(ctx context.Context, log *slog.Logger, qtx *tables.Queries, namespace string) error {
 	activityIDs, err := qtx.DeleteActivitiesForNamespace(ctx, namespace)
 	if err != nil {
 		return err
@@ -150,16 +176,42 @@ func (p *Persister) DeleteUserData(ctx context.Context, namespace string) error
 		return err
 	}
 
-	log.With("len", len(contactIDs)).Debug("Deleted debts")
+	log.With("len", len(contactIDs)).Debug("Deleted contacts")
 
 	journalEntryIDs, err := qtx.DeleteJournalEntriesForNamespace(ctx, namespace)
 	if err != nil {
 		return err
 	}
 
-	log.With("len", len(journalEntryIDs)).Debug("Deleted debts")
+	log.With("len", len(journalEntryIDs)).Debug("Deleted journal entries")
+
+	// Subscribers are notified before the webhooks are deleted, but since the webhooks of the namespace are deleted
+	// too, their outbox entries are deleted with them and only change notifications are sent
+	for _, deleted := range []struct {
+		entityType string
+		operation  string
+		ids        []int32
+	}{
+		{models.ChangeEntityActivity, models.ChangeOperationDeleted, activityIDs},
+		{models.ChangeEntityDebt, models.ChangeOperationSettled, debtIDs},
+		{models.ChangeEntityContact, models.ChangeOperationDeleted, contactIDs},
+		{models.ChangeEntityJournalEntry, models.ChangeOperationDeleted, journalEntryIDs},
+	} {
+		for _, id := range deleted.ids {
+			if err := recordChange(ctx, qtx, namespace, deleted.entityType, deleted.operation, id); err != nil {
+				return err
+			}
+		}
+	}
 
-	return tx.Commit()
+	webhookIDs, err := qtx.DeleteWebhooksForNamespace(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	log.With("len", len(webhookIDs)).Debug("Deleted webhooks")
+
+	return nil
 }
 
 func (p *Persister) CreateUserData(ctx context.Context, namespace string) (
@@ -173,7 +225,10 @@ func (p *Persister) CreateUserData(ctx context.Context, namespace string) (
 
 	err error,
 ) {
-	p.log.With("namespace", namespace).Debug("Creating user data")
+	// The span covers the whole transaction, so it only ends once the transaction has been committed or rolled back
+	ctx, span := p.tracer.Start(ctx, "Persister.CreateUserData")
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating user data")
 
 	createJournalEntry = func(journalEntry models.ExportedJournalEntry) error { return nil }
 	createContact = func(contact models.ExportedContact) error { return nil }
@@ -184,35 +239,59 @@ func (p *Persister) CreateUserData(ctx context.Context, namespace string) (
 	rollback = func() error { return nil }
 
 	var tx *sql.Tx
-	tx, err = p.db.Begin()
+	tx, err = p.db.BeginTx(ctx, nil)
 	if err != nil {
+		telemetry.RecordError(span, err)
+		span.End()
+
 		return
 	}
 
-	qtx := p.queries.WithTx(tx)
+	qtx := p.withTx(tx)
 
 	var (
 		contactIDMapLock sync.Mutex
 		contactIDMap     = map[int32]int32{}
+
+		// Imported entities are only counted once the transaction has been committed
+		importedLock sync.Mutex
+		imported     = map[string]int{}
 	)
 
+	countImported := func(entity string) {
+		importedLock.Lock()
+		defer importedLock.Unlock()
+
+		imported[entity]++
+	}
+
 	createJournalEntry = func(journalEntry models.ExportedJournalEntry) error {
-		p.log.With("namespace", namespace).Debug("Creating journal entry", "title", journalEntry.Title, "date", journalEntry.Date, "rating", journalEntry.Rating)
+		telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating journal entry", "title", journalEntry.Title, "date", journalEntry.Date, "rating", journalEntry.Rating)
+
+		j, err := qtx.CreateJournalEntry(ctx, models.CreateJournalEntryParams{
+			Title:               journalEntry.Title,
+			Body:                journalEntry.Body,
+			Rating:              journalEntry.Rating,
+			Namespace:           namespace,
+			EncryptionAlgorithm: journalEntry.EncryptionAlgorithm,
+			EncryptionKdf:       journalEntry.EncryptionKDF,
+			EncryptionSalt:      journalEntry.EncryptionSalt,
+		})
+		if err != nil {
+			return err
+		}
 
-		if _, err := qtx.CreateJournalEntry(ctx, models.CreateJournalEntryParams{
-			Title:     journalEntry.Title,
-			Body:      journalEntry.Body,
-			Rating:    journalEntry.Rating,
-			Namespace: namespace,
-		}); err != nil {
+		if err := recordChange(ctx, qtx, namespace, models.ChangeEntityJournalEntry, models.ChangeOperationCreated, j.ID); err != nil {
 			return err
 		}
 
+		countImported(userDataEntityJournalEntry)
+
 		return nil
 	}
 
 	createContact = func(contact models.ExportedContact) error {
-		p.log.With("namespace", namespace).Debug("Creating contact", "firstName", contact.FirstName, "lastName", contact.LastName, "email", contact.Email)
+		telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating contact", "firstName", contact.FirstName, "lastName", contact.LastName, "email", contact.Email)
 
 		c, err := qtx.CreateContact(ctx, models.CreateContactParams{
 			FirstName: contact.FirstName,
@@ -226,16 +305,22 @@ func (p *Persister) CreateUserData(ctx context.Context, namespace string) (
 			return err
 		}
 
+		if err := recordChange(ctx, qtx, namespace, models.ChangeEntityContact, models.ChangeOperationCreated, c.ID); err != nil {
+			return err
+		}
+
 		contactIDMapLock.Lock()
 		defer contactIDMapLock.Unlock()
 
 		contactIDMap[contact.ID] = c.ID
 
+		countImported(userDataEntityContact)
+
 		return nil
 	}
 
 	createDebt = func(debt models.ExportedDebt) error {
-		p.log.With("namespace", namespace).Debug("Creating debt", "amount", debt.Amount, "currency", debt.Currency, "contactID", debt.ContactID)
+		telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating debt", "amount", debt.Amount, "currency", debt.Currency, "contactID", debt.ContactID)
 
 		contactIDMapLock.Lock()
 		defer contactIDMapLock.Unlock()
@@ -249,21 +334,28 @@ func (p *Persister) CreateUserData(ctx context.Context, namespace string) (
 			return ErrContactDoesNotExist
 		}
 
-		if _, err := qtx.CreateDebt(ctx, models.CreateDebtParams{
+		d, err := qtx.CreateDebt(ctx, models.CreateDebtParams{
 			ID:          actualContactID,
 			Amount:      debt.Amount,
 			Currency:    debt.Currency,
 			Description: debt.Description,
 			Namespace:   namespace,
-		}); err != nil {
+		})
+		if err != nil {
 			return err
 		}
 
+		if err := recordChange(ctx, qtx, namespace, models.ChangeEntityDebt, models.ChangeOperationCreated, d.ID); err != nil {
+			return err
+		}
+
+		countImported(userDataEntityDebt)
+
 		return nil
 	}
 
 	createActivity = func(activity models.ExportedActivity) error {
-		p.log.With("namespace", namespace).Debug("Creating activity", "name", activity.Name, "date", activity.Date, "contactID", activity.ContactID)
+		telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating activity", "name", activity.Name, "date", activity.Date, "contactID", activity.ContactID)
 
 		contactIDMapLock.Lock()
 		defer contactIDMapLock.Unlock()
@@ -277,21 +369,49 @@ func (p *Persister) CreateUserData(ctx context.Context, namespace string) (
 			return ErrContactDoesNotExist
 		}
 
-		if _, err := qtx.CreateActivity(ctx, models.CreateActivityParams{
+		a, err := qtx.CreateActivity(ctx, models.CreateActivityParams{
 			ID:          actualContactID,
 			Name:        activity.Name,
 			Date:        activity.Date,
 			Description: activity.Description,
 			Namespace:   namespace,
-		}); err != nil {
+		})
+		if err != nil {
 			return err
 		}
 
+		if err := recordChange(ctx, qtx, namespace, models.ChangeEntityActivity, models.ChangeOperationCreated, a.ID); err != nil {
+			return err
+		}
+
+		countImported(userDataEntityActivity)
+
 		return nil
 	}
 
-	commit = tx.Commit
-	rollback = tx.Rollback
+	commit = func() error {
+		defer span.End()
+
+		if err := tx.Commit(); err != nil {
+			telemetry.RecordError(span, err)
+
+			return err
+		}
+
+		importedLock.Lock()
+		defer importedLock.Unlock()
+
+		for entity, count := range imported {
+			p.metrics.importedUserData.WithLabelValues(entity).Add(float64(count))
+		}
+
+		return nil
+	}
+	rollback = func() error {
+		defer span.End()
+
+		return tx.Rollback()
+	}
 
 	return
 }
@@ -0,0 +1,196 @@
+package persisters
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/pojntfx/senbara/senbara-common/internal/tables"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+var (
+	ErrWebhookDoesNotExist = errors.New("webhook does not exist")
+)
+
+func (p *Persister) CreateWebhook(ctx context.Context, url, secret string, eventTypes []string, namespace string) (models.CreateWebhookRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.CreateWebhook")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Creating webhook", "url", url, "eventTypes", eventTypes)
+
+	return p.queries.CreateWebhook(ctx, models.CreateWebhookParams{
+		Namespace:  namespace,
+		Url:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+	})
+}
+
+func (p *Persister) GetWebhooks(ctx context.Context, namespace string) ([]models.GetWebhooksRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetWebhooks")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting webhooks")
+
+	return p.queries.GetWebhooks(ctx, namespace)
+}
+
+func (p *Persister) GetWebhook(ctx context.Context, id int32, namespace string) (models.GetWebhookRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetWebhook")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting webhook", "id", id)
+
+	webhook, err := p.queries.GetWebhook(ctx, models.GetWebhookParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.GetWebhookRow{}, ErrWebhookDoesNotExist
+		}
+
+		return models.GetWebhookRow{}, err
+	}
+
+	return webhook, nil
+}
+
+func (p *Persister) DeleteWebhook(ctx context.Context, id int32, namespace string) (int32, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.DeleteWebhook")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Deleting webhook", "id", id)
+
+	deletedID, err := p.queries.DeleteWebhook(ctx, models.DeleteWebhookParams{
+		ID:        id,
+		Namespace: namespace,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, ErrWebhookDoesNotExist
+		}
+
+		return -1, err
+	}
+
+	return deletedID, nil
+}
+
+// GetWebhookDeliveries returns the most recent delivery attempts of the webhook, newest first
+func (p *Persister) GetWebhookDeliveries(ctx context.Context, id int32, maxDeliveries int32, namespace string) ([]models.GetWebhookDeliveriesRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.GetWebhookDeliveries")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).With("namespace", namespace).Debug("Getting webhook deliveries", "id", id, "maxDeliveries", maxDeliveries)
+
+	if _, err := p.GetWebhook(ctx, id, namespace); err != nil {
+		return nil, err
+	}
+
+	return p.queries.GetWebhookDeliveries(ctx, models.GetWebhookDeliveriesParams{
+		ID:            id,
+		Namespace:     namespace,
+		MaxDeliveries: maxDeliveries,
+	})
+}
+
+// createWebhookEvent writes an event to the outbox for every webhook of the namespace that is subscribed to it.
+// It is called with the transaction of the change that caused the event (see `recordChange`), so that events are
+// only delivered for changes that were committed and are never lost for changes that were.
+func createWebhookEvent(ctx context.Context, qtx *tables.Queries, namespace, eventType string, id int32) error {
+	payload, err := json.Marshal(models.WebhookEventData{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	return qtx.CreateWebhookOutboxEntries(ctx, models.CreateWebhookOutboxEntriesParams{
+		EventType: eventType,
+		Payload:   payload,
+		Namespace: namespace,
+	})
+}
+
+// ClaimWebhookOutboxEntries returns up to `maxEntries` outbox entries that are due for delivery. Claimed entries
+// aren't returned again until the lease has expired, so multiple instances can deliver webhooks concurrently and
+// entries of an instance that stopped while delivering them are retried.
+func (p *Persister) ClaimWebhookOutboxEntries(ctx context.Context, maxEntries int32, lease time.Duration) ([]models.ClaimWebhookOutboxEntriesRow, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.ClaimWebhookOutboxEntries")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).Debug("Claiming webhook outbox entries", "maxEntries", maxEntries, "lease", lease)
+
+	return p.queries.ClaimWebhookOutboxEntries(ctx, models.ClaimWebhookOutboxEntriesParams{
+		LeaseSeconds: int32(lease.Seconds()),
+		MaxEntries:   maxEntries,
+	})
+}
+
+// CompleteWebhookDelivery records a delivery attempt of an outbox entry in the delivery log and updates the entry;
+// if it was neither delivered nor failed permanently, it is retried at `nextAttemptAt`
+func (p *Persister) CompleteWebhookDelivery(
+	ctx context.Context,
+
+	entry models.ClaimWebhookOutboxEntriesRow,
+
+	statusCode int32,
+	deliveryErr string,
+	duration time.Duration,
+
+	delivered,
+	failed bool,
+	nextAttemptAt time.Time,
+) error {
+	ctx, span := p.tracer.Start(ctx, "Persister.CompleteWebhookDelivery")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).Debug("Completing webhook delivery", "id", entry.ID, "statusCode", statusCode, "delivered", delivered, "failed", failed)
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	qtx := p.withTx(tx)
+
+	if err := qtx.CreateWebhookDelivery(ctx, models.CreateWebhookDeliveryParams{
+		WebhookID:  entry.WebhookID,
+		OutboxID:   entry.ID,
+		EventType:  entry.EventType,
+		Attempt:    entry.Attempts + 1,
+		StatusCode: statusCode,
+		Error:      deliveryErr,
+		DurationMs: int32(duration.Milliseconds()),
+	}); err != nil {
+		return err
+	}
+
+	if err := qtx.UpdateWebhookOutboxEntry(ctx, models.UpdateWebhookOutboxEntryParams{
+		NextAttemptAt: nextAttemptAt,
+		Delivered:     delivered,
+		Failed:        failed,
+		ID:            entry.ID,
+	}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteExpiredWebhookOutboxEntries deletes outbox entries and their delivery log that were delivered or failed
+// permanently before `expiredBefore`
+func (p *Persister) DeleteExpiredWebhookOutboxEntries(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	ctx, span := p.tracer.Start(ctx, "Persister.DeleteExpiredWebhookOutboxEntries")
+	defer span.End()
+
+	telemetry.Logger(ctx, p.log).Debug("Deleting expired webhook outbox entries", "expiredBefore", expiredBefore)
+
+	return p.queries.DeleteExpiredWebhookOutboxEntries(ctx, expiredBefore)
+}
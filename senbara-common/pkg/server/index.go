@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// UnixPrefix is the prefix of listen addresses that are paths to Unix sockets (e.g. `unix:/run/senbara/senbara.sock`)
+	UnixPrefix = "unix:"
+)
+
+var (
+	ErrMissingTLSKey  = errors.New("TLS certificate is set, but TLS key is missing")
+	ErrMissingTLSCert = errors.New("TLS key is set, but TLS certificate is missing")
+
+	errNotASocket = errors.New("listen address exists and is not a socket")
+)
+
+type contextKey int
+
+const (
+	contextKeyShuttingDown contextKey = iota
+)
+
+// ShuttingDown returns a channel that is closed once the server handling the request starts shutting down. Since
+// shutting down waits for in-flight requests and doesn't cancel their contexts, long-lived requests such as event
+// streams must return once it is closed.
+func ShuttingDown(ctx context.Context) <-chan struct{} {
+	if shuttingDownCtx, ok := ctx.Value(contextKeyShuttingDown).(context.Context); ok {
+		return shuttingDownCtx.Done()
+	}
+
+	return nil
+}
+
+// Options configures the timeouts and TLS of a server. Timeouts of zero disable the timeout.
+type Options struct {
+	ReadTimeout,
+	WriteTimeout,
+	IdleTimeout time.Duration
+
+	TLSCertFile,
+	TLSKeyFile string
+}
+
+type Server struct {
+	log *slog.Logger
+
+	laddr   string
+	handler http.Handler
+	options Options
+
+	listener net.Listener
+	srv      *http.Server
+}
+
+func NewServer(
+	log *slog.Logger,
+
+	laddr string,
+	handler http.Handler,
+	options Options,
+) *Server {
+	return &Server{
+		log: log,
+
+		laddr:   laddr,
+		handler: handler,
+		options: options,
+	}
+}
+
+// UseListener makes the server accept connections from an existing listener, e.g. a socket passed by systemd,
+// instead of listening on its listen address. Must be called before `Init`.
+func (s *Server) UseListener(listener net.Listener) {
+	s.listener = listener
+}
+
+func (s *Server) Init(ctx context.Context) error {
+	if (s.options.TLSCertFile != "") && (s.options.TLSKeyFile == "") {
+		return ErrMissingTLSKey
+	}
+
+	if (s.options.TLSKeyFile != "") && (s.options.TLSCertFile == "") {
+		return ErrMissingTLSCert
+	}
+
+	s.srv = &http.Server{
+		Handler: s.handler,
+
+		ReadTimeout:  s.options.ReadTimeout,
+		WriteTimeout: s.options.WriteTimeout,
+		IdleTimeout:  s.options.IdleTimeout,
+
+		ErrorLog: slog.NewLogLogger(s.log.Handler(), slog.LevelDebug),
+	}
+
+	shuttingDownCtx, cancelShuttingDownCtx := context.WithCancel(context.Background())
+	s.srv.BaseContext = func(net.Listener) context.Context {
+		return context.WithValue(context.Background(), contextKeyShuttingDown, shuttingDownCtx)
+	}
+	s.srv.RegisterOnShutdown(cancelShuttingDownCtx)
+
+	if s.listener == nil {
+		var err error
+		s.listener, err = listen(s.laddr)
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.options.TLSCertFile != "" {
+		r := newCertificateReloader(s.log, s.options.TLSCertFile, s.options.TLSKeyFile)
+
+		// Fail early if the certificate can't be loaded instead of during the first handshake
+		if _, err := r.GetCertificate(nil); err != nil {
+			_ = s.listener.Close()
+
+			return err
+		}
+
+		s.srv.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: r.GetCertificate,
+		}
+
+		s.listener = tls.NewListener(s.listener, s.srv.TLSConfig)
+	}
+
+	s.log.Info("Listening", "laddr", s.listener.Addr().String(), "tls", s.srv.TLSConfig != nil)
+
+	return nil
+}
+
+// Serve handles requests until the server is shut down
+func (s *Server) Serve() error {
+	if err := s.srv.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// Shutdown stops accepting new connections and waits until in-flight requests (e.g. imports and exports)
+// have been handled or the context is cancelled
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.srv.Shutdown(ctx)
+}
+
+func listen(laddr string) (net.Listener, error) {
+	path, ok := strings.CutPrefix(laddr, UnixPrefix)
+	if !ok {
+		return net.Listen("tcp", laddr)
+	}
+
+	// Remove sockets that are left over from a previous run that didn't shut down cleanly
+	if info, err := os.Stat(path); err == nil {
+		if info.Mode().Type() != fs.ModeSocket {
+			return nil, errNotASocket
+		}
+
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return net.Listen("unix", path)
+}
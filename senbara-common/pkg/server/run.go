@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// Run serves requests with all servers until the process receives SIGINT or SIGTERM or one of the servers fails.
+// It then stops accepting new connections and drains in-flight requests (e.g. imports and exports) for up to
+// the shutdown timeout before closing the remaining connections.
+func Run(
+	ctx context.Context,
+	log *slog.Logger,
+
+	shutdownTimeout time.Duration,
+	servers ...*Server,
+) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErrs := make(chan error, len(servers))
+	for _, s := range servers {
+		go func() {
+			serveErrs <- s.Serve()
+		}()
+	}
+
+	var serveErr error
+	select {
+	case <-ctx.Done():
+		log.Info("Shutting down, waiting for in-flight requests to finish", "shutdownTimeout", shutdownTimeout)
+
+	case serveErr = <-serveErrs:
+		log.Debug("Server failed, shutting down", "err", serveErr)
+	}
+
+	// Stop listening for signals so that a second signal terminates the process immediately
+	stop()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	errs := []error{serveErr}
+	for _, s := range servers {
+		if err := s.Shutdown(shutdownCtx); err != nil {
+			log.Warn("Could not drain in-flight requests before shutdown timeout", "err", err)
+
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"net"
+	"os"
+	"strconv"
+)
+
+const (
+	// listenFDsStart is the first file descriptor that systemd passes sockets as (see sd_listen_fds(3))
+	listenFDsStart = 3
+)
+
+// SystemdListeners returns the sockets that systemd passed to the process if it was started through socket
+// activation, in the order they are listed in the socket unit. If the process wasn't socket-activated, no
+// listeners are returned.
+func SystemdListeners() ([]net.Listener, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, nil
+	}
+
+	fds, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || fds <= 0 {
+		return nil, nil
+	}
+
+	// Don't pass the sockets on to child processes
+	_ = os.Unsetenv("LISTEN_PID")
+	_ = os.Unsetenv("LISTEN_FDS")
+	_ = os.Unsetenv("LISTEN_FDNAMES")
+
+	listeners := []net.Listener{}
+	for fd := listenFDsStart; fd < listenFDsStart+fds; fd++ {
+		f := os.NewFile(uintptr(fd), "LISTEN_FD_"+strconv.Itoa(fd))
+
+		// `net.FileListener` duplicates the file descriptor, so the original one can be closed
+		l, err := net.FileListener(f)
+		_ = f.Close()
+		if err != nil {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+
+			return nil, err
+		}
+
+		listeners = append(listeners, l)
+	}
+
+	return listeners, nil
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"crypto/tls"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// certificateReloader loads the TLS certificate again once its files have changed, e.g. because it was renewed,
+// so that the server doesn't have to be restarted
+type certificateReloader struct {
+	log *slog.Logger
+
+	certFile,
+	keyFile string
+
+	lock        sync.Mutex
+	cert        *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+}
+
+func newCertificateReloader(log *slog.Logger, certFile, keyFile string) *certificateReloader {
+	return &certificateReloader{
+		log: log,
+
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+}
+
+func (c *certificateReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	certInfo, certErr := os.Stat(c.certFile)
+	keyInfo, keyErr := os.Stat(c.keyFile)
+
+	if c.cert != nil {
+		// Keep using the current certificate while the files are being replaced
+		if certErr != nil || keyErr != nil {
+			return c.cert, nil
+		}
+
+		if certInfo.ModTime().Equal(c.certModTime) && keyInfo.ModTime().Equal(c.keyModTime) {
+			return c.cert, nil
+		}
+	}
+
+	log := c.log.With("certFile", c.certFile, "keyFile", c.keyFile)
+
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		if c.cert != nil {
+			log.Warn("Could not reload TLS certificate, continuing to use the previous one", "err", err)
+
+			return c.cert, nil
+		}
+
+		log.Debug("Could not load TLS certificate", "err", err)
+
+		return nil, err
+	}
+
+	if c.cert == nil {
+		log.Debug("Loaded TLS certificate")
+	} else {
+		log.Info("Reloaded TLS certificate")
+	}
+
+	c.cert = &cert
+	if certErr == nil && keyErr == nil {
+		c.certModTime = certInfo.ModTime()
+		c.keyModTime = keyInfo.ModTime()
+	}
+
+	return c.cert, nil
+}
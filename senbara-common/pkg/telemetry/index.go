@@ -0,0 +1,125 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ExporterNone disables tracing
+	ExporterNone = ""
+	// ExporterOTLP exports traces to an OTLP/HTTP endpoint, e.g. an OpenTelemetry Collector or Jaeger
+	ExporterOTLP = "otlp"
+	// ExporterStdout writes traces to stdout, which is useful for local development
+	ExporterStdout = "stdout"
+)
+
+var (
+	ErrUnknownExporter = errors.New("unknown trace exporter")
+)
+
+// InitTracing sets up the global tracer provider which exports the traces of all components. The returned function
+// flushes the remaining traces and must be called before exiting.
+func InitTracing(
+	ctx context.Context,
+	log *slog.Logger,
+
+	serviceName,
+	exporter,
+	otlpEndpoint string,
+) (func(ctx context.Context) error, error) {
+	// Incoming trace context is also propagated if tracing is disabled so that spans of other services stay connected
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var (
+		spanExporter sdktrace.SpanExporter
+		err          error
+	)
+	switch exporter {
+	case ExporterNone:
+		log.Debug("Tracing is disabled")
+
+		return func(ctx context.Context) error { return nil }, nil
+
+	case ExporterOTLP:
+		log.Info("Exporting traces to OTLP endpoint", "otlpEndpoint", otlpEndpoint)
+
+		spanExporter, err = otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(otlpEndpoint))
+
+	case ExporterStdout:
+		log.Info("Exporting traces to stdout")
+
+		spanExporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+
+	default:
+		return nil, ErrUnknownExporter
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(r),
+	)
+
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// Logger returns a logger which adds the trace and span IDs of the span in the context to its
+// records so that they can be correlated with the trace
+func Logger(ctx context.Context, log *slog.Logger) *slog.Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return log
+	}
+
+	return log.With("traceID", sc.TraceID().String(), "spanID", sc.SpanID().String())
+}
+
+// RecordError marks the span as failed if there is an error
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// Instrument records a span for each request handled by the handler. Spans are named after the request method
+// until the handler that handles the request renames them (see `SetSpanName`).
+func Instrument(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "", otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+		return r.Method
+	}))
+}
+
+// SetSpanName renames the span of the request once it is known which handler handles it
+func SetSpanName(ctx context.Context, name string) {
+	trace.SpanFromContext(ctx).SetName(name)
+}
@@ -0,0 +1,72 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"net/url"
+	"syscall"
+)
+
+var (
+	ErrInvalidURL       = errors.New("webhook URL must be an absolute HTTP or HTTPS URL")
+	ErrForbiddenAddress = errors.New("webhook URL must not resolve to a loopback, private or link-local address")
+
+	// sharedAddressSpace is used for carrier-grade NAT and isn't covered by `netip.Addr.IsPrivate`,
+	// see https://www.rfc-editor.org/rfc/rfc6598
+	sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+)
+
+// isAllowedAddr checks if webhooks may be delivered to an IP address. Only global unicast addresses are allowed
+// so that webhooks can't be used to probe services that are only reachable from the server, such as the admin
+// listener or the cloud metadata service.
+func isAllowedAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// ValidateURL checks if `rawURL` is an absolute HTTP or HTTPS URL whose host only resolves to addresses
+// that webhooks may be delivered to. Since DNS records can change after a webhook has been created,
+// the dispatcher checks the address again before it connects.
+func ValidateURL(ctx context.Context, rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidURL, err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return nil, ErrInvalidURL
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return nil, errors.Join(ErrInvalidURL, err)
+	}
+
+	for _, addr := range addrs {
+		if !isAllowedAddr(addr) {
+			return nil, ErrForbiddenAddress
+		}
+	}
+
+	return u, nil
+}
+
+// controlDial rejects connections to addresses that webhooks may not be delivered to after the host has been
+// resolved, which also covers redirects and DNS records that have changed since the webhook was created
+func controlDial(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+
+	if !isAllowedAddr(addrPort.Addr()) {
+		return ErrForbiddenAddress
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// maxEntries is the number of outbox entries that are claimed and delivered concurrently
+	maxEntries = 32
+	// lease must be longer than it takes to deliver all claimed entries, otherwise they are delivered twice
+	lease           = time.Minute
+	deliveryTimeout = 10 * time.Second
+
+	// The backoff doubles after every attempt, so an entry is retried for about 4 hours before it fails permanently
+	maxAttempts    = 10
+	initialBackoff = 30 * time.Second
+
+	// Delivered and failed entries and their delivery log are kept for debugging for the retention period
+	retention     = 30 * 24 * time.Hour
+	pruneInterval = time.Hour
+
+	// maxResponseBodySize limits how much of the response is read so that the connection can be reused
+	maxResponseBodySize = 64 * 1024
+
+	deliveryResultDelivered = "delivered"
+	deliveryResultRetrying  = "retrying"
+	deliveryResultFailed    = "failed"
+)
+
+var (
+	errUnexpectedStatusCode = errors.New("unexpected status code")
+)
+
+// event is the request body of a delivery
+type event struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Dispatcher delivers the events in the outbox to the webhooks that are subscribed to them. Deliveries are
+// retried with an exponential backoff until they succeed or the maximum number of attempts is reached.
+type Dispatcher struct {
+	log       *slog.Logger
+	persister *persisters.Persister
+
+	pollInterval time.Duration
+
+	client *http.Client
+
+	deliveries *prometheus.CounterVec
+	registerer prometheus.Registerer
+}
+
+func NewDispatcher(
+	log *slog.Logger,
+	persister *persisters.Persister,
+
+	pollInterval time.Duration,
+) *Dispatcher {
+	// Webhook URLs are user-provided, so connections are only made to public addresses and never through a proxy
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   controlDial,
+	}).DialContext
+
+	return &Dispatcher{
+		log:       log,
+		persister: persister,
+
+		pollInterval: pollInterval,
+
+		client: &http.Client{
+			Transport: otelhttp.NewTransport(transport),
+			Timeout:   deliveryTimeout,
+		},
+
+		deliveries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "senbara_webhook_deliveries_total",
+			Help: "Number of webhook delivery attempts by whether they were delivered, will be retried or failed permanently",
+		}, []string{"result"}),
+	}
+}
+
+// EnableMetrics registers the webhook delivery metrics with the registerer. Must be called before `Run`.
+func (d *Dispatcher) EnableMetrics(registerer prometheus.Registerer) {
+	d.registerer = registerer
+}
+
+// Run delivers events until the context is cancelled. Since outbox entries are claimed with a lease, multiple
+// instances can run a dispatcher at the same time, and entries that are being delivered when the context is
+// cancelled are retried once the lease has expired.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	if d.registerer != nil {
+		if err := d.registerer.Register(d.deliveries); err != nil {
+			return err
+		}
+	}
+
+	d.log.Info("Delivering webhooks", "pollInterval", d.pollInterval)
+
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	var prunedAt time.Time
+	for {
+		if time.Since(prunedAt) >= pruneInterval {
+			deleted, err := d.persister.DeleteExpiredWebhookOutboxEntries(ctx, time.Now().Add(-retention))
+			if err != nil {
+				d.log.Warn("Could not delete expired webhook outbox entries", "err", err)
+			} else {
+				d.log.Debug("Deleted expired webhook outbox entries", "len", deleted)
+
+				prunedAt = time.Now()
+			}
+		}
+
+		if err := d.deliverPending(ctx); err != nil && ctx.Err() == nil {
+			d.log.Warn("Could not deliver webhooks", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverPending delivers all entries that are due, one batch at a time
+func (d *Dispatcher) deliverPending(ctx context.Context) error {
+	for {
+		entries, err := d.persister.ClaimWebhookOutboxEntries(ctx, maxEntries, lease)
+		if err != nil {
+			return err
+		}
+
+		var (
+			wg   sync.WaitGroup
+			lock sync.Mutex
+			errs []error
+		)
+		for _, entry := range entries {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				if err := d.deliver(ctx, entry); err != nil {
+					lock.Lock()
+					errs = append(errs, err)
+					lock.Unlock()
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+
+		if len(entries) < maxEntries {
+			return nil
+		}
+	}
+}
+
+// deliver sends an entry to its webhook and records the attempt
+func (d *Dispatcher) deliver(ctx context.Context, entry models.ClaimWebhookOutboxEntriesRow) error {
+	log := d.log.With("id", entry.ID, "webhookID", entry.WebhookID, "eventType", entry.EventType, "attempt", entry.Attempts+1)
+
+	log.Debug("Delivering webhook")
+
+	body, err := json.Marshal(event{
+		ID:        entry.ID,
+		Type:      entry.EventType,
+		CreatedAt: entry.CreatedAt,
+		Data:      entry.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	statusCode, deliveryErr := d.send(ctx, entry, body)
+	duration := time.Since(start)
+
+	var (
+		delivered     = deliveryErr == nil
+		failed        = !delivered && entry.Attempts+1 >= maxAttempts
+		nextAttemptAt = time.Now().Add(initialBackoff << entry.Attempts)
+
+		result         = deliveryResultDelivered
+		rawDeliveryErr string
+	)
+	if !delivered {
+		rawDeliveryErr = deliveryErr.Error()
+
+		if failed {
+			result = deliveryResultFailed
+
+			log.Warn("Could not deliver webhook, giving up", "err", deliveryErr)
+		} else {
+			result = deliveryResultRetrying
+
+			log.Debug("Could not deliver webhook, retrying after backoff", "err", deliveryErr, "nextAttemptAt", nextAttemptAt)
+		}
+	}
+
+	d.deliveries.WithLabelValues(result).Inc()
+
+	return d.persister.CompleteWebhookDelivery(
+		ctx,
+
+		entry,
+
+		statusCode,
+		rawDeliveryErr,
+		duration,
+
+		delivered,
+		failed,
+		nextAttemptAt,
+	)
+}
+
+// send posts the signed body to the webhook and returns the response's status code, which is 0 if no
+// response was received
+func (d *Dispatcher) send(ctx context.Context, entry models.ClaimWebhookOutboxEntriesRow, body []byte) (int32, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, entry.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Senbara-Webhooks")
+	req.Header.Set(EventIDHeader, strconv.FormatInt(entry.ID, 10))
+	req.Header.Set(EventTypeHeader, entry.EventType)
+	req.Header.Set(SignatureHeader, Sign([]byte(entry.Secret), time.Now().Unix(), body))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxResponseBodySize))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return int32(res.StatusCode), errUnexpectedStatusCode
+	}
+
+	return int32(res.StatusCode), nil
+}
@@ -0,0 +1,29 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+const (
+	// SignatureHeader contains the timestamp and HMAC-SHA256 signature of a delivery in the format
+	// `t=<unix timestamp>,v1=<hex-encoded signature>`
+	SignatureHeader = "Senbara-Signature"
+	EventIDHeader   = "Senbara-Event-ID"
+	EventTypeHeader = "Senbara-Event-Type"
+)
+
+// Sign returns the value of the signature header for a request body. The signature is calculated over the
+// timestamp and the body joined by a `.`, so receivers can reject replayed deliveries with old timestamps.
+func Sign(secret []byte, timestamp int64, body []byte) string {
+	t := strconv.FormatInt(timestamp, 10)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
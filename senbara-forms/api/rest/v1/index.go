@@ -3,16 +3,18 @@ package v1
 //go:generate tar czf code.tar.gz --exclude .git --exclude */api/openapi/v1/code.tar.gz -C ../../../ .
 
 import (
+	"context"
 	_ "embed"
 	"log/slog"
 	"net/http"
 	"os"
-	"strings"
+	"sync"
 
 	_ "github.com/lib/pq"
 
-	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
-	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/admin"
+	"github.com/pojntfx/senbara/senbara-common/pkg/bootstrap"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 	"github.com/pojntfx/senbara/senbara-forms/pkg/controllers"
 	"github.com/pojntfx/senbara/senbara-forms/web/static"
 )
@@ -20,11 +22,48 @@ import (
 //go:embed code.tar.gz
 var Code []byte
 
-var (
-	p *persisters.Persister
-	a *authn.Authner
-	c *controllers.Controller
-)
+// getBootstrapper reads the configuration of the serverless handler once, the services are built on the first request
+var getBootstrapper = sync.OnceValue(func() *bootstrap.Bootstrapper[*controllers.Controller] {
+	config := bootstrap.LoadEnvConfig()
+
+	opts := &slog.HandlerOptions{}
+	if config.Verbose {
+		opts.Level = slog.LevelDebug
+	}
+	log := slog.New(slog.NewJSONHandler(os.Stderr, opts))
+
+	return bootstrap.NewBootstrapper(log, config, func(ctx context.Context, services bootstrap.Services) (*controllers.Controller, error) {
+		return NewController(ctx, slog.New(log.Handler().WithGroup("controller")), config, services)
+	})
+})
+
+// NewController creates the web app's controller from the shared services
+func NewController(
+	ctx context.Context,
+	log *slog.Logger,
+
+	config bootstrap.Config,
+	services bootstrap.Services,
+) (*controllers.Controller, error) {
+	c := controllers.NewController(
+		log,
+
+		services.Persister,
+		services.Authner,
+
+		config.PrivacyURL,
+		config.TOSURL,
+		config.ImprintURL,
+
+		Code,
+	)
+
+	if err := c.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
 
 func SenbaraFormsHandler(
 	w http.ResponseWriter,
@@ -53,6 +92,7 @@ func SenbaraFormsHandler(
 	mux.HandleFunc("POST /contacts/delete", c.HandleDeleteContact)
 	mux.HandleFunc("POST /contacts/update", c.HandleUpdateContact)
 
+	mux.HandleFunc("GET /debts", c.HandleDebts)
 	mux.HandleFunc("GET /debts/add", c.HandleAddDebt)
 	mux.HandleFunc("GET /debts/edit", c.HandleEditDebt)
 
@@ -60,6 +100,7 @@ func SenbaraFormsHandler(
 	mux.HandleFunc("POST /debts/settle", c.HandleSettleDebt)
 	mux.HandleFunc("POST /debts/update", c.HandleUpdateDebt)
 
+	mux.HandleFunc("GET /activities", c.HandleActivities)
 	mux.HandleFunc("GET /activities/add", c.HandleAddActivity)
 	mux.HandleFunc("GET /activities/view", c.HandleViewActivity)
 	mux.HandleFunc("GET /activities/edit", c.HandleEditActivity)
@@ -73,77 +114,53 @@ func SenbaraFormsHandler(
 	mux.HandleFunc("POST /userdata", c.HandleCreateUserData)
 	mux.HandleFunc("POST /userdata/delete", c.HandleDeleteUserData)
 
-	mux.HandleFunc("GET /login", c.HandleLogin)
-	mux.HandleFunc("GET /authorize", c.HandleAuthorize)
+	mux.HandleFunc("GET /spaces", c.HandleSpaces)
 
-	mux.HandleFunc("GET /code/", c.HandleCode)
+	mux.HandleFunc("POST /spaces", c.HandleCreateSpace)
+	mux.HandleFunc("POST /spaces/switch", c.HandleSwitchSpace)
+	mux.HandleFunc("POST /spaces/delete", c.HandleDeleteSpace)
+	mux.HandleFunc("POST /spaces/invite", c.HandleCreateSpaceInvitation)
+	mux.HandleFunc("POST /spaces/members/delete", c.HandleDeleteSpaceMember)
 
-	mux.HandleFunc("/", c.HandleIndex)
+	mux.HandleFunc("POST /invitations/accept", c.HandleAcceptSpaceInvitation)
+	mux.HandleFunc("POST /invitations/decline", c.HandleDeclineSpaceInvitation)
 
-	mux.ServeHTTP(w, r)
-}
-
-func Handler(w http.ResponseWriter, r *http.Request) {
-	r.URL.Path = r.URL.Query().Get("path")
-
-	opts := &slog.HandlerOptions{}
-	if os.Getenv("VERBOSE") == "true" {
-		opts.Level = slog.LevelDebug
-	}
-	log := slog.New(slog.NewJSONHandler(os.Stderr, opts))
-
-	if p == nil {
-		p = persisters.NewPersister(slog.New(log.Handler().WithGroup("persister")), os.Getenv("POSTGRES_URL"))
+	mux.HandleFunc("GET /tokens", c.HandleAccessTokens)
 
-		if err := p.Init(r.Context()); err != nil {
-			panic(err)
-		}
-	}
+	mux.HandleFunc("POST /tokens", c.HandleCreateAccessToken)
+	mux.HandleFunc("POST /tokens/delete", c.HandleDeleteAccessToken)
 
-	if a == nil {
-		o, err := authn.DiscoverOIDCProviderConfiguration(
-			r.Context(),
+	mux.HandleFunc("GET /sessions", c.HandleSessions)
 
-			slog.New(log.Handler().WithGroup("oidcDiscovery")),
+	mux.HandleFunc("POST /sessions/delete", c.HandleDeleteSession)
+	mux.HandleFunc("POST /sessions/all/delete", c.HandleDeleteSessions)
 
-			strings.TrimSuffix(os.Getenv("OIDC_ISSUER"), "/")+authn.OIDCWellKnownURLSuffix,
-		)
-		if err != nil {
-			panic(err)
-		}
+	mux.HandleFunc("POST /backchannel-logout", c.HandleBackchannelLogout)
 
-		a = authn.NewAuthner(
-			slog.New(log.Handler().WithGroup("authner")),
+	mux.HandleFunc("GET /login", c.HandleLogin)
+	mux.HandleFunc("GET /authorize", c.HandleAuthorize)
 
-			o.Issuer,
-			o.EndSessionEndpoint,
+	mux.HandleFunc("GET /code/", c.HandleCode)
 
-			os.Getenv("OIDC_CLIENT_ID"),
-			os.Getenv("OIDC_REDIRECT_URL"),
-		)
+	mux.HandleFunc("/", c.HandleIndex)
 
-		if err := a.Init(r.Context()); err != nil {
-			panic(err)
-		}
+	// Requests are recorded for the pattern of the handler that handles them
+	if _, pattern := mux.Handler(r); pattern != "" {
+		admin.SetOperation(r.Context(), pattern)
+		telemetry.SetSpanName(r.Context(), pattern)
 	}
 
-	if c == nil {
-		c = controllers.NewController(
-			slog.New(log.Handler().WithGroup("controller")),
-
-			p,
-			a,
+	mux.ServeHTTP(w, r)
+}
 
-			os.Getenv("PRIVACY_URL"),
-			os.Getenv("TOS_URL"),
-			os.Getenv("IMPRINT_URL"),
+func Handler(w http.ResponseWriter, r *http.Request) {
+	r.URL.Path = r.URL.Query().Get("path")
 
-			Code,
-		)
+	c, _, err := getBootstrapper().Get(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
 
-		if err := c.Init(r.Context()); err != nil {
-			panic(err)
-		}
+		return
 	}
 
 	SenbaraFormsHandler(w, r, c)
@@ -6,13 +6,18 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/adrg/xdg"
-	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
-	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/admin"
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn/oidctest"
+	"github.com/pojntfx/senbara/senbara-common/pkg/bootstrap"
+	"github.com/pojntfx/senbara/senbara-common/pkg/server"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 	v1 "github.com/pojntfx/senbara/senbara-forms/api/rest/v1"
 	"github.com/pojntfx/senbara/senbara-forms/pkg/controllers"
 	"github.com/spf13/cobra"
@@ -20,25 +25,25 @@ import (
 )
 
 var (
-	errMissingOIDCIssuer      = errors.New("missing OIDC issuer")
 	errMissingOIDCClientID    = errors.New("missing OIDC client ID")
 	errMissingOIDCRedirectURL = errors.New("missing OIDC redirect URL")
-	errMissingPrivacyURL      = errors.New("missing privacy policy URL")
-	errMissingTOSURL          = errors.New("missing terms of service URL")
-	errMissingImprintURL      = errors.New("missing imprint URL")
 )
 
 const (
-	verboseKey         = "verbose"
 	configKey          = "config"
 	laddrKey           = "laddr"
-	pgaddrKey          = "pgaddr"
-	oidcIssuerKey      = "oidc-issuer"
-	oidcClientIDKey    = "oidc-client-id"
-	oidcRedirectURLKey = "oidc-redirect-url"
-	privacyURLKey      = "privacy-url"
-	tosURLKey          = "tos-url"
-	imprintURLKey      = "imprint-url"
+	adminLaddrKey      = "admin-laddr"
+	tlsCertKey         = "tls-cert"
+	tlsKeyKey          = "tls-key"
+	readTimeoutKey     = "read-timeout"
+	writeTimeoutKey    = "write-timeout"
+	idleTimeoutKey     = "idle-timeout"
+	shutdownTimeoutKey = "shutdown-timeout"
+	devOIDCKey         = "dev-oidc"
+	devOIDCLaddrKey    = "dev-oidc-laddr"
+	devOIDCUsersKey    = "dev-oidc-users"
+	otelExporterKey    = "otel-exporter"
+	otelEndpointKey    = "otel-endpoint"
 )
 
 func main() {
@@ -53,7 +58,7 @@ For more information, please visit https://github.com/pojntfx/senbara.`,
 			defer cancel()
 
 			opts := &slog.HandlerOptions{}
-			if viper.GetBool(verboseKey) {
+			if viper.GetBool(bootstrap.VerboseKey) {
 				opts.Level = slog.LevelDebug
 			}
 			log := slog.New(slog.NewJSONHandler(os.Stderr, opts))
@@ -71,7 +76,7 @@ For more information, please visit https://github.com/pojntfx/senbara.`,
 				}
 			}
 
-			if v := os.Getenv("PORT"); v != "" {
+			if v := os.Getenv("PORT"); v != "" && !strings.HasPrefix(viper.GetString(laddrKey), server.UnixPrefix) {
 				log.Info("Using port from PORT env variable")
 
 				la, err := net.ResolveTCPAddr("tcp", viper.GetString(laddrKey))
@@ -89,96 +94,183 @@ For more information, please visit https://github.com/pojntfx/senbara.`,
 				viper.Set(laddrKey, la.String())
 			}
 
-			if !viper.IsSet(oidcIssuerKey) {
-				return errMissingOIDCIssuer
-			}
+			config := bootstrap.LoadConfig(viper.GetViper())
 
-			if !viper.IsSet(oidcClientIDKey) {
+			if len(config.OIDCClientIDs) == 0 && !viper.GetBool(devOIDCKey) {
 				return errMissingOIDCClientID
 			}
 
-			if !viper.IsSet(oidcRedirectURLKey) {
+			if config.OIDCRedirectURL == "" {
 				return errMissingOIDCRedirectURL
 			}
 
-			if !viper.IsSet(privacyURLKey) {
-				return errMissingPrivacyURL
+			shutdownTracing, err := telemetry.InitTracing(
+				ctx,
+
+				slog.New(log.Handler().WithGroup("telemetry")),
+
+				cmd.Use,
+				viper.GetString(otelExporterKey),
+				viper.GetString(otelEndpointKey),
+			)
+			if err != nil {
+				return err
 			}
+			defer shutdownTracing(context.Background())
+
+			adm := admin.NewAdmin(slog.New(log.Handler().WithGroup("admin")))
+
+			if viper.GetBool(devOIDCKey) {
+				users := []oidctest.User{}
+				for _, rawUser := range viper.GetStringSlice(devOIDCUsersKey) {
+					u, err := oidctest.ParseUser(rawUser)
+					if err != nil {
+						return err
+					}
+
+					users = append(users, u)
+				}
+
+				// Without a configured client ID, use a client that is pre-registered with the development OIDC issuer
+				if len(config.OIDCClientIDs) == 0 {
+					config.OIDCClientIDs = []string{cmd.Use}
+				}
+
+				// The back-channel logout endpoint is served next to the redirect URL
+				backchannelLogoutURL, err := url.Parse(config.OIDCRedirectURL)
+				if err != nil {
+					return err
+				}
+
+				backchannelLogoutURL.Path = "/backchannel-logout"
+				backchannelLogoutURL.RawQuery = ""
+
+				i, err := oidctest.Listen(
+					slog.New(log.Handler().WithGroup("devOIDC")),
+
+					viper.GetString(devOIDCLaddrKey),
+					0,
 
-			if !viper.IsSet(tosURLKey) {
-				return errMissingTOSURL
+					users,
+					[]oidctest.Client{
+						{
+							ID:           config.OIDCClientIDs[0],
+							Name:         cmd.Use,
+							RedirectURIs: []string{config.OIDCRedirectURL},
+
+							BackchannelLogoutURI: backchannelLogoutURL.String(),
+						},
+					},
+				)
+				if err != nil {
+					return err
+				}
+				defer i.Close()
+
+				config.OIDCIssuers = []string{i.URL()}
 			}
 
-			if !viper.IsSet(imprintURLKey) {
-				return errMissingImprintURL
+			if config.OIDCStateKey == "" {
+				log.Warn("No OIDC state key configured, sign ins that are started on another instance or before a restart will fail")
 			}
 
-			p := persisters.NewPersister(slog.New(log.Handler().WithGroup("persister")), viper.GetString(pgaddrKey))
+			b := bootstrap.NewBootstrapper(log, config, func(ctx context.Context, services bootstrap.Services) (*controllers.Controller, error) {
+				return v1.NewController(ctx, slog.New(log.Handler().WithGroup("controller")), config, services)
+			})
+			b.EnableMetrics(adm.Registerer())
 
-			if err := p.Init(ctx); err != nil {
+			c, services, err := b.Init(ctx)
+			if err != nil {
 				return err
 			}
 
-			o, err := authn.DiscoverOIDCProviderConfiguration(
-				ctx,
+			adm.AddCheck("database", services.Persister.Ping)
+			adm.AddCheck("oidc", services.Authner.Ready)
 
-				slog.New(log.Handler().WithGroup("oidcDiscovery")),
+			if err := adm.Init(ctx); err != nil {
+				return err
+			}
 
-				strings.TrimSuffix(viper.GetString(oidcIssuerKey), "/")+authn.OIDCWellKnownURLSuffix,
-			)
+			listeners, err := server.SystemdListeners()
 			if err != nil {
 				return err
 			}
 
-			a := authn.NewAuthner(
-				slog.New(log.Handler().WithGroup("authner")),
+			options := server.Options{
+				ReadTimeout:  viper.GetDuration(readTimeoutKey),
+				WriteTimeout: viper.GetDuration(writeTimeoutKey),
+				IdleTimeout:  viper.GetDuration(idleTimeoutKey),
+			}
+
+			srvOptions := options
+			srvOptions.TLSCertFile = viper.GetString(tlsCertKey)
+			srvOptions.TLSKeyFile = viper.GetString(tlsKeyKey)
 
-				o.Issuer,
-				o.EndSessionEndpoint,
+			srv := server.NewServer(
+				slog.New(log.Handler().WithGroup("server")),
 
-				viper.GetString(oidcClientIDKey),
-				viper.GetString(oidcRedirectURLKey),
+				viper.GetString(laddrKey),
+				telemetry.Instrument(adm.Instrument(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					v1.SenbaraFormsHandler(w, r, c)
+				}))),
+				srvOptions,
 			)
 
-			if err := a.Init(ctx); err != nil {
-				return err
+			// The first socket passed by systemd is used for requests and the second one for admin requests
+			if len(listeners) > 0 {
+				srv.UseListener(listeners[0])
 			}
 
-			c := controllers.NewController(
-				slog.New(log.Handler().WithGroup("controller")),
+			servers := []*server.Server{srv}
+			if adminLaddr := viper.GetString(adminLaddrKey); adminLaddr != "" || len(listeners) > 1 {
+				adminSrv := server.NewServer(
+					slog.New(log.Handler().WithGroup("adminServer")),
 
-				p,
-				a,
+					adminLaddr,
+					adm.Handler(),
+					options,
+				)
 
-				viper.GetString(privacyURLKey),
-				viper.GetString(tosURLKey),
-				viper.GetString(imprintURLKey),
-
-				v1.Code,
-			)
+				if len(listeners) > 1 {
+					adminSrv.UseListener(listeners[1])
+				}
 
-			if err := c.Init(ctx); err != nil {
-				return err
+				servers = append(servers, adminSrv)
 			}
 
-			log.Info("Listening", "laddr", viper.GetString(laddrKey))
+			for _, sv := range servers {
+				if err := sv.Init(ctx); err != nil {
+					return err
+				}
+			}
 
-			panic(http.ListenAndServe(viper.GetString(laddrKey), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				v1.SenbaraFormsHandler(w, r, c)
-			})))
+			return server.Run(ctx, log, viper.GetDuration(shutdownTimeoutKey), servers...)
 		},
 	}
 
-	cmd.PersistentFlags().BoolP(verboseKey, "v", false, "Whether to enable verbose logging")
+	cmd.PersistentFlags().BoolP(bootstrap.VerboseKey, "v", false, "Whether to enable verbose logging")
 	cmd.PersistentFlags().StringP(configKey, "c", "", "Config file to use (by default "+cmd.Use+".yaml in the XDG config directory is read if it exists)")
-	cmd.PersistentFlags().StringP(laddrKey, "l", ":1337", "Listen address (port can also be set with `PORT` env variable)")
-	cmd.PersistentFlags().StringP(pgaddrKey, "p", "postgresql://postgres@localhost:5432/senbara?sslmode=disable", "Database address")
-	cmd.PersistentFlags().String(oidcIssuerKey, "", "OIDC Issuer (e.g. https://heuristic-rhodes-wqkaaxzmwj.projects.oryapis.com)")
-	cmd.PersistentFlags().String(oidcClientIDKey, "", "OIDC Client ID (e.g. myoidcclientid))")
-	cmd.PersistentFlags().String(oidcRedirectURLKey, "http://localhost:1337/authorize", "OIDC redirect URL")
-	cmd.PersistentFlags().String(privacyURLKey, "", "Privacy policy URL")
-	cmd.PersistentFlags().String(tosURLKey, "", "Terms of service URL")
-	cmd.PersistentFlags().String(imprintURLKey, "", "Imprint URL")
+	cmd.PersistentFlags().StringP(laddrKey, "l", ":1337", "Listen address (prefix with unix: to listen on a Unix socket; port can also be set with `PORT` env variable)")
+	cmd.PersistentFlags().String(adminLaddrKey, ":1340", "Listen address for the health check (/healthz and /readyz) and metrics (/metrics) endpoints (disabled if empty)")
+	cmd.PersistentFlags().String(tlsCertKey, "", "TLS certificate file to serve HTTPS with (reloaded when it changes; if empty, HTTP is served)")
+	cmd.PersistentFlags().String(tlsKeyKey, "", "TLS key file for the TLS certificate")
+	cmd.PersistentFlags().Duration(readTimeoutKey, 5*time.Minute, "Maximum duration for reading a request, including its body (e.g. an import) (0 to disable)")
+	cmd.PersistentFlags().Duration(writeTimeoutKey, 5*time.Minute, "Maximum duration for writing a response (e.g. an export) (0 to disable)")
+	cmd.PersistentFlags().Duration(idleTimeoutKey, 2*time.Minute, "Maximum duration to keep idle keep-alive connections open (0 to disable)")
+	cmd.PersistentFlags().Duration(shutdownTimeoutKey, time.Minute, "Maximum duration to wait for in-flight requests (e.g. imports and exports) to finish when shutting down")
+	cmd.PersistentFlags().StringP(bootstrap.PgaddrKey, "p", bootstrap.DefaultPgaddr, "Database address")
+	cmd.PersistentFlags().StringSlice(bootstrap.OIDCIssuerKey, []string{}, "OIDC issuers that users can sign in with, the first one is the default issuer (can be specified multiple times) (e.g. https://heuristic-rhodes-wqkaaxzmwj.projects.oryapis.com)")
+	cmd.PersistentFlags().Bool(devOIDCKey, false, "Whether to start an embedded OIDC issuer with test users for local development instead of using the OIDC issuer")
+	cmd.PersistentFlags().String(devOIDCLaddrKey, "localhost:1339", "Listen address for the embedded development OIDC issuer")
+	cmd.PersistentFlags().StringArray(devOIDCUsersKey, []string{"jane@example.com"}, "Test users for the embedded development OIDC issuer (in the format email[:unverified])")
+	cmd.PersistentFlags().StringSlice(bootstrap.OIDCClientIDKey, []string{}, "OIDC client IDs, one for each OIDC issuer in the same order (can be specified multiple times) (e.g. myoidcclientid)")
+	cmd.PersistentFlags().String(bootstrap.OIDCRedirectURLKey, bootstrap.DefaultOIDCRedirectURL, "OIDC redirect URL")
+	cmd.PersistentFlags().String(bootstrap.OIDCStateKeyKey, "", "Secret key to sign the OIDC state with (must be the same for all instances, by default a random key is generated on startup)")
+	cmd.PersistentFlags().String(bootstrap.PrivacyURLKey, "", "Privacy policy URL")
+	cmd.PersistentFlags().String(bootstrap.TOSURLKey, "", "Terms of service URL")
+	cmd.PersistentFlags().String(bootstrap.ImprintURLKey, "", "Imprint URL")
+	cmd.PersistentFlags().String(otelExporterKey, "", "Exporter for OpenTelemetry traces (otlp or stdout; if empty, tracing is disabled)")
+	cmd.PersistentFlags().String(otelEndpointKey, "http://localhost:4318", "OTLP/HTTP endpoint to export traces to if the otlp exporter is used")
 
 	if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
 		panic(err)
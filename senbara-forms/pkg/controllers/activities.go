@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 type activityData struct {
@@ -16,6 +17,125 @@ type activityData struct {
 	Entry models.GetActivityAndContactRow
 }
 
+type activitiesData struct {
+	pageData
+	Entries  []models.GetActivitiesForNamespaceRow
+	Contacts []models.Contact
+
+	ContactID int32
+	StartDate string
+	EndDate   string
+	Query     string
+}
+
+func (c *Controller) HandleActivities(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for activities page", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
+
+	log.Debug("Handling activities page")
+
+	// All filters are optional, so empty values match all activities
+	var contactID int
+	if rcontactID := r.FormValue("contact_id"); strings.TrimSpace(rcontactID) != "" {
+		contactID, err = strconv.Atoi(rcontactID)
+		if err != nil {
+			log.Warn("Could not prepare activities page", "err", errInvalidQueryParam)
+
+			http.Error(w, errInvalidQueryParam.Error(), http.StatusUnprocessableEntity)
+
+			return
+		}
+	}
+
+	rstartDate := r.FormValue("start_date")
+
+	var startDate *time.Time
+	if strings.TrimSpace(rstartDate) != "" {
+		v, err := time.Parse("2006-01-02", rstartDate)
+		if err != nil {
+			log.Warn("Could not prepare activities page", "err", errInvalidQueryParam)
+
+			http.Error(w, errInvalidQueryParam.Error(), http.StatusUnprocessableEntity)
+
+			return
+		}
+
+		startDate = &v
+	}
+
+	rendDate := r.FormValue("end_date")
+
+	var endDate *time.Time
+	if strings.TrimSpace(rendDate) != "" {
+		v, err := time.Parse("2006-01-02", rendDate)
+		if err != nil {
+			log.Warn("Could not prepare activities page", "err", errInvalidQueryParam)
+
+			http.Error(w, errInvalidQueryParam.Error(), http.StatusUnprocessableEntity)
+
+			return
+		}
+
+		endDate = &v
+	}
+
+	query := strings.TrimSpace(r.FormValue("query"))
+
+	log.Debug("Getting activities from DB", "contactID", contactID, "startDate", startDate, "endDate", endDate, "query", query)
+
+	activities, err := c.persister.GetActivitiesForNamespace(r.Context(), userData.Namespace, int32(contactID), startDate, endDate, query)
+	if err != nil {
+		log.Warn("Could not get activities from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		http.Error(w, errCouldNotFetchFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	contacts, err := c.persister.GetContacts(r.Context(), userData.Namespace)
+	if err != nil {
+		log.Warn("Could not get contacts from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		http.Error(w, errCouldNotFetchFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	if err := c.tpl.ExecuteTemplate(w, "activities.html", activitiesData{
+		pageData: pageData{
+			userData: userData,
+
+			Page:       userData.Locale.Get("All activities"),
+			PrivacyURL: c.privacyURL,
+			TosURL:     c.tosURL,
+			ImprintURL: c.imprintURL,
+		},
+		Entries:  activities,
+		Contacts: contacts,
+
+		ContactID: int32(contactID),
+		StartDate: rstartDate,
+		EndDate:   rendDate,
+		Query:     query,
+	}); err != nil {
+		log.Warn("Could not render activities template", "err", errors.Join(errCouldNotRenderTemplate, err))
+
+		http.Error(w, errCouldNotRenderTemplate.Error(), http.StatusInternalServerError)
+
+		return
+	}
+}
+
 func (c *Controller) HandleAddActivity(w http.ResponseWriter, r *http.Request) {
 	redirected, userData, status, err := c.authorize(w, r, true)
 	if err != nil {
@@ -28,7 +148,7 @@ func (c *Controller) HandleAddActivity(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling add activity page")
 
@@ -52,7 +172,7 @@ func (c *Controller) HandleAddActivity(w http.ResponseWriter, r *http.Request) {
 
 	log.Debug("Getting contact to add activity to on add activity page from DB", "id", id)
 
-	contact, err := c.persister.GetContact(r.Context(), int32(id), userData.Email)
+	contact, err := c.persister.GetContact(r.Context(), int32(id), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get contact to add activity from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -92,7 +212,7 @@ func (c *Controller) HandleCreateActivity(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling create activity")
 
@@ -166,7 +286,7 @@ func (c *Controller) HandleCreateActivity(w http.ResponseWriter, r *http.Request
 		description,
 
 		int32(contactID),
-		userData.Email,
+		userData.Namespace,
 	); err != nil {
 		log.Warn("Could not create activity in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
@@ -190,7 +310,7 @@ func (c *Controller) HandleDeleteActivity(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling delete activity")
 
@@ -248,7 +368,7 @@ func (c *Controller) HandleDeleteActivity(w http.ResponseWriter, r *http.Request
 
 		int32(id),
 
-		userData.Email,
+		userData.Namespace,
 	); err != nil {
 		log.Warn("Could not delete activity in DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
 
@@ -272,7 +392,7 @@ func (c *Controller) HandleUpdateActivity(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling update activity")
 
@@ -360,7 +480,7 @@ func (c *Controller) HandleUpdateActivity(w http.ResponseWriter, r *http.Request
 
 		int32(id),
 
-		userData.Email,
+		userData.Namespace,
 
 		name,
 		date,
@@ -388,7 +508,7 @@ func (c *Controller) HandleEditActivity(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling edit activity page")
 
@@ -414,7 +534,7 @@ func (c *Controller) HandleEditActivity(w http.ResponseWriter, r *http.Request)
 		"id", id,
 	)
 
-	activityAndContact, err := c.persister.GetActivityAndContact(r.Context(), int32(id), userData.Email)
+	activityAndContact, err := c.persister.GetActivityAndContact(r.Context(), int32(id), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get activity and contact from DB for edit", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -454,7 +574,7 @@ func (c *Controller) HandleViewActivity(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling view activity page")
 
@@ -499,7 +619,7 @@ func (c *Controller) HandleViewActivity(w http.ResponseWriter, r *http.Request)
 		"contactID", contactID,
 	)
 
-	activityAndContact, err := c.persister.GetActivityAndContact(r.Context(), int32(id), userData.Email)
+	activityAndContact, err := c.persister.GetActivityAndContact(r.Context(), int32(id), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get activity and contact from DB for view", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -3,10 +3,17 @@ package controllers
 import (
 	"errors"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/leonelquinteros/gotext"
 	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 type pageData struct {
@@ -21,8 +28,15 @@ type pageData struct {
 }
 
 type userData struct {
-	Email     string
-	LogoutURL string
+	Email            string
+	Namespace        string
+	AccountNamespace string
+	LogoutURL        string
+	SessionID        int32
+
+	SpaceID   int32
+	SpaceName string
+	SpaceRole string
 
 	Locale *gotext.Locale
 }
@@ -39,7 +53,7 @@ func (c *Controller) authorize(
 
 	err error,
 ) {
-	log := c.log.With(
+	log := telemetry.Logger(r.Context(), c.log).With(
 		"loginIfSignedOut", loginIfSignedOut,
 		"method", r.Method,
 		"path", r.URL.Path,
@@ -56,133 +70,163 @@ func (c *Controller) authorize(
 		return
 	}
 
-	var (
-		refreshToken,
-		idToken *string
+	store := authn.NewCookieTokenStore(w, r)
+
+	// With multiple issuers, users who haven't chosen an issuer yet have to choose one before signing in
+	chooseIssuer := false
+	if loginIfSignedOut && len(c.authner.Issuers()) > 1 {
+		if _, err := store.GetToken(authn.TokenKeyIssuer); err != nil {
+			if !errors.Is(err, authn.ErrTokenNotFound) {
+				log.Warn("Could not get issuer", "err", err)
+
+				return false, userData{
+					Locale: locale,
+				}, http.StatusInternalServerError, err
+			}
+
+			chooseIssuer = true
+		}
+	}
+
+	session, err := c.authner.Authorize(
+		r.Context(),
+
+		store,
+
+		loginIfSignedOut && !chooseIssuer,
+
+		r.Header.Get("Referer"),
+		r.URL.String(),
 	)
-	rt, err := r.Cookie(refreshTokenKey)
 	if err != nil {
-		if !errors.Is(err, http.ErrNoCookie) {
-			log.Debug("Failed to read refresh token cookie", "error", err)
-
+		if errors.Is(err, authn.ErrCouldNotLogin) {
 			return false, userData{
 				Locale: locale,
-			}, http.StatusUnauthorized, errors.Join(errCouldNotLogin, err)
+			}, http.StatusUnauthorized, err
 		}
-	} else {
-		refreshToken = &rt.Value
+
+		return false, userData{
+			Locale: locale,
+		}, http.StatusInternalServerError, err
 	}
 
-	it, err := r.Cookie(idTokenKey)
-	if err != nil {
-		if !errors.Is(err, http.ErrNoCookie) {
-			log.Debug("Failed to read ID token cookie", "error", err)
+	if chooseIssuer && session.Identity.Subject == "" && r.URL.Path != "/login" {
+		log.Debug("Redirecting to issuer chooser")
+
+		http.Redirect(w, r, "/login", http.StatusFound)
+
+		return true, userData{
+			Locale: locale,
+		}, http.StatusTemporaryRedirect, nil
+	}
+
+	var (
+		accountNamespace string
+		sessionID        int32
+		space            models.GetSpaceRow
+	)
+	if session.Identity.Subject != "" {
+		accountNamespace, err = c.persister.GetNamespaceForAccount(r.Context(), session.Identity.Issuer, session.Identity.Subject, session.Identity.Email, c.authner.IsDefaultIssuer(session.Identity.Issuer))
+		if err != nil {
+			log.Warn("Could not get namespace for account", "err", errors.Join(errCouldNotFetchFromDB, err))
 
 			return false, userData{
 				Locale: locale,
-			}, http.StatusUnauthorized, errors.Join(errCouldNotLogin, err)
+			}, http.StatusInternalServerError, errCouldNotFetchFromDB
 		}
-	} else {
-		idToken = &it.Value
-	}
 
-	nextURL, email, logoutURL, err := c.authner.Authorize(
-		r.Context(),
+		if sessionKey := session.Identity.SessionKey(); sessionKey != "" {
+			sessionID, err = c.persister.TouchSession(r.Context(), sessionKey, session.Identity.SessionID, session.Identity.ClientID, r.UserAgent(), accountNamespace)
+			if err != nil {
+				if !errors.Is(err, persisters.ErrSessionRevoked) {
+					log.Warn("Could not touch session", "err", errors.Join(errCouldNotUpdateInDB, err))
 
-		loginIfSignedOut,
+					return false, userData{
+						Locale: locale,
+					}, http.StatusInternalServerError, errCouldNotUpdateInDB
+				}
 
-		r.Header.Get("Referer"),
-		r.URL.String(),
+				log.Debug("Session has been revoked, signing out")
 
-		refreshToken,
-		idToken,
-
-		func(s string, t time.Time) error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     refreshTokenKey,
-				Value:    s,
-				Expires:  t,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+				// Clearing the tokens signs the user out here, and ending the session with the OIDC provider makes
+				// sure that signing in again starts a new session instead of continuing the revoked one
+				if err := store.DeleteToken(authn.TokenKeyRefreshToken); err != nil {
+					log.Warn("Could not clear refresh token", "err", err)
 
-			return nil
-		},
-		func(s string, t time.Time) error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     idTokenKey,
-				Value:    s,
-				Expires:  t,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+					return false, userData{
+						Locale: locale,
+					}, http.StatusInternalServerError, err
+				}
 
-			return nil
-		},
-
-		func(s string) error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     stateNonceKey,
-				Value:    s,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+				if err := store.DeleteToken(authn.TokenKeyIDToken); err != nil {
+					log.Warn("Could not clear ID token", "err", err)
 
-			return nil
-		},
-		func(s string) error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     pkceCodeVerifierKey,
-				Value:    s,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+					return false, userData{
+						Locale: locale,
+					}, http.StatusInternalServerError, err
+				}
 
-			return nil
-		},
-		func(s string) error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     oidcNonceKey,
-				Value:    s,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+				http.Redirect(w, r, session.LogoutURL, http.StatusFound)
+
+				return true, userData{
+					Locale: locale,
+				}, http.StatusTemporaryRedirect, nil
+			}
+		}
+
+		space.Namespace = accountNamespace
+		space.Role = models.SpaceRoleOwner
+
+		if sc, err := r.Cookie(spaceKey); err == nil {
+			if spaceID, err := strconv.Atoi(sc.Value); err == nil {
+				s, err := c.persister.GetSpace(r.Context(), int32(spaceID), accountNamespace)
+				if err != nil {
+					if !errors.Is(err, persisters.ErrSpaceDoesNotExist) {
+						log.Warn("Could not get space", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+						return false, userData{
+							Locale: locale,
+						}, http.StatusInternalServerError, errCouldNotFetchFromDB
+					}
+
+					log.Debug("Selected space does not exist anymore, falling back to personal space", "spaceID", spaceID)
+				} else {
+					space = s
+				}
+			}
+		}
+
+		if r.Method == http.MethodPost &&
+			space.Role == models.SpaceRoleViewer &&
+			!strings.HasPrefix(r.URL.Path, "/spaces") &&
+			!strings.HasPrefix(r.URL.Path, "/invitations") &&
+			!strings.HasPrefix(r.URL.Path, "/tokens") &&
+			!strings.HasPrefix(r.URL.Path, "/sessions") {
+			log.Debug("Role in space does not allow writing", "spaceID", space.ID, "role", space.Role)
 
-			return nil
-		},
-	)
-	if err != nil {
-		if errors.Is(err, authn.ErrCouldNotLogin) {
 			return false, userData{
 				Locale: locale,
-			}, http.StatusUnauthorized, err
+			}, http.StatusForbidden, persisters.ErrInsufficientSpaceRole
 		}
-
-		return false, userData{
-			Locale: locale,
-		}, http.StatusInternalServerError, err
 	}
 
-	redirected = nextURL != ""
+	redirected = session.NextURL != ""
 	u = userData{
-		Email:     email,
-		LogoutURL: logoutURL,
+		Email:            session.Identity.Email,
+		Namespace:        space.Namespace,
+		AccountNamespace: accountNamespace,
+		LogoutURL:        session.LogoutURL,
+		SessionID:        sessionID,
+
+		SpaceID:   space.ID,
+		SpaceName: space.Name,
+		SpaceRole: space.Role,
 
 		Locale: locale,
 	}
 
 	if redirected {
-		http.Redirect(w, r, nextURL, http.StatusFound)
+		http.Redirect(w, r, session.NextURL, http.StatusFound)
 
 		return redirected, u, http.StatusTemporaryRedirect, nil
 	}
@@ -190,183 +234,140 @@ func (c *Controller) authorize(
 	return redirected, u, http.StatusOK, nil
 }
 
+type loginData struct {
+	pageData
+	Issuers []loginIssuer
+}
+
+type loginIssuer struct {
+	URL  string
+	Name string
+}
+
 func (c *Controller) HandleLogin(w http.ResponseWriter, r *http.Request) {
 	nextURL := r.Header.Get("Referer")
 
 	c.log.Debug("Logging in user")
 
-	redirected, _, status, err := c.authorize(w, r, true)
-	if err != nil {
-		c.log.Warn("Could not authorize user for login", "err", err)
+	if issuer := r.URL.Query().Get("issuer"); issuer != "" {
+		log := telemetry.Logger(r.Context(), c.log).With("issuer", issuer)
 
-		http.Error(w, err.Error(), status)
+		log.Debug("Logging in user with issuer")
 
-		return
-	} else if redirected {
-		return
-	}
+		if !slices.Contains(c.authner.Issuers(), issuer) {
+			log.Debug("Could not log in user with untrusted issuer")
 
-	http.Redirect(w, r, nextURL, http.StatusFound)
-}
-
-func (c *Controller) HandleAuthorize(w http.ResponseWriter, r *http.Request) {
-	authCode := r.URL.Query().Get("code")
-	state := r.URL.Query().Get("state")
+			http.Error(w, authn.ErrUntrustedIssuer.Error(), http.StatusUnprocessableEntity)
 
-	log := c.log.With(
-		"authCode", authCode != "",
-		"state", state,
-	)
+			return
+		}
 
-	log.Debug("Handling user auth exchange")
+		store := authn.NewCookieTokenStore(w, r)
 
-	var (
-		stateNonce,
-		pkceCodeVerifier,
-		oidcNonce string
-	)
-	sn, err := r.Cookie(stateNonceKey)
-	if err != nil {
-		if !errors.Is(err, http.ErrNoCookie) {
-			log.Warn("Failed to read state nonce cookie", "err", errors.Join(errCouldNotExchange, err))
+		// The issuer is only remembered until the user has signed in, after which the exchange remembers it
+		if err := store.SetToken(authn.TokenKeyIssuer, issuer, time.Now().Add(time.Hour)); err != nil {
+			log.Warn("Could not set issuer", "err", err)
 
-			http.Error(w, errCouldNotExchange.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
 
 			return
 		}
-	} else {
-		stateNonce = sn.Value
-	}
 
-	pcv, err := r.Cookie(pkceCodeVerifierKey)
-	if err != nil {
-		if !errors.Is(err, http.ErrNoCookie) {
-			log.Warn("Failed to read PKCE code verifier cookie", "err", errors.Join(errCouldNotExchange, err))
+		session, err := c.authner.Authorize(
+			r.Context(),
 
-			http.Error(w, errCouldNotExchange.Error(), http.StatusInternalServerError)
+			store,
+
+			true,
+
+			nextURL,
+			r.URL.String(),
+		)
+		if err != nil {
+			log.Warn("Could not authorize user for login", "err", err)
+
+			http.Error(w, err.Error(), http.StatusInternalServerError)
 
 			return
 		}
-	} else {
-		pkceCodeVerifier = pcv.Value
+
+		if session.NextURL != "" {
+			nextURL = session.NextURL
+		}
+
+		http.Redirect(w, r, nextURL, http.StatusFound)
+
+		return
 	}
 
-	on, err := r.Cookie(oidcNonceKey)
+	redirected, userData, status, err := c.authorize(w, r, true)
 	if err != nil {
-		if !errors.Is(err, http.ErrNoCookie) {
-			log.Warn("Failed to read OIDC nonce cookie", "err", errors.Join(errCouldNotExchange, err))
+		c.log.Warn("Could not authorize user for login", "err", err)
 
-			http.Error(w, errCouldNotExchange.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), status)
 
-			return
-		}
-	} else {
-		oidcNonce = on.Value
+		return
+	} else if redirected {
+		return
 	}
 
-	nextURL, _, err := c.authner.Exchange(
-		r.Context(),
+	// If there are multiple issuers and the user isn't signed in yet, they need to choose an issuer first
+	if userData.AccountNamespace == "" {
+		issuers := []loginIssuer{}
+		for _, issuer := range c.authner.Issuers() {
+			name := issuer
+			if u, err := url.Parse(issuer); err == nil && u.Host != "" {
+				name = u.Host
+			}
+
+			issuers = append(issuers, loginIssuer{
+				URL:  issuer,
+				Name: name,
+			})
+		}
 
-		authCode,
-		state,
+		if err := c.tpl.ExecuteTemplate(w, "login.html", loginData{
+			pageData: pageData{
+				userData: userData,
 
-		stateNonce,
-		pkceCodeVerifier,
-		oidcNonce,
-
-		func(s string, t time.Time) error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     refreshTokenKey,
-				Value:    s,
-				Expires:  t,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+				Page:       userData.Locale.Get("Login"),
+				PrivacyURL: c.privacyURL,
+				TosURL:     c.tosURL,
+				ImprintURL: c.imprintURL,
+			},
+			Issuers: issuers,
+		}); err != nil {
+			c.log.Warn("Could not render login template", "err", errors.Join(errCouldNotRenderTemplate, err))
 
-			return nil
-		},
-		func(s string, t time.Time) error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     idTokenKey,
-				Value:    s,
-				Expires:  t,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+			http.Error(w, errCouldNotRenderTemplate.Error(), http.StatusInternalServerError)
 
-			return nil
-		},
-
-		func() error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     refreshTokenKey,
-				Value:    "",
-				MaxAge:   -1,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+			return
+		}
 
-			return nil
-		},
-		func() error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     idTokenKey,
-				Value:    "",
-				MaxAge:   -1,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+		return
+	}
 
-			return nil
-		},
-
-		func() error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     stateNonceKey,
-				Value:    "",
-				MaxAge:   -1,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+	http.Redirect(w, r, nextURL, http.StatusFound)
+}
 
-			return nil
-		},
-		func() error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     pkceCodeVerifierKey,
-				Value:    "",
-				MaxAge:   -1,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+func (c *Controller) HandleAuthorize(w http.ResponseWriter, r *http.Request) {
+	authCode := r.URL.Query().Get("code")
+	state := r.URL.Query().Get("state")
 
-			return nil
-		},
-		func() error {
-			http.SetCookie(w, &http.Cookie{
-				Name:     oidcNonceKey,
-				Value:    "",
-				MaxAge:   -1,
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-			})
+	log := telemetry.Logger(r.Context(), c.log).With(
+		"authCode", authCode != "",
+		"state", state,
+	)
 
-			return nil
-		},
+	log.Debug("Handling user auth exchange")
+
+	session, err := c.authner.Exchange(
+		r.Context(),
+
+		authn.NewCookieTokenStore(w, r),
+
+		authCode,
+		state,
 	)
 	if err != nil {
 		log.Warn("Could not exchange the OIDC auth code and state for refresh and ID token", "err", errors.Join(errCouldNotExchange, err))
@@ -376,5 +377,5 @@ func (c *Controller) HandleAuthorize(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.Redirect(w, r, nextURL, http.StatusFound)
+	http.Redirect(w, r, session.NextURL, http.StatusFound)
 }
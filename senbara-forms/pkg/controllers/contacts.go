@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 type contactsData struct {
@@ -36,11 +37,11 @@ func (c *Controller) HandleContacts(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling contacts page")
 
-	contacts, err := c.persister.GetContacts(r.Context(), userData.Email)
+	contacts, err := c.persister.GetContacts(r.Context(), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get contacts from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -80,7 +81,7 @@ func (c *Controller) HandleAddContact(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling add contact page")
 
@@ -112,7 +113,7 @@ func (c *Controller) HandleCreateContact(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling create contact")
 
@@ -177,7 +178,7 @@ func (c *Controller) HandleCreateContact(w http.ResponseWriter, r *http.Request)
 		nickname,
 		email,
 		pronouns,
-		userData.Email,
+		userData.Namespace,
 	)
 	if err != nil {
 		log.Warn("Could not create contact in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
@@ -202,7 +203,7 @@ func (c *Controller) HandleDeleteContact(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling delete contact")
 
@@ -234,7 +235,7 @@ func (c *Controller) HandleDeleteContact(w http.ResponseWriter, r *http.Request)
 
 	log.Debug("Deleting contact from DB", "id", id)
 
-	if _, err := c.persister.DeleteContact(r.Context(), int32(id), userData.Email); err != nil {
+	if _, err := c.persister.DeleteContact(r.Context(), int32(id), userData.Namespace); err != nil {
 		log.Warn("Could not delete contact from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
 
 		http.Error(w, errCouldNotDeleteFromDB.Error(), http.StatusInternalServerError)
@@ -257,7 +258,7 @@ func (c *Controller) HandleViewContact(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling view contact page")
 
@@ -283,7 +284,7 @@ func (c *Controller) HandleViewContact(w http.ResponseWriter, r *http.Request) {
 		"id", id,
 	)
 
-	contact, err := c.persister.GetContact(r.Context(), int32(id), userData.Email)
+	contact, err := c.persister.GetContact(r.Context(), int32(id), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get contact from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -296,7 +297,7 @@ func (c *Controller) HandleViewContact(w http.ResponseWriter, r *http.Request) {
 		"id", id,
 	)
 
-	debts, err := c.persister.GetDebts(r.Context(), int32(id), userData.Email)
+	debts, err := c.persister.GetDebts(r.Context(), int32(id), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get debts from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -309,7 +310,7 @@ func (c *Controller) HandleViewContact(w http.ResponseWriter, r *http.Request) {
 		"id", id,
 	)
 
-	activities, err := c.persister.GetActivities(r.Context(), int32(id), userData.Email)
+	activities, err := c.persister.GetActivities(r.Context(), int32(id), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get activities from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -353,7 +354,7 @@ func (c *Controller) HandleUpdateContact(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling update contact")
 
@@ -461,7 +462,7 @@ func (c *Controller) HandleUpdateContact(w http.ResponseWriter, r *http.Request)
 		nickname,
 		email,
 		pronouns,
-		userData.Email,
+		userData.Namespace,
 		birthday,
 		address,
 		notes,
@@ -489,7 +490,7 @@ func (c *Controller) HandleEditContact(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling edit contact page")
 
@@ -513,7 +514,7 @@ func (c *Controller) HandleEditContact(w http.ResponseWriter, r *http.Request) {
 
 	log.Debug("Getting contact for editing from DB", "id", id)
 
-	contact, err := c.persister.GetContact(r.Context(), int32(id), userData.Email)
+	contact, err := c.persister.GetContact(r.Context(), int32(id), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get contact from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
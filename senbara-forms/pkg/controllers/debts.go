@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 type debtData struct {
@@ -16,6 +18,147 @@ type debtData struct {
 	Entry models.GetDebtAndContactRow
 }
 
+type debtsData struct {
+	pageData
+	Entries  []models.GetDebtsForNamespaceRow
+	Contacts []models.Contact
+
+	ContactID int32
+	Currency  string
+	YouOwe    string
+	StartDate string
+	EndDate   string
+	Query     string
+}
+
+func (c *Controller) HandleDebts(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for debts page", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
+
+	log.Debug("Handling debts page")
+
+	// All filters are optional, so empty values match all debts
+	var contactID int
+	if rcontactID := r.FormValue("contact_id"); strings.TrimSpace(rcontactID) != "" {
+		contactID, err = strconv.Atoi(rcontactID)
+		if err != nil {
+			log.Warn("Could not prepare debts page", "err", errInvalidQueryParam)
+
+			http.Error(w, errInvalidQueryParam.Error(), http.StatusUnprocessableEntity)
+
+			return
+		}
+	}
+
+	ryouOwe := r.FormValue("you_owe")
+
+	var youOwe *bool
+	if strings.TrimSpace(ryouOwe) != "" {
+		v, err := strconv.Atoi(ryouOwe)
+		if err != nil {
+			log.Warn("Could not prepare debts page", "err", errInvalidQueryParam)
+
+			http.Error(w, errInvalidQueryParam.Error(), http.StatusUnprocessableEntity)
+
+			return
+		}
+
+		y := v == 1
+		youOwe = &y
+	}
+
+	rstartDate := r.FormValue("start_date")
+
+	var startDate *time.Time
+	if strings.TrimSpace(rstartDate) != "" {
+		v, err := time.Parse("2006-01-02", rstartDate)
+		if err != nil {
+			log.Warn("Could not prepare debts page", "err", errInvalidQueryParam)
+
+			http.Error(w, errInvalidQueryParam.Error(), http.StatusUnprocessableEntity)
+
+			return
+		}
+
+		startDate = &v
+	}
+
+	rendDate := r.FormValue("end_date")
+
+	var endDate *time.Time
+	if strings.TrimSpace(rendDate) != "" {
+		v, err := time.Parse("2006-01-02", rendDate)
+		if err != nil {
+			log.Warn("Could not prepare debts page", "err", errInvalidQueryParam)
+
+			http.Error(w, errInvalidQueryParam.Error(), http.StatusUnprocessableEntity)
+
+			return
+		}
+
+		endDate = &v
+	}
+
+	currency := strings.TrimSpace(r.FormValue("currency"))
+	query := strings.TrimSpace(r.FormValue("query"))
+
+	log.Debug("Getting debts from DB", "contactID", contactID, "currency", currency, "youOwe", youOwe, "startDate", startDate, "endDate", endDate, "query", query)
+
+	debts, err := c.persister.GetDebtsForNamespace(r.Context(), userData.Namespace, int32(contactID), currency, youOwe, startDate, endDate, query)
+	if err != nil {
+		log.Warn("Could not get debts from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		http.Error(w, errCouldNotFetchFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	contacts, err := c.persister.GetContacts(r.Context(), userData.Namespace)
+	if err != nil {
+		log.Warn("Could not get contacts from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		http.Error(w, errCouldNotFetchFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	if err := c.tpl.ExecuteTemplate(w, "debts.html", debtsData{
+		pageData: pageData{
+			userData: userData,
+
+			Page:       userData.Locale.Get("All debts"),
+			PrivacyURL: c.privacyURL,
+			TosURL:     c.tosURL,
+			ImprintURL: c.imprintURL,
+		},
+		Entries:  debts,
+		Contacts: contacts,
+
+		ContactID: int32(contactID),
+		Currency:  currency,
+		YouOwe:    ryouOwe,
+		StartDate: rstartDate,
+		EndDate:   rendDate,
+		Query:     query,
+	}); err != nil {
+		log.Warn("Could not render debts template", "err", errors.Join(errCouldNotRenderTemplate, err))
+
+		http.Error(w, errCouldNotRenderTemplate.Error(), http.StatusInternalServerError)
+
+		return
+	}
+}
+
 func (c *Controller) HandleAddDebt(w http.ResponseWriter, r *http.Request) {
 	redirected, userData, status, err := c.authorize(w, r, true)
 	if err != nil {
@@ -28,7 +171,7 @@ func (c *Controller) HandleAddDebt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling add debt page")
 
@@ -52,7 +195,7 @@ func (c *Controller) HandleAddDebt(w http.ResponseWriter, r *http.Request) {
 
 	log.Debug("Getting contact for debt addition from DB", "id", id)
 
-	contact, err := c.persister.GetContact(r.Context(), int32(id), userData.Email)
+	contact, err := c.persister.GetContact(r.Context(), int32(id), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get contact from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -92,7 +235,7 @@ func (c *Controller) HandleCreateDebt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling create debt")
 
@@ -190,7 +333,7 @@ func (c *Controller) HandleCreateDebt(w http.ResponseWriter, r *http.Request) {
 		description,
 
 		int32(contactID),
-		userData.Email,
+		userData.Namespace,
 	); err != nil {
 		log.Warn("Could not create debt in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
@@ -214,7 +357,7 @@ func (c *Controller) HandleSettleDebt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling settle debt")
 
@@ -272,7 +415,7 @@ func (c *Controller) HandleSettleDebt(w http.ResponseWriter, r *http.Request) {
 
 		int32(id),
 
-		userData.Email,
+		userData.Namespace,
 	); err != nil {
 		log.Warn("Could not settle debt in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
 
@@ -296,7 +439,7 @@ func (c *Controller) HandleUpdateDebt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling update debt")
 
@@ -410,7 +553,7 @@ func (c *Controller) HandleUpdateDebt(w http.ResponseWriter, r *http.Request) {
 
 		int32(id),
 
-		userData.Email,
+		userData.Namespace,
 
 		amount,
 		currency,
@@ -438,7 +581,7 @@ func (c *Controller) HandleEditDebt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling edit debt page")
 
@@ -462,7 +605,7 @@ func (c *Controller) HandleEditDebt(w http.ResponseWriter, r *http.Request) {
 
 	log.Debug("Getting debt and contact for editing from DB", "id", id)
 
-	debtAndContact, err := c.persister.GetDebtAndContact(r.Context(), int32(id), userData.Email)
+	debtAndContact, err := c.persister.GetDebtAndContact(r.Context(), int32(id), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get debt and contact from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 type indexData struct {
@@ -28,7 +29,7 @@ func (c *Controller) HandleIndex(w http.ResponseWriter, r *http.Request) {
 		}
 
 		var contactsAndJournalEntriesCount models.ContactsAndJournalEntriesCount
-		if strings.TrimSpace(userData.Email) == "" {
+		if strings.TrimSpace(userData.Namespace) == "" {
 			c.log.Debug("Counting all contacts and journal entries for index summary")
 
 			var err error
@@ -41,12 +42,12 @@ func (c *Controller) HandleIndex(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 		} else {
-			log := c.log.With("namespace", userData.Email)
+			log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 			log.Debug("Counting contacts and journal entries for index summary")
 
 			var err error
-			contactsAndJournalEntriesCount, err = c.persister.CountContactsAndJournalEntries(r.Context(), userData.Email)
+			contactsAndJournalEntriesCount, err = c.persister.CountContactsAndJournalEntries(r.Context(), userData.Namespace)
 			if err != nil {
 				log.Warn("Could not count contacts and journal entries for index summary", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -91,7 +92,7 @@ func (c *Controller) HandleIndex(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	w.WriteHeader(http.StatusNotFound)
 
@@ -24,6 +24,7 @@ var (
 	errCouldNotDeleteFromDB     = errors.New("could not delete from DB")
 	errCouldNotUpdateInDB       = errors.New("could not update in DB")
 	errInvalidQueryParam        = errors.New("could not use invalid query parameter")
+	errJournalEntryEncrypted    = errors.New("could not edit end-to-end encrypted journal entry")
 	errCouldNotLogin            = errors.New("could not login")
 	errCouldNotLocalize         = errors.New("could not localize")
 	errCouldNotWriteResponse    = errors.New("could not write response")
@@ -35,12 +36,7 @@ var (
 )
 
 const (
-	idTokenKey      = "id_token"
-	refreshTokenKey = "refresh_token"
-
-	stateNonceKey       = "state_nonce"
-	pkceCodeVerifierKey = "pkce_code_verifier"
-	oidcNonceKey        = "oidc_nonce"
+	spaceKey = "space"
 )
 
 type Controller struct {
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 type journalData struct {
@@ -32,11 +33,11 @@ func (c *Controller) HandleJournal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling journal page")
 
-	journalEntries, err := c.persister.GetJournalEntries(r.Context(), userData.Email)
+	journalEntries, err := c.persister.GetJournalEntries(r.Context(), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get journal entries from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -76,7 +77,7 @@ func (c *Controller) HandleAddJournal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling add journal page")
 
@@ -108,7 +109,7 @@ func (c *Controller) HandleCreateJournal(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling create journal")
 
@@ -161,7 +162,7 @@ func (c *Controller) HandleCreateJournal(w http.ResponseWriter, r *http.Request)
 		"rating", rating,
 	)
 
-	createdJournalEntry, err := c.persister.CreateJournalEntry(r.Context(), title, body, int32(rating), userData.Email)
+	createdJournalEntry, err := c.persister.CreateJournalEntry(r.Context(), title, body, int32(rating), userData.Namespace, "", "", "")
 	if err != nil {
 		log.Warn("Could not create journal entry in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
@@ -185,7 +186,7 @@ func (c *Controller) HandleDeleteJournal(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling delete journal")
 
@@ -219,7 +220,7 @@ func (c *Controller) HandleDeleteJournal(w http.ResponseWriter, r *http.Request)
 		"id", id,
 	)
 
-	if _, err := c.persister.DeleteJournalEntry(r.Context(), int32(id), userData.Email); err != nil {
+	if _, err := c.persister.DeleteJournalEntry(r.Context(), int32(id), userData.Namespace); err != nil {
 		log.Warn("Could not delete journal entry from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
 
 		http.Error(w, errCouldNotDeleteFromDB.Error(), http.StatusInternalServerError)
@@ -242,7 +243,7 @@ func (c *Controller) HandleEditJournal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling edit journal page")
 
@@ -268,7 +269,7 @@ func (c *Controller) HandleEditJournal(w http.ResponseWriter, r *http.Request) {
 		"id", id,
 	)
 
-	journalEntry, err := c.persister.GetJournalEntry(r.Context(), int32(id), userData.Email)
+	journalEntry, err := c.persister.GetJournalEntry(r.Context(), int32(id), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get journal entry for edit from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -277,6 +278,14 @@ func (c *Controller) HandleEditJournal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if journalEntry.EncryptionAlgorithm != "" {
+		log.Warn("Could not prepare edit journal page", "err", errJournalEntryEncrypted)
+
+		http.Error(w, errJournalEntryEncrypted.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
 	if err := c.tpl.ExecuteTemplate(w, "journal_edit.html", journalEntryData{
 		pageData: pageData{
 			userData: userData,
@@ -308,7 +317,7 @@ func (c *Controller) HandleUpdateJournal(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling update journal")
 
@@ -374,13 +383,36 @@ func (c *Controller) HandleUpdateJournal(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	log.Debug("Getting journal entry for update",
+		"id", id,
+	)
+
+	journalEntry, err := c.persister.GetJournalEntry(r.Context(), int32(id), userData.Namespace)
+	if err != nil {
+		log.Warn("Could not get journal entry for update from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		http.Error(w, errCouldNotFetchFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	// Since the form can only submit plaintext, updating an encrypted entry
+	// would drop its envelope and store the new content unencrypted
+	if journalEntry.EncryptionAlgorithm != "" {
+		log.Warn("Could not update journal entry", "err", errJournalEntryEncrypted)
+
+		http.Error(w, errJournalEntryEncrypted.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
 	log.Debug("Updating journal entry in DB",
 		"id", id,
 		"title", title,
 		"rating", rating,
 	)
 
-	updatedJournalEntry, err := c.persister.UpdateJournalEntry(r.Context(), int32(id), title, body, int32(rating), userData.Email)
+	updatedJournalEntry, err := c.persister.UpdateJournalEntry(r.Context(), int32(id), title, body, int32(rating), userData.Namespace, "", "", "")
 	if err != nil {
 		log.Warn("Could not update journal entry in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
 
@@ -404,7 +436,7 @@ func (c *Controller) HandleViewJournal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling view journal page")
 
@@ -430,7 +462,7 @@ func (c *Controller) HandleViewJournal(w http.ResponseWriter, r *http.Request) {
 		"id", id,
 	)
 
-	journalEntry, err := c.persister.GetJournalEntry(r.Context(), int32(id), userData.Email)
+	journalEntry, err := c.persister.GetJournalEntry(r.Context(), int32(id), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not get journal entry for view from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
@@ -439,11 +471,16 @@ func (c *Controller) HandleViewJournal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	page := journalEntry.Title
+	if journalEntry.EncryptionAlgorithm != "" {
+		page = userData.Locale.Get("Encrypted journal entry")
+	}
+
 	if err := c.tpl.ExecuteTemplate(w, "journal_view.html", journalEntryData{
 		pageData: pageData{
 			userData: userData,
 
-			Page:       journalEntry.Title,
+			Page:       page,
 			PrivacyURL: c.privacyURL,
 			TosURL:     c.tosURL,
 			ImprintURL: c.imprintURL,
@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+type sessionsData struct {
+	pageData
+	Entries []models.GetSessionsRow
+}
+
+func (c *Controller) HandleSessions(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for sessions page", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling sessions page")
+
+	sessions, err := c.persister.GetSessions(r.Context(), userData.AccountNamespace)
+	if err != nil {
+		log.Warn("Could not get sessions from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		http.Error(w, errCouldNotFetchFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	if err := c.tpl.ExecuteTemplate(w, "sessions.html", sessionsData{
+		pageData: pageData{
+			userData: userData,
+
+			Page:       userData.Locale.Get("Sessions"),
+			PrivacyURL: c.privacyURL,
+			TosURL:     c.tosURL,
+			ImprintURL: c.imprintURL,
+		},
+		Entries: sessions,
+	}); err != nil {
+		log.Warn("Could not render sessions template", "err", errors.Join(errCouldNotRenderTemplate, err))
+
+		http.Error(w, errCouldNotRenderTemplate.Error(), http.StatusInternalServerError)
+
+		return
+	}
+}
+
+func (c *Controller) HandleDeleteSession(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for delete session", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling delete session")
+
+	if err := r.ParseForm(); err != nil {
+		log.Warn("Could not delete session", "err", errors.Join(errCouldNotParseForm, err))
+
+		http.Error(w, errCouldNotParseForm.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	id, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		log.Warn("Could not delete session", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	log.Debug("Revoking session in DB", "id", id)
+
+	if _, err := c.persister.RevokeSession(r.Context(), int32(id), userData.AccountNamespace); err != nil {
+		if errors.Is(err, persisters.ErrSessionDoesNotExist) {
+			log.Warn("Could not delete session", "err", err)
+
+			http.Error(w, err.Error(), http.StatusForbidden)
+
+			return
+		}
+
+		log.Warn("Could not revoke session in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		http.Error(w, errCouldNotUpdateInDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	// Revoking the current session signs the user out
+	if int32(id) == userData.SessionID {
+		http.Redirect(w, r, userData.LogoutURL, http.StatusFound)
+
+		return
+	}
+
+	http.Redirect(w, r, "/sessions", http.StatusFound)
+}
+
+func (c *Controller) HandleDeleteSessions(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for delete sessions", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling delete sessions")
+
+	if _, err := c.persister.RevokeSessions(r.Context(), userData.AccountNamespace); err != nil {
+		log.Warn("Could not revoke sessions in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		http.Error(w, errCouldNotUpdateInDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	http.Redirect(w, r, userData.LogoutURL, http.StatusFound)
+}
+
+// HandleBackchannelLogout revokes the sessions of a user who signed out with the OIDC provider,
+// see https://openid.net/specs/openid-connect-backchannel-1_0.html#BCRequest
+func (c *Controller) HandleBackchannelLogout(w http.ResponseWriter, r *http.Request) {
+	c.log.Debug("Handling back-channel logout")
+
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err := r.ParseForm(); err != nil {
+		c.log.Warn("Could not handle back-channel logout", "err", errors.Join(errCouldNotParseForm, err))
+
+		http.Error(w, errCouldNotParseForm.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	logoutToken, err := c.authner.VerifyLogoutToken(r.Context(), r.FormValue("logout_token"))
+	if err != nil {
+		c.log.Warn("Could not verify logout token", "err", err)
+
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	ids, err := c.persister.RevokeOIDCSessions(r.Context(), logoutToken.Issuer, logoutToken.Subject, logoutToken.SessionID)
+	if err != nil {
+		c.log.Warn("Could not revoke sessions in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		http.Error(w, errCouldNotUpdateInDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	c.log.Debug("Revoked sessions", "ids", ids)
+}
@@ -0,0 +1,537 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"strings"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+type spacesData struct {
+	pageData
+	Entries     []models.GetSpacesRow
+	Members     []models.GetSpaceMembersRow
+	Invitations []models.GetSpaceInvitationsRow
+}
+
+func isSpaceAccessError(err error) bool {
+	return errors.Is(err, persisters.ErrSpaceDoesNotExist) ||
+		errors.Is(err, persisters.ErrInsufficientSpaceRole) ||
+		errors.Is(err, persisters.ErrCannotDeletePersonal) ||
+		errors.Is(err, persisters.ErrInvitationDoesNotExist)
+}
+
+func (c *Controller) HandleSpaces(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for spaces page", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling spaces page")
+
+	spaces, err := c.persister.GetSpaces(r.Context(), userData.AccountNamespace)
+	if err != nil {
+		log.Warn("Could not get spaces from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		http.Error(w, errCouldNotFetchFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	members := []models.GetSpaceMembersRow{}
+	if userData.SpaceID != 0 {
+		members, err = c.persister.GetSpaceMembers(r.Context(), userData.SpaceID, userData.AccountNamespace)
+		if err != nil {
+			log.Warn("Could not get space members from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+			http.Error(w, errCouldNotFetchFromDB.Error(), http.StatusInternalServerError)
+
+			return
+		}
+	}
+
+	invitations, err := c.persister.GetSpaceInvitations(r.Context(), userData.AccountNamespace)
+	if err != nil {
+		log.Warn("Could not get space invitations from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		http.Error(w, errCouldNotFetchFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	if err := c.tpl.ExecuteTemplate(w, "spaces.html", spacesData{
+		pageData: pageData{
+			userData: userData,
+
+			Page:       userData.Locale.Get("Spaces"),
+			PrivacyURL: c.privacyURL,
+			TosURL:     c.tosURL,
+			ImprintURL: c.imprintURL,
+		},
+		Entries:     spaces,
+		Members:     members,
+		Invitations: invitations,
+	}); err != nil {
+		log.Warn("Could not render spaces template", "err", errors.Join(errCouldNotRenderTemplate, err))
+
+		http.Error(w, errCouldNotRenderTemplate.Error(), http.StatusInternalServerError)
+
+		return
+	}
+}
+
+func (c *Controller) HandleCreateSpace(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for create space", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling create space")
+
+	if err := r.ParseForm(); err != nil {
+		log.Warn("Could not create space", "err", errors.Join(errCouldNotParseForm, err))
+
+		http.Error(w, errCouldNotParseForm.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	name := r.FormValue("name")
+	if strings.TrimSpace(name) == "" {
+		log.Warn("Could not create space", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	log.Debug("Creating space in DB", "name", name)
+
+	createdSpace, err := c.persister.CreateSpace(r.Context(), name, userData.AccountNamespace)
+	if err != nil {
+		log.Warn("Could not create space in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
+
+		http.Error(w, errCouldNotInsertIntoDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	c.setSpace(w, createdSpace.ID)
+
+	http.Redirect(w, r, "/spaces", http.StatusFound)
+}
+
+func (c *Controller) HandleSwitchSpace(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for switch space", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling switch space")
+
+	if err := r.ParseForm(); err != nil {
+		log.Warn("Could not switch space", "err", errors.Join(errCouldNotParseForm, err))
+
+		http.Error(w, errCouldNotParseForm.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	id, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		log.Warn("Could not switch space", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	log.Debug("Getting space from DB", "id", id)
+
+	space, err := c.persister.GetSpace(r.Context(), int32(id), userData.AccountNamespace)
+	if err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not switch space", "err", err)
+
+			http.Error(w, err.Error(), http.StatusForbidden)
+
+			return
+		}
+
+		log.Warn("Could not get space from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		http.Error(w, errCouldNotFetchFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	if space.Personal {
+		c.setSpace(w, 0)
+	} else {
+		c.setSpace(w, space.ID)
+	}
+
+	http.Redirect(w, r, "/spaces", http.StatusFound)
+}
+
+func (c *Controller) HandleDeleteSpace(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for delete space", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling delete space")
+
+	if err := r.ParseForm(); err != nil {
+		log.Warn("Could not delete space", "err", errors.Join(errCouldNotParseForm, err))
+
+		http.Error(w, errCouldNotParseForm.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	id, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		log.Warn("Could not delete space", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	log.Debug("Deleting space from DB", "id", id)
+
+	if _, err := c.persister.DeleteSpace(r.Context(), int32(id), userData.AccountNamespace); err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not delete space", "err", err)
+
+			http.Error(w, err.Error(), http.StatusForbidden)
+
+			return
+		}
+
+		log.Warn("Could not delete space from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
+
+		http.Error(w, errCouldNotDeleteFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	if userData.SpaceID == int32(id) {
+		c.setSpace(w, 0)
+	}
+
+	http.Redirect(w, r, "/spaces", http.StatusFound)
+}
+
+func (c *Controller) HandleDeleteSpaceMember(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for delete space member", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling delete space member")
+
+	if err := r.ParseForm(); err != nil {
+		log.Warn("Could not delete space member", "err", errors.Join(errCouldNotParseForm, err))
+
+		http.Error(w, errCouldNotParseForm.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	id, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		log.Warn("Could not delete space member", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	memberID, err := strconv.Atoi(r.FormValue("member_id"))
+	if err != nil {
+		log.Warn("Could not delete space member", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	log.Debug("Deleting space member from DB", "id", id, "memberID", memberID)
+
+	if _, err := c.persister.DeleteSpaceMember(r.Context(), int32(id), int32(memberID), userData.AccountNamespace); err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not delete space member", "err", err)
+
+			http.Error(w, err.Error(), http.StatusForbidden)
+
+			return
+		}
+
+		log.Warn("Could not delete space member from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
+
+		http.Error(w, errCouldNotDeleteFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	http.Redirect(w, r, "/spaces", http.StatusFound)
+}
+
+func (c *Controller) HandleCreateSpaceInvitation(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for create space invitation", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling create space invitation")
+
+	if err := r.ParseForm(); err != nil {
+		log.Warn("Could not create space invitation", "err", errors.Join(errCouldNotParseForm, err))
+
+		http.Error(w, errCouldNotParseForm.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	id, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		log.Warn("Could not create space invitation", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	email := r.FormValue("email")
+	if _, err := mail.ParseAddress(email); err != nil {
+		log.Warn("Could not create space invitation", "err", errors.Join(errInvalidForm, err))
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	role := r.FormValue("role")
+	if role != models.SpaceRoleEditor && role != models.SpaceRoleViewer {
+		log.Warn("Could not create space invitation", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	log.Debug("Creating space invitation in DB",
+		"id", id,
+		"email", email,
+		"role", role,
+	)
+
+	if _, err := c.persister.CreateSpaceInvitation(r.Context(), int32(id), email, role, userData.AccountNamespace); err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not create space invitation", "err", err)
+
+			http.Error(w, err.Error(), http.StatusForbidden)
+
+			return
+		}
+
+		log.Warn("Could not create space invitation in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
+
+		http.Error(w, errCouldNotInsertIntoDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	http.Redirect(w, r, "/spaces", http.StatusFound)
+}
+
+func (c *Controller) HandleAcceptSpaceInvitation(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for accept space invitation", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling accept space invitation")
+
+	if err := r.ParseForm(); err != nil {
+		log.Warn("Could not accept space invitation", "err", errors.Join(errCouldNotParseForm, err))
+
+		http.Error(w, errCouldNotParseForm.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	id, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		log.Warn("Could not accept space invitation", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	log.Debug("Accepting space invitation in DB", "id", id)
+
+	space, err := c.persister.AcceptSpaceInvitation(r.Context(), int32(id), userData.AccountNamespace)
+	if err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not accept space invitation", "err", err)
+
+			http.Error(w, err.Error(), http.StatusForbidden)
+
+			return
+		}
+
+		log.Warn("Could not accept space invitation in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		http.Error(w, errCouldNotUpdateInDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	c.setSpace(w, space.ID)
+
+	http.Redirect(w, r, "/spaces", http.StatusFound)
+}
+
+func (c *Controller) HandleDeclineSpaceInvitation(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for decline space invitation", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling decline space invitation")
+
+	if err := r.ParseForm(); err != nil {
+		log.Warn("Could not decline space invitation", "err", errors.Join(errCouldNotParseForm, err))
+
+		http.Error(w, errCouldNotParseForm.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	id, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		log.Warn("Could not decline space invitation", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	log.Debug("Declining space invitation in DB", "id", id)
+
+	if _, err := c.persister.DeclineSpaceInvitation(r.Context(), int32(id), userData.AccountNamespace); err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not decline space invitation", "err", err)
+
+			http.Error(w, err.Error(), http.StatusForbidden)
+
+			return
+		}
+
+		log.Warn("Could not decline space invitation in DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
+
+		http.Error(w, errCouldNotDeleteFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	http.Redirect(w, r, "/spaces", http.StatusFound)
+}
+
+func (c *Controller) setSpace(w http.ResponseWriter, id int32) {
+	if id == 0 {
+		http.SetCookie(w, &http.Cookie{
+			Name:     spaceKey,
+			Value:    "",
+			MaxAge:   -1,
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteLaxMode,
+			Path:     "/",
+		})
+
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     spaceKey,
+		Value:    strconv.Itoa(int(id)),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+		Path:     "/",
+	})
+}
@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+type accessTokensData struct {
+	pageData
+	Entries []models.GetAccessTokensRow
+	Token   string
+}
+
+func (c *Controller) renderAccessTokens(w http.ResponseWriter, r *http.Request, userData userData, token string) {
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	accessTokens, err := c.persister.GetAccessTokens(r.Context(), userData.AccountNamespace)
+	if err != nil {
+		log.Warn("Could not get access tokens from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		http.Error(w, errCouldNotFetchFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	if err := c.tpl.ExecuteTemplate(w, "tokens.html", accessTokensData{
+		pageData: pageData{
+			userData: userData,
+
+			Page:       userData.Locale.Get("Access tokens"),
+			PrivacyURL: c.privacyURL,
+			TosURL:     c.tosURL,
+			ImprintURL: c.imprintURL,
+		},
+		Entries: accessTokens,
+		Token:   token,
+	}); err != nil {
+		log.Warn("Could not render access tokens template", "err", errors.Join(errCouldNotRenderTemplate, err))
+
+		http.Error(w, errCouldNotRenderTemplate.Error(), http.StatusInternalServerError)
+
+		return
+	}
+}
+
+func (c *Controller) HandleAccessTokens(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for access tokens page", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	c.log.With("namespace", userData.AccountNamespace).Debug("Handling access tokens page")
+
+	c.renderAccessTokens(w, r, userData, "")
+}
+
+func (c *Controller) HandleCreateAccessToken(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for create access token", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling create access token")
+
+	if err := r.ParseForm(); err != nil {
+		log.Warn("Could not create access token", "err", errors.Join(errCouldNotParseForm, err))
+
+		http.Error(w, errCouldNotParseForm.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	name := r.FormValue("name")
+	if strings.TrimSpace(name) == "" {
+		log.Warn("Could not create access token", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	scope := r.FormValue("scope")
+	if scope != models.AccessTokenScopeRead && scope != models.AccessTokenScopeWrite {
+		log.Warn("Could not create access token", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	log.Debug("Creating access token in DB",
+		"name", name,
+		"scope", scope,
+	)
+
+	_, token, err := c.persister.CreateAccessToken(r.Context(), name, scope, userData.AccountNamespace)
+	if err != nil {
+		log.Warn("Could not create access token in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
+
+		http.Error(w, errCouldNotInsertIntoDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	// The token is only shown once, so we render it directly instead of redirecting
+	c.renderAccessTokens(w, r, userData, token)
+}
+
+func (c *Controller) HandleDeleteAccessToken(w http.ResponseWriter, r *http.Request) {
+	redirected, userData, status, err := c.authorize(w, r, true)
+	if err != nil {
+		c.log.Warn("Could not authorize user for delete access token", "err", err)
+
+		http.Error(w, err.Error(), status)
+
+		return
+	} else if redirected {
+		return
+	}
+
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.AccountNamespace)
+
+	log.Debug("Handling delete access token")
+
+	if err := r.ParseForm(); err != nil {
+		log.Warn("Could not delete access token", "err", errors.Join(errCouldNotParseForm, err))
+
+		http.Error(w, errCouldNotParseForm.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	id, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		log.Warn("Could not delete access token", "err", errInvalidForm)
+
+		http.Error(w, errInvalidForm.Error(), http.StatusUnprocessableEntity)
+
+		return
+	}
+
+	log.Debug("Deleting access token from DB", "id", id)
+
+	if _, err := c.persister.DeleteAccessToken(r.Context(), int32(id), userData.AccountNamespace); err != nil {
+		if errors.Is(err, persisters.ErrAccessTokenDoesNotExist) {
+			log.Warn("Could not delete access token", "err", err)
+
+			http.Error(w, err.Error(), http.StatusForbidden)
+
+			return
+		}
+
+		log.Warn("Could not delete access token from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
+
+		http.Error(w, errCouldNotDeleteFromDB.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	http.Redirect(w, r, "/tokens", http.StatusFound)
+}
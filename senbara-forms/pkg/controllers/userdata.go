@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 )
 
 const (
@@ -28,7 +29,7 @@ func (c *Controller) HandleUserData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling export user data")
 
@@ -42,7 +43,7 @@ func (c *Controller) HandleUserData(w http.ResponseWriter, r *http.Request) {
 	if err := c.persister.GetUserData(
 		r.Context(),
 
-		userData.Email,
+		userData.Namespace,
 
 		func(journalEntry models.ExportedJournalEntry) error {
 			log.Debug("Exporting journal entry",
@@ -129,7 +130,7 @@ func (c *Controller) HandleCreateUserData(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling import user data")
 
@@ -155,7 +156,7 @@ func (c *Controller) HandleCreateUserData(w http.ResponseWriter, r *http.Request
 		commit,
 		rollback,
 
-		err := c.persister.CreateUserData(r.Context(), userData.Email)
+		err := c.persister.CreateUserData(r.Context(), userData.Namespace)
 	if err != nil {
 		log.Warn("Could not start transaction for user data import", "err", errors.Join(errCouldNotStartTransaction, err))
 
@@ -326,13 +327,13 @@ func (c *Controller) HandleDeleteUserData(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	log := c.log.With("namespace", userData.Email)
+	log := telemetry.Logger(r.Context(), c.log).With("namespace", userData.Namespace)
 
 	log.Debug("Handling delete user data")
 
 	log.Debug("Deleting user data from DB")
 
-	if err := c.persister.DeleteUserData(r.Context(), userData.Email); err != nil {
+	if err := c.persister.DeleteUserData(r.Context(), userData.Namespace); err != nil {
 		log.Warn("Could not delete user data from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
 
 		http.Error(w, errCouldNotDeleteFromDB.Error(), http.StatusInternalServerError)
@@ -22,16 +22,22 @@ const (
 	SettingRegistrationClientURIKey = "registration-client-uri"
 	SettingOIDCClientIDKey          = "oidc-client-id"
 	SettingAnonymousMode            = "anonymous-mode"
+	SettingJournalEncryptionKey     = "journal-encryption"
+	SettingSpaceKey                 = "space"
 
 	SecretRegistrationAccessToken = "registration-access-token"
 
 	SecretRefreshTokenKey = "refresh-token"
 	SecretIDTokenKey      = "id-token"
+	SecretAccessTokenKey  = "access-token"
 
 	SecretStateNonceKey       = "state-nonce"
 	SecretPKCECodeVerifierKey = "pkce-code_verifier"
 	SecretOIDCNonceKey        = "oidc-nonce"
 
+	SecretJournalPassphraseKey = "journal-passphrase"
+	SecretJournalSaltKey       = "journal-salt"
+
 	PageIndex = "/"
 
 	PageWelcome  = "/welcome"
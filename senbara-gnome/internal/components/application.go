@@ -1,6 +1,7 @@
 package components
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -14,6 +15,7 @@ import (
 	"net/mail"
 	"net/url"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
@@ -57,6 +59,7 @@ type application struct {
 	mto adw.ToastOverlay
 
 	authner  *authn.Authner
+	offline  *offlineCache
 	u        userData
 	rawError string
 }
@@ -166,6 +169,7 @@ func (a *application) authorize(
 
 		a.authner,
 		a.settings,
+		a.offline,
 
 		func() string {
 			return a.nv.GetVisiblePage().GetTag()
@@ -221,6 +225,32 @@ func (a *application) onActivate(app *gio.Application) {
 		return a.authorize(ctx, loginIfSignedOut)
 	}
 
+	offline, err := newOfflineCache(
+		log,
+
+		filepath.Join(glib.GetUserDataDir(), resources.AppID, offlineCacheFileName),
+
+		func() string {
+			return settings.GetString(resources.SettingServerURLKey)
+		},
+		func(offline bool) {
+			idleAdd(func() {
+				if offline {
+					a.mto.AddToast(adw.NewToast(L("You are offline, changes will be synced once you are back online")))
+
+					return
+				}
+
+				a.mto.AddToast(adw.NewToast(L("You are back online")))
+			})
+		},
+	)
+	if err != nil {
+		log.Warn("Could not open offline cache, continuing without offline mode", "err", err)
+	} else {
+		a.offline = offline
+	}
+
 	aboutDialog := adw.NewAboutDialogFromAppdata(resources.ResourceMetainfoPath, resources.AppVersion)
 	aboutDialog.SetDevelopers(resources.AppDevelopers)
 	aboutDialog.SetArtists(resources.AppArtists)
@@ -254,8 +284,11 @@ func (a *application) onActivate(app *gio.Application) {
 	b.GetObject("main_toasts_overlay").Cast(&a.mto)
 
 	var (
-		preferencesDialog              adw.PreferencesDialog
-		preferencesDialogVerboseSwitch gtk.Switch
+		preferencesDialog                        adw.PreferencesDialog
+		preferencesDialogVerboseSwitch           gtk.Switch
+		preferencesDialogJournalEncryptionSwitch gtk.Switch
+		preferencesDialogJournalPassphraseInput  adw.PasswordEntryRow
+		preferencesDialogSpaceComboRow           adw.ComboRow
 
 		welcomeGetStartedButton  gtk.Button
 		welcomeGetStartedSpinner adw.Spinner
@@ -505,6 +538,9 @@ func (a *application) onActivate(app *gio.Application) {
 
 	preferencesDialogBuilder.GetObject("preferences_dialog").Cast(&preferencesDialog)
 	preferencesDialogBuilder.GetObject("preferences_dialog_verbose_switch").Cast(&preferencesDialogVerboseSwitch)
+	preferencesDialogBuilder.GetObject("preferences_dialog_journal_encryption_switch").Cast(&preferencesDialogJournalEncryptionSwitch)
+	preferencesDialogBuilder.GetObject("preferences_dialog_journal_passphrase_input").Cast(&preferencesDialogJournalPassphraseInput)
+	preferencesDialogBuilder.GetObject("preferences_dialog_space_combo_row").Cast(&preferencesDialogSpaceComboRow)
 
 	var (
 		pageIndex                  adw.NavigationPage
@@ -825,6 +861,89 @@ func (a *application) onActivate(app *gio.Application) {
 	pageHomeBuilder.GetObject("journal_entries_edit_page_body_input").Cast(&journalEntriesEditPageBodyInput)
 
 	settings.Bind(resources.SettingVerboseKey, &preferencesDialogVerboseSwitch.Object, "active", gio.GSettingsBindDefaultValue)
+	settings.Bind(resources.SettingJournalEncryptionKey, &preferencesDialogJournalEncryptionSwitch.Object, "active", gio.GSettingsBindDefaultValue)
+
+	connectPasswordEntryRowApply(&preferencesDialogJournalPassphraseInput, func() {
+		log.Info("Handling journal passphrase update")
+
+		passphrase := preferencesDialogJournalPassphraseInput.GetText()
+
+		go func() {
+			if passphrase == "" {
+				if err := keyring.Delete(resources.AppID, resources.SecretJournalPassphraseKey); err != nil && !errors.Is(err, keyring.ErrNotFound) {
+					onPanic(err)
+
+					return
+				}
+			} else if err := keyring.Set(resources.AppID, resources.SecretJournalPassphraseKey, passphrase); err != nil {
+				onPanic(err)
+
+				return
+			}
+
+			idleAdd(func() {
+				preferencesDialogJournalPassphraseInput.SetText("")
+
+				a.mto.AddToast(adw.NewToast(L("Saved journal passphrase")))
+			})
+		}()
+	})
+
+	getSpace := func() *api.SpaceSelector {
+		space := settings.GetInt64(resources.SettingSpaceKey)
+		if space == 0 {
+			return nil
+		}
+
+		return &space
+	}
+
+	var (
+		spaceIDsLock     sync.Mutex
+		spaceIDs         []int64
+		spaceIDsUpdating bool
+	)
+
+	setSpaces := func(names []string, ids []int64) {
+		spaceIDsLock.Lock()
+		spaceIDs = ids
+		spaceIDsUpdating = true
+		spaceIDsLock.Unlock()
+
+		defer func() {
+			spaceIDsLock.Lock()
+			defer spaceIDsLock.Unlock()
+
+			spaceIDsUpdating = false
+		}()
+
+		preferencesDialogSpaceComboRow.SetModel(gtk.NewStringList(names))
+
+		space := settings.GetInt64(resources.SettingSpaceKey)
+		for i, id := range ids {
+			if id == space {
+				preferencesDialogSpaceComboRow.SetSelected(uint32(i))
+
+				break
+			}
+		}
+	}
+
+	connectComboRowSelected(&preferencesDialogSpaceComboRow, func() {
+		spaceIDsLock.Lock()
+		defer spaceIDsLock.Unlock()
+
+		selected := int(preferencesDialogSpaceComboRow.GetSelected())
+		if spaceIDsUpdating || selected < 0 || selected >= len(spaceIDs) {
+			return
+		}
+
+		if space := spaceIDs[selected]; space != settings.GetInt64(resources.SettingSpaceKey) {
+			log.Info("Switching space", "space", space)
+
+			settings.SetInt64(resources.SettingSpaceKey, space)
+		}
+	})
 
 	setValidationSuffixVisible := func(input *adw.EntryRow, suffix *gtk.MenuButton, visible bool) {
 		if visible && suffix.GetParent() == nil {
@@ -871,6 +990,7 @@ func (a *application) onActivate(app *gio.Application) {
 				return err
 			}
 
+			// If the registration has already been revoked upstream, there is nothing left to deregister
 			if err := authn.DeregisterOIDCClient(
 				ctx,
 
@@ -878,7 +998,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				registrationAccessToken,
 				registrationClientURI,
-			); err != nil {
+			); err != nil && !errors.Is(err, authn.ErrOIDCClientRegistrationInvalid) {
 				return err
 			}
 
@@ -951,6 +1071,11 @@ func (a *application) onActivate(app *gio.Application) {
 		case resources.SettingVerboseKey:
 			onSetLogLevel(settings.GetBoolean(resources.SettingVerboseKey))
 
+		case resources.SettingSpaceKey:
+			if a.nv.GetVisiblePage().GetTag() == resources.PageHome {
+				a.nv.ReplaceWithTags([]string{resources.PageHome}, 1)
+			}
+
 		case resources.SettingServerURLKey:
 			configServerURLContinueButton.SetSensitive(false)
 			configServerURLContinueSpinner.SetVisible(true)
@@ -1012,14 +1137,34 @@ func (a *application) onActivate(app *gio.Application) {
 			return err
 		}
 
-		oidcClientID := settings.GetString(resources.SettingOIDCClientIDKey)
-		if oidcClientID == "" && registerClient {
-			c, err := authn.RegisterOIDCClient(
+		var (
+			oidcClientID          = settings.GetString(resources.SettingOIDCClientIDKey)
+			registrationClientURI = settings.GetString(resources.SettingRegistrationClientURIKey)
+		)
+		// If we registered the client ourselves, we check that the registration is still valid and register a new client
+		// if it has been revoked upstream
+		if (oidcClientID == "" && registerClient) || registrationClientURI != "" {
+			var registration *authn.OIDCClientRegistrationResponse
+			if registrationClientURI != "" {
+				registrationAccessToken, err := keyring.Get(resources.AppID, resources.SecretRegistrationAccessToken)
+				if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+					return err
+				}
+
+				registration = &authn.OIDCClientRegistrationResponse{
+					ClientID:                oidcClientID,
+					RegistrationAccessToken: registrationAccessToken,
+					RegistrationClientURI:   registrationClientURI,
+				}
+			}
+
+			c, changed, err := authn.EnsureOIDCClient(
 				ctx,
 
 				slog.New(log.Handler().WithGroup("oidcRegistration")),
 
 				o,
+				registration,
 
 				"Senbara GNOME",
 				redirectURL,
@@ -1030,16 +1175,18 @@ func (a *application) onActivate(app *gio.Application) {
 				return err
 			}
 
-			if ok := settings.SetString(resources.SettingOIDCClientIDKey, c.ClientID); !ok {
-				return errCouldNotWriteSettingsKey
-			}
+			if changed {
+				if ok := settings.SetString(resources.SettingOIDCClientIDKey, c.ClientID); !ok {
+					return errCouldNotWriteSettingsKey
+				}
 
-			if ok := settings.SetString(resources.SettingRegistrationClientURIKey, c.RegistrationClientURI); !ok {
-				return errCouldNotWriteSettingsKey
-			}
+				if ok := settings.SetString(resources.SettingRegistrationClientURIKey, c.RegistrationClientURI); !ok {
+					return errCouldNotWriteSettingsKey
+				}
 
-			if err := keyring.Set(resources.AppID, resources.SecretRegistrationAccessToken, c.RegistrationAccessToken); err != nil {
-				return err
+				if err := keyring.Set(resources.AppID, resources.SecretRegistrationAccessToken, c.RegistrationAccessToken); err != nil {
+					return err
+				}
 			}
 
 			oidcClientID = c.ClientID
@@ -1057,6 +1204,11 @@ func (a *application) onActivate(app *gio.Application) {
 			redirectURL,
 		)
 
+		// If the server validates access tokens, it publishes the audience to request them for
+		if audience, ok := spec.Components.SecuritySchemes["oidc"].Value.Extensions[api.OidcAudienceExtensionKey].(string); ok && audience != "" {
+			a.authner.RequestAccessTokens(audience)
+		}
+
 		if err := a.authner.Init(ctx); err != nil {
 			return err
 		}
@@ -1859,7 +2011,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 			log.Debug("Creating contact", "request", req)
 
-			res, err := c.CreateContactWithResponse(ctx, req)
+			res, err := c.CreateContactWithResponse(ctx, &api.CreateContactParams{Space: getSpace()}, req)
 			if err != nil {
 				onPanic(err)
 
@@ -1928,7 +2080,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 			log.Debug("Creating debt", "request", req)
 
-			res, err := c.CreateDebtWithResponse(ctx, req)
+			res, err := c.CreateDebtWithResponse(ctx, &api.CreateDebtParams{Space: getSpace()}, req)
 			if err != nil {
 				onPanic(err)
 
@@ -2005,7 +2157,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 			log.Debug("Creating activity", "request", req)
 
-			res, err := c.CreateActivityWithResponse(ctx, req)
+			res, err := c.CreateActivityWithResponse(ctx, &api.CreateActivityParams{Space: getSpace()}, req)
 			if err != nil {
 				onPanic(err)
 
@@ -2081,7 +2233,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 			log.Debug("Updating activity", "request", req)
 
-			res, err := c.UpdateActivityWithResponse(ctx, id, req)
+			res, err := c.UpdateActivityWithResponse(ctx, id, &api.UpdateActivityParams{Space: getSpace()}, req)
 			if err != nil {
 				onPanic(err)
 
@@ -2147,7 +2299,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 			log.Debug("Updating debt", "request", req)
 
-			res, err := c.UpdateDebtWithResponse(ctx, id, req)
+			res, err := c.UpdateDebtWithResponse(ctx, id, &api.UpdateDebtParams{Space: getSpace()}, req)
 			if err != nil {
 				onPanic(err)
 
@@ -2242,7 +2394,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 			log.Debug("Creating contact", "request", req)
 
-			res, err := c.UpdateContactWithResponse(ctx, id, req)
+			res, err := c.UpdateContactWithResponse(ctx, id, &api.UpdateContactParams{Space: getSpace()}, req)
 			if err != nil {
 				onPanic(err)
 
@@ -2294,9 +2446,31 @@ func (a *application) onActivate(app *gio.Application) {
 				Title:  journalEntriesCreateDialogTitleInput.GetText(),
 			}
 
+			if settings.GetBoolean(resources.SettingJournalEncryptionKey) {
+				e, err := getJournalEncrypter(log)
+				if err != nil {
+					onPanic(err)
+
+					return
+				} else if e == nil {
+					onPanic(errMissingJournalPassphrase)
+
+					return
+				}
+
+				log.Debug("Encrypting journal entry")
+
+				req.Title, req.Body, req.Encryption, err = sealJournalEntry(e, req.Title, req.Body)
+				if err != nil {
+					onPanic(err)
+
+					return
+				}
+			}
+
 			log.Debug("Creating journal entry", "request", req)
 
-			res, err := c.CreateJournalEntryWithResponse(ctx, req)
+			res, err := c.CreateJournalEntryWithResponse(ctx, &api.CreateJournalEntryParams{Space: getSpace()}, req)
 			if err != nil {
 				onPanic(err)
 
@@ -2356,9 +2530,31 @@ func (a *application) onActivate(app *gio.Application) {
 				Title:  journalEntriesEditPageTitleInput.GetText(),
 			}
 
+			if settings.GetBoolean(resources.SettingJournalEncryptionKey) {
+				e, err := getJournalEncrypter(log)
+				if err != nil {
+					onPanic(err)
+
+					return
+				} else if e == nil {
+					onPanic(errMissingJournalPassphrase)
+
+					return
+				}
+
+				log.Debug("Encrypting journal entry")
+
+				req.Title, req.Body, req.Encryption, err = sealJournalEntry(e, req.Title, req.Body)
+				if err != nil {
+					onPanic(err)
+
+					return
+				}
+			}
+
 			log.Debug("Creating journal entry", "request", req)
 
-			res, err := c.UpdateJournalEntryWithResponse(ctx, id, req)
+			res, err := c.UpdateJournalEntryWithResponse(ctx, id, &api.UpdateJournalEntryParams{Space: getSpace()}, req)
 			if err != nil {
 				onPanic(err)
 
@@ -2384,6 +2580,12 @@ func (a *application) onActivate(app *gio.Application) {
 		a.nv.ReplaceWithTags([]string{resources.PageExchangeLogout}, 1)
 
 		go func() {
+			if a.offline != nil {
+				if err := a.offline.clear(ctx); err != nil {
+					log.Warn("Could not clear offline cache", "err", err)
+				}
+			}
+
 			if _, err := gio.AppInfoLaunchDefaultForUri(a.u.LogoutURL, nil); err != nil {
 				onPanic(err)
 
@@ -2576,7 +2778,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 		log.Debug("Exporting user data")
 
-		res, err := c.ExportUserData(ctx)
+		res, err := c.ExportUserData(ctx, &api.ExportUserDataParams{Space: getSpace()})
 		if err != nil {
 			disableHomeUserMenuLoading()
 
@@ -2660,7 +2862,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 		log.Debug("Getting summary")
 
-		res, err := c.GetSummaryWithResponse(ctx)
+		res, err := c.GetSummaryWithResponse(ctx, &api.GetSummaryParams{Space: getSpace()})
 		if err != nil {
 			onPanic(err)
 
@@ -2669,6 +2871,15 @@ func (a *application) onActivate(app *gio.Application) {
 
 		log.Debug("Got summary", "status", res.StatusCode())
 
+		if res.StatusCode() == http.StatusForbidden && getSpace() != nil {
+			log.Warn("Could not access space, switching to personal space", "status", res.StatusCode())
+
+			// Switching the space reloads the home page
+			settings.SetInt64(resources.SettingSpaceKey, 0)
+
+			return false
+		}
+
 		if res.StatusCode() != http.StatusOK {
 			onPanic(errors.New(res.Status()))
 
@@ -2678,6 +2889,42 @@ func (a *application) onActivate(app *gio.Application) {
 		homeSidebarContactsCountLabel.SetText(fmt.Sprintf("%v", *res.JSON200.ContactsCount))
 		homeSidebarJournalEntriesCountLabel.SetText(fmt.Sprintf("%v", *res.JSON200.JournalEntriesCount))
 
+		log.Debug("Getting spaces")
+
+		spacesRes, err := c.GetSpacesWithResponse(ctx)
+		if err != nil {
+			onPanic(err)
+
+			return false
+		}
+
+		log.Debug("Got spaces", "status", spacesRes.StatusCode())
+
+		if spacesRes.StatusCode() != http.StatusOK {
+			onPanic(errors.New(spacesRes.Status()))
+
+			return false
+		}
+
+		var (
+			spaceNames = []string{}
+			ids        = []int64{}
+		)
+		for _, space := range *spacesRes.JSON200 {
+			// The personal space is selected with the default space ID
+			if *space.Personal {
+				spaceNames = append(spaceNames, L("Personal"))
+				ids = append(ids, 0)
+
+				continue
+			}
+
+			spaceNames = append(spaceNames, *space.Name)
+			ids = append(ids, *space.Id)
+		}
+
+		setSpaces(spaceNames, ids)
+
 		return true
 	}
 
@@ -2786,7 +3033,7 @@ func (a *application) onActivate(app *gio.Application) {
 							}
 						}()
 
-						res, err := c.ImportUserDataWithBodyWithResponse(ctx, enc.FormDataContentType(), reader)
+						res, err := c.ImportUserDataWithBodyWithResponse(ctx, &api.ImportUserDataParams{Space: getSpace()}, enc.FormDataContentType(), reader)
 						if err != nil {
 							onPanic(err)
 
@@ -2845,7 +3092,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Deleting user data")
 
-				res, err := c.DeleteUserDataWithResponse(ctx)
+				res, err := c.DeleteUserDataWithResponse(ctx, &api.DeleteUserDataParams{Space: getSpace()})
 				if err != nil {
 					onPanic(err)
 
@@ -2925,7 +3172,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Deleting contact")
 
-				res, err := c.DeleteContactWithResponse(ctx, int64(id))
+				res, err := c.DeleteContactWithResponse(ctx, int64(id), &api.DeleteContactParams{Space: getSpace()})
 				if err != nil {
 					onPanic(err)
 
@@ -2986,7 +3233,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Settling debt")
 
-				res, err := c.SettleDebtWithResponse(ctx, int64(id))
+				res, err := c.SettleDebtWithResponse(ctx, int64(id), &api.SettleDebtParams{Space: getSpace()})
 				if err != nil {
 					onPanic(err)
 
@@ -3047,7 +3294,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Deleting activity")
 
-				res, err := c.DeleteActivityWithResponse(ctx, int64(id))
+				res, err := c.DeleteActivityWithResponse(ctx, int64(id), &api.DeleteActivityParams{Space: getSpace()})
 				if err != nil {
 					onPanic(err)
 
@@ -3108,7 +3355,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Deleting journal entry")
 
-				res, err := c.DeleteJournalEntryWithResponse(ctx, int64(id))
+				res, err := c.DeleteJournalEntryWithResponse(ctx, int64(id), &api.DeleteJournalEntryParams{Space: getSpace()})
 				if err != nil {
 					onPanic(err)
 
@@ -3370,7 +3617,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Listing contacts")
 
-				res, err := c.GetContactsWithResponse(ctx)
+				res, err := c.GetContactsWithResponse(ctx, &api.GetContactsParams{Space: getSpace()})
 				if err != nil {
 					handleContactsError(err)
 
@@ -3473,7 +3720,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Getting contact", "id", selectedContactID)
 
-				res, err := c.GetContactWithResponse(ctx, int64(selectedContactID))
+				res, err := c.GetContactWithResponse(ctx, int64(selectedContactID), &api.GetContactParams{Space: getSpace()})
 				if err != nil {
 					handleContactsViewError(err)
 
@@ -3690,7 +3937,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Getting activity", "id", selectedActivityID)
 
-				res, err := c.GetActivityWithResponse(ctx, int64(selectedActivityID))
+				res, err := c.GetActivityWithResponse(ctx, int64(selectedActivityID), &api.GetActivityParams{Space: getSpace()})
 				if err != nil {
 					handleActivitiesViewError(err)
 
@@ -3755,7 +4002,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Getting activity", "id", selectedActivityID)
 
-				res, err := c.GetActivityWithResponse(ctx, int64(selectedActivityID))
+				res, err := c.GetActivityWithResponse(ctx, int64(selectedActivityID), &api.GetActivityParams{Space: getSpace()})
 				if err != nil {
 					handleActivitiesEditError(err)
 
@@ -3807,7 +4054,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Getting contact", "id", selectedContactID)
 
-				res, err := c.GetContactWithResponse(ctx, int64(selectedContactID))
+				res, err := c.GetContactWithResponse(ctx, int64(selectedContactID), &api.GetContactParams{Space: getSpace()})
 				if err != nil {
 					handleDebtsEditError(err)
 
@@ -3878,7 +4125,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Getting contact", "id", selectedContactID)
 
-				res, err := c.GetContactWithResponse(ctx, int64(selectedContactID))
+				res, err := c.GetContactWithResponse(ctx, int64(selectedContactID), &api.GetContactParams{Space: getSpace()})
 				if err != nil {
 					handleContactsEditError(err)
 
@@ -3951,7 +4198,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Listing journal entries")
 
-				res, err := c.GetJournalEntriesWithResponse(ctx)
+				res, err := c.GetJournalEntriesWithResponse(ctx, &api.GetJournalEntriesParams{Space: getSpace()})
 				if err != nil {
 					handleJournalEntriesError(err)
 
@@ -3972,6 +4219,11 @@ func (a *application) onActivate(app *gio.Application) {
 
 				journalEntriesListBox.RemoveAll()
 
+				e, err := getJournalEncrypter(log)
+				if err != nil {
+					log.Warn("Could not get journal encrypter, continuing without decryption", "err", err)
+				}
+
 				journalEntriesCount = len(*res.JSON200)
 				if journalEntriesCount > 0 {
 					journalEntriesAddButton.SetVisible(true)
@@ -3980,7 +4232,13 @@ func (a *application) onActivate(app *gio.Application) {
 					for _, journalEntry := range *res.JSON200 {
 						r := adw.NewActionRow()
 
-						r.SetTitle(*journalEntry.Title)
+						if err := openJournalEntry(e, &journalEntry); err != nil {
+							log.Debug("Could not decrypt journal entry", "id", *journalEntry.Id, "err", err)
+
+							r.SetTitle(L("Encrypted journal entry"))
+						} else {
+							r.SetTitle(*journalEntry.Title)
+						}
 
 						subtitle := glibDateTimeFromGo(*journalEntry.Date).Format("%x") + " | "
 						switch *journalEntry.Rating {
@@ -4050,7 +4308,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Getting journal entry", "id", selectedJournalEntryID)
 
-				res, err := c.GetJournalEntryWithResponse(ctx, int64(selectedJournalEntryID))
+				res, err := c.GetJournalEntryWithResponse(ctx, int64(selectedJournalEntryID), &api.GetJournalEntryParams{Space: getSpace()})
 				if err != nil {
 					handleJournalEntriesViewError(err)
 
@@ -4065,6 +4323,21 @@ func (a *application) onActivate(app *gio.Application) {
 					return
 				}
 
+				e, err := getJournalEncrypter(log)
+				if err != nil {
+					handleJournalEntriesViewError(err)
+
+					return
+				}
+
+				if err := openJournalEntry(e, res.JSON200); err != nil {
+					log.Warn("Could not decrypt journal entry for journal entries view page", "err", err)
+
+					handleJournalEntriesViewError(err)
+
+					return
+				}
+
 				journalEntriesViewEditButton.SetActionTargetValue(glib.NewVariantInt64(*res.JSON200.Id))
 				journalEntriesViewDeleteButton.SetActionTargetValue(glib.NewVariantInt64(*res.JSON200.Id))
 
@@ -4120,7 +4393,7 @@ func (a *application) onActivate(app *gio.Application) {
 
 				log.Debug("Getting journal entry", "id", selectedJournalEntryID)
 
-				res, err := c.GetJournalEntryWithResponse(ctx, int64(selectedJournalEntryID))
+				res, err := c.GetJournalEntryWithResponse(ctx, int64(selectedJournalEntryID), &api.GetJournalEntryParams{Space: getSpace()})
 				if err != nil {
 					handleJournalEntriesEditError(err)
 
@@ -4135,6 +4408,21 @@ func (a *application) onActivate(app *gio.Application) {
 					return
 				}
 
+				e, err := getJournalEncrypter(log)
+				if err != nil {
+					handleJournalEntriesEditError(err)
+
+					return
+				}
+
+				if err := openJournalEntry(e, res.JSON200); err != nil {
+					log.Warn("Could not decrypt journal entry for journal entry edit page", "err", err)
+
+					handleJournalEntriesEditError(err)
+
+					return
+				}
+
 				defer clearJournalEntriesEditError()
 
 				journalEntriesEditPageSaveButton.SetActionTargetValue(glib.NewVariantInt64(*res.JSON200.Id))
@@ -4336,6 +4624,357 @@ func (a *application) onActivate(app *gio.Application) {
 		homeNavigation.ReplaceWithTags([]string{actionRow.GetName()}, 1)
 	})
 
+	var (
+		changesLock   sync.Mutex
+		cancelChanges = func() {}
+
+		conflictsLock      sync.Mutex
+		resolvingConflicts bool
+
+		syncOfflineChanges func(ctx context.Context) error
+	)
+
+	// onChanges refreshes the sidebar and the visible page if it shows any of the changed entities. If `changes`
+	// is `nil`, changes might have been missed, so the visible page is always refreshed.
+	onChanges := func(changes []api.Change) {
+		go func() {
+			_ = refreshSidebarWithLatestSummary()
+		}()
+
+		var (
+			tag      = homeNavigation.GetVisiblePage().GetTag()
+			affected = changes == nil
+		)
+		for _, change := range changes {
+			isSelected := func(entityType api.ChangeEntityType, id int) bool {
+				return change.EntityType == entityType && int(change.Id) == id
+			}
+
+			switch tag {
+			case resources.PageContacts:
+				affected = affected || change.EntityType == api.ChangeEntityTypeContact
+
+			case resources.PageContactsView:
+				if isSelected(api.ChangeEntityTypeContact, selectedContactID) && change.Operation == api.Deleted {
+					homeNavigation.ReplaceWithTags([]string{resources.PageContacts}, 1)
+
+					return
+				}
+
+				affected = affected ||
+					isSelected(api.ChangeEntityTypeContact, selectedContactID) ||
+					change.EntityType == api.ChangeEntityTypeDebt ||
+					change.EntityType == api.ChangeEntityTypeActivity
+
+			case resources.PageActivitiesView:
+				if isSelected(api.ChangeEntityTypeContact, selectedContactID) && change.Operation == api.Deleted {
+					homeNavigation.ReplaceWithTags([]string{resources.PageContacts}, 1)
+
+					return
+				}
+
+				if isSelected(api.ChangeEntityTypeActivity, selectedActivityID) && change.Operation == api.Deleted {
+					homeNavigation.Pop()
+
+					return
+				}
+
+				affected = affected || isSelected(api.ChangeEntityTypeActivity, selectedActivityID)
+
+			case resources.PageJournalEntries:
+				affected = affected || change.EntityType == api.ChangeEntityTypeJournalEntry
+
+			case resources.PageJournalEntriesView:
+				if isSelected(api.ChangeEntityTypeJournalEntry, selectedJournalEntryID) && change.Operation == api.Deleted {
+					homeNavigation.Pop()
+
+					return
+				}
+
+				affected = affected || isSelected(api.ChangeEntityTypeJournalEntry, selectedJournalEntryID)
+			}
+		}
+
+		// Edit pages are never refreshed so that changes that haven't been saved yet aren't lost
+		if !affected {
+			return
+		}
+
+		log.Debug("Refreshing page after changes", "tag", tag, "len", len(changes))
+
+		// Refreshing the page must not navigate away from the sidebar if it's collapsed
+		showContent := homeSplitView.GetShowContent()
+		onHomeNavigation()
+		homeSplitView.SetShowContent(showContent)
+	}
+
+	// streamChanges calls `onChanges` with the changes to the selected space until the context is cancelled or
+	// the event stream is closed. Changes are collected for a short time before they are handled, so that bulk
+	// changes such as imports only refresh the visible page once.
+	streamChanges := func(ctx context.Context) error {
+		redirected, c, _, err := authorize(
+			ctx,
+
+			false,
+		)
+		if err != nil {
+			return err
+		} else if redirected {
+			return nil
+		}
+
+		log.Debug("Subscribing to changes")
+
+		res, err := c.GetEvents(ctx, &api.GetEventsParams{Space: getSpace()})
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		log.Debug("Subscribed to changes", "status", res.StatusCode)
+
+		if res.StatusCode != http.StatusOK {
+			return errors.New(res.Status)
+		}
+
+		var (
+			pendingChangesLock sync.Mutex
+			pendingChanges     []api.Change
+		)
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			data, ok := strings.CutPrefix(scanner.Text(), "data:")
+			if !ok {
+				continue
+			}
+
+			var change api.Change
+			if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &change); err != nil {
+				log.Warn("Could not parse change", "err", err)
+
+				continue
+			}
+
+			log.Debug("Received change", "entityType", change.EntityType, "id", change.Id, "operation", change.Operation)
+
+			pendingChangesLock.Lock()
+			if len(pendingChanges) == 0 {
+				time.AfterFunc(changesDebounceInterval, func() {
+					pendingChangesLock.Lock()
+					changes := pendingChanges
+					pendingChanges = nil
+					pendingChangesLock.Unlock()
+
+					// The offline cache is kept up to date so that it has the latest changes once the user goes offline
+					if err := syncOfflineChanges(ctx); err != nil && ctx.Err() == nil {
+						log.Debug("Could not sync offline changes", "err", err)
+					}
+
+					idleAdd(func() {
+						if ctx.Err() != nil {
+							return
+						}
+
+						onChanges(changes)
+					})
+				})
+			}
+			pendingChanges = append(pendingChanges, change)
+			pendingChangesLock.Unlock()
+		}
+
+		return scanner.Err()
+	}
+
+	// resolveConflicts asks the user whether to keep or discard each of their offline changes that conflict with
+	// changes from other clients. Conflicts that are dismissed are asked about again after the next sync.
+	var resolveConflicts func(conflicts []offlineConflict)
+	resolveConflicts = func(conflicts []offlineConflict) {
+		if len(conflicts) == 0 {
+			conflictsLock.Lock()
+			resolvingConflicts = false
+			conflictsLock.Unlock()
+
+			go func() {
+				if err := syncOfflineChanges(ctx); err != nil {
+					log.Debug("Could not sync resolved conflicts", "err", err)
+				}
+			}()
+
+			return
+		}
+
+		conflict := conflicts[0]
+
+		log := log.With(
+			"id", conflict.ID,
+			"entityType", conflict.Mutation.EntityType,
+			"operation", conflict.Mutation.Operation,
+		)
+
+		log.Info("Handling sync conflict")
+
+		dialog := adw.NewAlertDialog(
+			L("Resolving a sync conflict"),
+			fmt.Sprintf(L("%v was changed on another device while you were offline. Do you want to keep your change or discard it?"), getConflictLabel(conflict)),
+		)
+		dialog.AddResponse("discard", L("Discard My Change"))
+		dialog.AddResponse("keep", L("Keep My Change"))
+		dialog.SetResponseAppearance("discard", adw.ResponseDestructiveValue)
+		dialog.SetResponseAppearance("keep", adw.ResponseSuggestedValue)
+		dialog.SetDefaultResponse("keep")
+		connectAlertDialogResponse(dialog, func(response string) {
+			if response != "keep" && response != "discard" {
+				log.Debug("Sync conflict was dismissed")
+
+				conflictsLock.Lock()
+				resolvingConflicts = false
+				conflictsLock.Unlock()
+
+				return
+			}
+
+			if err := a.offline.resolveConflict(ctx, conflict.ID, response == "keep"); err != nil {
+				log.Warn("Could not resolve sync conflict", "err", err)
+
+				onPanic(err)
+			}
+
+			resolveConflicts(conflicts[1:])
+		})
+
+		dialog.Present(&a.w.ApplicationWindow.Window.Widget)
+	}
+
+	// syncOfflineChanges replays the changes that were made while offline and updates the offline cache of the
+	// selected space with the changes from other clients
+	syncOfflineChanges = func(ctx context.Context) error {
+		if a.offline == nil {
+			return nil
+		}
+
+		redirected, c, _, err := authorize(
+			ctx,
+
+			false,
+		)
+		if err != nil {
+			return err
+		} else if redirected {
+			return nil
+		}
+
+		log.Debug("Syncing offline changes")
+
+		conflicts, dropped, err := a.offline.sync(ctx, c, getSpace())
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Synced offline changes", "conflicts", len(conflicts), "dropped", dropped)
+
+		idleAdd(func() {
+			if ctx.Err() != nil {
+				return
+			}
+
+			// Entities that were created offline have been assigned new IDs by the server
+			switch homeNavigation.GetVisiblePage().GetTag() {
+			case resources.PageContactsView:
+				if selectedContactID < 0 {
+					homeNavigation.ReplaceWithTags([]string{resources.PageContacts}, 1)
+				}
+
+			case resources.PageActivitiesView:
+				if selectedContactID < 0 || selectedActivityID < 0 {
+					homeNavigation.ReplaceWithTags([]string{resources.PageContacts}, 1)
+				}
+
+			case resources.PageJournalEntriesView:
+				if selectedJournalEntryID < 0 {
+					homeNavigation.ReplaceWithTags([]string{resources.PageJournalEntries}, 1)
+				}
+			}
+
+			if dropped > 0 {
+				a.mto.AddToast(adw.NewToast(L("Some changes made while offline could not be applied")))
+			}
+
+			if len(conflicts) == 0 {
+				return
+			}
+
+			conflictsLock.Lock()
+			if resolvingConflicts {
+				conflictsLock.Unlock()
+
+				return
+			}
+			resolvingConflicts = true
+			conflictsLock.Unlock()
+
+			resolveConflicts(conflicts)
+		})
+
+		return nil
+	}
+
+	// subscribeToChanges keeps the home page up to date with changes made by other clients until
+	// `unsubscribeFromChanges` is called. The event stream is re-established if it is closed, after
+	// which the offline changes are synced and the visible page is refreshed since changes might have
+	// been missed in the meantime.
+	subscribeToChanges := func() {
+		changesLock.Lock()
+		defer changesLock.Unlock()
+
+		cancelChanges()
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancelChanges = cancel
+
+		go func() {
+			reconnected := false
+			for {
+				if err := syncOfflineChanges(ctx); err != nil {
+					if ctx.Err() == nil {
+						log.Debug("Could not sync offline changes", "err", err)
+					}
+				} else if reconnected {
+					reconnected = false
+
+					idleAdd(func() {
+						if ctx.Err() != nil {
+							return
+						}
+
+						onChanges(nil)
+					})
+				}
+
+				if err := streamChanges(ctx); err != nil && ctx.Err() == nil {
+					log.Debug("Could not stream changes, reconnecting", "err", err, "reconnectInterval", changesReconnectInterval)
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+
+				case <-time.After(changesReconnectInterval):
+				}
+
+				reconnected = true
+			}
+		}()
+	}
+
+	unsubscribeFromChanges := func() {
+		changesLock.Lock()
+		defer changesLock.Unlock()
+
+		cancelChanges()
+		cancelChanges = func() {}
+	}
+
 	onNavigation := func() {
 		var (
 			tag = a.nv.GetVisiblePage().GetTag()
@@ -4344,6 +4983,10 @@ func (a *application) onActivate(app *gio.Application) {
 
 		log.Info("Handling page")
 
+		if tag != resources.PageHome {
+			unsubscribeFromChanges()
+		}
+
 		switch tag {
 		case resources.PageIndex:
 			go func() {
@@ -4465,6 +5108,8 @@ func (a *application) onActivate(app *gio.Application) {
 					return
 				}
 
+				subscribeToChanges()
+
 				contactsRow := homeSidebarListbox.GetRowAtIndex(0)
 				contactsRow.GrabFocus()
 				homeSidebarListbox.SelectRow(contactsRow)
@@ -4531,105 +5176,13 @@ func (appp *application) onOpen(a *gio.Application, filesPtr uintptr, nFiles int
 
 	log.Debug("Handling user auth exchange")
 
-	var (
-		stateNonce,
-		pkceCodeVerifier,
-		oidcNonce string
-	)
-	sn, err := keyring.Get(resources.AppID, resources.SecretStateNonceKey)
-	if err != nil {
-		if !errors.Is(err, keyring.ErrNotFound) {
-			log.Debug("Failed to read state nonce cookie", "error", err)
-
-			appp.onPanic(errors.Join(errCouldNotLogin, err))
-
-			return
-		}
-	} else {
-		stateNonce = sn
-	}
-
-	pcv, err := keyring.Get(resources.AppID, resources.SecretPKCECodeVerifierKey)
-	if err != nil {
-		if !errors.Is(err, keyring.ErrNotFound) {
-			log.Debug("Failed to read PKCE code verifier cookie", "error", err)
-
-			appp.onPanic(errors.Join(errCouldNotLogin, err))
-
-			return
-		}
-	} else {
-		pkceCodeVerifier = pcv
-	}
-
-	on, err := keyring.Get(resources.AppID, resources.SecretOIDCNonceKey)
-	if err != nil {
-		if !errors.Is(err, keyring.ErrNotFound) {
-			log.Debug("Failed to read OIDC nonce cookie", "error", err)
-
-			appp.onPanic(errors.Join(errCouldNotLogin, err))
-
-			return
-		}
-	} else {
-		oidcNonce = on
-	}
-
-	nextURL, signedOut, err := appp.authner.Exchange(
+	session, err := appp.authner.Exchange(
 		ctx,
 
+		newTokenStore(),
+
 		authCode,
 		state,
-
-		stateNonce,
-		pkceCodeVerifier,
-		oidcNonce,
-
-		func(s string, t time.Time) error {
-			// TODO: Handle expiry time
-			return keyring.Set(resources.AppID, resources.SecretRefreshTokenKey, s)
-		},
-		func(s string, t time.Time) error {
-			// TODO: Handle expiry time
-			return keyring.Set(resources.AppID, resources.SecretIDTokenKey, s)
-		},
-
-		func() error {
-			if err := keyring.Delete(resources.AppID, resources.SecretRefreshTokenKey); err != nil && !errors.Is(err, keyring.ErrNotFound) {
-				return err
-			}
-
-			return nil
-		},
-		func() error {
-			if err := keyring.Delete(resources.AppID, resources.SecretIDTokenKey); err != nil && !errors.Is(err, keyring.ErrNotFound) {
-				return err
-			}
-
-			return nil
-		},
-
-		func() error {
-			if err := keyring.Delete(resources.AppID, resources.SecretStateNonceKey); err != nil && !errors.Is(err, keyring.ErrNotFound) {
-				return err
-			}
-
-			return nil
-		},
-		func() error {
-			if err := keyring.Delete(resources.AppID, resources.SecretPKCECodeVerifierKey); err != nil && !errors.Is(err, keyring.ErrNotFound) {
-				return err
-			}
-
-			return nil
-		},
-		func() error {
-			if err := keyring.Delete(resources.AppID, resources.SecretOIDCNonceKey); err != nil && !errors.Is(err, keyring.ErrNotFound) {
-				return err
-			}
-
-			return nil
-		},
 	)
 	if err != nil {
 		appp.onPanic(err)
@@ -4641,7 +5194,8 @@ func (appp *application) onOpen(a *gio.Application, filesPtr uintptr, nFiles int
 	// authn. In the GNOME version, that is not the case since the unauthenticated
 	// page is a separate page from home, so we need to rewrite the path to distinguish
 	// between the two manually
-	if signedOut && nextURL == resources.PageHome {
+	nextURL := session.NextURL
+	if session.SignedOut && nextURL == resources.PageHome {
 		nextURL = resources.PageIndex
 	}
 
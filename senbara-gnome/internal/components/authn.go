@@ -6,16 +6,28 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
 	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
 	"github.com/pojntfx/senbara/senbara-gnome/assets/resources"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
-	"github.com/zalando/go-keyring"
 )
 
+// newTokenStore returns the keyring-backed token store for the OIDC session, re-using
+// the secret names from before the token store was introduced
+func newTokenStore() authn.TokenStore {
+	return authn.NewKeyringTokenStore(resources.AppID, map[authn.TokenKey]string{
+		authn.TokenKeyRefreshToken: resources.SecretRefreshTokenKey,
+		authn.TokenKeyIDToken:      resources.SecretIDTokenKey,
+		authn.TokenKeyAccessToken:  resources.SecretAccessTokenKey,
+
+		authn.TokenKeyStateNonce:       resources.SecretStateNonceKey,
+		authn.TokenKeyPKCECodeVerifier: resources.SecretPKCECodeVerifierKey,
+		authn.TokenKeyOIDCNonce:        resources.SecretOIDCNonceKey,
+	})
+}
+
 type userData struct {
 	Email     string
 	LogoutURL string
@@ -26,6 +38,7 @@ type authorizer struct {
 
 	authner  *authn.Authner
 	settings *gio.Settings
+	offline  *offlineCache
 
 	getVisiblePageTag func() string
 	replaceWithTags   func(tags []string, position int)
@@ -38,6 +51,7 @@ func newAuthorizer(
 
 	authner *authn.Authner,
 	settings *gio.Settings,
+	offline *offlineCache,
 
 	getVisiblePageTag func() string,
 	replaceWithTags func(tags []string, position int),
@@ -49,6 +63,7 @@ func newAuthorizer(
 
 		authner:  authner,
 		settings: settings,
+		offline:  offline,
 
 		getVisiblePageTag: getVisiblePageTag,
 		replaceWithTags:   replaceWithTags,
@@ -78,61 +93,26 @@ func (a *authorizer) authorize(
 
 	log.Debug("Handling user auth")
 
-	var (
-		refreshToken,
-		idToken *string
-	)
-	rt, err := keyring.Get(resources.AppID, resources.SecretRefreshTokenKey)
-	if err != nil {
-		if !errors.Is(err, keyring.ErrNotFound) {
-			log.Debug("Failed to read refresh token cookie", "error", err)
-
-			return false, nil, http.StatusUnauthorized, errors.Join(errCouldNotLogin, err)
-		}
-	} else {
-		refreshToken = &rt
-	}
+	// The session's tokens can't be refreshed while offline, so the last session is re-used until the server can be reached again
+	if a.offline != nil && a.offline.isOffline() {
+		if u, bearerToken, ok := a.offline.getSession(); ok {
+			log.Debug("Re-using last session while offline")
 
-	it, err := keyring.Get(resources.AppID, resources.SecretIDTokenKey)
-	if err != nil {
-		if !errors.Is(err, keyring.ErrNotFound) {
-			log.Debug("Failed to read ID token cookie", "error", err)
+			a.setUserData(u)
 
-			return false, nil, http.StatusUnauthorized, errors.Join(errCouldNotLogin, err)
+			return a.createClient(log, u, bearerToken)
 		}
-	} else {
-		idToken = &it
 	}
 
-	nextURL, email, logoutURL, err := a.authner.Authorize(
+	session, err := a.authner.Authorize(
 		ctx,
 
+		newTokenStore(),
+
 		loginIfSignedOut,
 
 		path,
 		path,
-
-		refreshToken,
-		idToken,
-
-		func(s string, t time.Time) error {
-			// TODO: Handle expiry time
-			return keyring.Set(resources.AppID, resources.SecretRefreshTokenKey, s)
-		},
-		func(s string, t time.Time) error {
-			// TODO: Handle expiry time
-			return keyring.Set(resources.AppID, resources.SecretIDTokenKey, s)
-		},
-
-		func(s string) error {
-			return keyring.Set(resources.AppID, resources.SecretStateNonceKey, s)
-		},
-		func(s string) error {
-			return keyring.Set(resources.AppID, resources.SecretPKCECodeVerifierKey, s)
-		},
-		func(s string) error {
-			return keyring.Set(resources.AppID, resources.SecretOIDCNonceKey, s)
-		},
 	)
 	if err != nil {
 		if errors.Is(err, authn.ErrCouldNotLogin) {
@@ -142,17 +122,32 @@ func (a *authorizer) authorize(
 		return false, nil, http.StatusInternalServerError, err
 	}
 
-	redirected = nextURL != ""
+	redirected = session.NextURL != ""
 	u := userData{
-		Email:     email,
-		LogoutURL: logoutURL,
+		Email:     session.Identity.Email,
+		LogoutURL: session.LogoutURL,
+	}
+
+	if a.offline != nil {
+		// A session that couldn't be refreshed because the user is offline hasn't expired, so the last session is re-used
+		if lastU, bearerToken, ok := a.offline.getSession(); ok && (redirected || strings.TrimSpace(u.Email) == "") && !a.offline.isReachable(ctx) {
+			log.Debug("Could not refresh session while offline, re-using last session")
+
+			a.offline.setOffline(true)
+			a.setUserData(lastU)
+
+			return a.createClient(log, lastU, bearerToken)
+		}
+
+		a.offline.setSession(u, session.BearerToken())
 	}
+
 	a.setUserData(u)
 
 	if redirected {
 		a.replaceWithTags([]string{resources.PageExchangeLogin}, 1)
 
-		if _, err := gio.AppInfoLaunchDefaultForUri(nextURL, nil); err != nil {
+		if _, err := gio.AppInfoLaunchDefaultForUri(session.NextURL, nil); err != nil {
 			log.Debug("Could not open nextURL", "error", err)
 
 			return false, nil, http.StatusUnauthorized, errors.Join(errCouldNotLogin, err)
@@ -161,18 +156,31 @@ func (a *authorizer) authorize(
 		return redirected, nil, http.StatusTemporaryRedirect, nil
 	}
 
+	return a.createClient(log, u, session.BearerToken())
+}
+
+func (a *authorizer) createClient(
+	log *slog.Logger,
+
+	u userData,
+	bearerToken string,
+) (
+	redirected bool,
+
+	client *api.ClientWithResponses,
+	status int,
+
+	err error,
+) {
 	opts := []api.ClientOption{}
+	if a.offline != nil {
+		opts = append(opts, api.WithHTTPClient(a.offline))
+	}
+
 	if strings.TrimSpace(u.Email) != "" {
 		log.Debug("Creating authenticated client")
 
-		it, err = keyring.Get(resources.AppID, resources.SecretIDTokenKey)
-		if err != nil {
-			log.Debug("Failed to read ID token cookie", "error", err)
-
-			return false, nil, http.StatusUnauthorized, errors.Join(errCouldNotLogin, err)
-		}
-
-		sp, err := securityprovider.NewSecurityProviderBearerToken(it)
+		sp, err := securityprovider.NewSecurityProviderBearerToken(bearerToken)
 		if err != nil {
 			log.Debug("Could not create bearer token security provider", "error", err)
 
@@ -194,5 +202,5 @@ func (a *authorizer) authorize(
 		return false, nil, http.StatusUnauthorized, errors.Join(errCouldNotLogin, err)
 	}
 
-	return redirected, client, http.StatusOK, nil
+	return false, client, http.StatusOK, nil
 }
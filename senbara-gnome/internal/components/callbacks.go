@@ -50,6 +50,16 @@ func connectPasswordEntryRowChanged(row *adw.PasswordEntryRow, fn func()) {
 	row.EntryRow.PreferencesRow.ListBoxRow.Widget.InitiallyUnowned.Object.ConnectSignal("changed", &cb)
 }
 
+func connectPasswordEntryRowApply(row *adw.PasswordEntryRow, fn func()) {
+	cb := func() { fn() }
+	row.EntryRow.PreferencesRow.ListBoxRow.Widget.InitiallyUnowned.Object.ConnectSignal("apply", &cb)
+}
+
+func connectComboRowSelected(row *adw.ComboRow, fn func()) {
+	cb := func() { fn() }
+	row.ActionRow.PreferencesRow.ListBoxRow.Widget.InitiallyUnowned.Object.ConnectSignal("notify::selected", &cb)
+}
+
 func connectSearchEntryChanged(entry *gtk.SearchEntry, fn func()) {
 	cb := func(_ gtk.SearchEntry) { fn() }
 	entry.ConnectSearchChanged(&cb)
@@ -1,10 +1,19 @@
 package components
 
+import "time"
+
 const (
 	dataKeyGoInstance = "go_instance"
 
 	redirectURL = "senbara:///authorize"
 
+	// changesDebounceInterval is how long changes are collected before the visible page is refreshed
+	changesDebounceInterval = 500 * time.Millisecond
+	// changesReconnectInterval is how long to wait before re-establishing a closed event stream
+	changesReconnectInterval = 5 * time.Second
+
+	offlineCacheFileName = "offline.sqlite"
+
 	renderedMarkdownHTMLPrefix = `<meta name="color-scheme" content="light dark" />
 <style>
   body {
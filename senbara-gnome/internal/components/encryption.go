@@ -0,0 +1,109 @@
+package components
+
+import (
+	"encoding/base64"
+	"errors"
+	"log/slog"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/encryption"
+	"github.com/pojntfx/senbara/senbara-gnome/assets/resources"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+	"github.com/zalando/go-keyring"
+)
+
+// getJournalEncrypter returns nil if no passphrase is stored in the keyring
+func getJournalEncrypter(log *slog.Logger) (*encryption.Encrypter, error) {
+	passphrase, err := keyring.Get(resources.AppID, resources.SecretJournalPassphraseKey)
+	if err != nil {
+		if !errors.Is(err, keyring.ErrNotFound) {
+			return nil, err
+		}
+
+		log.Debug("No journal encryption passphrase stored in keyring")
+
+		return nil, nil
+	}
+
+	var salt []byte
+	rawSalt, err := keyring.Get(resources.AppID, resources.SecretJournalSaltKey)
+	if err != nil {
+		if !errors.Is(err, keyring.ErrNotFound) {
+			return nil, err
+		}
+
+		log.Debug("No journal encryption salt stored in keyring, generating new salt")
+
+		salt, err = encryption.NewSalt()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := keyring.Set(resources.AppID, resources.SecretJournalSaltKey, base64.StdEncoding.EncodeToString(salt)); err != nil {
+			return nil, err
+		}
+	} else {
+		salt, err = base64.StdEncoding.DecodeString(rawSalt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return encryption.NewEncrypter(passphrase, salt)
+}
+
+func sealJournalEntry(e *encryption.Encrypter, title, body string) (encryptedTitle, encryptedBody string, envelope *api.EncryptionEnvelope, err error) {
+	env := e.Envelope()
+
+	encryptedTitle, err = e.Seal(env, title)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	encryptedBody, err = e.Seal(env, body)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return encryptedTitle, encryptedBody, &api.EncryptionEnvelope{
+		Algorithm: api.EncryptionEnvelopeAlgorithm(env.Algorithm),
+		Kdf:       api.EncryptionEnvelopeKdf(env.KDF),
+		Salt:      env.Salt,
+	}, nil
+}
+
+// openJournalEntry decrypts the title and body of the journal entry in place
+func openJournalEntry(e *encryption.Encrypter, journalEntry *api.JournalEntry) error {
+	if journalEntry.Encrypted == nil || !*journalEntry.Encrypted || journalEntry.Encryption == nil {
+		return nil
+	}
+
+	if e == nil {
+		return errMissingJournalPassphrase
+	}
+
+	env := encryption.Envelope{
+		Algorithm: string(journalEntry.Encryption.Algorithm),
+		KDF:       string(journalEntry.Encryption.Kdf),
+		Salt:      journalEntry.Encryption.Salt,
+	}
+
+	if journalEntry.Title != nil {
+		title, err := e.Open(env, *journalEntry.Title)
+		if err != nil {
+			return err
+		}
+
+		journalEntry.Title = &title
+	}
+
+	if journalEntry.Body != nil {
+		body, err := e.Open(env, *journalEntry.Body)
+		if err != nil {
+			return err
+		}
+
+		journalEntry.Body = &body
+	}
+
+	return nil
+}
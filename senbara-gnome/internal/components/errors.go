@@ -13,4 +13,5 @@ var (
 	errDebtDoesNotExist         = errors.New("debt does not exist")
 	errMissingJournalEntryID    = errors.New("missing journal entry ID")
 	errInvalidJournaEntrylID    = errors.New("invalid journal entry ID")
+	errMissingJournalPassphrase = errors.New("missing journal encryption passphrase, please set one in the preferences")
 )
@@ -0,0 +1,1651 @@
+package components
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/pojntfx/go-gettext/pkg/i18n"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+	_ "modernc.org/sqlite"
+)
+
+const (
+	offlineCacheSchema = `create table if not exists entities (
+    scope text not null,
+    entity_type text not null,
+    id integer not null,
+    contact_id integer not null default 0,
+    data text not null,
+    primary key (scope, entity_type, id)
+);
+create table if not exists cursors (
+    scope text primary key,
+    cursor text not null
+);
+create table if not exists responses (
+    scope text not null,
+    path text not null,
+    body blob not null,
+    primary key (scope, path)
+);
+create table if not exists outbox (
+    id integer primary key autoincrement,
+    scope text not null,
+    entity_type text not null,
+    entity_id integer not null,
+    contact_id integer not null default 0,
+    mutation text not null,
+    conflict integer not null default 0
+);`
+
+	// maxSyncMutations is the maximum amount of mutations that the server accepts in one request
+	maxSyncMutations = 100
+)
+
+var (
+	errCouldNotSyncMutations = errors.New("could not sync mutations")
+	errUnexpectedSyncResults = errors.New("unexpected amount of sync results")
+)
+
+type offlineQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// offlineConflict is a mutation from the outbox that couldn't be applied since its entity was changed by
+// another client after the user's copy of it was synced
+type offlineConflict struct {
+	ID       int64
+	Mutation api.SyncMutation
+}
+
+type outboxEntry struct {
+	id         int64
+	entityType api.ChangeEntityType
+	entityID   int64
+	contactID  int64
+	mutation   api.SyncMutation
+}
+
+// offlineCache is a local copy of the data of the spaces that the user has visited and an outbox of the changes
+// that they made while the server couldn't be reached. It sits between the API client and the network, so if a
+// request fails because the user is offline, it is served from the cache instead.
+type offlineCache struct {
+	log *slog.Logger
+	db  *sql.DB
+
+	getServerURL     func() string
+	onOfflineChanged func(offline bool)
+
+	lock        sync.Mutex
+	offline     bool
+	u           userData
+	bearerToken string
+
+	syncLock sync.Mutex
+}
+
+func newOfflineCache(
+	log *slog.Logger,
+
+	path string,
+
+	getServerURL func() string,
+	onOfflineChanged func(offline bool),
+) (*offlineCache, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite only allows one writer at a time
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(offlineCacheSchema); err != nil {
+		_ = db.Close()
+
+		return nil, err
+	}
+
+	return &offlineCache{
+		log: log,
+		db:  db,
+
+		getServerURL:     getServerURL,
+		onOfflineChanged: onOfflineChanged,
+	}, nil
+}
+
+// setSession remembers the last session, since its tokens can't be refreshed while offline
+func (c *offlineCache) setSession(u userData, bearerToken string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.u = u
+	c.bearerToken = bearerToken
+}
+
+func (c *offlineCache) getSession() (u userData, bearerToken string, ok bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.u, c.bearerToken, strings.TrimSpace(c.u.Email) != ""
+}
+
+func (c *offlineCache) isOffline() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.offline
+}
+
+func (c *offlineCache) setOffline(offline bool) {
+	c.lock.Lock()
+	changed := c.offline != offline
+	c.offline = offline
+	c.lock.Unlock()
+
+	if changed {
+		c.log.Info("Connectivity changed", "offline", offline)
+
+		c.onOfflineChanged(offline)
+	}
+}
+
+// isReachable checks whether the server can be reached at all, which distinguishes sessions that have
+// expired from sessions that couldn't be refreshed because the user is offline
+func (c *offlineCache) isReachable(ctx context.Context) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c.getServerURL(), nil)
+	if err != nil {
+		return false
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return !isUnreachable(err)
+	}
+	_ = res.Body.Close()
+
+	return true
+}
+
+// isUnreachable checks whether a request failed because the server couldn't be connected to,
+// in which case the request was never sent
+func isUnreachable(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+
+	return errors.As(err, &dnsErr)
+}
+
+// getScope returns the key that the data of a space is cached under. Data is cached per server,
+// user and space so that switching between them never mixes up their data.
+func (c *offlineCache) getScope(space *api.SpaceSelector) (string, bool) {
+	u, _, ok := c.getSession()
+	if !ok {
+		return "", false
+	}
+
+	rawSpace := ""
+	if space != nil {
+		rawSpace = strconv.FormatInt(*space, 10)
+	}
+
+	return strings.Join([]string{c.getServerURL(), u.Email, rawSpace}, "\n"), true
+}
+
+func (c *offlineCache) getPath(req *http.Request) string {
+	base := ""
+	if u, err := url.Parse(c.getServerURL()); err == nil {
+		base = strings.TrimSuffix(u.Path, "/")
+	}
+
+	return strings.TrimPrefix(req.URL.Path, base)
+}
+
+func (c *offlineCache) Do(req *http.Request) (*http.Response, error) {
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		if !isUnreachable(err) || req.Context().Err() != nil {
+			return nil, err
+		}
+
+		c.setOffline(true)
+
+		res, ok, serveErr := c.serve(req)
+		if serveErr != nil {
+			c.log.Warn("Could not serve request from offline cache", "method", req.Method, "path", req.URL.Path, "err", serveErr)
+
+			return nil, errors.Join(err, serveErr)
+		} else if !ok {
+			return nil, err
+		}
+
+		return res, nil
+	}
+
+	c.setOffline(false)
+
+	// Spaces aren't part of the synced data, so their latest response is cached as-is
+	if req.Method == http.MethodGet && res.StatusCode == http.StatusOK && c.getPath(req) == "/spaces" {
+		body, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		res.Body = io.NopCloser(bytes.NewReader(body))
+
+		if scope, ok := c.getScope(nil); ok {
+			if _, err := c.db.ExecContext(
+				req.Context(),
+				`insert into responses (scope, path, body) values (?, ?, ?) on conflict (scope, path) do update set body = excluded.body`,
+				scope,
+				"/spaces",
+				body,
+			); err != nil {
+				c.log.Warn("Could not cache spaces", "err", err)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// serve answers a request from the offline cache. Reads are served from the cached entities and mutations are
+// applied to them and added to the outbox. If the request can't be served, for example because the entity
+// hasn't been cached, `ok` is false.
+func (c *offlineCache) serve(req *http.Request) (res *http.Response, ok bool, err error) {
+	ctx := req.Context()
+
+	var space *api.SpaceSelector
+	if rawSpace := req.URL.Query().Get("space"); rawSpace != "" {
+		s, err := strconv.ParseInt(rawSpace, 10, 64)
+		if err != nil {
+			return nil, false, nil
+		}
+
+		space = &s
+	}
+
+	scope, ok := c.getScope(space)
+	if !ok {
+		return nil, false, nil
+	}
+
+	var (
+		segments = strings.Split(strings.Trim(c.getPath(req), "/"), "/")
+		resource = segments[0]
+		id       int64
+		hasID    = len(segments) == 2
+	)
+	if len(segments) > 2 {
+		return nil, false, nil
+	}
+
+	if hasID {
+		id, err = strconv.ParseInt(segments[1], 10, 64)
+		if err != nil {
+			return nil, false, nil
+		}
+	}
+
+	var body []byte
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, false, err
+		}
+		defer rc.Close()
+
+		body, err = io.ReadAll(rc)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	log := c.log.With("method", req.Method, "resource", resource, "id", id)
+
+	log.Debug("Serving request from offline cache")
+
+	var v any
+	switch {
+	case req.Method == http.MethodGet && resource == "spaces" && !hasID:
+		var rawSpaces []byte
+		if err := c.db.QueryRowContext(ctx, `select body from responses where scope = ? and path = ?`, scope, "/spaces").Scan(&rawSpaces); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, false, nil
+			}
+
+			return nil, false, err
+		}
+
+		v = json.RawMessage(rawSpaces)
+
+	case req.Method == http.MethodGet && resource == "summary" && !hasID:
+		v, err = c.getSummary(ctx, scope)
+
+	case req.Method == http.MethodGet && resource == "contacts" && !hasID:
+		v, err = c.getContacts(ctx, scope)
+
+	case req.Method == http.MethodGet && resource == "contacts" && hasID:
+		v, err = c.getContactData(ctx, scope, id)
+
+	case req.Method == http.MethodGet && resource == "activities" && hasID:
+		v, err = c.getActivityWithContact(ctx, scope, id)
+
+	case req.Method == http.MethodGet && resource == "journal" && !hasID:
+		v, err = c.getJournalEntries(ctx, scope)
+
+	case req.Method == http.MethodGet && resource == "journal" && hasID:
+		var entry api.JournalEntry
+		err = c.getEntity(ctx, c.db, scope, api.ChangeEntityTypeJournalEntry, id, &entry)
+		v = entry
+
+	case req.Method == http.MethodPost && resource == "contacts" && !hasID:
+		v, err = c.createContact(ctx, scope, body)
+
+	case req.Method == http.MethodPut && resource == "contacts" && hasID:
+		v, err = c.updateContact(ctx, scope, id, body)
+
+	case req.Method == http.MethodPost && resource == "debts" && !hasID:
+		v, err = c.createDebt(ctx, scope, body)
+
+	case req.Method == http.MethodPut && resource == "debts" && hasID:
+		v, err = c.updateDebt(ctx, scope, id, body)
+
+	case req.Method == http.MethodPost && resource == "activities" && !hasID:
+		v, err = c.createActivity(ctx, scope, body)
+
+	case req.Method == http.MethodPut && resource == "activities" && hasID:
+		v, err = c.updateActivity(ctx, scope, id, body)
+
+	case req.Method == http.MethodPost && resource == "journal" && !hasID:
+		v, err = c.createJournalEntry(ctx, scope, body)
+
+	case req.Method == http.MethodPut && resource == "journal" && hasID:
+		v, err = c.updateJournalEntry(ctx, scope, id, body)
+
+	case req.Method == http.MethodDelete && hasID:
+		switch resource {
+		case "contacts":
+			v, err = c.deleteEntity(ctx, scope, api.ChangeEntityTypeContact, id)
+
+		case "debts":
+			v, err = c.deleteEntity(ctx, scope, api.ChangeEntityTypeDebt, id)
+
+		case "activities":
+			v, err = c.deleteEntity(ctx, scope, api.ChangeEntityTypeActivity, id)
+
+		case "journal":
+			v, err = c.deleteEntity(ctx, scope, api.ChangeEntityTypeJournalEntry, id)
+
+		default:
+			return nil, false, nil
+		}
+
+	default:
+		return nil, false, nil
+	}
+	if err != nil {
+		// Entities that haven't been cached can't be served, so the original error is shown instead
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug("Entity is not in offline cache")
+
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &http.Response{
+		Status:     http.StatusText(http.StatusOK),
+		StatusCode: http.StatusOK,
+		Proto:      req.Proto,
+		ProtoMajor: req.ProtoMajor,
+		ProtoMinor: req.ProtoMinor,
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+		},
+		Body:          io.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       req,
+	}, true, nil
+}
+
+func (c *offlineCache) getEntity(ctx context.Context, q offlineQuerier, scope string, entityType api.ChangeEntityType, id int64, v any) error {
+	var data []byte
+	if err := q.QueryRowContext(
+		ctx,
+		`select data from entities where scope = ? and entity_type = ? and id = ?`,
+		scope,
+		entityType,
+		id,
+	).Scan(&data); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// getEntities returns the raw data of all cached entities of a type; if `contactID` isn't 0,
+// only the entities that belong to the contact are returned
+func (c *offlineCache) getEntities(ctx context.Context, scope string, entityType api.ChangeEntityType, contactID int64) ([][]byte, error) {
+	query := `select data from entities where scope = ? and entity_type = ? order by id < 0, abs(id)`
+	args := []any{scope, entityType}
+	if contactID != 0 {
+		query = `select data from entities where scope = ? and entity_type = ? and contact_id = ? order by id < 0, abs(id)`
+		args = append(args, contactID)
+	}
+
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entities := [][]byte{}
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, data)
+	}
+
+	return entities, rows.Err()
+}
+
+func (c *offlineCache) putEntity(ctx context.Context, q offlineQuerier, scope string, entityType api.ChangeEntityType, id, contactID int64, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.ExecContext(
+		ctx,
+		`insert into entities (scope, entity_type, id, contact_id, data) values (?, ?, ?, ?, ?)
+on conflict (scope, entity_type, id) do update set contact_id = excluded.contact_id, data = excluded.data`,
+		scope,
+		entityType,
+		id,
+		contactID,
+		data,
+	)
+
+	return err
+}
+
+// removeEntity removes an entity from the cache; the debts and activities of contacts are removed with them
+func (c *offlineCache) removeEntity(ctx context.Context, q offlineQuerier, scope string, entityType api.ChangeEntityType, id int64) error {
+	if _, err := q.ExecContext(
+		ctx,
+		`delete from entities where scope = ? and entity_type = ? and id = ?`,
+		scope,
+		entityType,
+		id,
+	); err != nil {
+		return err
+	}
+
+	if entityType == api.ChangeEntityTypeContact {
+		if _, err := q.ExecContext(
+			ctx,
+			`delete from entities where scope = ? and entity_type in (?, ?) and contact_id = ?`,
+			scope,
+			api.ChangeEntityTypeDebt,
+			api.ChangeEntityTypeActivity,
+			id,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getTemporaryID returns an ID for an entity that is created offline. Temporary IDs are negative so that they
+// never collide with the IDs that the server assigns once the entity is synced.
+func (c *offlineCache) getTemporaryID(ctx context.Context, q offlineQuerier, scope string, entityType api.ChangeEntityType) (int64, error) {
+	var minID sql.NullInt64
+	if err := q.QueryRowContext(
+		ctx,
+		`select min(id) from entities where scope = ? and entity_type = ?`,
+		scope,
+		entityType,
+	).Scan(&minID); err != nil {
+		return -1, err
+	}
+
+	return min(minID.Int64, 0) - 1, nil
+}
+
+func (c *offlineCache) getCursor(ctx context.Context, q offlineQuerier, scope string) (string, bool, error) {
+	var cursor string
+	if err := q.QueryRowContext(ctx, `select cursor from cursors where scope = ?`, scope).Scan(&cursor); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", false, nil
+		}
+
+		return "", false, err
+	}
+
+	return cursor, true, nil
+}
+
+// enqueue adds a mutation to the outbox. Mutations of an entity that already has a pending mutation are merged
+// into it, since the server can't resolve temporary IDs and would see a conflict with the earlier mutation.
+func (c *offlineCache) enqueue(ctx context.Context, q offlineQuerier, scope string, mutation api.SyncMutation, entityID, contactID int64) error {
+	var (
+		pendingID          int64
+		rawPendingMutation []byte
+	)
+	if err := q.QueryRowContext(
+		ctx,
+		`select id, mutation from outbox where scope = ? and entity_type = ? and entity_id = ? and conflict = 0 order by id desc limit 1`,
+		scope,
+		mutation.EntityType,
+		entityID,
+	).Scan(&pendingID, &rawPendingMutation); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		if mutation.Operation != api.Create {
+			cursor, ok, err := c.getCursor(ctx, q, scope)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				cursor = "0"
+			}
+
+			mutation.Id = &entityID
+			mutation.BaseCursor = &cursor
+		}
+
+		rawMutation, err := json.Marshal(mutation)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.ExecContext(
+			ctx,
+			`insert into outbox (scope, entity_type, entity_id, contact_id, mutation) values (?, ?, ?, ?, ?)`,
+			scope,
+			mutation.EntityType,
+			entityID,
+			contactID,
+			rawMutation,
+		)
+
+		return err
+	}
+
+	var pendingMutation api.SyncMutation
+	if err := json.Unmarshal(rawPendingMutation, &pendingMutation); err != nil {
+		return err
+	}
+
+	switch {
+	// Entities that were created and deleted offline never have to be sent to the server
+	case mutation.Operation == api.Delete && pendingMutation.Operation == api.Create:
+		if _, err := q.ExecContext(ctx, `delete from outbox where id = ?`, pendingID); err != nil {
+			return err
+		}
+
+		if mutation.EntityType == api.ChangeEntityTypeContact {
+			if _, err := q.ExecContext(ctx, `delete from outbox where scope = ? and contact_id = ?`, scope, entityID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case mutation.Operation == api.Delete:
+		pendingMutation.Operation = api.Delete
+		pendingMutation.Contact = nil
+		pendingMutation.JournalEntry = nil
+		pendingMutation.Debt = nil
+		pendingMutation.Activity = nil
+
+	default:
+		pendingMutation.Contact = mutation.Contact
+		pendingMutation.JournalEntry = mutation.JournalEntry
+		pendingMutation.Debt = mutation.Debt
+		pendingMutation.Activity = mutation.Activity
+	}
+
+	rawMutation, err := json.Marshal(pendingMutation)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.ExecContext(ctx, `update outbox set mutation = ? where id = ?`, rawMutation, pendingID)
+
+	return err
+}
+
+func (c *offlineCache) getSummary(ctx context.Context, scope string) (api.IndexData, error) {
+	var contactsCount, journalEntriesCount int64
+	if err := c.db.QueryRowContext(
+		ctx,
+		`select count(*) filter (where entity_type = ?), count(*) filter (where entity_type = ?) from entities where scope = ?`,
+		api.ChangeEntityTypeContact,
+		api.ChangeEntityTypeJournalEntry,
+		scope,
+	).Scan(&contactsCount, &journalEntriesCount); err != nil {
+		return api.IndexData{}, err
+	}
+
+	return api.IndexData{
+		ContactsCount:       &contactsCount,
+		JournalEntriesCount: &journalEntriesCount,
+	}, nil
+}
+
+func (c *offlineCache) getContacts(ctx context.Context, scope string) ([]api.Contact, error) {
+	rawContacts, err := c.getEntities(ctx, scope, api.ChangeEntityTypeContact, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts := []api.Contact{}
+	for _, rawContact := range rawContacts {
+		var contact api.Contact
+		if err := json.Unmarshal(rawContact, &contact); err != nil {
+			return nil, err
+		}
+
+		contacts = append(contacts, contact)
+	}
+
+	// The server sorts contacts by their first name in descending order
+	slices.SortStableFunc(contacts, func(a, b api.Contact) int {
+		return strings.Compare(*b.FirstName, *a.FirstName)
+	})
+
+	return contacts, nil
+}
+
+func (c *offlineCache) getContactData(ctx context.Context, scope string, id int64) (api.ContactData, error) {
+	var contact api.Contact
+	if err := c.getEntity(ctx, c.db, scope, api.ChangeEntityTypeContact, id, &contact); err != nil {
+		return api.ContactData{}, err
+	}
+
+	rawDebts, err := c.getEntities(ctx, scope, api.ChangeEntityTypeDebt, id)
+	if err != nil {
+		return api.ContactData{}, err
+	}
+
+	debts := []api.Debt{}
+	for _, rawDebt := range rawDebts {
+		var debt api.SyncDebt
+		if err := json.Unmarshal(rawDebt, &debt); err != nil {
+			return api.ContactData{}, err
+		}
+
+		debts = append(debts, api.Debt{
+			Amount:      debt.Amount,
+			Currency:    debt.Currency,
+			Description: debt.Description,
+			Id:          debt.Id,
+		})
+	}
+
+	rawActivities, err := c.getEntities(ctx, scope, api.ChangeEntityTypeActivity, id)
+	if err != nil {
+		return api.ContactData{}, err
+	}
+
+	activities := []api.Activity{}
+	for _, rawActivity := range rawActivities {
+		var activity api.SyncActivity
+		if err := json.Unmarshal(rawActivity, &activity); err != nil {
+			return api.ContactData{}, err
+		}
+
+		activities = append(activities, api.Activity{
+			Date:        activity.Date,
+			Description: activity.Description,
+			Id:          activity.Id,
+			Name:        activity.Name,
+		})
+	}
+
+	return api.ContactData{
+		Activities: &activities,
+		Debts:      &debts,
+		Entry:      &contact,
+	}, nil
+}
+
+func (c *offlineCache) getActivityWithContact(ctx context.Context, scope string, id int64) (api.ActivityWithContact, error) {
+	var activity api.SyncActivity
+	if err := c.getEntity(ctx, c.db, scope, api.ChangeEntityTypeActivity, id, &activity); err != nil {
+		return api.ActivityWithContact{}, err
+	}
+
+	var contact api.Contact
+	if err := c.getEntity(ctx, c.db, scope, api.ChangeEntityTypeContact, *activity.ContactId, &contact); err != nil {
+		return api.ActivityWithContact{}, err
+	}
+
+	return api.ActivityWithContact{
+		ActivityId:  activity.Id,
+		ContactId:   contact.Id,
+		Date:        activity.Date,
+		Description: activity.Description,
+		FirstName:   contact.FirstName,
+		LastName:    contact.LastName,
+		Name:        activity.Name,
+	}, nil
+}
+
+func (c *offlineCache) getJournalEntries(ctx context.Context, scope string) ([]api.JournalEntry, error) {
+	rawEntries, err := c.getEntities(ctx, scope, api.ChangeEntityTypeJournalEntry, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []api.JournalEntry{}
+	for _, rawEntry := range rawEntries {
+		var entry api.JournalEntry
+		if err := json.Unmarshal(rawEntry, &entry); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	// The server sorts journal entries by their date in descending order
+	slices.SortStableFunc(entries, func(a, b api.JournalEntry) int {
+		return b.Date.Compare(*a.Date)
+	})
+
+	return entries, nil
+}
+
+func (c *offlineCache) createContact(ctx context.Context, scope string, body []byte) (api.Contact, error) {
+	var req api.CreateContactJSONRequestBody
+	if err := json.Unmarshal(body, &req); err != nil {
+		return api.Contact{}, err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Contact{}, err
+	}
+	defer tx.Rollback()
+
+	id, err := c.getTemporaryID(ctx, tx, scope, api.ChangeEntityTypeContact)
+	if err != nil {
+		return api.Contact{}, err
+	}
+
+	nickname := ""
+	if v := req.Nickname; v != nil {
+		nickname = *v
+	}
+
+	var (
+		address = ""
+		notes   = ""
+	)
+	contact := api.Contact{
+		Address:   &address,
+		Email:     &req.Email,
+		FirstName: &req.FirstName,
+		Id:        &id,
+		LastName:  &req.LastName,
+		Nickname:  &nickname,
+		Notes:     &notes,
+		Pronouns:  &req.Pronouns,
+	}
+
+	if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeContact, id, 0, contact); err != nil {
+		return api.Contact{}, err
+	}
+
+	if err := c.enqueue(ctx, tx, scope, api.SyncMutation{
+		EntityType: api.ChangeEntityTypeContact,
+		Operation:  api.Create,
+		Contact: &api.SyncContactData{
+			Email:     req.Email,
+			FirstName: req.FirstName,
+			LastName:  req.LastName,
+			Nickname:  req.Nickname,
+			Pronouns:  req.Pronouns,
+		},
+	}, id, 0); err != nil {
+		return api.Contact{}, err
+	}
+
+	return contact, tx.Commit()
+}
+
+func (c *offlineCache) updateContact(ctx context.Context, scope string, id int64, body []byte) (api.Contact, error) {
+	var req api.UpdateContactJSONRequestBody
+	if err := json.Unmarshal(body, &req); err != nil {
+		return api.Contact{}, err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Contact{}, err
+	}
+	defer tx.Rollback()
+
+	var contact api.Contact
+	if err := c.getEntity(ctx, tx, scope, api.ChangeEntityTypeContact, id, &contact); err != nil {
+		return api.Contact{}, err
+	}
+
+	nickname := ""
+	if v := req.Nickname; v != nil {
+		nickname = *v
+	}
+
+	address := ""
+	if v := req.Address; v != nil {
+		address = *v
+	}
+
+	notes := ""
+	if v := req.Notes; v != nil {
+		notes = *v
+	}
+
+	contact.Address = &address
+	contact.Birthday = req.Birthday
+	contact.Email = &req.Email
+	contact.FirstName = &req.FirstName
+	contact.LastName = &req.LastName
+	contact.Nickname = &nickname
+	contact.Notes = &notes
+	contact.Pronouns = &req.Pronouns
+
+	if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeContact, id, 0, contact); err != nil {
+		return api.Contact{}, err
+	}
+
+	if err := c.enqueue(ctx, tx, scope, api.SyncMutation{
+		EntityType: api.ChangeEntityTypeContact,
+		Operation:  api.Update,
+		Contact: &api.SyncContactData{
+			Address:   req.Address,
+			Birthday:  req.Birthday,
+			Email:     req.Email,
+			FirstName: req.FirstName,
+			LastName:  req.LastName,
+			Nickname:  req.Nickname,
+			Notes:     req.Notes,
+			Pronouns:  req.Pronouns,
+		},
+	}, id, 0); err != nil {
+		return api.Contact{}, err
+	}
+
+	return contact, tx.Commit()
+}
+
+func (c *offlineCache) createDebt(ctx context.Context, scope string, body []byte) (api.Debt, error) {
+	var req api.CreateDebtJSONRequestBody
+	if err := json.Unmarshal(body, &req); err != nil {
+		return api.Debt{}, err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Debt{}, err
+	}
+	defer tx.Rollback()
+
+	var contact api.Contact
+	if err := c.getEntity(ctx, tx, scope, api.ChangeEntityTypeContact, req.ContactId, &contact); err != nil {
+		return api.Debt{}, err
+	}
+
+	id, err := c.getTemporaryID(ctx, tx, scope, api.ChangeEntityTypeDebt)
+	if err != nil {
+		return api.Debt{}, err
+	}
+
+	amount := float32(math.Abs(float64(req.Amount)))
+	if req.YouOwe {
+		amount = -amount
+	}
+
+	description := ""
+	if v := req.Description; v != nil {
+		description = *v
+	}
+
+	debt := api.SyncDebt{
+		Amount:      &amount,
+		ContactId:   &req.ContactId,
+		Currency:    &req.Currency,
+		Description: &description,
+		Id:          &id,
+	}
+
+	if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeDebt, id, req.ContactId, debt); err != nil {
+		return api.Debt{}, err
+	}
+
+	if err := c.enqueue(ctx, tx, scope, api.SyncMutation{
+		EntityType: api.ChangeEntityTypeDebt,
+		Operation:  api.Create,
+		Debt: &api.SyncDebtData{
+			Amount:      req.Amount,
+			ContactId:   &req.ContactId,
+			Currency:    req.Currency,
+			Description: req.Description,
+			YouOwe:      req.YouOwe,
+		},
+	}, id, req.ContactId); err != nil {
+		return api.Debt{}, err
+	}
+
+	return api.Debt{
+		Amount:      debt.Amount,
+		Currency:    debt.Currency,
+		Description: debt.Description,
+		Id:          debt.Id,
+	}, tx.Commit()
+}
+
+func (c *offlineCache) updateDebt(ctx context.Context, scope string, id int64, body []byte) (api.Debt, error) {
+	var req api.UpdateDebtJSONRequestBody
+	if err := json.Unmarshal(body, &req); err != nil {
+		return api.Debt{}, err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Debt{}, err
+	}
+	defer tx.Rollback()
+
+	var debt api.SyncDebt
+	if err := c.getEntity(ctx, tx, scope, api.ChangeEntityTypeDebt, id, &debt); err != nil {
+		return api.Debt{}, err
+	}
+
+	amount := float32(math.Abs(float64(req.Amount)))
+	if req.YouOwe {
+		amount = -amount
+	}
+
+	description := ""
+	if v := req.Description; v != nil {
+		description = *v
+	}
+
+	debt.Amount = &amount
+	debt.Currency = &req.Currency
+	debt.Description = &description
+
+	if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeDebt, id, *debt.ContactId, debt); err != nil {
+		return api.Debt{}, err
+	}
+
+	if err := c.enqueue(ctx, tx, scope, api.SyncMutation{
+		EntityType: api.ChangeEntityTypeDebt,
+		Operation:  api.Update,
+		Debt: &api.SyncDebtData{
+			Amount:      req.Amount,
+			Currency:    req.Currency,
+			Description: req.Description,
+			YouOwe:      req.YouOwe,
+		},
+	}, id, *debt.ContactId); err != nil {
+		return api.Debt{}, err
+	}
+
+	return api.Debt{
+		Amount:      debt.Amount,
+		Currency:    debt.Currency,
+		Description: debt.Description,
+		Id:          debt.Id,
+	}, tx.Commit()
+}
+
+func (c *offlineCache) createActivity(ctx context.Context, scope string, body []byte) (api.Activity, error) {
+	var req api.CreateActivityJSONRequestBody
+	if err := json.Unmarshal(body, &req); err != nil {
+		return api.Activity{}, err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Activity{}, err
+	}
+	defer tx.Rollback()
+
+	var contact api.Contact
+	if err := c.getEntity(ctx, tx, scope, api.ChangeEntityTypeContact, req.ContactId, &contact); err != nil {
+		return api.Activity{}, err
+	}
+
+	id, err := c.getTemporaryID(ctx, tx, scope, api.ChangeEntityTypeActivity)
+	if err != nil {
+		return api.Activity{}, err
+	}
+
+	description := ""
+	if v := req.Description; v != nil {
+		description = *v
+	}
+
+	activity := api.SyncActivity{
+		ContactId:   &req.ContactId,
+		Date:        &req.Date,
+		Description: &description,
+		Id:          &id,
+		Name:        &req.Name,
+	}
+
+	if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeActivity, id, req.ContactId, activity); err != nil {
+		return api.Activity{}, err
+	}
+
+	if err := c.enqueue(ctx, tx, scope, api.SyncMutation{
+		EntityType: api.ChangeEntityTypeActivity,
+		Operation:  api.Create,
+		Activity: &api.SyncActivityData{
+			ContactId:   &req.ContactId,
+			Date:        req.Date,
+			Description: req.Description,
+			Name:        req.Name,
+		},
+	}, id, req.ContactId); err != nil {
+		return api.Activity{}, err
+	}
+
+	return api.Activity{
+		Date:        activity.Date,
+		Description: activity.Description,
+		Id:          activity.Id,
+		Name:        activity.Name,
+	}, tx.Commit()
+}
+
+func (c *offlineCache) updateActivity(ctx context.Context, scope string, id int64, body []byte) (api.Activity, error) {
+	var req api.UpdateActivityJSONRequestBody
+	if err := json.Unmarshal(body, &req); err != nil {
+		return api.Activity{}, err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Activity{}, err
+	}
+	defer tx.Rollback()
+
+	var activity api.SyncActivity
+	if err := c.getEntity(ctx, tx, scope, api.ChangeEntityTypeActivity, id, &activity); err != nil {
+		return api.Activity{}, err
+	}
+
+	description := ""
+	if v := req.Description; v != nil {
+		description = *v
+	}
+
+	activity.Date = &req.Date
+	activity.Description = &description
+	activity.Name = &req.Name
+
+	if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeActivity, id, *activity.ContactId, activity); err != nil {
+		return api.Activity{}, err
+	}
+
+	if err := c.enqueue(ctx, tx, scope, api.SyncMutation{
+		EntityType: api.ChangeEntityTypeActivity,
+		Operation:  api.Update,
+		Activity: &api.SyncActivityData{
+			Date:        req.Date,
+			Description: req.Description,
+			Name:        req.Name,
+		},
+	}, id, *activity.ContactId); err != nil {
+		return api.Activity{}, err
+	}
+
+	return api.Activity{
+		Date:        activity.Date,
+		Description: activity.Description,
+		Id:          activity.Id,
+		Name:        activity.Name,
+	}, tx.Commit()
+}
+
+func (c *offlineCache) createJournalEntry(ctx context.Context, scope string, body []byte) (api.JournalEntry, error) {
+	var req api.CreateJournalEntryJSONRequestBody
+	if err := json.Unmarshal(body, &req); err != nil {
+		return api.JournalEntry{}, err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.JournalEntry{}, err
+	}
+	defer tx.Rollback()
+
+	id, err := c.getTemporaryID(ctx, tx, scope, api.ChangeEntityTypeJournalEntry)
+	if err != nil {
+		return api.JournalEntry{}, err
+	}
+
+	var (
+		date      = time.Now()
+		encrypted = req.Encryption != nil
+	)
+	entry := api.JournalEntry{
+		Body:       &req.Body,
+		Date:       &date,
+		Encrypted:  &encrypted,
+		Encryption: req.Encryption,
+		Id:         &id,
+		Rating:     &req.Rating,
+		Title:      &req.Title,
+	}
+
+	if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeJournalEntry, id, 0, entry); err != nil {
+		return api.JournalEntry{}, err
+	}
+
+	if err := c.enqueue(ctx, tx, scope, api.SyncMutation{
+		EntityType: api.ChangeEntityTypeJournalEntry,
+		Operation:  api.Create,
+		JournalEntry: &api.SyncJournalEntryData{
+			Body:       req.Body,
+			Encryption: req.Encryption,
+			Rating:     req.Rating,
+			Title:      req.Title,
+		},
+	}, id, 0); err != nil {
+		return api.JournalEntry{}, err
+	}
+
+	return entry, tx.Commit()
+}
+
+func (c *offlineCache) updateJournalEntry(ctx context.Context, scope string, id int64, body []byte) (api.JournalEntry, error) {
+	var req api.UpdateJournalEntryJSONRequestBody
+	if err := json.Unmarshal(body, &req); err != nil {
+		return api.JournalEntry{}, err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.JournalEntry{}, err
+	}
+	defer tx.Rollback()
+
+	var entry api.JournalEntry
+	if err := c.getEntity(ctx, tx, scope, api.ChangeEntityTypeJournalEntry, id, &entry); err != nil {
+		return api.JournalEntry{}, err
+	}
+
+	encrypted := req.Encryption != nil
+
+	entry.Body = &req.Body
+	entry.Encrypted = &encrypted
+	entry.Encryption = req.Encryption
+	entry.Rating = &req.Rating
+	entry.Title = &req.Title
+
+	if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeJournalEntry, id, 0, entry); err != nil {
+		return api.JournalEntry{}, err
+	}
+
+	if err := c.enqueue(ctx, tx, scope, api.SyncMutation{
+		EntityType: api.ChangeEntityTypeJournalEntry,
+		Operation:  api.Update,
+		JournalEntry: &api.SyncJournalEntryData{
+			Body:       req.Body,
+			Encryption: req.Encryption,
+			Rating:     req.Rating,
+			Title:      req.Title,
+		},
+	}, id, 0); err != nil {
+		return api.JournalEntry{}, err
+	}
+
+	return entry, tx.Commit()
+}
+
+func (c *offlineCache) deleteEntity(ctx context.Context, scope string, entityType api.ChangeEntityType, id int64) (int64, error) {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback()
+
+	var contactID int64
+	if err := tx.QueryRowContext(
+		ctx,
+		`select contact_id from entities where scope = ? and entity_type = ? and id = ?`,
+		scope,
+		entityType,
+		id,
+	).Scan(&contactID); err != nil {
+		return -1, err
+	}
+
+	if err := c.removeEntity(ctx, tx, scope, entityType, id); err != nil {
+		return -1, err
+	}
+
+	if err := c.enqueue(ctx, tx, scope, api.SyncMutation{
+		EntityType: entityType,
+		Operation:  api.Delete,
+	}, id, contactID); err != nil {
+		return -1, err
+	}
+
+	return id, tx.Commit()
+}
+
+// sync replays the outbox of a space and then fetches the changes that were made to it since the last sync.
+// It returns the mutations that conflict with changes made by other clients, which stay in the outbox until
+// they are resolved, and the amount of mutations that were dropped because they can't be applied anymore.
+func (c *offlineCache) sync(ctx context.Context, client *api.ClientWithResponses, space *api.SpaceSelector) (conflicts []offlineConflict, dropped int, err error) {
+	c.syncLock.Lock()
+	defer c.syncLock.Unlock()
+
+	scope, ok := c.getScope(space)
+	if !ok {
+		return nil, 0, nil
+	}
+
+	log := c.log.With("space", space)
+
+	for {
+		entries, err := c.getOutbox(ctx, scope, false)
+		if err != nil {
+			return nil, dropped, err
+		}
+
+		if len(entries) == 0 {
+			break
+		}
+
+		batch := []outboxEntry{}
+		for _, entry := range entries {
+			// Debts and activities of contacts that were created offline can only be sent once the contact's ID is known
+			if entry.contactID < 0 {
+				break
+			}
+
+			batch = append(batch, entry)
+		}
+
+		if len(batch) == 0 {
+			log.Debug("Dropping mutation for contact that could not be created", "entityType", entries[0].entityType, "contactID", entries[0].contactID)
+
+			if _, err := c.db.ExecContext(ctx, `delete from outbox where id = ?`, entries[0].id); err != nil {
+				return nil, dropped, err
+			}
+
+			dropped++
+
+			continue
+		}
+
+		mutations := []api.SyncMutation{}
+		for _, entry := range batch {
+			mutation := entry.mutation
+			if mutation.Operation == api.Create {
+				switch entry.entityType {
+				case api.ChangeEntityTypeDebt:
+					mutation.Debt.ContactId = &entry.contactID
+
+				case api.ChangeEntityTypeActivity:
+					mutation.Activity.ContactId = &entry.contactID
+				}
+			}
+
+			mutations = append(mutations, mutation)
+		}
+
+		log.Debug("Replaying mutations", "len", len(mutations))
+
+		res, err := client.PostSyncWithResponse(ctx, &api.PostSyncParams{Space: space}, api.PostSyncJSONRequestBody{
+			Mutations: mutations,
+		})
+		if err != nil {
+			return nil, dropped, err
+		}
+
+		log.Debug("Replayed mutations", "status", res.StatusCode())
+
+		if res.StatusCode() != http.StatusOK {
+			return nil, dropped, errors.Join(errCouldNotSyncMutations, errors.New(res.Status()))
+		}
+
+		if len(*res.JSON200) != len(batch) {
+			return nil, dropped, errUnexpectedSyncResults
+		}
+
+		for i, result := range *res.JSON200 {
+			if err := c.applySyncMutationResult(ctx, scope, batch[i], result); err != nil {
+				return nil, dropped, err
+			}
+
+			if result.Status == api.SyncMutationStatusNotFound || result.Status == api.SyncMutationStatusInvalid {
+				dropped++
+			}
+		}
+	}
+
+	if err := c.pull(ctx, client, scope, space); err != nil {
+		return nil, dropped, err
+	}
+
+	conflictingEntries, err := c.getOutbox(ctx, scope, true)
+	if err != nil {
+		return nil, dropped, err
+	}
+
+	for _, entry := range conflictingEntries {
+		conflicts = append(conflicts, offlineConflict{
+			ID:       entry.id,
+			Mutation: entry.mutation,
+		})
+	}
+
+	return conflicts, dropped, nil
+}
+
+func (c *offlineCache) getOutbox(ctx context.Context, scope string, conflict bool) ([]outboxEntry, error) {
+	rows, err := c.db.QueryContext(
+		ctx,
+		`select id, entity_type, entity_id, contact_id, mutation from outbox where scope = ? and conflict = ? order by id limit ?`,
+		scope,
+		conflict,
+		maxSyncMutations,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []outboxEntry{}
+	for rows.Next() {
+		var (
+			entry       outboxEntry
+			rawMutation []byte
+		)
+		if err := rows.Scan(&entry.id, &entry.entityType, &entry.entityID, &entry.contactID, &rawMutation); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(rawMutation, &entry.mutation); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// applySyncMutationResult removes applied or inapplicable mutations from the outbox and marks conflicting ones. Once
+// an entity that was created offline has been applied, its temporary ID is replaced with the one from the server.
+func (c *offlineCache) applySyncMutationResult(ctx context.Context, scope string, entry outboxEntry, result api.SyncMutationResult) error {
+	log := c.log.With("entityType", entry.entityType, "operation", entry.mutation.Operation, "id", entry.entityID, "status", result.Status)
+
+	if result.Status == api.SyncMutationStatusConflict {
+		log.Debug("Mutation conflicts with changes from other clients")
+
+		_, err := c.db.ExecContext(ctx, `update outbox set conflict = 1 where id = ?`, entry.id)
+
+		return err
+	}
+
+	if result.Status != api.SyncMutationStatusApplied {
+		detail := ""
+		if v := result.Detail; v != nil {
+			detail = *v
+		}
+
+		log.Warn("Dropping mutation that could not be applied", "detail", detail)
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `delete from outbox where id = ?`, entry.id); err != nil {
+		return err
+	}
+
+	if entry.mutation.Operation == api.Create {
+		// The entity is fetched again with its new ID after replaying the outbox
+		if err := c.removeEntity(ctx, tx, scope, entry.entityType, entry.entityID); err != nil {
+			return err
+		}
+
+		if result.Status == api.SyncMutationStatusApplied && result.Id != nil && entry.entityType == api.ChangeEntityTypeContact {
+			if _, err := tx.ExecContext(
+				ctx,
+				`update outbox set contact_id = ? where scope = ? and contact_id = ?`,
+				*result.Id,
+				scope,
+				entry.entityID,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// pull fetches the changes to a space since the last sync; if the space has never been synced,
+// all of its entities are fetched and replace the cached ones
+func (c *offlineCache) pull(ctx context.Context, client *api.ClientWithResponses, scope string, space *api.SpaceSelector) error {
+	cursor, ok, err := c.getCursor(ctx, c.db, scope)
+	if err != nil {
+		return err
+	}
+
+	params := &api.GetSyncParams{Space: space}
+	if ok {
+		params.Since = &cursor
+	}
+
+	log := c.log.With("space", space, "since", cursor)
+
+	log.Debug("Pulling changes")
+
+	res, err := client.GetSyncWithResponse(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("Pulled changes", "status", res.StatusCode())
+
+	if res.StatusCode() != http.StatusOK {
+		return errors.New(res.Status())
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Entities that were created offline are kept until their creation has been replayed
+	if !ok {
+		if _, err := tx.ExecContext(ctx, `delete from entities where scope = ? and id > 0`, scope); err != nil {
+			return err
+		}
+	}
+
+	for _, contact := range res.JSON200.Contacts {
+		if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeContact, *contact.Id, 0, contact); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range res.JSON200.JournalEntries {
+		if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeJournalEntry, *entry.Id, 0, entry); err != nil {
+			return err
+		}
+	}
+
+	for _, debt := range res.JSON200.Debts {
+		if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeDebt, *debt.Id, *debt.ContactId, debt); err != nil {
+			return err
+		}
+	}
+
+	for _, activity := range res.JSON200.Activities {
+		if err := c.putEntity(ctx, tx, scope, api.ChangeEntityTypeActivity, *activity.Id, *activity.ContactId, activity); err != nil {
+			return err
+		}
+	}
+
+	for _, tombstone := range res.JSON200.Deleted {
+		if err := c.removeEntity(ctx, tx, scope, tombstone.EntityType, tombstone.Id); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`insert into cursors (scope, cursor) values (?, ?) on conflict (scope) do update set cursor = excluded.cursor`,
+		scope,
+		res.JSON200.Cursor,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// resolveConflict keeps or discards a conflicting mutation. Kept mutations are re-based onto the latest cursor,
+// so they overwrite the changes from other clients when they are replayed during the next sync.
+func (c *offlineCache) resolveConflict(ctx context.Context, id int64, keep bool) error {
+	if !keep {
+		_, err := c.db.ExecContext(ctx, `delete from outbox where id = ?`, id)
+
+		return err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var (
+		scope       string
+		rawMutation []byte
+	)
+	if err := tx.QueryRowContext(ctx, `select scope, mutation from outbox where id = ?`, id).Scan(&scope, &rawMutation); err != nil {
+		return err
+	}
+
+	var mutation api.SyncMutation
+	if err := json.Unmarshal(rawMutation, &mutation); err != nil {
+		return err
+	}
+
+	cursor, _, err := c.getCursor(ctx, tx, scope)
+	if err != nil {
+		return err
+	}
+	mutation.BaseCursor = &cursor
+
+	if rawMutation, err = json.Marshal(mutation); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `update outbox set mutation = ?, conflict = 0 where id = ?`, rawMutation, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// clear removes all cached data and pending mutations, e.g. after the user signed out
+func (c *offlineCache) clear(ctx context.Context) error {
+	c.setSession(userData{}, "")
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"entities", "cursors", "responses", "outbox"} {
+		if _, err := tx.ExecContext(ctx, `delete from `+table); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func getConflictLabel(conflict offlineConflict) string {
+	switch conflict.Mutation.EntityType {
+	case api.ChangeEntityTypeContact:
+		if v := conflict.Mutation.Contact; v != nil {
+			return strings.TrimSpace(v.FirstName + " " + v.LastName)
+		}
+
+		return L("A contact")
+
+	case api.ChangeEntityTypeActivity:
+		if v := conflict.Mutation.Activity; v != nil {
+			return v.Name
+		}
+
+		return L("An activity")
+
+	case api.ChangeEntityTypeDebt:
+		return L("A debt")
+
+	default:
+		return L("A journal entry")
+	}
+}
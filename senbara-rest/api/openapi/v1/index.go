@@ -8,7 +8,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
-	"strings"
+	"sync"
 
 	_ "github.com/lib/pq"
 	"github.com/rs/cors"
@@ -17,7 +17,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3filter"
 	middleware "github.com/oapi-codegen/nethttp-middleware"
 	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
-	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/bootstrap"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/controllers"
 )
@@ -25,12 +25,72 @@ import (
 //go:embed code.tar.gz
 var Code []byte
 
-var (
-	p *persisters.Persister
-	a *authn.Authner
-	c *controllers.Controller
-	s *openapi3.T
-)
+// serverless are the dependencies of the serverless handler, which are read once; the services are built on the
+// first request
+type serverless struct {
+	log          *slog.Logger
+	config       bootstrap.Config
+	spec         *openapi3.T
+	bootstrapper *bootstrap.Bootstrapper[*controllers.Controller]
+}
+
+var getServerless = sync.OnceValues(func() (serverless, error) {
+	config := bootstrap.LoadEnvConfig()
+
+	opts := &slog.HandlerOptions{}
+	if config.Verbose {
+		opts.Level = slog.LevelDebug
+	}
+	log := slog.New(slog.NewJSONHandler(os.Stderr, opts))
+
+	s, err := api.GetSwagger()
+	if err != nil {
+		return serverless{}, err
+	}
+
+	return serverless{
+		log:    log,
+		config: config,
+		spec:   s,
+		bootstrapper: bootstrap.NewBootstrapper(log, config, func(ctx context.Context, services bootstrap.Services) (*controllers.Controller, error) {
+			return NewController(slog.New(log.Handler().WithGroup("controller")), config, services, s), nil
+		}),
+	}, nil
+})
+
+// NewController creates the REST API's controller from the shared services
+func NewController(
+	log *slog.Logger,
+
+	config bootstrap.Config,
+	services bootstrap.Services,
+	s *openapi3.T,
+) *controllers.Controller {
+	return controllers.NewController(
+		log,
+
+		services.Persister,
+		services.Authner,
+
+		s,
+
+		config.OIDCIssuers[0],
+		config.OIDCDcrInitialAccessTokenPortalURL,
+		config.OIDCAudience,
+
+		config.PrivacyURL,
+		config.TOSURL,
+		config.ImprintURL,
+
+		config.ContactName,
+		config.ContactEmail,
+
+		config.ServerURL,
+		config.ServerDescription,
+
+		Code,
+	)
+}
 
 func SenbaraRESTHandler(
 	w http.ResponseWriter,
@@ -42,6 +102,9 @@ func SenbaraRESTHandler(
 	c *controllers.Controller,
 	s *openapi3.T,
 ) {
+	// The request validator authenticates the request first, and the handler reuses its result
+	r = authn.WithAuthentication(r)
+
 	mux := http.NewServeMux()
 
 	mux.Handle(
@@ -50,6 +113,7 @@ func SenbaraRESTHandler(
 			s,
 			&middleware.Options{
 				SilenceServersWarning: true,
+				ErrorHandlerWithOpts:  c.HandleRequestValidationError,
 				Options: openapi3filter.Options{
 					AuthenticationFunc: func(ctx context.Context, ai *openapi3filter.AuthenticationInput) error {
 						_, err := c.Authenticate(r)
@@ -60,8 +124,13 @@ func SenbaraRESTHandler(
 			},
 		)(
 			api.Handler(
-				api.NewStrictHandler(c, []api.StrictMiddlewareFunc{
+				api.NewStrictHandlerWithOptions(c, []api.StrictMiddlewareFunc{
 					c.Authorize,
+					c.SetOperation,
+					c.Trace,
+				}, api.StrictHTTPServerOptions{
+					RequestErrorHandlerFunc:  c.HandleRequestError,
+					ResponseErrorHandlerFunc: c.HandleResponseError,
 				}),
 			),
 		),
@@ -73,7 +142,7 @@ func SenbaraRESTHandler(
 		cors.New(cors.Options{
 			AllowedOrigins:   o,
 			AllowCredentials: true,
-			AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodDelete, http.MethodPut},
+			AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodDelete, http.MethodPut, http.MethodPatch},
 			AllowedHeaders:   []string{"authorization"},
 			Debug:            log.Enabled(ctx, slog.LevelDebug),
 			Logger:           slog.NewLogLogger(log.Handler(), slog.LevelDebug),
@@ -85,105 +154,18 @@ func SenbaraRESTHandler(
 func Handler(w http.ResponseWriter, r *http.Request) {
 	r.URL.Path = r.URL.Query().Get("path")
 
-	opts := &slog.HandlerOptions{}
-	if os.Getenv("VERBOSE") == "true" {
-		opts.Level = slog.LevelDebug
-	}
-	log := slog.New(slog.NewJSONHandler(os.Stderr, opts))
-
-	if p == nil {
-		p = persisters.NewPersister(slog.New(log.Handler().WithGroup("persister")), os.Getenv("POSTGRES_URL"))
-
-		if err := p.Init(r.Context()); err != nil {
-			panic(err)
-		}
-	}
-
-	if a == nil {
-		o, err := authn.DiscoverOIDCProviderConfiguration(
-			r.Context(),
-
-			slog.New(log.Handler().WithGroup("oidcDiscovery")),
-
-			strings.TrimSuffix(os.Getenv("OIDC_ISSUER"), "/")+authn.OIDCWellKnownURLSuffix,
-		)
-		if err != nil {
-			panic(err)
-		}
-
-		a = authn.NewAuthner(
-			slog.New(log.Handler().WithGroup("authner")),
-
-			o.Issuer,
-			o.EndSessionEndpoint,
+	sl, err := getServerless()
+	if err != nil {
+		controllers.HandleUnavailable(w, r)
 
-			os.Getenv("OIDC_CLIENT_ID"),
-			os.Getenv("OIDC_REDIRECT_URL"),
-		)
-
-		if err := a.Init(r.Context()); err != nil {
-			panic(err)
-		}
-	}
-
-	if s == nil {
-		var err error
-		s, err = api.GetSwagger()
-		if err != nil {
-			panic(err)
-		}
+		return
 	}
 
-	if c == nil {
-		var (
-			contactName  = os.Getenv("CONTACT_NAME")
-			contactEmail = os.Getenv("CONTACT_EMAIL")
-
-			serverURL         = os.Getenv("SERVER_URL")
-			serverDescription = os.Getenv("SERVER_DESCRIPTION")
-		)
-		if contactName == "" {
-			contactName = "Felicitas Pojtinger"
-		}
-		if contactEmail == "" {
-			contactEmail = "felicitas@pojtinger.com"
-		}
-
-		if serverURL == "" {
-			serverURL = "http://localhost:1337/"
-		}
-		if serverDescription == "" {
-			serverDescription = "Local development server"
-		}
-
-		c = controllers.NewController(
-			slog.New(log.Handler().WithGroup("controller")),
-
-			p,
-			a,
-
-			s,
-
-			os.Getenv("OIDC_ISSUER"),
-			os.Getenv("OIDC_DCR_INITIAL_ACCESS_TOKEN_PORTAL_URL"),
+	c, _, err := sl.bootstrapper.Get(r.Context())
+	if err != nil {
+		controllers.HandleUnavailable(w, r)
 
-			os.Getenv("PRIVACY_URL"),
-			os.Getenv("TOS_URL"),
-			os.Getenv("IMPRINT_URL"),
-
-			contactName,
-			contactEmail,
-
-			serverURL,
-			serverDescription,
-
-			Code,
-		)
-	}
-
-	o := []string{}
-	if v := os.Getenv("CORS_ORIGINS"); v != "" {
-		o = strings.Split(v, ",")
+		return
 	}
 
 	SenbaraRESTHandler(
@@ -191,9 +173,9 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 		r,
 
 		r.Context(),
-		slog.New(log.Handler().WithGroup("handler")),
-		o,
+		slog.New(sl.log.Handler().WithGroup("handler")),
+		sl.config.CORSOrigins,
 		c,
-		s,
+		sl.spec,
 	)
 }
@@ -9,10 +9,15 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/adrg/xdg"
-	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
-	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/admin"
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn/oidctest"
+	"github.com/pojntfx/senbara/senbara-common/pkg/bootstrap"
+	"github.com/pojntfx/senbara/senbara-common/pkg/server"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"github.com/pojntfx/senbara/senbara-common/pkg/webhooks"
 	v1 "github.com/pojntfx/senbara/senbara-rest/api/openapi/v1"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/controllers"
@@ -20,28 +25,22 @@ import (
 	"github.com/spf13/viper"
 )
 
-var (
-	errMissingOIDCIssuer = errors.New("missing OIDC issuer")
-	errMissingPrivacyURL = errors.New("missing privacy policy URL")
-	errMissingTOSURL     = errors.New("missing terms of service URL")
-	errMissingImprintURL = errors.New("missing imprint URL")
-)
-
 const (
-	verboseKey                            = "verbose"
-	configKey                             = "config"
-	laddrKey                              = "laddr"
-	pgaddrKey                             = "pgaddr"
-	oidcIssuerKey                         = "oidc-issuer"
-	oidcDcrInitialAccessTokenPortalUrlKey = "oidc-dcr-initial-access-token-portal-url"
-	corsOriginsKey                        = "cors-origins"
-	privacyURLKey                         = "privacy-url"
-	tosURLKey                             = "tos-url"
-	imprintURLKey                         = "imprint-url"
-	contactNameKey                        = "contact-name"
-	contactEmailKey                       = "contact-email"
-	serverURLKey                          = "server-url"
-	serverDescriptionKey                  = "server-description"
+	configKey               = "config"
+	laddrKey                = "laddr"
+	adminLaddrKey           = "admin-laddr"
+	tlsCertKey              = "tls-cert"
+	tlsKeyKey               = "tls-key"
+	readTimeoutKey          = "read-timeout"
+	writeTimeoutKey         = "write-timeout"
+	idleTimeoutKey          = "idle-timeout"
+	shutdownTimeoutKey      = "shutdown-timeout"
+	webhooksPollIntervalKey = "webhooks-poll-interval"
+	devOIDCKey              = "dev-oidc"
+	devOIDCLaddrKey         = "dev-oidc-laddr"
+	devOIDCUsersKey         = "dev-oidc-users"
+	otelExporterKey         = "otel-exporter"
+	otelEndpointKey         = "otel-endpoint"
 )
 
 func main() {
@@ -56,7 +55,7 @@ For more information, please visit https://github.com/pojntfx/senbara.`,
 			defer cancel()
 
 			opts := &slog.HandlerOptions{}
-			if viper.GetBool(verboseKey) {
+			if viper.GetBool(bootstrap.VerboseKey) {
 				opts.Level = slog.LevelDebug
 			}
 			log := slog.New(slog.NewJSONHandler(os.Stderr, opts))
@@ -74,7 +73,7 @@ For more information, please visit https://github.com/pojntfx/senbara.`,
 				}
 			}
 
-			if v := os.Getenv("PORT"); v != "" {
+			if v := os.Getenv("PORT"); v != "" && !strings.HasPrefix(viper.GetString(laddrKey), server.UnixPrefix) {
 				log.Info("Using port from PORT env variable")
 
 				la, err := net.ResolveTCPAddr("tcp", viper.GetString(laddrKey))
@@ -92,113 +91,193 @@ For more information, please visit https://github.com/pojntfx/senbara.`,
 				viper.Set(laddrKey, la.String())
 			}
 
-			if !viper.IsSet(oidcIssuerKey) {
-				return errMissingOIDCIssuer
+			config := bootstrap.LoadConfig(viper.GetViper())
+
+			shutdownTracing, err := telemetry.InitTracing(
+				ctx,
+
+				slog.New(log.Handler().WithGroup("telemetry")),
+
+				cmd.Use,
+				viper.GetString(otelExporterKey),
+				viper.GetString(otelEndpointKey),
+			)
+			if err != nil {
+				return err
 			}
+			defer shutdownTracing(context.Background())
 
-			if !viper.IsSet(privacyURLKey) {
-				return errMissingPrivacyURL
+			adm := admin.NewAdmin(slog.New(log.Handler().WithGroup("admin")))
+
+			if viper.GetBool(devOIDCKey) {
+				users := []oidctest.User{}
+				for _, rawUser := range viper.GetStringSlice(devOIDCUsersKey) {
+					u, err := oidctest.ParseUser(rawUser)
+					if err != nil {
+						return err
+					}
+
+					users = append(users, u)
+				}
+
+				i, err := oidctest.Listen(
+					slog.New(log.Handler().WithGroup("devOIDC")),
+
+					viper.GetString(devOIDCLaddrKey),
+					0,
+
+					users,
+					nil,
+				)
+				if err != nil {
+					return err
+				}
+				defer i.Close()
+
+				config.OIDCIssuers = []string{i.URL()}
 			}
 
-			if !viper.IsSet(tosURLKey) {
-				return errMissingTOSURL
+			if config.OIDCAudience == "" {
+				log.Warn("No OIDC audience configured, accepting ID tokens for any client of the OIDC issuer as bearer tokens")
 			}
 
-			if !viper.IsSet(imprintURLKey) {
-				return errMissingImprintURL
+			s, err := api.GetSwagger()
+			if err != nil {
+				return err
 			}
 
-			p := persisters.NewPersister(slog.New(log.Handler().WithGroup("persister")), viper.GetString(pgaddrKey))
+			b := bootstrap.NewBootstrapper(log, config, func(ctx context.Context, services bootstrap.Services) (*controllers.Controller, error) {
+				return v1.NewController(slog.New(log.Handler().WithGroup("controller")), config, services, s), nil
+			})
+			b.EnableMetrics(adm.Registerer())
 
-			if err := p.Init(ctx); err != nil {
+			c, services, err := b.Init(ctx)
+			if err != nil {
 				return err
 			}
 
-			o, err := authn.DiscoverOIDCProviderConfiguration(
-				ctx,
-
-				slog.New(log.Handler().WithGroup("oidcDiscovery")),
+			adm.AddCheck("database", services.Persister.Ping)
+			adm.AddCheck("oidc", services.Authner.Ready)
 
-				strings.TrimSuffix(viper.GetString(oidcIssuerKey), "/")+authn.OIDCWellKnownURLSuffix,
-			)
-			if err != nil {
+			if err := adm.Init(ctx); err != nil {
 				return err
 			}
 
-			a := authn.NewAuthner(
-				slog.New(log.Handler().WithGroup("authner")),
+			// The dispatcher stops once the servers have shut down; deliveries that are interrupted are retried
+			if pollInterval := viper.GetDuration(webhooksPollIntervalKey); pollInterval > 0 {
+				d := webhooks.NewDispatcher(
+					slog.New(log.Handler().WithGroup("webhooks")),
 
-				o.Issuer,
-				o.EndSessionEndpoint,
+					services.Persister,
 
-				"",
-				"",
-			)
+					pollInterval,
+				)
+				d.EnableMetrics(adm.Registerer())
 
-			if err := a.Init(ctx); err != nil {
-				return err
+				go func() {
+					if err := d.Run(ctx); err != nil {
+						log.Warn("Could not deliver webhooks", "err", err)
+					}
+				}()
 			}
 
-			s, err := api.GetSwagger()
+			listeners, err := server.SystemdListeners()
 			if err != nil {
 				return err
 			}
 
-			c := controllers.NewController(
-				slog.New(log.Handler().WithGroup("controller")),
-
-				p,
-				a,
-
-				s,
+			options := server.Options{
+				ReadTimeout:  viper.GetDuration(readTimeoutKey),
+				WriteTimeout: viper.GetDuration(writeTimeoutKey),
+				IdleTimeout:  viper.GetDuration(idleTimeoutKey),
+			}
 
-				viper.GetString(oidcIssuerKey),
-				viper.GetString(oidcDcrInitialAccessTokenPortalUrlKey),
+			srvOptions := options
+			srvOptions.TLSCertFile = viper.GetString(tlsCertKey)
+			srvOptions.TLSKeyFile = viper.GetString(tlsKeyKey)
+
+			srv := server.NewServer(
+				slog.New(log.Handler().WithGroup("server")),
+
+				viper.GetString(laddrKey),
+				telemetry.Instrument(adm.Instrument(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					v1.SenbaraRESTHandler(
+						w,
+						r,
+
+						r.Context(),
+						slog.New(log.Handler().WithGroup("handler")),
+						config.CORSOrigins,
+						c,
+						s,
+					)
+				}))),
+				srvOptions,
+			)
 
-				viper.GetString(privacyURLKey),
-				viper.GetString(tosURLKey),
-				viper.GetString(imprintURLKey),
+			// The first socket passed by systemd is used for requests and the second one for admin requests
+			if len(listeners) > 0 {
+				srv.UseListener(listeners[0])
+			}
 
-				viper.GetString(contactNameKey),
-				viper.GetString(contactEmailKey),
+			servers := []*server.Server{srv}
+			if adminLaddr := viper.GetString(adminLaddrKey); adminLaddr != "" || len(listeners) > 1 {
+				adminSrv := server.NewServer(
+					slog.New(log.Handler().WithGroup("adminServer")),
 
-				viper.GetString(serverURLKey),
-				viper.GetString(serverDescriptionKey),
+					adminLaddr,
+					adm.Handler(),
+					options,
+				)
 
-				v1.Code,
-			)
+				if len(listeners) > 1 {
+					adminSrv.UseListener(listeners[1])
+				}
 
-			log.Info("Listening", "laddr", viper.GetString(laddrKey))
+				servers = append(servers, adminSrv)
+			}
 
-			panic(http.ListenAndServe(viper.GetString(laddrKey), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				v1.SenbaraRESTHandler(
-					w,
-					r,
+			for _, sv := range servers {
+				if err := sv.Init(ctx); err != nil {
+					return err
+				}
+			}
 
-					r.Context(),
-					slog.New(log.Handler().WithGroup("handler")),
-					viper.GetStringSlice(corsOriginsKey),
-					c,
-					s,
-				)
-			})))
+			return server.Run(ctx, log, viper.GetDuration(shutdownTimeoutKey), servers...)
 		},
 	}
 
-	cmd.PersistentFlags().BoolP(verboseKey, "v", false, "Whether to enable verbose logging")
+	cmd.PersistentFlags().BoolP(bootstrap.VerboseKey, "v", false, "Whether to enable verbose logging")
 	cmd.PersistentFlags().StringP(configKey, "c", "", "Config file to use (by default "+cmd.Use+".yaml in the XDG config directory is read if it exists)")
-	cmd.PersistentFlags().StringP(laddrKey, "l", ":1337", "Listen address (port can also be set with `PORT` env variable)")
-	cmd.PersistentFlags().StringP(pgaddrKey, "p", "postgresql://postgres@localhost:5432/senbara?sslmode=disable", "Database address")
-	cmd.PersistentFlags().String(oidcIssuerKey, "", "OIDC Issuer (e.g. https://heuristic-rhodes-wqkaaxzmwj.projects.oryapis.com)")
-	cmd.PersistentFlags().String(oidcDcrInitialAccessTokenPortalUrlKey, "", "OIDC DCR initial access token portal URL")
-	cmd.PersistentFlags().StringArray(corsOriginsKey, []string{}, "CORS origins to allow")
-	cmd.PersistentFlags().String(privacyURLKey, "", "Privacy policy URL")
-	cmd.PersistentFlags().String(tosURLKey, "", "Terms of service URL")
-	cmd.PersistentFlags().String(imprintURLKey, "", "Imprint URL")
-	cmd.PersistentFlags().String(contactNameKey, "Felicitas Pojtinger", "Contact name")
-	cmd.PersistentFlags().String(contactEmailKey, "felicitas@pojtinger.com", "Contact email")
-	cmd.PersistentFlags().String(serverURLKey, "http://localhost:1337/", "Server URL")
-	cmd.PersistentFlags().String(serverDescriptionKey, "Local development server", "Server description")
+	cmd.PersistentFlags().StringP(laddrKey, "l", ":1337", "Listen address (prefix with unix: to listen on a Unix socket; port can also be set with `PORT` env variable)")
+	cmd.PersistentFlags().String(adminLaddrKey, ":1340", "Listen address for the health check (/healthz and /readyz) and metrics (/metrics) endpoints (disabled if empty)")
+	cmd.PersistentFlags().String(tlsCertKey, "", "TLS certificate file to serve HTTPS with (reloaded when it changes; if empty, HTTP is served)")
+	cmd.PersistentFlags().String(tlsKeyKey, "", "TLS key file for the TLS certificate")
+	cmd.PersistentFlags().Duration(readTimeoutKey, 5*time.Minute, "Maximum duration for reading a request, including its body (e.g. an import) (0 to disable)")
+	cmd.PersistentFlags().Duration(writeTimeoutKey, 5*time.Minute, "Maximum duration for writing a response (e.g. an export) (0 to disable)")
+	cmd.PersistentFlags().Duration(idleTimeoutKey, 2*time.Minute, "Maximum duration to keep idle keep-alive connections open (0 to disable)")
+	cmd.PersistentFlags().Duration(shutdownTimeoutKey, time.Minute, "Maximum duration to wait for in-flight requests (e.g. imports and exports) to finish when shutting down")
+	cmd.PersistentFlags().Duration(webhooksPollIntervalKey, 5*time.Second, "Interval to check for webhook events to deliver at (0 to not deliver webhooks from this instance)")
+	cmd.PersistentFlags().StringP(bootstrap.PgaddrKey, "p", bootstrap.DefaultPgaddr, "Database address")
+	cmd.PersistentFlags().StringSlice(bootstrap.OIDCIssuerKey, []string{}, "OIDC issuers that users can sign in with, the first one is the default issuer (can be specified multiple times) (e.g. https://heuristic-rhodes-wqkaaxzmwj.projects.oryapis.com)")
+	cmd.PersistentFlags().String(bootstrap.OIDCAudienceKey, "", "OIDC audience to validate access tokens for (if not set, ID tokens are accepted instead of access tokens)")
+	cmd.PersistentFlags().String(bootstrap.OIDCIntrospectionIssuerKey, "", "OIDC issuer to introspect opaque access tokens with, opaque access tokens are never sent to other OIDC issuers (if not set, the default issuer is used)")
+	cmd.PersistentFlags().String(bootstrap.OIDCIntrospectionClientIDKey, "", "OIDC client ID to authenticate with when introspecting opaque access tokens with the OIDC introspection issuer")
+	cmd.PersistentFlags().String(bootstrap.OIDCIntrospectionClientSecretKey, "", "OIDC client secret to authenticate with when introspecting opaque access tokens with the OIDC introspection issuer")
+	cmd.PersistentFlags().Bool(devOIDCKey, false, "Whether to start an embedded OIDC issuer with test users for local development instead of using the OIDC issuer")
+	cmd.PersistentFlags().String(devOIDCLaddrKey, "localhost:1339", "Listen address for the embedded development OIDC issuer")
+	cmd.PersistentFlags().StringArray(devOIDCUsersKey, []string{"jane@example.com"}, "Test users for the embedded development OIDC issuer (in the format email[:unverified])")
+	cmd.PersistentFlags().String(bootstrap.OIDCDcrInitialAccessTokenPortalUrlKey, "", "OIDC DCR initial access token portal URL")
+	cmd.PersistentFlags().StringArray(bootstrap.CORSOriginsKey, []string{}, "CORS origins to allow")
+	cmd.PersistentFlags().String(bootstrap.PrivacyURLKey, "", "Privacy policy URL")
+	cmd.PersistentFlags().String(bootstrap.TOSURLKey, "", "Terms of service URL")
+	cmd.PersistentFlags().String(bootstrap.ImprintURLKey, "", "Imprint URL")
+	cmd.PersistentFlags().String(bootstrap.ContactNameKey, bootstrap.DefaultContactName, "Contact name")
+	cmd.PersistentFlags().String(bootstrap.ContactEmailKey, bootstrap.DefaultContactEmail, "Contact email")
+	cmd.PersistentFlags().String(bootstrap.ServerURLKey, bootstrap.DefaultServerURL, "Server URL")
+	cmd.PersistentFlags().String(bootstrap.ServerDescriptionKey, bootstrap.DefaultServerDescription, "Server description")
+	cmd.PersistentFlags().String(otelExporterKey, "", "Exporter for OpenTelemetry traces (otlp or stdout; if empty, tracing is disabled)")
+	cmd.PersistentFlags().String(otelEndpointKey, "http://localhost:4318", "OTLP/HTTP endpoint to export traces to if the otlp exporter is used")
 
 	if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
 		panic(err)
@@ -2,7 +2,7 @@
 
 // Package api provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
 package api
 
 import (
@@ -30,6 +30,115 @@ const (
 	OidcScopes = "oidc.Scopes"
 )
 
+// Defines values for AccessTokenScope.
+const (
+	Read  AccessTokenScope = "read"
+	Write AccessTokenScope = "write"
+)
+
+// Defines values for BatchOperationStatus.
+const (
+	BatchOperationStatusApplied    BatchOperationStatus = "applied"
+	BatchOperationStatusFailed     BatchOperationStatus = "failed"
+	BatchOperationStatusRolledBack BatchOperationStatus = "rolled_back"
+	BatchOperationStatusSkipped    BatchOperationStatus = "skipped"
+)
+
+// Defines values for ChangeEntityType.
+const (
+	ChangeEntityTypeActivity     ChangeEntityType = "activity"
+	ChangeEntityTypeContact      ChangeEntityType = "contact"
+	ChangeEntityTypeDebt         ChangeEntityType = "debt"
+	ChangeEntityTypeJournalEntry ChangeEntityType = "journal_entry"
+)
+
+// Defines values for ChangeOperation.
+const (
+	Created ChangeOperation = "created"
+	Deleted ChangeOperation = "deleted"
+	Settled ChangeOperation = "settled"
+	Updated ChangeOperation = "updated"
+)
+
+// Defines values for EncryptionEnvelopeAlgorithm.
+const (
+	Xchacha20Poly1305 EncryptionEnvelopeAlgorithm = "xchacha20-poly1305"
+)
+
+// Defines values for EncryptionEnvelopeKdf.
+const (
+	Argon2id EncryptionEnvelopeKdf = "argon2id"
+)
+
+// Defines values for ProblemType.
+const (
+	ProblemTypeBadRequest   ProblemType = "urn:senbara:problem:bad-request"
+	ProblemTypeConflict     ProblemType = "urn:senbara:problem:conflict"
+	ProblemTypeForbidden    ProblemType = "urn:senbara:problem:forbidden"
+	ProblemTypeInternal     ProblemType = "urn:senbara:problem:internal"
+	ProblemTypeNotFound     ProblemType = "urn:senbara:problem:not-found"
+	ProblemTypeUnauthorized ProblemType = "urn:senbara:problem:unauthorized"
+	ProblemTypeValidation   ProblemType = "urn:senbara:problem:validation"
+)
+
+// Defines values for SpaceInvitationRole.
+const (
+	SpaceInvitationRoleEditor SpaceInvitationRole = "editor"
+	SpaceInvitationRoleViewer SpaceInvitationRole = "viewer"
+)
+
+// Defines values for SpaceRole.
+const (
+	SpaceRoleEditor SpaceRole = "editor"
+	SpaceRoleOwner  SpaceRole = "owner"
+	SpaceRoleViewer SpaceRole = "viewer"
+)
+
+// Defines values for SyncMutationStatus.
+const (
+	SyncMutationStatusApplied  SyncMutationStatus = "applied"
+	SyncMutationStatusConflict SyncMutationStatus = "conflict"
+	SyncMutationStatusInvalid  SyncMutationStatus = "invalid"
+	SyncMutationStatusNotFound SyncMutationStatus = "not_found"
+)
+
+// Defines values for SyncOperation.
+const (
+	Create SyncOperation = "create"
+	Delete SyncOperation = "delete"
+	Update SyncOperation = "update"
+)
+
+// Defines values for WebhookEventType.
+const (
+	ContactCreated      WebhookEventType = "contact.created"
+	ContactDeleted      WebhookEventType = "contact.deleted"
+	ContactUpdated      WebhookEventType = "contact.updated"
+	DebtCreated         WebhookEventType = "debt.created"
+	DebtSettled         WebhookEventType = "debt.settled"
+	DebtUpdated         WebhookEventType = "debt.updated"
+	JournalEntryCreated WebhookEventType = "journal_entry.created"
+	JournalEntryDeleted WebhookEventType = "journal_entry.deleted"
+	JournalEntryUpdated WebhookEventType = "journal_entry.updated"
+)
+
+// AccessToken defines model for AccessToken.
+type AccessToken struct {
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	Id         *int64     `json:"id,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Name       *string    `json:"name,omitempty"`
+
+	// Scope Whether the token can only read (`read`) or also create, update and delete (`write`) data
+	Scope *AccessTokenScope `json:"scope,omitempty"`
+
+	// Token The token to send as a bearer token; only returned when the token is created
+	Token *string `json:"token,omitempty"`
+}
+
+// AccessTokenScope Whether the token can only read (`read`) or also create, update and delete (`write`) data
+type AccessTokenScope string
+
 // Activity defines model for Activity.
 type Activity struct {
 	Date        *openapi_types.Date `json:"date,omitempty"`
@@ -38,6 +147,9 @@ type Activity struct {
 	Name        *string             `json:"name,omitempty"`
 }
 
+// ActivityPatch defines model for ActivityPatch.
+type ActivityPatch = map[string]interface{}
+
 // ActivityWithContact defines model for ActivityWithContact.
 type ActivityWithContact struct {
 	ActivityId  *int64              `json:"activity_id,omitempty"`
@@ -49,6 +161,60 @@ type ActivityWithContact struct {
 	Name        *string             `json:"name,omitempty"`
 }
 
+// BatchOperation An operation of a batch; only the data of the operation's entity type is used, and deletions don't need any
+type BatchOperation struct {
+	Activity *SyncActivityData `json:"activity,omitempty"`
+	Contact  *SyncContactData  `json:"contact,omitempty"`
+
+	// ContactRef Index of an earlier operation of the batch that created the contact to create a debt or activity for, instead of its ID
+	ContactRef *int32           `json:"contact_ref,omitempty"`
+	Debt       *SyncDebtData    `json:"debt,omitempty"`
+	EntityType ChangeEntityType `json:"entity_type"`
+
+	// Id ID of the entity to update or delete
+	Id           *int64                `json:"id,omitempty"`
+	JournalEntry *SyncJournalEntryData `json:"journal_entry,omitempty"`
+	Operation    SyncOperation         `json:"operation"`
+
+	// Ref Index of an earlier operation of the batch that created the entity to update or delete, instead of its ID
+	Ref *int32 `json:"ref,omitempty"`
+}
+
+// BatchOperationResult defines model for BatchOperationResult.
+type BatchOperationResult struct {
+	// Detail Why the operation failed
+	Detail *string `json:"detail,omitempty"`
+
+	// Id ID of the created, updated or deleted entity if the batch was committed
+	Id *int64 `json:"id,omitempty"`
+
+	// Status Whether the operation was applied, failed, was rolled back because another operation failed or was skipped because an earlier operation failed
+	Status BatchOperationStatus `json:"status"`
+}
+
+// BatchOperationStatus Whether the operation was applied, failed, was rolled back because another operation failed or was skipped because an earlier operation failed
+type BatchOperationStatus string
+
+// BatchResult defines model for BatchResult.
+type BatchResult struct {
+	// Committed Whether all operations were applied
+	Committed bool                   `json:"committed"`
+	Results   []BatchOperationResult `json:"results"`
+}
+
+// Change defines model for Change.
+type Change struct {
+	EntityType ChangeEntityType `json:"entity_type"`
+	Id         int64            `json:"id"`
+	Operation  ChangeOperation  `json:"operation"`
+}
+
+// ChangeEntityType defines model for ChangeEntityType.
+type ChangeEntityType string
+
+// ChangeOperation defines model for ChangeOperation.
+type ChangeOperation string
+
 // Contact defines model for Contact.
 type Contact struct {
 	Address   *string              `json:"address,omitempty"`
@@ -69,6 +235,9 @@ type ContactData struct {
 	Entry      *Contact    `json:"entry,omitempty"`
 }
 
+// ContactPatch defines model for ContactPatch.
+type ContactPatch = map[string]interface{}
+
 // Debt defines model for Debt.
 type Debt struct {
 	Amount      *float32 `json:"amount,omitempty"`
@@ -77,6 +246,34 @@ type Debt struct {
 	Id          *int64   `json:"id,omitempty"`
 }
 
+// DebtPatch defines model for DebtPatch.
+type DebtPatch = map[string]interface{}
+
+// DebtWithContact defines model for DebtWithContact.
+type DebtWithContact struct {
+	Amount      *float32   `json:"amount,omitempty"`
+	ContactId   *int64     `json:"contact_id,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Currency    *string    `json:"currency,omitempty"`
+	DebtId      *int64     `json:"debt_id,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	FirstName   *string    `json:"first_name,omitempty"`
+	LastName    *string    `json:"last_name,omitempty"`
+}
+
+// EncryptionEnvelope Parameters required to decrypt an end-to-end encrypted title and body with a key derived from the user's passphrase
+type EncryptionEnvelope struct {
+	Algorithm EncryptionEnvelopeAlgorithm `json:"algorithm"`
+	Kdf       EncryptionEnvelopeKdf       `json:"kdf"`
+	Salt      []byte                      `json:"salt"`
+}
+
+// EncryptionEnvelopeAlgorithm defines model for EncryptionEnvelope.Algorithm.
+type EncryptionEnvelopeAlgorithm string
+
+// EncryptionEnvelopeKdf defines model for EncryptionEnvelope.Kdf.
+type EncryptionEnvelopeKdf string
+
 // IndexData defines model for IndexData.
 type IndexData struct {
 	ContactsCount       *int64 `json:"contactsCount,omitempty"`
@@ -85,11 +282,269 @@ type IndexData struct {
 
 // JournalEntry defines model for JournalEntry.
 type JournalEntry struct {
-	Body   *string    `json:"body,omitempty"`
-	Date   *time.Time `json:"date,omitempty"`
-	Id     *int64     `json:"id,omitempty"`
-	Rating *int32     `json:"rating,omitempty"`
-	Title  *string    `json:"title,omitempty"`
+	Body *string    `json:"body,omitempty"`
+	Date *time.Time `json:"date,omitempty"`
+
+	// Encrypted Whether the title and body are end-to-end encrypted
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// Encryption Parameters required to decrypt an end-to-end encrypted title and body with a key derived from the user's passphrase
+	Encryption *EncryptionEnvelope `json:"encryption,omitempty"`
+	Id         *int64              `json:"id,omitempty"`
+	Rating     *int32              `json:"rating,omitempty"`
+	Title      *string             `json:"title,omitempty"`
+}
+
+// JournalEntryPatch defines model for JournalEntryPatch.
+type JournalEntryPatch = map[string]interface{}
+
+// Problem Details of an error as defined in RFC 9457
+type Problem struct {
+	// Detail Explanation of this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Instance Path of the request that caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP status code of the response
+	Status int32 `json:"status"`
+
+	// Title Short summary of the problem type
+	Title string `json:"title"`
+
+	// Type Stable URI that identifies the type of a problem
+	Type ProblemType `json:"type"`
+}
+
+// ProblemType Stable URI that identifies the type of a problem
+type ProblemType string
+
+// Session defines model for Session.
+type Session struct {
+	// ClientId ID of the OIDC client that the session was signed in with
+	ClientId  *string    `json:"client_id,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Current Whether the request was made with this session
+	Current    *bool      `json:"current,omitempty"`
+	Id         *int64     `json:"id,omitempty"`
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	UserAgent  *string    `json:"user_agent,omitempty"`
+}
+
+// Space defines model for Space.
+type Space struct {
+	Id   *int64  `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+
+	// Personal Whether this is the authenticated user's personal space, which can't be shared or deleted
+	Personal *bool      `json:"personal,omitempty"`
+	Role     *SpaceRole `json:"role,omitempty"`
+}
+
+// SpaceInvitation defines model for SpaceInvitation.
+type SpaceInvitation struct {
+	Email     *openapi_types.Email `json:"email,omitempty"`
+	Id        *int64               `json:"id,omitempty"`
+	Role      *SpaceInvitationRole `json:"role,omitempty"`
+	SpaceId   *int64               `json:"space_id,omitempty"`
+	SpaceName *string              `json:"space_name,omitempty"`
+}
+
+// SpaceInvitationRole defines model for SpaceInvitationRole.
+type SpaceInvitationRole string
+
+// SpaceMember defines model for SpaceMember.
+type SpaceMember struct {
+	Email *openapi_types.Email `json:"email,omitempty"`
+	Id    *int64               `json:"id,omitempty"`
+	Role  *SpaceRole           `json:"role,omitempty"`
+}
+
+// SpaceRole defines model for SpaceRole.
+type SpaceRole string
+
+// SyncActivity defines model for SyncActivity.
+type SyncActivity struct {
+	ContactId   *int64              `json:"contact_id,omitempty"`
+	Date        *openapi_types.Date `json:"date,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Id          *int64              `json:"id,omitempty"`
+	Name        *string             `json:"name,omitempty"`
+}
+
+// SyncActivityData defines model for SyncActivityData.
+type SyncActivityData struct {
+	// ContactId ID of the contact to create the activity for; ignored for updates
+	ContactId   *int64             `json:"contact_id,omitempty"`
+	Date        openapi_types.Date `json:"date"`
+	Description *string            `json:"description,omitempty"`
+	Name        string             `json:"name"`
+}
+
+// SyncChangeset defines model for SyncChangeset.
+type SyncChangeset struct {
+	Activities []SyncActivity `json:"activities"`
+	Contacts   []Contact      `json:"contacts"`
+
+	// Cursor Cursor to pass to the next sync
+	Cursor         string          `json:"cursor"`
+	Debts          []SyncDebt      `json:"debts"`
+	Deleted        []SyncTombstone `json:"deleted"`
+	JournalEntries []JournalEntry  `json:"journal_entries"`
+}
+
+// SyncContactData defines model for SyncContactData.
+type SyncContactData struct {
+	Address   *string             `json:"address,omitempty"`
+	Birthday  *openapi_types.Date `json:"birthday"`
+	Email     openapi_types.Email `json:"email"`
+	FirstName string              `json:"first_name"`
+	LastName  string              `json:"last_name"`
+	Nickname  *string             `json:"nickname,omitempty"`
+	Notes     *string             `json:"notes,omitempty"`
+	Pronouns  string              `json:"pronouns"`
+}
+
+// SyncDebt defines model for SyncDebt.
+type SyncDebt struct {
+	Amount      *float32 `json:"amount,omitempty"`
+	ContactId   *int64   `json:"contact_id,omitempty"`
+	Currency    *string  `json:"currency,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Id          *int64   `json:"id,omitempty"`
+}
+
+// SyncDebtData defines model for SyncDebtData.
+type SyncDebtData struct {
+	Amount float32 `json:"amount"`
+
+	// ContactId ID of the contact to create the debt for; ignored for updates
+	ContactId   *int64  `json:"contact_id,omitempty"`
+	Currency    string  `json:"currency"`
+	Description *string `json:"description,omitempty"`
+	YouOwe      bool    `json:"you_owe"`
+}
+
+// SyncJournalEntryData defines model for SyncJournalEntryData.
+type SyncJournalEntryData struct {
+	Body string `json:"body"`
+
+	// Encryption Parameters required to decrypt an end-to-end encrypted title and body with a key derived from the user's passphrase
+	Encryption *EncryptionEnvelope `json:"encryption,omitempty"`
+	Rating     int32               `json:"rating"`
+	Title      string              `json:"title"`
+}
+
+// SyncMutation A mutation of an entity; only the data of the mutation's entity type is used, and deletions don't need any
+type SyncMutation struct {
+	Activity *SyncActivityData `json:"activity,omitempty"`
+
+	// BaseCursor Cursor of the sync that the client's copy of the entity to update or delete is based on
+	BaseCursor *string          `json:"base_cursor,omitempty"`
+	Contact    *SyncContactData `json:"contact,omitempty"`
+	Debt       *SyncDebtData    `json:"debt,omitempty"`
+	EntityType ChangeEntityType `json:"entity_type"`
+
+	// Id ID of the entity to update or delete
+	Id           *int64                `json:"id,omitempty"`
+	JournalEntry *SyncJournalEntryData `json:"journal_entry,omitempty"`
+	Operation    SyncOperation         `json:"operation"`
+}
+
+// SyncMutationResult defines model for SyncMutationResult.
+type SyncMutationResult struct {
+	// Detail Why the mutation wasn't applied
+	Detail *string `json:"detail,omitempty"`
+
+	// Id ID of the created, updated or deleted entity if the mutation was applied
+	Id     *int64             `json:"id,omitempty"`
+	Status SyncMutationStatus `json:"status"`
+}
+
+// SyncMutationStatus defines model for SyncMutationStatus.
+type SyncMutationStatus string
+
+// SyncOperation defines model for SyncOperation.
+type SyncOperation string
+
+// SyncTombstone defines model for SyncTombstone.
+type SyncTombstone struct {
+	EntityType ChangeEntityType `json:"entity_type"`
+	Id         int64            `json:"id"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt  *time.Time          `json:"created_at,omitempty"`
+	EventTypes *[]WebhookEventType `json:"event_types,omitempty"`
+	Id         *int64              `json:"id,omitempty"`
+	Url        *string             `json:"url,omitempty"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempt    *int32     `json:"attempt,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	DurationMs *int32     `json:"duration_ms,omitempty"`
+
+	// Error Why the attempt failed, or empty if the event was delivered
+	Error *string `json:"error,omitempty"`
+
+	// EventId ID of the delivered event, which is the same for all attempts to deliver it
+	EventId *int64 `json:"event_id,omitempty"`
+
+	// EventType Type of change that a webhook event is sent for
+	EventType *WebhookEventType `json:"event_type,omitempty"`
+	Id        *int64            `json:"id,omitempty"`
+
+	// StatusCode Status code of the response, or 0 if no response was received
+	StatusCode *int32 `json:"status_code,omitempty"`
+}
+
+// WebhookEventType Type of change that a webhook event is sent for
+type WebhookEventType string
+
+// SpaceSelector defines model for SpaceSelector.
+type SpaceSelector = int64
+
+// BadRequest Details of an error as defined in RFC 9457
+type BadRequest = Problem
+
+// Conflict Details of an error as defined in RFC 9457
+type Conflict = Problem
+
+// Forbidden Details of an error as defined in RFC 9457
+type Forbidden = Problem
+
+// InternalServerError Details of an error as defined in RFC 9457
+type InternalServerError = Problem
+
+// NotFound Details of an error as defined in RFC 9457
+type NotFound = Problem
+
+// Unauthorized Details of an error as defined in RFC 9457
+type Unauthorized = Problem
+
+// UnprocessableContent Details of an error as defined in RFC 9457
+type UnprocessableContent = Problem
+
+// GetActivitiesParams defines parameters for GetActivities.
+type GetActivitiesParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+
+	// ContactId Only return the activities of this contact
+	ContactId *int64 `form:"contact_id,omitempty" json:"contact_id,omitempty"`
+
+	// StartDate Only return the activities on or after this date
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Only return the activities on or before this date
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Query Only return the activities whose name, description or contact's name contain this text (case-insensitive)
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
 }
 
 // CreateActivityJSONBody defines parameters for CreateActivity.
@@ -100,6 +555,30 @@ type CreateActivityJSONBody struct {
 	Name        string             `json:"name"`
 }
 
+// CreateActivityParams defines parameters for CreateActivity.
+type CreateActivityParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// DeleteActivityParams defines parameters for DeleteActivity.
+type DeleteActivityParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// GetActivityParams defines parameters for GetActivity.
+type GetActivityParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// PatchActivityParams defines parameters for PatchActivity.
+type PatchActivityParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
 // UpdateActivityJSONBody defines parameters for UpdateActivity.
 type UpdateActivityJSONBody struct {
 	Date        openapi_types.Date `json:"date"`
@@ -107,6 +586,34 @@ type UpdateActivityJSONBody struct {
 	Name        string             `json:"name"`
 }
 
+// UpdateActivityParams defines parameters for UpdateActivity.
+type UpdateActivityParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// BackchannelLogoutFormdataBody defines parameters for BackchannelLogout.
+type BackchannelLogoutFormdataBody struct {
+	LogoutToken string `form:"logout_token" json:"logout_token"`
+}
+
+// PostBatchJSONBody defines parameters for PostBatch.
+type PostBatchJSONBody struct {
+	Operations []BatchOperation `json:"operations"`
+}
+
+// PostBatchParams defines parameters for PostBatch.
+type PostBatchParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// GetContactsParams defines parameters for GetContacts.
+type GetContactsParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
 // CreateContactJSONBody defines parameters for CreateContact.
 type CreateContactJSONBody struct {
 	Email     openapi_types.Email `json:"email"`
@@ -116,6 +623,30 @@ type CreateContactJSONBody struct {
 	Pronouns  string              `json:"pronouns"`
 }
 
+// CreateContactParams defines parameters for CreateContact.
+type CreateContactParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// DeleteContactParams defines parameters for DeleteContact.
+type DeleteContactParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// GetContactParams defines parameters for GetContact.
+type GetContactParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// PatchContactParams defines parameters for PatchContact.
+type PatchContactParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
 // UpdateContactJSONBody defines parameters for UpdateContact.
 type UpdateContactJSONBody struct {
 	Address   *string             `json:"address,omitempty"`
@@ -128,6 +659,36 @@ type UpdateContactJSONBody struct {
 	Pronouns  string              `json:"pronouns"`
 }
 
+// UpdateContactParams defines parameters for UpdateContact.
+type UpdateContactParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// GetDebtsParams defines parameters for GetDebts.
+type GetDebtsParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+
+	// ContactId Only return the debts of this contact
+	ContactId *int64 `form:"contact_id,omitempty" json:"contact_id,omitempty"`
+
+	// Currency Only return the debts in this currency (case-insensitive)
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// YouOwe Only return the debts that you owe (if true) or that are owed to you (if false)
+	YouOwe *bool `form:"you_owe,omitempty" json:"you_owe,omitempty"`
+
+	// StartDate Only return the debts created on or after this date
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Only return the debts created on or before this date
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Query Only return the debts whose description or contact's name contain this text (case-insensitive)
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+}
+
 // CreateDebtJSONBody defines parameters for CreateDebt.
 type CreateDebtJSONBody struct {
 	Amount      float32 `json:"amount"`
@@ -137,6 +698,24 @@ type CreateDebtJSONBody struct {
 	YouOwe      bool    `json:"you_owe"`
 }
 
+// CreateDebtParams defines parameters for CreateDebt.
+type CreateDebtParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// SettleDebtParams defines parameters for SettleDebt.
+type SettleDebtParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// PatchDebtParams defines parameters for PatchDebt.
+type PatchDebtParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
 // UpdateDebtJSONBody defines parameters for UpdateDebt.
 type UpdateDebtJSONBody struct {
 	Amount      float32 `json:"amount"`
@@ -145,66 +724,251 @@ type UpdateDebtJSONBody struct {
 	YouOwe      bool    `json:"you_owe"`
 }
 
+// UpdateDebtParams defines parameters for UpdateDebt.
+type UpdateDebtParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// GetEventsParams defines parameters for GetEvents.
+type GetEventsParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// GetJournalEntriesParams defines parameters for GetJournalEntries.
+type GetJournalEntriesParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
 // CreateJournalEntryJSONBody defines parameters for CreateJournalEntry.
 type CreateJournalEntryJSONBody struct {
-	Body   string `json:"body"`
-	Rating int32  `json:"rating"`
-	Title  string `json:"title"`
+	Body string `json:"body"`
+
+	// Encryption Parameters required to decrypt an end-to-end encrypted title and body with a key derived from the user's passphrase
+	Encryption *EncryptionEnvelope `json:"encryption,omitempty"`
+	Rating     int32               `json:"rating"`
+	Title      string              `json:"title"`
 }
 
-// UpdateJournalEntryJSONBody defines parameters for UpdateJournalEntry.
-type UpdateJournalEntryJSONBody struct {
-	Body   string `json:"body"`
-	Rating int32  `json:"rating"`
-	Title  string `json:"title"`
+// CreateJournalEntryParams defines parameters for CreateJournalEntry.
+type CreateJournalEntryParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
 }
 
-// ImportUserDataMultipartBody defines parameters for ImportUserData.
-type ImportUserDataMultipartBody struct {
-	UserData *openapi_types.File `json:"userData,omitempty"`
+// DeleteJournalEntryParams defines parameters for DeleteJournalEntry.
+type DeleteJournalEntryParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
 }
 
-// CreateActivityJSONRequestBody defines body for CreateActivity for application/json ContentType.
-type CreateActivityJSONRequestBody CreateActivityJSONBody
+// GetJournalEntryParams defines parameters for GetJournalEntry.
+type GetJournalEntryParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
 
-// UpdateActivityJSONRequestBody defines body for UpdateActivity for application/json ContentType.
-type UpdateActivityJSONRequestBody UpdateActivityJSONBody
+// PatchJournalEntryParams defines parameters for PatchJournalEntry.
+type PatchJournalEntryParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
 
-// CreateContactJSONRequestBody defines body for CreateContact for application/json ContentType.
-type CreateContactJSONRequestBody CreateContactJSONBody
+// UpdateJournalEntryJSONBody defines parameters for UpdateJournalEntry.
+type UpdateJournalEntryJSONBody struct {
+	Body string `json:"body"`
 
-// UpdateContactJSONRequestBody defines body for UpdateContact for application/json ContentType.
-type UpdateContactJSONRequestBody UpdateContactJSONBody
+	// Encryption Parameters required to decrypt an end-to-end encrypted title and body with a key derived from the user's passphrase
+	Encryption *EncryptionEnvelope `json:"encryption,omitempty"`
+	Rating     int32               `json:"rating"`
+	Title      string              `json:"title"`
+}
 
-// CreateDebtJSONRequestBody defines body for CreateDebt for application/json ContentType.
-type CreateDebtJSONRequestBody CreateDebtJSONBody
+// UpdateJournalEntryParams defines parameters for UpdateJournalEntry.
+type UpdateJournalEntryParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
 
-// UpdateDebtJSONRequestBody defines body for UpdateDebt for application/json ContentType.
-type UpdateDebtJSONRequestBody UpdateDebtJSONBody
+// CreateSpaceJSONBody defines parameters for CreateSpace.
+type CreateSpaceJSONBody struct {
+	Name string `json:"name"`
+}
 
-// CreateJournalEntryJSONRequestBody defines body for CreateJournalEntry for application/json ContentType.
-type CreateJournalEntryJSONRequestBody CreateJournalEntryJSONBody
+// CreateSpaceInvitationJSONBody defines parameters for CreateSpaceInvitation.
+type CreateSpaceInvitationJSONBody struct {
+	Email openapi_types.Email `json:"email"`
+	Role  SpaceInvitationRole `json:"role"`
+}
 
-// UpdateJournalEntryJSONRequestBody defines body for UpdateJournalEntry for application/json ContentType.
-type UpdateJournalEntryJSONRequestBody UpdateJournalEntryJSONBody
+// GetSummaryParams defines parameters for GetSummary.
+type GetSummaryParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
 
-// ImportUserDataMultipartRequestBody defines body for ImportUserData for multipart/form-data ContentType.
-type ImportUserDataMultipartRequestBody ImportUserDataMultipartBody
+// GetSyncParams defines parameters for GetSync.
+type GetSyncParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+	// Since Cursor returned by a previous sync
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+}
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+// PostSyncJSONBody defines parameters for PostSync.
+type PostSyncJSONBody struct {
+	Mutations []SyncMutation `json:"mutations"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
+// PostSyncParams defines parameters for PostSync.
+type PostSyncParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// CreateAccessTokenJSONBody defines parameters for CreateAccessToken.
+type CreateAccessTokenJSONBody struct {
+	Name string `json:"name"`
+
+	// Scope Whether the token can only read (`read`) or also create, update and delete (`write`) data
+	Scope AccessTokenScope `json:"scope"`
+}
+
+// DeleteUserDataParams defines parameters for DeleteUserData.
+type DeleteUserDataParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// ExportUserDataParams defines parameters for ExportUserData.
+type ExportUserDataParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// ImportUserDataMultipartBody defines parameters for ImportUserData.
+type ImportUserDataMultipartBody struct {
+	UserData *openapi_types.File `json:"userData,omitempty"`
+}
+
+// ImportUserDataParams defines parameters for ImportUserData.
+type ImportUserDataParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// GetWebhooksParams defines parameters for GetWebhooks.
+type GetWebhooksParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// CreateWebhookJSONBody defines parameters for CreateWebhook.
+type CreateWebhookJSONBody struct {
+	EventTypes []WebhookEventType `json:"event_types"`
+
+	// Secret Secret to sign deliveries with; it is never returned
+	Secret string `json:"secret"`
+
+	// Url HTTP or HTTPS URL to send events to
+	Url string `json:"url"`
+}
+
+// CreateWebhookParams defines parameters for CreateWebhook.
+type CreateWebhookParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// DeleteWebhookParams defines parameters for DeleteWebhook.
+type DeleteWebhookParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// GetWebhookDeliveriesParams defines parameters for GetWebhookDeliveries.
+type GetWebhookDeliveriesParams struct {
+	// Space ID of the space to operate in (by default the authenticated user's personal space is used)
+	Space *SpaceSelector `form:"space,omitempty" json:"space,omitempty"`
+}
+
+// CreateActivityJSONRequestBody defines body for CreateActivity for application/json ContentType.
+type CreateActivityJSONRequestBody CreateActivityJSONBody
+
+// PatchActivityApplicationMergePatchPlusJSONRequestBody defines body for PatchActivity for application/merge-patch+json ContentType.
+type PatchActivityApplicationMergePatchPlusJSONRequestBody = ActivityPatch
+
+// UpdateActivityJSONRequestBody defines body for UpdateActivity for application/json ContentType.
+type UpdateActivityJSONRequestBody UpdateActivityJSONBody
+
+// BackchannelLogoutFormdataRequestBody defines body for BackchannelLogout for application/x-www-form-urlencoded ContentType.
+type BackchannelLogoutFormdataRequestBody BackchannelLogoutFormdataBody
+
+// PostBatchJSONRequestBody defines body for PostBatch for application/json ContentType.
+type PostBatchJSONRequestBody PostBatchJSONBody
+
+// CreateContactJSONRequestBody defines body for CreateContact for application/json ContentType.
+type CreateContactJSONRequestBody CreateContactJSONBody
+
+// PatchContactApplicationMergePatchPlusJSONRequestBody defines body for PatchContact for application/merge-patch+json ContentType.
+type PatchContactApplicationMergePatchPlusJSONRequestBody = ContactPatch
+
+// UpdateContactJSONRequestBody defines body for UpdateContact for application/json ContentType.
+type UpdateContactJSONRequestBody UpdateContactJSONBody
+
+// CreateDebtJSONRequestBody defines body for CreateDebt for application/json ContentType.
+type CreateDebtJSONRequestBody CreateDebtJSONBody
+
+// PatchDebtApplicationMergePatchPlusJSONRequestBody defines body for PatchDebt for application/merge-patch+json ContentType.
+type PatchDebtApplicationMergePatchPlusJSONRequestBody = DebtPatch
+
+// UpdateDebtJSONRequestBody defines body for UpdateDebt for application/json ContentType.
+type UpdateDebtJSONRequestBody UpdateDebtJSONBody
+
+// CreateJournalEntryJSONRequestBody defines body for CreateJournalEntry for application/json ContentType.
+type CreateJournalEntryJSONRequestBody CreateJournalEntryJSONBody
+
+// PatchJournalEntryApplicationMergePatchPlusJSONRequestBody defines body for PatchJournalEntry for application/merge-patch+json ContentType.
+type PatchJournalEntryApplicationMergePatchPlusJSONRequestBody = JournalEntryPatch
+
+// UpdateJournalEntryJSONRequestBody defines body for UpdateJournalEntry for application/json ContentType.
+type UpdateJournalEntryJSONRequestBody UpdateJournalEntryJSONBody
+
+// CreateSpaceJSONRequestBody defines body for CreateSpace for application/json ContentType.
+type CreateSpaceJSONRequestBody CreateSpaceJSONBody
+
+// CreateSpaceInvitationJSONRequestBody defines body for CreateSpaceInvitation for application/json ContentType.
+type CreateSpaceInvitationJSONRequestBody CreateSpaceInvitationJSONBody
+
+// PostSyncJSONRequestBody defines body for PostSync for application/json ContentType.
+type PostSyncJSONRequestBody PostSyncJSONBody
+
+// CreateAccessTokenJSONRequestBody defines body for CreateAccessToken for application/json ContentType.
+type CreateAccessTokenJSONRequestBody CreateAccessTokenJSONBody
+
+// ImportUserDataMultipartRequestBody defines body for ImportUserData for multipart/form-data ContentType.
+type ImportUserDataMultipartRequestBody ImportUserDataMultipartBody
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody CreateWebhookJSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
 	// to the server, such as https://api.deepmap.com/dev-test, and all the
 	// paths in the swagger spec will be appended to the server.
 	Server string
@@ -264,97 +1028,209 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetActivities request
+	GetActivities(ctx context.Context, params *GetActivitiesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateActivityWithBody request with any body
-	CreateActivityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateActivityWithBody(ctx context.Context, params *CreateActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CreateActivity(ctx context.Context, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateActivity(ctx context.Context, params *CreateActivityParams, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteActivity request
-	DeleteActivity(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteActivity(ctx context.Context, id int64, params *DeleteActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetActivity request
-	GetActivity(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetActivity(ctx context.Context, id int64, params *GetActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchActivityWithBody request with any body
+	PatchActivityWithBody(ctx context.Context, id int64, params *PatchActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchActivityWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int64, params *PatchActivityParams, body PatchActivityApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateActivityWithBody request with any body
-	UpdateActivityWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateActivityWithBody(ctx context.Context, id int64, params *UpdateActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateActivity(ctx context.Context, id int64, params *UpdateActivityParams, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateActivity(ctx context.Context, id int64, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// BackchannelLogoutWithBody request with any body
+	BackchannelLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BackchannelLogoutWithFormdataBody(ctx context.Context, body BackchannelLogoutFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBatchWithBody request with any body
+	PostBatchWithBody(ctx context.Context, params *PostBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostBatch(ctx context.Context, params *PostBatchParams, body PostBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetSourceCode request
 	GetSourceCode(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetContacts request
-	GetContacts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetContacts(ctx context.Context, params *GetContactsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateContactWithBody request with any body
-	CreateContactWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateContactWithBody(ctx context.Context, params *CreateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CreateContact(ctx context.Context, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateContact(ctx context.Context, params *CreateContactParams, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteContact request
-	DeleteContact(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteContact(ctx context.Context, id int64, params *DeleteContactParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetContact request
-	GetContact(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetContact(ctx context.Context, id int64, params *GetContactParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchContactWithBody request with any body
+	PatchContactWithBody(ctx context.Context, id int64, params *PatchContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchContactWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int64, params *PatchContactParams, body PatchContactApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateContactWithBody request with any body
-	UpdateContactWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateContactWithBody(ctx context.Context, id int64, params *UpdateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateContact(ctx context.Context, id int64, params *UpdateContactParams, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateContact(ctx context.Context, id int64, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetDebts request
+	GetDebts(ctx context.Context, params *GetDebtsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateDebtWithBody request with any body
-	CreateDebtWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateDebtWithBody(ctx context.Context, params *CreateDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CreateDebt(ctx context.Context, body CreateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateDebt(ctx context.Context, params *CreateDebtParams, body CreateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// SettleDebt request
-	SettleDebt(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	SettleDebt(ctx context.Context, id int64, params *SettleDebtParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchDebtWithBody request with any body
+	PatchDebtWithBody(ctx context.Context, id int64, params *PatchDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchDebtWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int64, params *PatchDebtParams, body PatchDebtApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateDebtWithBody request with any body
-	UpdateDebtWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateDebtWithBody(ctx context.Context, id int64, params *UpdateDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDebt(ctx context.Context, id int64, params *UpdateDebtParams, body UpdateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEvents request
+	GetEvents(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSpaceInvitations request
+	GetSpaceInvitations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeclineSpaceInvitation request
+	DeclineSpaceInvitation(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateDebt(ctx context.Context, id int64, body UpdateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// AcceptSpaceInvitation request
+	AcceptSpaceInvitation(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetJournalEntries request
-	GetJournalEntries(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetJournalEntries(ctx context.Context, params *GetJournalEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateJournalEntryWithBody request with any body
-	CreateJournalEntryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateJournalEntryWithBody(ctx context.Context, params *CreateJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CreateJournalEntry(ctx context.Context, body CreateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateJournalEntry(ctx context.Context, params *CreateJournalEntryParams, body CreateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteJournalEntry request
-	DeleteJournalEntry(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteJournalEntry(ctx context.Context, id int64, params *DeleteJournalEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetJournalEntry request
-	GetJournalEntry(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetJournalEntry(ctx context.Context, id int64, params *GetJournalEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchJournalEntryWithBody request with any body
+	PatchJournalEntryWithBody(ctx context.Context, id int64, params *PatchJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchJournalEntryWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int64, params *PatchJournalEntryParams, body PatchJournalEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateJournalEntryWithBody request with any body
-	UpdateJournalEntryWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateJournalEntryWithBody(ctx context.Context, id int64, params *UpdateJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateJournalEntry(ctx context.Context, id int64, body UpdateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateJournalEntry(ctx context.Context, id int64, params *UpdateJournalEntryParams, body UpdateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOpenAPISpec request
 	GetOpenAPISpec(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteSessions request
+	DeleteSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessions request
+	GetSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSession request
+	DeleteSession(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSpaces request
+	GetSpaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSpaceWithBody request with any body
+	CreateSpaceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSpace(ctx context.Context, body CreateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSpace request
+	DeleteSpace(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSpaceInvitationWithBody request with any body
+	CreateSpaceInvitationWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSpaceInvitation(ctx context.Context, id int64, body CreateSpaceInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSpaceMembers request
+	GetSpaceMembers(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSpaceMember request
+	DeleteSpaceMember(ctx context.Context, id int64, memberId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetStatistics request
 	GetStatistics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetSummary request
-	GetSummary(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetSummary(ctx context.Context, params *GetSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSync request
+	GetSync(ctx context.Context, params *GetSyncParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSyncWithBody request with any body
+	PostSyncWithBody(ctx context.Context, params *PostSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSync(ctx context.Context, params *PostSyncParams, body PostSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAccessTokens request
+	GetAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAccessTokenWithBody request with any body
+	CreateAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAccessToken(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAccessToken request
+	DeleteAccessToken(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteUserData request
-	DeleteUserData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteUserData(ctx context.Context, params *DeleteUserDataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ExportUserData request
-	ExportUserData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ExportUserData(ctx context.Context, params *ExportUserDataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ImportUserDataWithBody request with any body
-	ImportUserDataWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ImportUserDataWithBody(ctx context.Context, params *ImportUserDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooks request
+	GetWebhooks(ctx context.Context, params *GetWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebhookWithBody request with any body
+	CreateWebhookWithBody(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebhook(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhook request
+	DeleteWebhook(ctx context.Context, id int64, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhookDeliveries request
+	GetWebhookDeliveries(ctx context.Context, id int64, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) CreateActivityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateActivityRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetActivities(ctx context.Context, params *GetActivitiesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetActivitiesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -365,8 +1241,8 @@ func (c *Client) CreateActivityWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateActivity(ctx context.Context, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateActivityRequest(c.Server, body)
+func (c *Client) CreateActivityWithBody(ctx context.Context, params *CreateActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateActivityRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -377,8 +1253,8 @@ func (c *Client) CreateActivity(ctx context.Context, body CreateActivityJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteActivity(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteActivityRequest(c.Server, id)
+func (c *Client) CreateActivity(ctx context.Context, params *CreateActivityParams, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateActivityRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -389,8 +1265,8 @@ func (c *Client) DeleteActivity(ctx context.Context, id int64, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetActivity(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetActivityRequest(c.Server, id)
+func (c *Client) DeleteActivity(ctx context.Context, id int64, params *DeleteActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteActivityRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -401,8 +1277,8 @@ func (c *Client) GetActivity(ctx context.Context, id int64, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateActivityWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateActivityRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) GetActivity(ctx context.Context, id int64, params *GetActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetActivityRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -413,8 +1289,8 @@ func (c *Client) UpdateActivityWithBody(ctx context.Context, id int64, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateActivity(ctx context.Context, id int64, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateActivityRequest(c.Server, id, body)
+func (c *Client) PatchActivityWithBody(ctx context.Context, id int64, params *PatchActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchActivityRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -425,8 +1301,8 @@ func (c *Client) UpdateActivity(ctx context.Context, id int64, body UpdateActivi
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSourceCode(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSourceCodeRequest(c.Server)
+func (c *Client) PatchActivityWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int64, params *PatchActivityParams, body PatchActivityApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchActivityRequestWithApplicationMergePatchPlusJSONBody(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -437,8 +1313,8 @@ func (c *Client) GetSourceCode(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetContacts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetContactsRequest(c.Server)
+func (c *Client) UpdateActivityWithBody(ctx context.Context, id int64, params *UpdateActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateActivityRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -449,8 +1325,8 @@ func (c *Client) GetContacts(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateContactWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateContactRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateActivity(ctx context.Context, id int64, params *UpdateActivityParams, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateActivityRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -461,8 +1337,8 @@ func (c *Client) CreateContactWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateContact(ctx context.Context, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateContactRequest(c.Server, body)
+func (c *Client) BackchannelLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBackchannelLogoutRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -473,8 +1349,8 @@ func (c *Client) CreateContact(ctx context.Context, body CreateContactJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteContact(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteContactRequest(c.Server, id)
+func (c *Client) BackchannelLogoutWithFormdataBody(ctx context.Context, body BackchannelLogoutFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBackchannelLogoutRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -485,8 +1361,8 @@ func (c *Client) DeleteContact(ctx context.Context, id int64, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetContact(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetContactRequest(c.Server, id)
+func (c *Client) PostBatchWithBody(ctx context.Context, params *PostBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBatchRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -497,8 +1373,8 @@ func (c *Client) GetContact(ctx context.Context, id int64, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateContactWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateContactRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) PostBatch(ctx context.Context, params *PostBatchParams, body PostBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBatchRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -509,8 +1385,8 @@ func (c *Client) UpdateContactWithBody(ctx context.Context, id int64, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateContact(ctx context.Context, id int64, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateContactRequest(c.Server, id, body)
+func (c *Client) GetSourceCode(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSourceCodeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -521,8 +1397,8 @@ func (c *Client) UpdateContact(ctx context.Context, id int64, body UpdateContact
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDebtWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDebtRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetContacts(ctx context.Context, params *GetContactsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetContactsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -533,8 +1409,8 @@ func (c *Client) CreateDebtWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDebt(ctx context.Context, body CreateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDebtRequest(c.Server, body)
+func (c *Client) CreateContactWithBody(ctx context.Context, params *CreateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateContactRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -545,8 +1421,8 @@ func (c *Client) CreateDebt(ctx context.Context, body CreateDebtJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) SettleDebt(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSettleDebtRequest(c.Server, id)
+func (c *Client) CreateContact(ctx context.Context, params *CreateContactParams, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateContactRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -557,8 +1433,8 @@ func (c *Client) SettleDebt(ctx context.Context, id int64, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDebtWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDebtRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) DeleteContact(ctx context.Context, id int64, params *DeleteContactParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteContactRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -569,8 +1445,8 @@ func (c *Client) UpdateDebtWithBody(ctx context.Context, id int64, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDebt(ctx context.Context, id int64, body UpdateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDebtRequest(c.Server, id, body)
+func (c *Client) GetContact(ctx context.Context, id int64, params *GetContactParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetContactRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -581,8 +1457,8 @@ func (c *Client) UpdateDebt(ctx context.Context, id int64, body UpdateDebtJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetJournalEntries(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetJournalEntriesRequest(c.Server)
+func (c *Client) PatchContactWithBody(ctx context.Context, id int64, params *PatchContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchContactRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -593,8 +1469,8 @@ func (c *Client) GetJournalEntries(ctx context.Context, reqEditors ...RequestEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateJournalEntryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateJournalEntryRequestWithBody(c.Server, contentType, body)
+func (c *Client) PatchContactWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int64, params *PatchContactParams, body PatchContactApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchContactRequestWithApplicationMergePatchPlusJSONBody(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -605,8 +1481,8 @@ func (c *Client) CreateJournalEntryWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateJournalEntry(ctx context.Context, body CreateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateJournalEntryRequest(c.Server, body)
+func (c *Client) UpdateContactWithBody(ctx context.Context, id int64, params *UpdateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateContactRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -617,8 +1493,8 @@ func (c *Client) CreateJournalEntry(ctx context.Context, body CreateJournalEntry
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteJournalEntry(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteJournalEntryRequest(c.Server, id)
+func (c *Client) UpdateContact(ctx context.Context, id int64, params *UpdateContactParams, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateContactRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -629,8 +1505,8 @@ func (c *Client) DeleteJournalEntry(ctx context.Context, id int64, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetJournalEntry(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetJournalEntryRequest(c.Server, id)
+func (c *Client) GetDebts(ctx context.Context, params *GetDebtsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDebtsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -641,8 +1517,8 @@ func (c *Client) GetJournalEntry(ctx context.Context, id int64, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateJournalEntryWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateJournalEntryRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) CreateDebtWithBody(ctx context.Context, params *CreateDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDebtRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -653,8 +1529,8 @@ func (c *Client) UpdateJournalEntryWithBody(ctx context.Context, id int64, conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateJournalEntry(ctx context.Context, id int64, body UpdateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateJournalEntryRequest(c.Server, id, body)
+func (c *Client) CreateDebt(ctx context.Context, params *CreateDebtParams, body CreateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDebtRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -665,8 +1541,8 @@ func (c *Client) UpdateJournalEntry(ctx context.Context, id int64, body UpdateJo
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOpenAPISpec(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOpenAPISpecRequest(c.Server)
+func (c *Client) SettleDebt(ctx context.Context, id int64, params *SettleDebtParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSettleDebtRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -677,8 +1553,8 @@ func (c *Client) GetOpenAPISpec(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetStatistics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetStatisticsRequest(c.Server)
+func (c *Client) PatchDebtWithBody(ctx context.Context, id int64, params *PatchDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchDebtRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -689,8 +1565,8 @@ func (c *Client) GetStatistics(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSummary(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSummaryRequest(c.Server)
+func (c *Client) PatchDebtWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int64, params *PatchDebtParams, body PatchDebtApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchDebtRequestWithApplicationMergePatchPlusJSONBody(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -701,8 +1577,8 @@ func (c *Client) GetSummary(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteUserData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteUserDataRequest(c.Server)
+func (c *Client) UpdateDebtWithBody(ctx context.Context, id int64, params *UpdateDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDebtRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -713,8 +1589,8 @@ func (c *Client) DeleteUserData(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) ExportUserData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewExportUserDataRequest(c.Server)
+func (c *Client) UpdateDebt(ctx context.Context, id int64, params *UpdateDebtParams, body UpdateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDebtRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -725,8 +1601,8 @@ func (c *Client) ExportUserData(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) ImportUserDataWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewImportUserDataRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetEvents(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -737,493 +1613,500 @@ func (c *Client) ImportUserDataWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-// NewCreateActivityRequest calls the generic CreateActivity builder with application/json body
-func NewCreateActivityRequest(server string, body CreateActivityJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetSpaceInvitations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpaceInvitationsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateActivityRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateActivityRequestWithBody generates requests for CreateActivity with any type of body
-func NewCreateActivityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeclineSpaceInvitation(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeclineSpaceInvitationRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/activities")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AcceptSpaceInvitation(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcceptSpaceInvitationRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteActivityRequest generates requests for DeleteActivity
-func NewDeleteActivityRequest(server string, id int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetJournalEntries(ctx context.Context, params *GetJournalEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJournalEntriesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/activities/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateJournalEntryWithBody(ctx context.Context, params *CreateJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateJournalEntryRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetActivityRequest generates requests for GetActivity
-func NewGetActivityRequest(server string, id int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) CreateJournalEntry(ctx context.Context, params *CreateJournalEntryParams, body CreateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateJournalEntryRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/activities/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteJournalEntry(ctx context.Context, id int64, params *DeleteJournalEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteJournalEntryRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateActivityRequest calls the generic UpdateActivity builder with application/json body
-func NewUpdateActivityRequest(server string, id int64, body UpdateActivityJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetJournalEntry(ctx context.Context, id int64, params *GetJournalEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJournalEntryRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateActivityRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateActivityRequestWithBody generates requests for UpdateActivity with any type of body
-func NewUpdateActivityRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) PatchJournalEntryWithBody(ctx context.Context, id int64, params *PatchJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchJournalEntryRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchJournalEntryWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int64, params *PatchJournalEntryParams, body PatchJournalEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchJournalEntryRequestWithApplicationMergePatchPlusJSONBody(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/activities/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateJournalEntryWithBody(ctx context.Context, id int64, params *UpdateJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateJournalEntryRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) UpdateJournalEntry(ctx context.Context, id int64, params *UpdateJournalEntryParams, body UpdateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateJournalEntryRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetSourceCodeRequest generates requests for GetSourceCode
-func NewGetSourceCodeRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOpenAPISpec(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenAPISpecRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/code/")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSessionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetContactsRequest generates requests for GetContacts
-func NewGetContactsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/contacts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteSession(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSessionRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateContactRequest calls the generic CreateContact builder with application/json body
-func NewCreateContactRequest(server string, body CreateContactJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetSpaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpacesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateContactRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateContactRequestWithBody generates requests for CreateContact with any type of body
-func NewCreateContactRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateSpaceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSpaceRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/contacts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateSpace(ctx context.Context, body CreateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSpaceRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteContactRequest generates requests for DeleteContact
-func NewDeleteContactRequest(server string, id int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) DeleteSpace(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSpaceRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateSpaceInvitationWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSpaceInvitationRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/contacts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateSpaceInvitation(ctx context.Context, id int64, body CreateSpaceInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSpaceInvitationRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetContactRequest generates requests for GetContact
-func NewGetContactRequest(server string, id int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetSpaceMembers(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpaceMembersRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteSpaceMember(ctx context.Context, id int64, memberId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSpaceMemberRequest(c.Server, id, memberId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/contacts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetStatistics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatisticsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateContactRequest calls the generic UpdateContact builder with application/json body
-func NewUpdateContactRequest(server string, id int64, body UpdateContactJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetSummary(ctx context.Context, params *GetSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSummaryRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateContactRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateContactRequestWithBody generates requests for UpdateContact with any type of body
-func NewUpdateContactRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetSync(ctx context.Context, params *GetSyncParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSyncRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostSyncWithBody(ctx context.Context, params *PostSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSyncRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/contacts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostSync(ctx context.Context, params *PostSyncParams, body PostSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSyncRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateDebtRequest calls the generic CreateDebt builder with application/json body
-func NewCreateDebtRequest(server string, body CreateDebtJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccessTokensRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDebtRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateDebtRequestWithBody generates requests for CreateDebt with any type of body
-func NewCreateDebtRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAccessTokenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/debts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateAccessToken(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAccessTokenRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewSettleDebtRequest generates requests for SettleDebt
-func NewSettleDebtRequest(server string, id int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) DeleteAccessToken(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccessTokenRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteUserData(ctx context.Context, params *DeleteUserDataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserDataRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/debts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ExportUserData(ctx context.Context, params *ExportUserDataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportUserDataRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateDebtRequest calls the generic UpdateDebt builder with application/json body
-func NewUpdateDebtRequest(server string, id int64, body UpdateDebtJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ImportUserDataWithBody(ctx context.Context, params *ImportUserDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportUserDataRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDebtRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateDebtRequestWithBody generates requests for UpdateDebt with any type of body
-func NewUpdateDebtRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetWebhooks(ctx context.Context, params *GetWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateWebhookWithBody(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/debts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateWebhook(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) DeleteWebhook(ctx context.Context, id int64, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+func (c *Client) GetWebhookDeliveries(ctx context.Context, id int64, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookDeliveriesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetJournalEntriesRequest generates requests for GetJournalEntries
-func NewGetJournalEntriesRequest(server string) (*http.Request, error) {
+// NewGetActivitiesRequest generates requests for GetActivities
+func NewGetActivitiesRequest(server string, params *GetActivitiesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1231,7 +2114,7 @@ func NewGetJournalEntriesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/journal")
+	operationPath := fmt.Sprintf("/activities")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1241,6 +2124,92 @@ func NewGetJournalEntriesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ContactId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "contact_id", runtime.ParamLocationQuery, *params.ContactId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query", runtime.ParamLocationQuery, *params.Query); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1249,19 +2218,19 @@ func NewGetJournalEntriesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateJournalEntryRequest calls the generic CreateJournalEntry builder with application/json body
-func NewCreateJournalEntryRequest(server string, body CreateJournalEntryJSONRequestBody) (*http.Request, error) {
+// NewCreateActivityRequest calls the generic CreateActivity builder with application/json body
+func NewCreateActivityRequest(server string, params *CreateActivityParams, body CreateActivityJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateJournalEntryRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateActivityRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewCreateJournalEntryRequestWithBody generates requests for CreateJournalEntry with any type of body
-func NewCreateJournalEntryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateActivityRequestWithBody generates requests for CreateActivity with any type of body
+func NewCreateActivityRequestWithBody(server string, params *CreateActivityParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1269,7 +2238,7 @@ func NewCreateJournalEntryRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/journal")
+	operationPath := fmt.Sprintf("/activities")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1279,6 +2248,28 @@ func NewCreateJournalEntryRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -1289,8 +2280,8 @@ func NewCreateJournalEntryRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
-// NewDeleteJournalEntryRequest generates requests for DeleteJournalEntry
-func NewDeleteJournalEntryRequest(server string, id int64) (*http.Request, error) {
+// NewDeleteActivityRequest generates requests for DeleteActivity
+func NewDeleteActivityRequest(server string, id int64, params *DeleteActivityParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1305,7 +2296,7 @@ func NewDeleteJournalEntryRequest(server string, id int64) (*http.Request, error
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/journal/%s", pathParam0)
+	operationPath := fmt.Sprintf("/activities/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1315,6 +2306,28 @@ func NewDeleteJournalEntryRequest(server string, id int64) (*http.Request, error
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1323,8 +2336,8 @@ func NewDeleteJournalEntryRequest(server string, id int64) (*http.Request, error
 	return req, nil
 }
 
-// NewGetJournalEntryRequest generates requests for GetJournalEntry
-func NewGetJournalEntryRequest(server string, id int64) (*http.Request, error) {
+// NewGetActivityRequest generates requests for GetActivity
+func NewGetActivityRequest(server string, id int64, params *GetActivityParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1339,7 +2352,7 @@ func NewGetJournalEntryRequest(server string, id int64) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/journal/%s", pathParam0)
+	operationPath := fmt.Sprintf("/activities/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1349,6 +2362,28 @@ func NewGetJournalEntryRequest(server string, id int64) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1357,19 +2392,19 @@ func NewGetJournalEntryRequest(server string, id int64) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateJournalEntryRequest calls the generic UpdateJournalEntry builder with application/json body
-func NewUpdateJournalEntryRequest(server string, id int64, body UpdateJournalEntryJSONRequestBody) (*http.Request, error) {
+// NewPatchActivityRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchActivity builder with application/merge-patch+json body
+func NewPatchActivityRequestWithApplicationMergePatchPlusJSONBody(server string, id int64, params *PatchActivityParams, body PatchActivityApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateJournalEntryRequestWithBody(server, id, "application/json", bodyReader)
+	return NewPatchActivityRequestWithBody(server, id, params, "application/merge-patch+json", bodyReader)
 }
 
-// NewUpdateJournalEntryRequestWithBody generates requests for UpdateJournalEntry with any type of body
-func NewUpdateJournalEntryRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchActivityRequestWithBody generates requests for PatchActivity with any type of body
+func NewPatchActivityRequestWithBody(server string, id int64, params *PatchActivityParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1384,7 +2419,7 @@ func NewUpdateJournalEntryRequestWithBody(server string, id int64, contentType s
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/journal/%s", pathParam0)
+	operationPath := fmt.Sprintf("/activities/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1394,7 +2429,29 @@ func NewUpdateJournalEntryRequestWithBody(server string, id int64, contentType s
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1404,16 +2461,34 @@ func NewUpdateJournalEntryRequestWithBody(server string, id int64, contentType s
 	return req, nil
 }
 
-// NewGetOpenAPISpecRequest generates requests for GetOpenAPISpec
-func NewGetOpenAPISpecRequest(server string) (*http.Request, error) {
+// NewUpdateActivityRequest calls the generic UpdateActivity builder with application/json body
+func NewUpdateActivityRequest(server string, id int64, params *UpdateActivityParams, body UpdateActivityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateActivityRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateActivityRequestWithBody generates requests for UpdateActivity with any type of body
+func NewUpdateActivityRequestWithBody(server string, id int64, params *UpdateActivityParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/openapi.json")
+	operationPath := fmt.Sprintf("/activities/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1423,16 +2498,51 @@ func NewGetOpenAPISpecRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetStatisticsRequest generates requests for GetStatistics
-func NewGetStatisticsRequest(server string) (*http.Request, error) {
+// NewBackchannelLogoutRequestWithFormdataBody calls the generic BackchannelLogout builder with application/x-www-form-urlencoded body
+func NewBackchannelLogoutRequestWithFormdataBody(server string, body BackchannelLogoutFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewBackchannelLogoutRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewBackchannelLogoutRequestWithBody generates requests for BackchannelLogout with any type of body
+func NewBackchannelLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1440,7 +2550,7 @@ func NewGetStatisticsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/statistics")
+	operationPath := fmt.Sprintf("/backchannel-logout")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1450,16 +2560,29 @@ func NewGetStatisticsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetSummaryRequest generates requests for GetSummary
-func NewGetSummaryRequest(server string) (*http.Request, error) {
+// NewPostBatchRequest calls the generic PostBatch builder with application/json body
+func NewPostBatchRequest(server string, params *PostBatchParams, body PostBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostBatchRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostBatchRequestWithBody generates requests for PostBatch with any type of body
+func NewPostBatchRequestWithBody(server string, params *PostBatchParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1467,7 +2590,7 @@ func NewGetSummaryRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/summary")
+	operationPath := fmt.Sprintf("/batch")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1477,16 +2600,40 @@ func NewGetSummaryRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDeleteUserDataRequest generates requests for DeleteUserData
-func NewDeleteUserDataRequest(server string) (*http.Request, error) {
+// NewGetSourceCodeRequest generates requests for GetSourceCode
+func NewGetSourceCodeRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1494,7 +2641,7 @@ func NewDeleteUserDataRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/userdata")
+	operationPath := fmt.Sprintf("/code/")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1504,7 +2651,7 @@ func NewDeleteUserDataRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1512,8 +2659,8 @@ func NewDeleteUserDataRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewExportUserDataRequest generates requests for ExportUserData
-func NewExportUserDataRequest(server string) (*http.Request, error) {
+// NewGetContactsRequest generates requests for GetContacts
+func NewGetContactsRequest(server string, params *GetContactsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1521,7 +2668,7 @@ func NewExportUserDataRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/userdata")
+	operationPath := fmt.Sprintf("/contacts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1531,6 +2678,28 @@ func NewExportUserDataRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1539,8 +2708,19 @@ func NewExportUserDataRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewImportUserDataRequestWithBody generates requests for ImportUserData with any type of body
-func NewImportUserDataRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateContactRequest calls the generic CreateContact builder with application/json body
+func NewCreateContactRequest(server string, params *CreateContactParams, body CreateContactJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateContactRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateContactRequestWithBody generates requests for CreateContact with any type of body
+func NewCreateContactRequestWithBody(server string, params *CreateContactParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1548,7 +2728,7 @@ func NewImportUserDataRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/userdata")
+	operationPath := fmt.Sprintf("/contacts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1558,6 +2738,28 @@ func NewImportUserDataRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -1568,3282 +2770,14907 @@ func NewImportUserDataRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewDeleteContactRequest generates requests for DeleteContact
+func NewDeleteContactRequest(server string, id int64, params *DeleteContactParams) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// CreateActivityWithBodyWithResponse request with any body
-	CreateActivityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error)
+	operationPath := fmt.Sprintf("/contacts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateActivityWithResponse(ctx context.Context, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteActivityWithResponse request
-	DeleteActivityWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteActivityResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetActivityWithResponse request
-	GetActivityWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetActivityResponse, error)
+		if params.Space != nil {
 
-	// UpdateActivityWithBodyWithResponse request with any body
-	UpdateActivityWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	UpdateActivityWithResponse(ctx context.Context, id int64, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error)
+		}
 
-	// GetSourceCodeWithResponse request
-	GetSourceCodeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourceCodeResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// GetContactsWithResponse request
-	GetContactsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetContactsResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateContactWithBodyWithResponse request with any body
-	CreateContactWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContactResponse, error)
+	return req, nil
+}
 
-	CreateContactWithResponse(ctx context.Context, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContactResponse, error)
+// NewGetContactRequest generates requests for GetContact
+func NewGetContactRequest(server string, id int64, params *GetContactParams) (*http.Request, error) {
+	var err error
 
-	// DeleteContactWithResponse request
-	DeleteContactWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteContactResponse, error)
+	var pathParam0 string
 
-	// GetContactWithResponse request
-	GetContactWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetContactResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateContactWithBodyWithResponse request with any body
-	UpdateContactWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateContactWithResponse(ctx context.Context, id int64, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error)
+	operationPath := fmt.Sprintf("/contacts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreateDebtWithBodyWithResponse request with any body
-	CreateDebtWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDebtResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateDebtWithResponse(ctx context.Context, body CreateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDebtResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// SettleDebtWithResponse request
-	SettleDebtWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*SettleDebtResponse, error)
+		if params.Space != nil {
 
-	// UpdateDebtWithBodyWithResponse request with any body
-	UpdateDebtWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDebtResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	UpdateDebtWithResponse(ctx context.Context, id int64, body UpdateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDebtResponse, error)
+		}
 
-	// GetJournalEntriesWithResponse request
-	GetJournalEntriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJournalEntriesResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// CreateJournalEntryWithBodyWithResponse request with any body
-	CreateJournalEntryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateJournalEntryResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateJournalEntryWithResponse(ctx context.Context, body CreateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateJournalEntryResponse, error)
+	return req, nil
+}
 
-	// DeleteJournalEntryWithResponse request
-	DeleteJournalEntryWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteJournalEntryResponse, error)
+// NewPatchContactRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchContact builder with application/merge-patch+json body
+func NewPatchContactRequestWithApplicationMergePatchPlusJSONBody(server string, id int64, params *PatchContactParams, body PatchContactApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchContactRequestWithBody(server, id, params, "application/merge-patch+json", bodyReader)
+}
 
-	// GetJournalEntryWithResponse request
-	GetJournalEntryWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetJournalEntryResponse, error)
+// NewPatchContactRequestWithBody generates requests for PatchContact with any type of body
+func NewPatchContactRequestWithBody(server string, id int64, params *PatchContactParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// UpdateJournalEntryWithBodyWithResponse request with any body
-	UpdateJournalEntryWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateJournalEntryResponse, error)
+	var pathParam0 string
 
-	UpdateJournalEntryWithResponse(ctx context.Context, id int64, body UpdateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateJournalEntryResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOpenAPISpecWithResponse request
-	GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetStatisticsWithResponse request
-	GetStatisticsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsResponse, error)
+	operationPath := fmt.Sprintf("/contacts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetSummaryWithResponse request
-	GetSummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSummaryResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteUserDataWithResponse request
-	DeleteUserDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUserDataResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// ExportUserDataWithResponse request
-	ExportUserDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportUserDataResponse, error)
+		if params.Space != nil {
 
-	// ImportUserDataWithBodyWithResponse request with any body
-	ImportUserDataWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportUserDataResponse, error)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type CreateActivityResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Activity
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r CreateActivityResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateActivityResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteActivityResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *int64
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r DeleteActivityResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteActivityResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUpdateContactRequest calls the generic UpdateContact builder with application/json body
+func NewUpdateContactRequest(server string, id int64, params *UpdateContactParams, body UpdateContactJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateContactRequestWithBody(server, id, params, "application/json", bodyReader)
 }
 
-type GetActivityResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ActivityWithContact
-}
+// NewUpdateContactRequestWithBody generates requests for UpdateContact with any type of body
+func NewUpdateContactRequestWithBody(server string, id int64, params *UpdateContactParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetActivityResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetActivityResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateActivityResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Activity
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateActivityResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/contacts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateActivityResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetSourceCodeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetSourceCodeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Space != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSourceCodeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type GetContactsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Contact
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetContactsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetContactsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateContactResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Contact
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r CreateContactResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateContactResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetDebtsRequest generates requests for GetDebts
+func NewGetDebtsRequest(server string, params *GetDebtsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteContactResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *int64
-}
-
-// Status returns HTTPResponse.Status
-func (r DeleteContactResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/debts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteContactResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetContactResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ContactData
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetContactResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Space != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetContactResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type UpdateContactResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Contact
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r UpdateContactResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.ContactId != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateContactResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "contact_id", runtime.ParamLocationQuery, *params.ContactId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type CreateDebtResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Debt
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r CreateDebtResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Currency != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDebtResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type SettleDebtResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *int64
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r SettleDebtResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.YouOwe != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SettleDebtResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "you_owe", runtime.ParamLocationQuery, *params.YouOwe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type UpdateDebtResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Debt
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r UpdateDebtResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query", runtime.ParamLocationQuery, *params.Query); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDebtResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetJournalEntriesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]JournalEntry
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetJournalEntriesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateDebtRequest calls the generic CreateDebt builder with application/json body
+func NewCreateDebtRequest(server string, params *CreateDebtParams, body CreateDebtJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDebtRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetJournalEntriesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewCreateDebtRequestWithBody generates requests for CreateDebt with any type of body
+func NewCreateDebtRequestWithBody(server string, params *CreateDebtParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type CreateJournalEntryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *JournalEntry
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateJournalEntryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/debts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateJournalEntryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteJournalEntryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *int64
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r DeleteJournalEntryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteJournalEntryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetJournalEntryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *JournalEntry
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetJournalEntryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetJournalEntryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewSettleDebtRequest generates requests for SettleDebt
+func NewSettleDebtRequest(server string, id int64, params *SettleDebtParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateJournalEntryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *JournalEntry
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateJournalEntryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/debts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateJournalEntryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetOpenAPISpecResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetOpenAPISpecResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOpenAPISpecResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetStatisticsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IndexData
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetStatisticsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPatchDebtRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchDebt builder with application/merge-patch+json body
+func NewPatchDebtRequestWithApplicationMergePatchPlusJSONBody(server string, id int64, params *PatchDebtParams, body PatchDebtApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchDebtRequestWithBody(server, id, params, "application/merge-patch+json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetStatisticsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewPatchDebtRequestWithBody generates requests for PatchDebt with any type of body
+func NewPatchDebtRequestWithBody(server string, id int64, params *PatchDebtParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetSummaryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IndexData
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetSummaryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSummaryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type DeleteUserDataResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
 
-// Status returns HTTPResponse.Status
-func (r DeleteUserDataResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/debts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUserDataResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type ExportUserDataResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
 
-// Status returns HTTPResponse.Status
-func (r ExportUserDataResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ExportUserDataResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		if params.Space != nil {
 
-type ImportUserDataResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// Status returns HTTPResponse.Status
-func (r ImportUserDataResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ImportUserDataResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return 0
-}
 
-// CreateActivityWithBodyWithResponse request with arbitrary body returning *CreateActivityResponse
-func (c *ClientWithResponses) CreateActivityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error) {
-	rsp, err := c.CreateActivityWithBody(ctx, contentType, body, reqEditors...)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateActivityResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateActivityWithResponse(ctx context.Context, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error) {
-	rsp, err := c.CreateActivity(ctx, body, reqEditors...)
+// NewUpdateDebtRequest calls the generic UpdateDebt builder with application/json body
+func NewUpdateDebtRequest(server string, id int64, params *UpdateDebtParams, body UpdateDebtJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateActivityResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDebtRequestWithBody(server, id, params, "application/json", bodyReader)
 }
 
-// DeleteActivityWithResponse request returning *DeleteActivityResponse
-func (c *ClientWithResponses) DeleteActivityWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteActivityResponse, error) {
-	rsp, err := c.DeleteActivity(ctx, id, reqEditors...)
+// NewUpdateDebtRequestWithBody generates requests for UpdateDebt with any type of body
+func NewUpdateDebtRequestWithBody(server string, id int64, params *UpdateDebtParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteActivityResponse(rsp)
-}
 
-// GetActivityWithResponse request returning *GetActivityResponse
-func (c *ClientWithResponses) GetActivityWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetActivityResponse, error) {
-	rsp, err := c.GetActivity(ctx, id, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetActivityResponse(rsp)
-}
 
-// UpdateActivityWithBodyWithResponse request with arbitrary body returning *UpdateActivityResponse
-func (c *ClientWithResponses) UpdateActivityWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error) {
-	rsp, err := c.UpdateActivityWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/debts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseUpdateActivityResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateActivityWithResponse(ctx context.Context, id int64, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error) {
-	rsp, err := c.UpdateActivity(ctx, id, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateActivityResponse(rsp)
-}
 
-// GetSourceCodeWithResponse request returning *GetSourceCodeResponse
-func (c *ClientWithResponses) GetSourceCodeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourceCodeResponse, error) {
-	rsp, err := c.GetSourceCode(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return ParseGetSourceCodeResponse(rsp)
-}
 
-// GetContactsWithResponse request returning *GetContactsResponse
-func (c *ClientWithResponses) GetContactsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetContactsResponse, error) {
-	rsp, err := c.GetContacts(ctx, reqEditors...)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetContactsResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// CreateContactWithBodyWithResponse request with arbitrary body returning *CreateContactResponse
-func (c *ClientWithResponses) CreateContactWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContactResponse, error) {
-	rsp, err := c.CreateContactWithBody(ctx, contentType, body, reqEditors...)
+// NewGetEventsRequest generates requests for GetEvents
+func NewGetEventsRequest(server string, params *GetEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateContactResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateContactWithResponse(ctx context.Context, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContactResponse, error) {
-	rsp, err := c.CreateContact(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseCreateContactResponse(rsp)
-}
 
-// DeleteContactWithResponse request returning *DeleteContactResponse
-func (c *ClientWithResponses) DeleteContactWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteContactResponse, error) {
-	rsp, err := c.DeleteContact(ctx, id, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteContactResponse(rsp)
-}
 
-// GetContactWithResponse request returning *GetContactResponse
-func (c *ClientWithResponses) GetContactWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetContactResponse, error) {
-	rsp, err := c.GetContact(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return ParseGetContactResponse(rsp)
-}
 
-// UpdateContactWithBodyWithResponse request with arbitrary body returning *UpdateContactResponse
-func (c *ClientWithResponses) UpdateContactWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error) {
-	rsp, err := c.UpdateContactWithBody(ctx, id, contentType, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateContactResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) UpdateContactWithResponse(ctx context.Context, id int64, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error) {
-	rsp, err := c.UpdateContact(ctx, id, body, reqEditors...)
+// NewGetSpaceInvitationsRequest generates requests for GetSpaceInvitations
+func NewGetSpaceInvitationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateContactResponse(rsp)
-}
 
-// CreateDebtWithBodyWithResponse request with arbitrary body returning *CreateDebtResponse
-func (c *ClientWithResponses) CreateDebtWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDebtResponse, error) {
-	rsp, err := c.CreateDebtWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/invitations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseCreateDebtResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateDebtWithResponse(ctx context.Context, body CreateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDebtResponse, error) {
-	rsp, err := c.CreateDebt(ctx, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDebtResponse(rsp)
-}
 
-// SettleDebtWithResponse request returning *SettleDebtResponse
-func (c *ClientWithResponses) SettleDebtWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*SettleDebtResponse, error) {
-	rsp, err := c.SettleDebt(ctx, id, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSettleDebtResponse(rsp)
+
+	return req, nil
 }
 
-// UpdateDebtWithBodyWithResponse request with arbitrary body returning *UpdateDebtResponse
-func (c *ClientWithResponses) UpdateDebtWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDebtResponse, error) {
-	rsp, err := c.UpdateDebtWithBody(ctx, id, contentType, body, reqEditors...)
+// NewDeclineSpaceInvitationRequest generates requests for DeclineSpaceInvitation
+func NewDeclineSpaceInvitationRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDebtResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateDebtWithResponse(ctx context.Context, id int64, body UpdateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDebtResponse, error) {
-	rsp, err := c.UpdateDebt(ctx, id, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDebtResponse(rsp)
-}
 
-// GetJournalEntriesWithResponse request returning *GetJournalEntriesResponse
-func (c *ClientWithResponses) GetJournalEntriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJournalEntriesResponse, error) {
-	rsp, err := c.GetJournalEntries(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/invitations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseGetJournalEntriesResponse(rsp)
-}
 
-// CreateJournalEntryWithBodyWithResponse request with arbitrary body returning *CreateJournalEntryResponse
-func (c *ClientWithResponses) CreateJournalEntryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateJournalEntryResponse, error) {
-	rsp, err := c.CreateJournalEntryWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateJournalEntryResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateJournalEntryWithResponse(ctx context.Context, body CreateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateJournalEntryResponse, error) {
-	rsp, err := c.CreateJournalEntry(ctx, body, reqEditors...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateJournalEntryResponse(rsp)
+
+	return req, nil
 }
 
-// DeleteJournalEntryWithResponse request returning *DeleteJournalEntryResponse
-func (c *ClientWithResponses) DeleteJournalEntryWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteJournalEntryResponse, error) {
-	rsp, err := c.DeleteJournalEntry(ctx, id, reqEditors...)
+// NewAcceptSpaceInvitationRequest generates requests for AcceptSpaceInvitation
+func NewAcceptSpaceInvitationRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteJournalEntryResponse(rsp)
-}
 
-// GetJournalEntryWithResponse request returning *GetJournalEntryResponse
-func (c *ClientWithResponses) GetJournalEntryWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetJournalEntryResponse, error) {
-	rsp, err := c.GetJournalEntry(ctx, id, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetJournalEntryResponse(rsp)
-}
 
-// UpdateJournalEntryWithBodyWithResponse request with arbitrary body returning *UpdateJournalEntryResponse
-func (c *ClientWithResponses) UpdateJournalEntryWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateJournalEntryResponse, error) {
-	rsp, err := c.UpdateJournalEntryWithBody(ctx, id, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/invitations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateJournalEntryResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateJournalEntryWithResponse(ctx context.Context, id int64, body UpdateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateJournalEntryResponse, error) {
-	rsp, err := c.UpdateJournalEntry(ctx, id, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateJournalEntryResponse(rsp)
+
+	return req, nil
 }
 
-// GetOpenAPISpecWithResponse request returning *GetOpenAPISpecResponse
-func (c *ClientWithResponses) GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error) {
-	rsp, err := c.GetOpenAPISpec(ctx, reqEditors...)
+// NewGetJournalEntriesRequest generates requests for GetJournalEntries
+func NewGetJournalEntriesRequest(server string, params *GetJournalEntriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetOpenAPISpecResponse(rsp)
-}
 
-// GetStatisticsWithResponse request returning *GetStatisticsResponse
-func (c *ClientWithResponses) GetStatisticsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsResponse, error) {
-	rsp, err := c.GetStatistics(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/journal")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseGetStatisticsResponse(rsp)
-}
 
-// GetSummaryWithResponse request returning *GetSummaryResponse
-func (c *ClientWithResponses) GetSummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSummaryResponse, error) {
-	rsp, err := c.GetSummary(ctx, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSummaryResponse(rsp)
-}
 
-// DeleteUserDataWithResponse request returning *DeleteUserDataResponse
-func (c *ClientWithResponses) DeleteUserDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUserDataResponse, error) {
-	rsp, err := c.DeleteUserData(ctx, reqEditors...)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteUserDataResponse(rsp)
+
+	return req, nil
 }
 
-// ExportUserDataWithResponse request returning *ExportUserDataResponse
-func (c *ClientWithResponses) ExportUserDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportUserDataResponse, error) {
-	rsp, err := c.ExportUserData(ctx, reqEditors...)
+// NewCreateJournalEntryRequest calls the generic CreateJournalEntry builder with application/json body
+func NewCreateJournalEntryRequest(server string, params *CreateJournalEntryParams, body CreateJournalEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseExportUserDataResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateJournalEntryRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// ImportUserDataWithBodyWithResponse request with arbitrary body returning *ImportUserDataResponse
-func (c *ClientWithResponses) ImportUserDataWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportUserDataResponse, error) {
-	rsp, err := c.ImportUserDataWithBody(ctx, contentType, body, reqEditors...)
+// NewCreateJournalEntryRequestWithBody generates requests for CreateJournalEntry with any type of body
+func NewCreateJournalEntryRequestWithBody(server string, params *CreateJournalEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseImportUserDataResponse(rsp)
-}
 
-// ParseCreateActivityResponse parses an HTTP response from a CreateActivityWithResponse call
-func ParseCreateActivityResponse(rsp *http.Response) (*CreateActivityResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	operationPath := fmt.Sprintf("/journal")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateActivityResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Activity
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON200 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParseDeleteActivityResponse parses an HTTP response from a DeleteActivityWithResponse call
-func ParseDeleteActivityResponse(rsp *http.Response) (*DeleteActivityResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteActivityResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	req.Header.Add("Content-Type", contentType)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest int64
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return req, nil
+}
 
-	}
+// NewDeleteJournalEntryRequest generates requests for DeleteJournalEntry
+func NewDeleteJournalEntryRequest(server string, id int64, params *DeleteJournalEntryParams) (*http.Request, error) {
+	var err error
 
-	return response, nil
-}
+	var pathParam0 string
 
-// ParseGetActivityResponse parses an HTTP response from a GetActivityWithResponse call
-func ParseGetActivityResponse(rsp *http.Response) (*GetActivityResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetActivityResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ActivityWithContact
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	operationPath := fmt.Sprintf("/journal/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseUpdateActivityResponse parses an HTTP response from a UpdateActivityWithResponse call
-func ParseUpdateActivityResponse(rsp *http.Response) (*UpdateActivityResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateActivityResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Activity
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON200 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParseGetSourceCodeResponse parses an HTTP response from a GetSourceCodeWithResponse call
-func ParseGetSourceCodeResponse(rsp *http.Response) (*GetSourceCodeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSourceCodeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
+	return req, nil
 }
 
-// ParseGetContactsResponse parses an HTTP response from a GetContactsWithResponse call
-func ParseGetContactsResponse(rsp *http.Response) (*GetContactsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetJournalEntryRequest generates requests for GetJournalEntry
+func NewGetJournalEntryRequest(server string, id int64, params *GetJournalEntryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetContactsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Contact
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	operationPath := fmt.Sprintf("/journal/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseCreateContactResponse parses an HTTP response from a CreateContactWithResponse call
-func ParseCreateContactResponse(rsp *http.Response) (*CreateContactResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateContactResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Contact
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON200 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseDeleteContactResponse parses an HTTP response from a DeleteContactWithResponse call
-func ParseDeleteContactResponse(rsp *http.Response) (*DeleteContactResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPatchJournalEntryRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchJournalEntry builder with application/merge-patch+json body
+func NewPatchJournalEntryRequestWithApplicationMergePatchPlusJSONBody(server string, id int64, params *PatchJournalEntryParams, body PatchJournalEntryApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchJournalEntryRequestWithBody(server, id, params, "application/merge-patch+json", bodyReader)
+}
 
-	response := &DeleteContactResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// NewPatchJournalEntryRequestWithBody generates requests for PatchJournalEntry with any type of body
+func NewPatchJournalEntryRequestWithBody(server string, id int64, params *PatchJournalEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest int64
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
-
-// ParseGetContactResponse parses an HTTP response from a GetContactWithResponse call
-func ParseGetContactResponse(rsp *http.Response) (*GetContactResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetContactResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/journal/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ContactData
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON200 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-// ParseUpdateContactResponse parses an HTTP response from a UpdateContactWithResponse call
-func ParseUpdateContactResponse(rsp *http.Response) (*UpdateContactResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateJournalEntryRequest calls the generic UpdateJournalEntry builder with application/json body
+func NewUpdateJournalEntryRequest(server string, id int64, params *UpdateJournalEntryParams, body UpdateJournalEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateJournalEntryRequestWithBody(server, id, params, "application/json", bodyReader)
+}
 
-	response := &UpdateContactResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Contact
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
+// NewUpdateJournalEntryRequestWithBody generates requests for UpdateJournalEntry with any type of body
+func NewUpdateJournalEntryRequestWithBody(server string, id int64, params *UpdateJournalEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	return response, nil
-}
+	var pathParam0 string
 
-// ParseCreateDebtResponse parses an HTTP response from a CreateDebtWithResponse call
-func ParseCreateDebtResponse(rsp *http.Response) (*CreateDebtResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDebtResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Debt
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	operationPath := fmt.Sprintf("/journal/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseSettleDebtResponse parses an HTTP response from a SettleDebtWithResponse call
-func ParseSettleDebtResponse(rsp *http.Response) (*SettleDebtResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SettleDebtResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Space != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest int64
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON200 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParseUpdateDebtResponse parses an HTTP response from a UpdateDebtWithResponse call
-func ParseUpdateDebtResponse(rsp *http.Response) (*UpdateDebtResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDebtResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Debt
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
+	req.Header.Add("Content-Type", contentType)
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetJournalEntriesResponse parses an HTTP response from a GetJournalEntriesWithResponse call
-func ParseGetJournalEntriesResponse(rsp *http.Response) (*GetJournalEntriesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetOpenAPISpecRequest generates requests for GetOpenAPISpec
+func NewGetOpenAPISpecRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetJournalEntriesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/openapi.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []JournalEntry
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseCreateJournalEntryResponse parses an HTTP response from a CreateJournalEntryWithResponse call
-func ParseCreateJournalEntryResponse(rsp *http.Response) (*CreateJournalEntryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewDeleteSessionsRequest generates requests for DeleteSessions
+func NewDeleteSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateJournalEntryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JournalEntry
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseDeleteJournalEntryResponse parses an HTTP response from a DeleteJournalEntryWithResponse call
-func ParseDeleteJournalEntryResponse(rsp *http.Response) (*DeleteJournalEntryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetSessionsRequest generates requests for GetSessions
+func NewGetSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteJournalEntryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest int64
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetJournalEntryResponse parses an HTTP response from a GetJournalEntryWithResponse call
-func ParseGetJournalEntryResponse(rsp *http.Response) (*GetJournalEntryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewDeleteSessionRequest generates requests for DeleteSession
+func NewDeleteSessionRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetJournalEntryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JournalEntry
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseUpdateJournalEntryResponse parses an HTTP response from a UpdateJournalEntryWithResponse call
-func ParseUpdateJournalEntryResponse(rsp *http.Response) (*UpdateJournalEntryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetSpacesRequest generates requests for GetSpaces
+func NewGetSpacesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateJournalEntryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/spaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JournalEntry
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetOpenAPISpecResponse parses an HTTP response from a GetOpenAPISpecWithResponse call
-func ParseGetOpenAPISpecResponse(rsp *http.Response) (*GetOpenAPISpecResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewCreateSpaceRequest calls the generic CreateSpace builder with application/json body
+func NewCreateSpaceRequest(server string, body CreateSpaceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	response := &GetOpenAPISpecResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSpaceRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ParseGetStatisticsResponse parses an HTTP response from a GetStatisticsWithResponse call
-func ParseGetStatisticsResponse(rsp *http.Response) (*GetStatisticsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewCreateSpaceRequestWithBody generates requests for CreateSpace with any type of body
+func NewCreateSpaceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetStatisticsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/spaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IndexData
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParseGetSummaryResponse parses an HTTP response from a GetSummaryWithResponse call
-func ParseGetSummaryResponse(rsp *http.Response) (*GetSummaryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewDeleteSpaceRequest generates requests for DeleteSpace
+func NewDeleteSpaceRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSummaryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IndexData
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/spaces/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseDeleteUserDataResponse parses an HTTP response from a DeleteUserDataWithResponse call
-func ParseDeleteUserDataResponse(rsp *http.Response) (*DeleteUserDataResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewCreateSpaceInvitationRequest calls the generic CreateSpaceInvitation builder with application/json body
+func NewCreateSpaceInvitationRequest(server string, id int64, body CreateSpaceInvitationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSpaceInvitationRequestWithBody(server, id, "application/json", bodyReader)
+}
 
-	response := &DeleteUserDataResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// NewCreateSpaceInvitationRequestWithBody generates requests for CreateSpaceInvitation with any type of body
+func NewCreateSpaceInvitationRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	return response, nil
-}
+	var pathParam0 string
 
-// ParseExportUserDataResponse parses an HTTP response from a ExportUserDataWithResponse call
-func ParseExportUserDataResponse(rsp *http.Response) (*ExportUserDataResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ExportUserDataResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/spaces/%s/invitations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseImportUserDataResponse parses an HTTP response from a ImportUserDataWithResponse call
-func ParseImportUserDataResponse(rsp *http.Response) (*ImportUserDataResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ImportUserDataResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
-
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Create a new activity
-	// (POST /activities)
-	CreateActivity(w http.ResponseWriter, r *http.Request)
-	// Delete an activity
-	// (DELETE /activities/{id})
-	DeleteActivity(w http.ResponseWriter, r *http.Request, id int64)
-	// Get a specific activity
-	// (GET /activities/{id})
-	GetActivity(w http.ResponseWriter, r *http.Request, id int64)
-	// Update an activity
-	// (PUT /activities/{id})
-	UpdateActivity(w http.ResponseWriter, r *http.Request, id int64)
-	// Download application source code
-	// (GET /code/)
-	GetSourceCode(w http.ResponseWriter, r *http.Request)
-	// List all contacts
-	// (GET /contacts)
-	GetContacts(w http.ResponseWriter, r *http.Request)
-	// Create a new contact
-	// (POST /contacts)
-	CreateContact(w http.ResponseWriter, r *http.Request)
-	// Delete a contact
-	// (DELETE /contacts/{id})
-	DeleteContact(w http.ResponseWriter, r *http.Request, id int64)
-	// Get contact including debts and activities
-	// (GET /contacts/{id})
-	GetContact(w http.ResponseWriter, r *http.Request, id int64)
-	// Update a contact
-	// (PUT /contacts/{id})
-	UpdateContact(w http.ResponseWriter, r *http.Request, id int64)
-	// Create a new debt
-	// (POST /debts)
-	CreateDebt(w http.ResponseWriter, r *http.Request)
-	// Settle a debt
-	// (DELETE /debts/{id})
-	SettleDebt(w http.ResponseWriter, r *http.Request, id int64)
-	// Update a debt
-	// (PUT /debts/{id})
-	UpdateDebt(w http.ResponseWriter, r *http.Request, id int64)
-	// List all journal entries
-	// (GET /journal)
-	GetJournalEntries(w http.ResponseWriter, r *http.Request)
-	// Create a new journal entry
-	// (POST /journal)
-	CreateJournalEntry(w http.ResponseWriter, r *http.Request)
-	// Delete a journal entry
-	// (DELETE /journal/{id})
-	DeleteJournalEntry(w http.ResponseWriter, r *http.Request, id int64)
-	// Get a specific journal entry
-	// (GET /journal/{id})
-	GetJournalEntry(w http.ResponseWriter, r *http.Request, id int64)
-	// Update a journal entry
-	// (PUT /journal/{id})
-	UpdateJournalEntry(w http.ResponseWriter, r *http.Request, id int64)
-	// Get the OpenAPI spec
-	// (GET /openapi.json)
-	GetOpenAPISpec(w http.ResponseWriter, r *http.Request)
-	// Get total counts of contacts and journal entries
-	// (GET /statistics)
-	GetStatistics(w http.ResponseWriter, r *http.Request)
-	// Get counts of contacts and journal entries for the authenticated user
-	// (GET /summary)
-	GetSummary(w http.ResponseWriter, r *http.Request)
-	// Delete all user data
-	// (DELETE /userdata)
-	DeleteUserData(w http.ResponseWriter, r *http.Request)
-	// Export all user data
-	// (GET /userdata)
-	ExportUserData(w http.ResponseWriter, r *http.Request)
-	// Import user data
-	// (POST /userdata)
-	ImportUserData(w http.ResponseWriter, r *http.Request)
-}
+	req.Header.Add("Content-Type", contentType)
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	return req, nil
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// NewGetSpaceMembersRequest generates requests for GetSpaceMembers
+func NewGetSpaceMembersRequest(server string, id int64) (*http.Request, error) {
+	var err error
 
-// CreateActivity operation middleware
-func (siw *ServerInterfaceWrapper) CreateActivity(w http.ResponseWriter, r *http.Request) {
+	var pathParam0 string
 
-	ctx := r.Context()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	r = r.WithContext(ctx)
+	operationPath := fmt.Sprintf("/spaces/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateActivity(w, r)
-	}))
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
+	return req, nil
 }
 
-// DeleteActivity operation middleware
-func (siw *ServerInterfaceWrapper) DeleteActivity(w http.ResponseWriter, r *http.Request) {
-
+// NewDeleteSpaceMemberRequest generates requests for DeleteSpaceMember
+func NewDeleteSpaceMemberRequest(server string, id int64, memberId int64) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	var pathParam0 string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	ctx := r.Context()
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "memberId", runtime.ParamLocationPath, memberId)
+	if err != nil {
+		return nil, err
+	}
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	r = r.WithContext(ctx)
+	operationPath := fmt.Sprintf("/spaces/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteActivity(w, r, id)
-	}))
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
+	return req, nil
 }
 
-// GetActivity operation middleware
-func (siw *ServerInterfaceWrapper) GetActivity(w http.ResponseWriter, r *http.Request) {
-
+// NewGetStatisticsRequest generates requests for GetStatistics
+func NewGetStatisticsRequest(server string) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	serverURL, err := url.Parse(server)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
-
-	r = r.WithContext(ctx)
+	operationPath := fmt.Sprintf("/statistics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetActivity(w, r, id)
-	}))
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
+	return req, nil
 }
 
-// UpdateActivity operation middleware
-func (siw *ServerInterfaceWrapper) UpdateActivity(w http.ResponseWriter, r *http.Request) {
-
+// NewGetSummaryRequest generates requests for GetSummary
+func NewGetSummaryRequest(server string, params *GetSummaryParams) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	serverURL, err := url.Parse(server)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	operationPath := fmt.Sprintf("/summary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	r = r.WithContext(ctx)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateActivity(w, r, id)
-	}))
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+		if params.Space != nil {
 
-	handler.ServeHTTP(w, r)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// GetSourceCode operation middleware
-func (siw *ServerInterfaceWrapper) GetSourceCode(w http.ResponseWriter, r *http.Request) {
+		}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSourceCode(w, r)
-	}))
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
+	return req, nil
 }
 
-// GetContacts operation middleware
-func (siw *ServerInterfaceWrapper) GetContacts(w http.ResponseWriter, r *http.Request) {
+// NewGetSyncRequest generates requests for GetSync
+func NewGetSyncRequest(server string, params *GetSyncParams) (*http.Request, error) {
+	var err error
 
-	ctx := r.Context()
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	operationPath := fmt.Sprintf("/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	r = r.WithContext(ctx)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetContacts(w, r)
-	}))
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+		if params.Space != nil {
 
-	handler.ServeHTTP(w, r)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// CreateContact operation middleware
-func (siw *ServerInterfaceWrapper) CreateContact(w http.ResponseWriter, r *http.Request) {
+		}
 
-	ctx := r.Context()
+		if params.Since != nil {
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	r = r.WithContext(ctx)
+		}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateContact(w, r)
-	}))
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
+	return req, nil
 }
 
-// DeleteContact operation middleware
-func (siw *ServerInterfaceWrapper) DeleteContact(w http.ResponseWriter, r *http.Request) {
+// NewPostSyncRequest calls the generic PostSync builder with application/json body
+func NewPostSyncRequest(server string, params *PostSyncParams, body PostSyncJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSyncRequestWithBody(server, params, "application/json", bodyReader)
+}
 
+// NewPostSyncRequestWithBody generates requests for PostSync with any type of body
+func NewPostSyncRequestWithBody(server string, params *PostSyncParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	operationPath := fmt.Sprintf("/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	ctx := r.Context()
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+		if params.Space != nil {
 
-	r = r.WithContext(ctx)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteContact(w, r, id)
-	}))
+		}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-// GetContact operation middleware
-func (siw *ServerInterfaceWrapper) GetContact(w http.ResponseWriter, r *http.Request) {
+	req.Header.Add("Content-Type", contentType)
 
-	var err error
+	return req, nil
+}
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+// NewGetAccessTokensRequest generates requests for GetAccessTokens
+func NewGetAccessTokensRequest(server string) (*http.Request, error) {
+	var err error
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	serverURL, err := url.Parse(server)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
-
-	r = r.WithContext(ctx)
+	operationPath := fmt.Sprintf("/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetContact(w, r, id)
-	}))
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
+	return req, nil
 }
 
-// UpdateContact operation middleware
-func (siw *ServerInterfaceWrapper) UpdateContact(w http.ResponseWriter, r *http.Request) {
+// NewCreateAccessTokenRequest calls the generic CreateAccessToken builder with application/json body
+func NewCreateAccessTokenRequest(server string, body CreateAccessTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAccessTokenRequestWithBody(server, "application/json", bodyReader)
+}
 
+// NewCreateAccessTokenRequestWithBody generates requests for CreateAccessToken with any type of body
+func NewCreateAccessTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	serverURL, err := url.Parse(server)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
-
-	r = r.WithContext(ctx)
+	operationPath := fmt.Sprintf("/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateContact(w, r, id)
-	}))
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// CreateDebt operation middleware
-func (siw *ServerInterfaceWrapper) CreateDebt(w http.ResponseWriter, r *http.Request) {
+// NewDeleteAccessTokenRequest generates requests for DeleteAccessToken
+func NewDeleteAccessTokenRequest(server string, id int64) (*http.Request, error) {
+	var err error
 
-	ctx := r.Context()
+	var pathParam0 string
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	r = r.WithContext(ctx)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateDebt(w, r)
-	}))
+	operationPath := fmt.Sprintf("/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-// SettleDebt operation middleware
-func (siw *ServerInterfaceWrapper) SettleDebt(w http.ResponseWriter, r *http.Request) {
+	return req, nil
+}
 
+// NewDeleteUserDataRequest generates requests for DeleteUserData
+func NewDeleteUserDataRequest(server string, params *DeleteUserDataParams) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	operationPath := fmt.Sprintf("/userdata")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	ctx := r.Context()
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+		if params.Space != nil {
 
-	r = r.WithContext(ctx)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SettleDebt(w, r, id)
-	}))
+		}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-// UpdateDebt operation middleware
-func (siw *ServerInterfaceWrapper) UpdateDebt(w http.ResponseWriter, r *http.Request) {
+	return req, nil
+}
 
+// NewExportUserDataRequest generates requests for ExportUserData
+func NewExportUserDataRequest(server string, params *ExportUserDataParams) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	serverURL, err := url.Parse(server)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	ctx := r.Context()
+	operationPath := fmt.Sprintf("/userdata")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	r = r.WithContext(ctx)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateDebt(w, r, id)
-	}))
+		if params.Space != nil {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	handler.ServeHTTP(w, r)
-}
+		}
 
-// GetJournalEntries operation middleware
-func (siw *ServerInterfaceWrapper) GetJournalEntries(w http.ResponseWriter, r *http.Request) {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	ctx := r.Context()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	return req, nil
+}
 
-	r = r.WithContext(ctx)
+// NewImportUserDataRequestWithBody generates requests for ImportUserData with any type of body
+func NewImportUserDataRequestWithBody(server string, params *ImportUserDataParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetJournalEntries(w, r)
-	}))
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	operationPath := fmt.Sprintf("/userdata")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// CreateJournalEntry operation middleware
-func (siw *ServerInterfaceWrapper) CreateJournalEntry(w http.ResponseWriter, r *http.Request) {
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	ctx := r.Context()
+		if params.Space != nil {
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	r = r.WithContext(ctx)
+		}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateJournalEntry(w, r)
-	}))
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	req.Header.Add("Content-Type", contentType)
 
-// DeleteJournalEntry operation middleware
-func (siw *ServerInterfaceWrapper) DeleteJournalEntry(w http.ResponseWriter, r *http.Request) {
+	return req, nil
+}
 
+// NewGetWebhooksRequest generates requests for GetWebhooks
+func NewGetWebhooksRequest(server string, params *GetWebhooksParams) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	ctx := r.Context()
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+		if params.Space != nil {
 
-	r = r.WithContext(ctx)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteJournalEntry(w, r, id)
-	}))
+		}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	handler.ServeHTTP(w, r)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// GetJournalEntry operation middleware
-func (siw *ServerInterfaceWrapper) GetJournalEntry(w http.ResponseWriter, r *http.Request) {
+// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
+func NewCreateWebhookRequest(server string, params *CreateWebhookParams, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookRequestWithBody(server, params, "application/json", bodyReader)
+}
 
+// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
+func NewCreateWebhookRequestWithBody(server string, params *CreateWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	ctx := r.Context()
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+		if params.Space != nil {
 
-	r = r.WithContext(ctx)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetJournalEntry(w, r, id)
-	}))
+		}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-// UpdateJournalEntry operation middleware
-func (siw *ServerInterfaceWrapper) UpdateJournalEntry(w http.ResponseWriter, r *http.Request) {
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
 
+// NewDeleteWebhookRequest generates requests for DeleteWebhook
+func NewDeleteWebhookRequest(server string, id int64, params *DeleteWebhookParams) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	var pathParam0 string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	ctx := r.Context()
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	r = r.WithContext(ctx)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateJournalEntry(w, r, id)
-	}))
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+		if params.Space != nil {
 
-	handler.ServeHTTP(w, r)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// GetOpenAPISpec operation middleware
-func (siw *ServerInterfaceWrapper) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+		}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetOpenAPISpec(w, r)
-	}))
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
+	return req, nil
 }
 
-// GetStatistics operation middleware
-func (siw *ServerInterfaceWrapper) GetStatistics(w http.ResponseWriter, r *http.Request) {
+// NewGetWebhookDeliveriesRequest generates requests for GetWebhookDeliveries
+func NewGetWebhookDeliveriesRequest(server string, id int64, params *GetWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatistics(w, r)
-	}))
+	var pathParam0 string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// GetSummary operation middleware
-func (siw *ServerInterfaceWrapper) GetSummary(w http.ResponseWriter, r *http.Request) {
+	operationPath := fmt.Sprintf("/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	ctx := r.Context()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	r = r.WithContext(ctx)
+		if params.Space != nil {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSummary(w, r)
-	}))
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "space", runtime.ParamLocationQuery, *params.Space); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
+		}
 
-// DeleteUserData operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUserData(w http.ResponseWriter, r *http.Request) {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	ctx := r.Context()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	return req, nil
+}
 
-	r = r.WithContext(ctx)
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteUserData(w, r)
-	}))
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
 	}
+	return &ClientWithResponses{client}, nil
+}
 
-	handler.ServeHTTP(w, r)
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
 }
 
-// ExportUserData operation middleware
-func (siw *ServerInterfaceWrapper) ExportUserData(w http.ResponseWriter, r *http.Request) {
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetActivitiesWithResponse request
+	GetActivitiesWithResponse(ctx context.Context, params *GetActivitiesParams, reqEditors ...RequestEditorFn) (*GetActivitiesResponse, error)
 
-	ctx := r.Context()
+	// CreateActivityWithBodyWithResponse request with any body
+	CreateActivityWithBodyWithResponse(ctx context.Context, params *CreateActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error)
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	CreateActivityWithResponse(ctx context.Context, params *CreateActivityParams, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error)
 
-	r = r.WithContext(ctx)
+	// DeleteActivityWithResponse request
+	DeleteActivityWithResponse(ctx context.Context, id int64, params *DeleteActivityParams, reqEditors ...RequestEditorFn) (*DeleteActivityResponse, error)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExportUserData(w, r)
-	}))
+	// GetActivityWithResponse request
+	GetActivityWithResponse(ctx context.Context, id int64, params *GetActivityParams, reqEditors ...RequestEditorFn) (*GetActivityResponse, error)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// PatchActivityWithBodyWithResponse request with any body
+	PatchActivityWithBodyWithResponse(ctx context.Context, id int64, params *PatchActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchActivityResponse, error)
 
-	handler.ServeHTTP(w, r)
-}
+	PatchActivityWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int64, params *PatchActivityParams, body PatchActivityApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchActivityResponse, error)
 
-// ImportUserData operation middleware
-func (siw *ServerInterfaceWrapper) ImportUserData(w http.ResponseWriter, r *http.Request) {
+	// UpdateActivityWithBodyWithResponse request with any body
+	UpdateActivityWithBodyWithResponse(ctx context.Context, id int64, params *UpdateActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error)
 
-	ctx := r.Context()
+	UpdateActivityWithResponse(ctx context.Context, id int64, params *UpdateActivityParams, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error)
 
-	ctx = context.WithValue(ctx, OidcScopes, []string{})
+	// BackchannelLogoutWithBodyWithResponse request with any body
+	BackchannelLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BackchannelLogoutResponse, error)
 
-	r = r.WithContext(ctx)
+	BackchannelLogoutWithFormdataBodyWithResponse(ctx context.Context, body BackchannelLogoutFormdataRequestBody, reqEditors ...RequestEditorFn) (*BackchannelLogoutResponse, error)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ImportUserData(w, r)
-	}))
+	// PostBatchWithBodyWithResponse request with any body
+	PostBatchWithBodyWithResponse(ctx context.Context, params *PostBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBatchResponse, error)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	PostBatchWithResponse(ctx context.Context, params *PostBatchParams, body PostBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBatchResponse, error)
 
-	handler.ServeHTTP(w, r)
-}
+	// GetSourceCodeWithResponse request
+	GetSourceCodeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourceCodeResponse, error)
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	// GetContactsWithResponse request
+	GetContactsWithResponse(ctx context.Context, params *GetContactsParams, reqEditors ...RequestEditorFn) (*GetContactsResponse, error)
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	// CreateContactWithBodyWithResponse request with any body
+	CreateContactWithBodyWithResponse(ctx context.Context, params *CreateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContactResponse, error)
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	CreateContactWithResponse(ctx context.Context, params *CreateContactParams, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContactResponse, error)
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	// DeleteContactWithResponse request
+	DeleteContactWithResponse(ctx context.Context, id int64, params *DeleteContactParams, reqEditors ...RequestEditorFn) (*DeleteContactResponse, error)
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// GetContactWithResponse request
+	GetContactWithResponse(ctx context.Context, id int64, params *GetContactParams, reqEditors ...RequestEditorFn) (*GetContactResponse, error)
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// PatchContactWithBodyWithResponse request with any body
+	PatchContactWithBodyWithResponse(ctx context.Context, id int64, params *PatchContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchContactResponse, error)
 
-type RequiredParamError struct {
-	ParamName string
-}
+	PatchContactWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int64, params *PatchContactParams, body PatchContactApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchContactResponse, error)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// UpdateContactWithBodyWithResponse request with any body
+	UpdateContactWithBodyWithResponse(ctx context.Context, id int64, params *UpdateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error)
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	UpdateContactWithResponse(ctx context.Context, id int64, params *UpdateContactParams, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error)
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// GetDebtsWithResponse request
+	GetDebtsWithResponse(ctx context.Context, params *GetDebtsParams, reqEditors ...RequestEditorFn) (*GetDebtsResponse, error)
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	// CreateDebtWithBodyWithResponse request with any body
+	CreateDebtWithBodyWithResponse(ctx context.Context, params *CreateDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDebtResponse, error)
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	CreateDebtWithResponse(ctx context.Context, params *CreateDebtParams, body CreateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDebtResponse, error)
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// SettleDebtWithResponse request
+	SettleDebtWithResponse(ctx context.Context, id int64, params *SettleDebtParams, reqEditors ...RequestEditorFn) (*SettleDebtResponse, error)
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	// PatchDebtWithBodyWithResponse request with any body
+	PatchDebtWithBodyWithResponse(ctx context.Context, id int64, params *PatchDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDebtResponse, error)
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	PatchDebtWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int64, params *PatchDebtParams, body PatchDebtApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDebtResponse, error)
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	// UpdateDebtWithBodyWithResponse request with any body
+	UpdateDebtWithBodyWithResponse(ctx context.Context, id int64, params *UpdateDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDebtResponse, error)
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+	UpdateDebtWithResponse(ctx context.Context, id int64, params *UpdateDebtParams, body UpdateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDebtResponse, error)
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+	// GetEventsWithResponse request
+	GetEventsWithResponse(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*GetEventsResponse, error)
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// GetSpaceInvitationsWithResponse request
+	GetSpaceInvitationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSpaceInvitationsResponse, error)
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+	// DeclineSpaceInvitationWithResponse request
+	DeclineSpaceInvitationWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeclineSpaceInvitationResponse, error)
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
+	// AcceptSpaceInvitationWithResponse request
+	AcceptSpaceInvitationWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*AcceptSpaceInvitationResponse, error)
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+	// GetJournalEntriesWithResponse request
+	GetJournalEntriesWithResponse(ctx context.Context, params *GetJournalEntriesParams, reqEditors ...RequestEditorFn) (*GetJournalEntriesResponse, error)
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+	// CreateJournalEntryWithBodyWithResponse request with any body
+	CreateJournalEntryWithBodyWithResponse(ctx context.Context, params *CreateJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateJournalEntryResponse, error)
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	CreateJournalEntryWithResponse(ctx context.Context, params *CreateJournalEntryParams, body CreateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateJournalEntryResponse, error)
 
-	m.HandleFunc("POST "+options.BaseURL+"/activities", wrapper.CreateActivity)
-	m.HandleFunc("DELETE "+options.BaseURL+"/activities/{id}", wrapper.DeleteActivity)
-	m.HandleFunc("GET "+options.BaseURL+"/activities/{id}", wrapper.GetActivity)
-	m.HandleFunc("PUT "+options.BaseURL+"/activities/{id}", wrapper.UpdateActivity)
-	m.HandleFunc("GET "+options.BaseURL+"/code/", wrapper.GetSourceCode)
-	m.HandleFunc("GET "+options.BaseURL+"/contacts", wrapper.GetContacts)
-	m.HandleFunc("POST "+options.BaseURL+"/contacts", wrapper.CreateContact)
-	m.HandleFunc("DELETE "+options.BaseURL+"/contacts/{id}", wrapper.DeleteContact)
-	m.HandleFunc("GET "+options.BaseURL+"/contacts/{id}", wrapper.GetContact)
-	m.HandleFunc("PUT "+options.BaseURL+"/contacts/{id}", wrapper.UpdateContact)
-	m.HandleFunc("POST "+options.BaseURL+"/debts", wrapper.CreateDebt)
-	m.HandleFunc("DELETE "+options.BaseURL+"/debts/{id}", wrapper.SettleDebt)
-	m.HandleFunc("PUT "+options.BaseURL+"/debts/{id}", wrapper.UpdateDebt)
-	m.HandleFunc("GET "+options.BaseURL+"/journal", wrapper.GetJournalEntries)
-	m.HandleFunc("POST "+options.BaseURL+"/journal", wrapper.CreateJournalEntry)
-	m.HandleFunc("DELETE "+options.BaseURL+"/journal/{id}", wrapper.DeleteJournalEntry)
-	m.HandleFunc("GET "+options.BaseURL+"/journal/{id}", wrapper.GetJournalEntry)
-	m.HandleFunc("PUT "+options.BaseURL+"/journal/{id}", wrapper.UpdateJournalEntry)
-	m.HandleFunc("GET "+options.BaseURL+"/openapi.json", wrapper.GetOpenAPISpec)
-	m.HandleFunc("GET "+options.BaseURL+"/statistics", wrapper.GetStatistics)
-	m.HandleFunc("GET "+options.BaseURL+"/summary", wrapper.GetSummary)
-	m.HandleFunc("DELETE "+options.BaseURL+"/userdata", wrapper.DeleteUserData)
-	m.HandleFunc("GET "+options.BaseURL+"/userdata", wrapper.ExportUserData)
-	m.HandleFunc("POST "+options.BaseURL+"/userdata", wrapper.ImportUserData)
+	// DeleteJournalEntryWithResponse request
+	DeleteJournalEntryWithResponse(ctx context.Context, id int64, params *DeleteJournalEntryParams, reqEditors ...RequestEditorFn) (*DeleteJournalEntryResponse, error)
 
-	return m
-}
+	// GetJournalEntryWithResponse request
+	GetJournalEntryWithResponse(ctx context.Context, id int64, params *GetJournalEntryParams, reqEditors ...RequestEditorFn) (*GetJournalEntryResponse, error)
 
-type CreateActivityRequestObject struct {
-	Body *CreateActivityJSONRequestBody
-}
+	// PatchJournalEntryWithBodyWithResponse request with any body
+	PatchJournalEntryWithBodyWithResponse(ctx context.Context, id int64, params *PatchJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchJournalEntryResponse, error)
 
-type CreateActivityResponseObject interface {
-	VisitCreateActivityResponse(w http.ResponseWriter) error
-}
+	PatchJournalEntryWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int64, params *PatchJournalEntryParams, body PatchJournalEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchJournalEntryResponse, error)
 
-type CreateActivity200JSONResponse Activity
+	// UpdateJournalEntryWithBodyWithResponse request with any body
+	UpdateJournalEntryWithBodyWithResponse(ctx context.Context, id int64, params *UpdateJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateJournalEntryResponse, error)
 
-func (response CreateActivity200JSONResponse) VisitCreateActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	UpdateJournalEntryWithResponse(ctx context.Context, id int64, params *UpdateJournalEntryParams, body UpdateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateJournalEntryResponse, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// GetOpenAPISpecWithResponse request
+	GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error)
 
-type CreateActivity403TextResponse string
+	// DeleteSessionsWithResponse request
+	DeleteSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionsResponse, error)
 
-func (response CreateActivity403TextResponse) VisitCreateActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
+	// GetSessionsWithResponse request
+	GetSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSessionsResponse, error)
 
-	_, err := w.Write([]byte(response))
-	return err
-}
+	// DeleteSessionWithResponse request
+	DeleteSessionWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error)
 
-type CreateActivity500TextResponse string
+	// GetSpacesWithResponse request
+	GetSpacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSpacesResponse, error)
 
-func (response CreateActivity500TextResponse) VisitCreateActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+	// CreateSpaceWithBodyWithResponse request with any body
+	CreateSpaceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error)
 
-	_, err := w.Write([]byte(response))
-	return err
-}
+	CreateSpaceWithResponse(ctx context.Context, body CreateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error)
 
-type DeleteActivityRequestObject struct {
-	Id int64 `json:"id"`
-}
+	// DeleteSpaceWithResponse request
+	DeleteSpaceWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteSpaceResponse, error)
 
-type DeleteActivityResponseObject interface {
-	VisitDeleteActivityResponse(w http.ResponseWriter) error
-}
+	// CreateSpaceInvitationWithBodyWithResponse request with any body
+	CreateSpaceInvitationWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSpaceInvitationResponse, error)
 
-type DeleteActivity200JSONResponse int64
+	CreateSpaceInvitationWithResponse(ctx context.Context, id int64, body CreateSpaceInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSpaceInvitationResponse, error)
 
-func (response DeleteActivity200JSONResponse) VisitDeleteActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// GetSpaceMembersWithResponse request
+	GetSpaceMembersWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetSpaceMembersResponse, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// DeleteSpaceMemberWithResponse request
+	DeleteSpaceMemberWithResponse(ctx context.Context, id int64, memberId int64, reqEditors ...RequestEditorFn) (*DeleteSpaceMemberResponse, error)
 
-type DeleteActivity403TextResponse string
+	// GetStatisticsWithResponse request
+	GetStatisticsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsResponse, error)
 
-func (response DeleteActivity403TextResponse) VisitDeleteActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
+	// GetSummaryWithResponse request
+	GetSummaryWithResponse(ctx context.Context, params *GetSummaryParams, reqEditors ...RequestEditorFn) (*GetSummaryResponse, error)
 
-	_, err := w.Write([]byte(response))
-	return err
-}
+	// GetSyncWithResponse request
+	GetSyncWithResponse(ctx context.Context, params *GetSyncParams, reqEditors ...RequestEditorFn) (*GetSyncResponse, error)
 
-type DeleteActivity500TextResponse string
+	// PostSyncWithBodyWithResponse request with any body
+	PostSyncWithBodyWithResponse(ctx context.Context, params *PostSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSyncResponse, error)
 
-func (response DeleteActivity500TextResponse) VisitDeleteActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+	PostSyncWithResponse(ctx context.Context, params *PostSyncParams, body PostSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSyncResponse, error)
 
-	_, err := w.Write([]byte(response))
-	return err
-}
+	// GetAccessTokensWithResponse request
+	GetAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccessTokensResponse, error)
 
-type GetActivityRequestObject struct {
-	Id int64 `json:"id"`
-}
+	// CreateAccessTokenWithBodyWithResponse request with any body
+	CreateAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error)
 
-type GetActivityResponseObject interface {
-	VisitGetActivityResponse(w http.ResponseWriter) error
-}
+	CreateAccessTokenWithResponse(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error)
 
-type GetActivity200JSONResponse ActivityWithContact
+	// DeleteAccessTokenWithResponse request
+	DeleteAccessTokenWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteAccessTokenResponse, error)
 
-func (response GetActivity200JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// DeleteUserDataWithResponse request
+	DeleteUserDataWithResponse(ctx context.Context, params *DeleteUserDataParams, reqEditors ...RequestEditorFn) (*DeleteUserDataResponse, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ExportUserDataWithResponse request
+	ExportUserDataWithResponse(ctx context.Context, params *ExportUserDataParams, reqEditors ...RequestEditorFn) (*ExportUserDataResponse, error)
 
-type GetActivity403TextResponse string
+	// ImportUserDataWithBodyWithResponse request with any body
+	ImportUserDataWithBodyWithResponse(ctx context.Context, params *ImportUserDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportUserDataResponse, error)
 
-func (response GetActivity403TextResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
+	// GetWebhooksWithResponse request
+	GetWebhooksWithResponse(ctx context.Context, params *GetWebhooksParams, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error)
 
-	_, err := w.Write([]byte(response))
-	return err
-}
+	// CreateWebhookWithBodyWithResponse request with any body
+	CreateWebhookWithBodyWithResponse(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
 
-type GetActivity500TextResponse string
+	CreateWebhookWithResponse(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
 
-func (response GetActivity500TextResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+	// DeleteWebhookWithResponse request
+	DeleteWebhookWithResponse(ctx context.Context, id int64, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error)
 
-	_, err := w.Write([]byte(response))
-	return err
+	// GetWebhookDeliveriesWithResponse request
+	GetWebhookDeliveriesWithResponse(ctx context.Context, id int64, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhookDeliveriesResponse, error)
 }
 
-type UpdateActivityRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *UpdateActivityJSONRequestBody
+type GetActivitiesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]ActivityWithContact
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type UpdateActivityResponseObject interface {
-	VisitUpdateActivityResponse(w http.ResponseWriter) error
+// Status returns HTTPResponse.Status
+func (r GetActivitiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type UpdateActivity200JSONResponse Activity
-
-func (response UpdateActivity200JSONResponse) VisitUpdateActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetActivitiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type UpdateActivity403TextResponse string
-
-func (response UpdateActivity403TextResponse) VisitUpdateActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+type CreateActivityResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Activity
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type UpdateActivity500TextResponse string
-
-func (response UpdateActivity500TextResponse) VisitUpdateActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+// Status returns HTTPResponse.Status
+func (r CreateActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetSourceCodeRequestObject struct {
+type DeleteActivityResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *int64
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetSourceCodeResponseObject interface {
-	VisitGetSourceCodeResponse(w http.ResponseWriter) error
+// Status returns HTTPResponse.Status
+func (r DeleteActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetSourceCode200ResponseHeaders struct {
-	ContentDisposition string
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetSourceCode200ApplicationgzipResponse struct {
-	Body          io.Reader
-	Headers       GetSourceCode200ResponseHeaders
-	ContentLength int64
+type GetActivityResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ActivityWithContact
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-func (response GetSourceCode200ApplicationgzipResponse) VisitGetSourceCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/gzip")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+// Status returns HTTPResponse.Status
+func (r GetActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
-	w.WriteHeader(200)
+	return http.StatusText(0)
+}
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	return 0
 }
 
-type GetSourceCode500TextResponse string
-
-func (response GetSourceCode500TextResponse) VisitGetSourceCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+type PatchActivityResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Activity
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetContactsRequestObject struct {
+// Status returns HTTPResponse.Status
+func (r PatchActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetContactsResponseObject interface {
-	VisitGetContactsResponse(w http.ResponseWriter) error
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetContacts200JSONResponse []Contact
-
-func (response GetContacts200JSONResponse) VisitGetContactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type UpdateActivityResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Activity
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetContacts403TextResponse string
-
-func (response GetContacts403TextResponse) VisitGetContactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r UpdateActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetContacts500TextResponse string
-
-func (response GetContacts500TextResponse) VisitGetContactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type CreateContactRequestObject struct {
-	Body *CreateContactJSONRequestBody
+type BackchannelLogoutResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type CreateContactResponseObject interface {
-	VisitCreateContactResponse(w http.ResponseWriter) error
+// Status returns HTTPResponse.Status
+func (r BackchannelLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type CreateContact200JSONResponse Contact
-
-func (response CreateContact200JSONResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// StatusCode returns HTTPResponse.StatusCode
+func (r BackchannelLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type CreateContact403TextResponse string
-
-func (response CreateContact403TextResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+type PostBatchResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BatchResult
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type CreateContact500TextResponse string
-
-func (response CreateContact500TextResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r PostBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type DeleteContactRequestObject struct {
-	Id int64 `json:"id"`
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type DeleteContactResponseObject interface {
-	VisitDeleteContactResponse(w http.ResponseWriter) error
+type GetSourceCodeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type DeleteContact200JSONResponse int64
-
-func (response DeleteContact200JSONResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// Status returns HTTPResponse.Status
+func (r GetSourceCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type DeleteContact403TextResponse string
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSourceCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-func (response DeleteContact403TextResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
+type GetContactsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Contact
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r GetContactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type DeleteContact500TextResponse string
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetContactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-func (response DeleteContact500TextResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+type CreateContactResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Contact
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r CreateContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetContactRequestObject struct {
-	Id int64 `json:"id"`
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetContactResponseObject interface {
-	VisitGetContactResponse(w http.ResponseWriter) error
+type DeleteContactResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *int64
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetContact200JSONResponse ContactData
-
-func (response GetContact200JSONResponse) VisitGetContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// Status returns HTTPResponse.Status
+func (r DeleteContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetContact403TextResponse string
-
-func (response GetContact403TextResponse) VisitGetContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetContact500TextResponse string
-
-func (response GetContact500TextResponse) VisitGetContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+type GetContactResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ContactData
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type UpdateContactRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *UpdateContactJSONRequestBody
+// Status returns HTTPResponse.Status
+func (r GetContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type UpdateContactResponseObject interface {
-	VisitUpdateContactResponse(w http.ResponseWriter) error
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type UpdateContact200JSONResponse Contact
-
-func (response UpdateContact200JSONResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type PatchContactResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Contact
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type UpdateContact403TextResponse string
-
-func (response UpdateContact403TextResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r PatchContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type UpdateContact500TextResponse string
-
-func (response UpdateContact500TextResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type CreateDebtRequestObject struct {
-	Body *CreateDebtJSONRequestBody
+type UpdateContactResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Contact
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type CreateDebtResponseObject interface {
-	VisitCreateDebtResponse(w http.ResponseWriter) error
+// Status returns HTTPResponse.Status
+func (r UpdateContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type CreateDebt200JSONResponse Debt
-
-func (response CreateDebt200JSONResponse) VisitCreateDebtResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type CreateDebt403TextResponse string
-
-func (response CreateDebt403TextResponse) VisitCreateDebtResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+type GetDebtsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]DebtWithContact
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type CreateDebt500TextResponse string
-
-func (response CreateDebt500TextResponse) VisitCreateDebtResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r GetDebtsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type SettleDebtRequestObject struct {
-	Id int64 `json:"id"`
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDebtsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type SettleDebtResponseObject interface {
-	VisitSettleDebtResponse(w http.ResponseWriter) error
+type CreateDebtResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Debt
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type SettleDebt200JSONResponse int64
-
-func (response SettleDebt200JSONResponse) VisitSettleDebtResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// Status returns HTTPResponse.Status
+func (r CreateDebtResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type SettleDebt403TextResponse string
-
-func (response SettleDebt403TextResponse) VisitSettleDebtResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDebtResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type SettleDebt500TextResponse string
-
-func (response SettleDebt500TextResponse) VisitSettleDebtResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+type SettleDebtResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *int64
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type UpdateDebtRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *UpdateDebtJSONRequestBody
+// Status returns HTTPResponse.Status
+func (r SettleDebtResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type UpdateDebtResponseObject interface {
-	VisitUpdateDebtResponse(w http.ResponseWriter) error
+// StatusCode returns HTTPResponse.StatusCode
+func (r SettleDebtResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type UpdateDebt200JSONResponse Debt
-
-func (response UpdateDebt200JSONResponse) VisitUpdateDebtResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type PatchDebtResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Debt
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type UpdateDebt403TextResponse string
-
-func (response UpdateDebt403TextResponse) VisitUpdateDebtResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r PatchDebtResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type UpdateDebt500TextResponse string
-
-func (response UpdateDebt500TextResponse) VisitUpdateDebtResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchDebtResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetJournalEntriesRequestObject struct {
+type UpdateDebtResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Debt
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetJournalEntriesResponseObject interface {
-	VisitGetJournalEntriesResponse(w http.ResponseWriter) error
+// Status returns HTTPResponse.Status
+func (r UpdateDebtResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetJournalEntries200JSONResponse []JournalEntry
-
-func (response GetJournalEntries200JSONResponse) VisitGetJournalEntriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDebtResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetJournalEntries403TextResponse string
-
-func (response GetJournalEntries403TextResponse) VisitGetJournalEntriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+type GetEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetJournalEntries500TextResponse string
+// Status returns HTTPResponse.Status
+func (r GetEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-func (response GetJournalEntries500TextResponse) VisitGetJournalEntriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+type GetSpaceInvitationsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]SpaceInvitation
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type CreateJournalEntryRequestObject struct {
-	Body *CreateJournalEntryJSONRequestBody
+// Status returns HTTPResponse.Status
+func (r GetSpaceInvitationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type CreateJournalEntryResponseObject interface {
-	VisitCreateJournalEntryResponse(w http.ResponseWriter) error
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpaceInvitationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type CreateJournalEntry200JSONResponse JournalEntry
+type DeclineSpaceInvitationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *int64
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-func (response CreateJournalEntry200JSONResponse) VisitCreateJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// Status returns HTTPResponse.Status
+func (r DeclineSpaceInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeclineSpaceInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type CreateJournalEntry403TextResponse string
+type AcceptSpaceInvitationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Space
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-func (response CreateJournalEntry403TextResponse) VisitCreateJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r AcceptSpaceInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type CreateJournalEntry500TextResponse string
-
-func (response CreateJournalEntry500TextResponse) VisitCreateJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcceptSpaceInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type DeleteJournalEntryRequestObject struct {
-	Id int64 `json:"id"`
+type GetJournalEntriesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]JournalEntry
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type DeleteJournalEntryResponseObject interface {
-	VisitDeleteJournalEntryResponse(w http.ResponseWriter) error
+// Status returns HTTPResponse.Status
+func (r GetJournalEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type DeleteJournalEntry200JSONResponse int64
-
-func (response DeleteJournalEntry200JSONResponse) VisitDeleteJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJournalEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type DeleteJournalEntry403TextResponse string
-
-func (response DeleteJournalEntry403TextResponse) VisitDeleteJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+type CreateJournalEntryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *JournalEntry
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type DeleteJournalEntry500TextResponse string
-
-func (response DeleteJournalEntry500TextResponse) VisitDeleteJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r CreateJournalEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetJournalEntryRequestObject struct {
-	Id int64 `json:"id"`
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateJournalEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetJournalEntryResponseObject interface {
-	VisitGetJournalEntryResponse(w http.ResponseWriter) error
+type DeleteJournalEntryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *int64
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetJournalEntry200JSONResponse JournalEntry
-
-func (response GetJournalEntry200JSONResponse) VisitGetJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// Status returns HTTPResponse.Status
+func (r DeleteJournalEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetJournalEntry403TextResponse string
-
-func (response GetJournalEntry403TextResponse) VisitGetJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteJournalEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetJournalEntry500TextResponse string
-
-func (response GetJournalEntry500TextResponse) VisitGetJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+type GetJournalEntryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *JournalEntry
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type UpdateJournalEntryRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *UpdateJournalEntryJSONRequestBody
+// Status returns HTTPResponse.Status
+func (r GetJournalEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type UpdateJournalEntryResponseObject interface {
-	VisitUpdateJournalEntryResponse(w http.ResponseWriter) error
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJournalEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type UpdateJournalEntry200JSONResponse JournalEntry
-
-func (response UpdateJournalEntry200JSONResponse) VisitUpdateJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type PatchJournalEntryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *JournalEntry
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type UpdateJournalEntry403TextResponse string
-
-func (response UpdateJournalEntry403TextResponse) VisitUpdateJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
-
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r PatchJournalEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type UpdateJournalEntry500TextResponse string
-
-func (response UpdateJournalEntry500TextResponse) VisitUpdateJournalEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
-
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchJournalEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetOpenAPISpecRequestObject struct {
+type UpdateJournalEntryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *JournalEntry
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetOpenAPISpecResponseObject interface {
-	VisitGetOpenAPISpecResponse(w http.ResponseWriter) error
+// Status returns HTTPResponse.Status
+func (r UpdateJournalEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetOpenAPISpec200ResponseHeaders struct {
-	ContentType string
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateJournalEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetOpenAPISpec200ApplicationoctetStreamResponse struct {
-	Body          io.Reader
-	Headers       GetOpenAPISpec200ResponseHeaders
-	ContentLength int64
+type GetOpenAPISpecResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-func (response GetOpenAPISpec200ApplicationoctetStreamResponse) VisitGetOpenAPISpecResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/octet-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+// Status returns HTTPResponse.Status
+func (r GetOpenAPISpecResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	w.Header().Set("Content-Type", fmt.Sprint(response.Headers.ContentType))
-	w.WriteHeader(200)
+	return http.StatusText(0)
+}
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPISpecResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	return 0
 }
 
-type GetOpenAPISpec500TextResponse string
+type DeleteSessionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]int64
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-func (response GetOpenAPISpec500TextResponse) VisitGetOpenAPISpecResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+// Status returns HTTPResponse.Status
+func (r DeleteSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetStatisticsRequestObject struct {
+type GetSessionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Session
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetStatisticsResponseObject interface {
-	VisitGetStatisticsResponse(w http.ResponseWriter) error
+// Status returns HTTPResponse.Status
+func (r GetSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetStatistics200JSONResponse IndexData
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-func (response GetStatistics200JSONResponse) VisitGetStatisticsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type DeleteSessionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *int64
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Status returns HTTPResponse.Status
+func (r DeleteSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetStatistics403TextResponse string
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-func (response GetStatistics403TextResponse) VisitGetStatisticsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
+type GetSpacesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Space
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r GetSpacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetStatistics500TextResponse string
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-func (response GetStatistics500TextResponse) VisitGetStatisticsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+type CreateSpaceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Space
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r CreateSpaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetSummaryRequestObject struct {
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSpaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetSummaryResponseObject interface {
-	VisitGetSummaryResponse(w http.ResponseWriter) error
+type DeleteSpaceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *int64
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetSummary200JSONResponse IndexData
+// Status returns HTTPResponse.Status
+func (r DeleteSpaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-func (response GetSummary200JSONResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSpaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	return json.NewEncoder(w).Encode(response)
+type CreateSpaceInvitationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SpaceInvitation
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetSummary403TextResponse string
+// Status returns HTTPResponse.Status
+func (r CreateSpaceInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-func (response GetSummary403TextResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSpaceInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+type GetSpaceMembersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]SpaceMember
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type GetSummary500TextResponse string
+// Status returns HTTPResponse.Status
+func (r GetSpaceMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-func (response GetSummary500TextResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpaceMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+type DeleteSpaceMemberResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *int64
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type DeleteUserDataRequestObject struct {
+// Status returns HTTPResponse.Status
+func (r DeleteSpaceMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type DeleteUserDataResponseObject interface {
-	VisitDeleteUserDataResponse(w http.ResponseWriter) error
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSpaceMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type DeleteUserData200Response struct {
+type GetStatisticsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *IndexData
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-func (response DeleteUserData200Response) VisitDeleteUserDataResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+// Status returns HTTPResponse.Status
+func (r GetStatisticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type DeleteUserData403TextResponse string
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatisticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-func (response DeleteUserData403TextResponse) VisitDeleteUserDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
+type GetSummaryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *IndexData
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r GetSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type DeleteUserData500TextResponse string
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-func (response DeleteUserData500TextResponse) VisitDeleteUserDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+type GetSyncResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SyncChangeset
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+// Status returns HTTPResponse.Status
+func (r GetSyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type ExportUserDataRequestObject struct {
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type ExportUserDataResponseObject interface {
-	VisitExportUserDataResponse(w http.ResponseWriter) error
+type PostSyncResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]SyncMutationResult
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type ExportUserData200ResponseHeaders struct {
-	ContentDisposition string
+// Status returns HTTPResponse.Status
+func (r PostSyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type ExportUserData200ApplicationjsonlResponse struct {
-	Body          io.Reader
-	Headers       ExportUserData200ResponseHeaders
-	ContentLength int64
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-func (response ExportUserData200ApplicationjsonlResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/jsonl")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+type GetAccessTokensResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]AccessToken
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON500 *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccessTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
-	w.WriteHeader(200)
+	return http.StatusText(0)
+}
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccessTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	return 0
 }
 
-type ExportUserData403TextResponse string
+type CreateAccessTokenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AccessToken
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-func (response ExportUserData403TextResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
+// Status returns HTTPResponse.Status
+func (r CreateAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type ExportUserData500TextResponse string
+type DeleteAccessTokenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *int64
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
 
-func (response ExportUserData500TextResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+// Status returns HTTPResponse.Status
+func (r DeleteAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type ImportUserDataRequestObject struct {
-	Body *multipart.Reader
+type DeleteUserDataResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type ImportUserDataResponseObject interface {
-	VisitImportUserDataResponse(w http.ResponseWriter) error
+// Status returns HTTPResponse.Status
+func (r DeleteUserDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type ImportUserData200Response struct {
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-func (response ImportUserData200Response) VisitImportUserDataResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type ExportUserDataResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type ImportUserData403TextResponse string
+// Status returns HTTPResponse.Status
+func (r ExportUserDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-func (response ImportUserData403TextResponse) VisitImportUserDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(403)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportUserDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+type ImportUserDataResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-type ImportUserData500TextResponse string
+// Status returns HTTPResponse.Status
+func (r ImportUserDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-func (response ImportUserData500TextResponse) VisitImportUserDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(500)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportUserDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	_, err := w.Write([]byte(response))
-	return err
+type GetWebhooksResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Webhook
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Create a new activity
-	// (POST /activities)
-	CreateActivity(ctx context.Context, request CreateActivityRequestObject) (CreateActivityResponseObject, error)
-	// Delete an activity
-	// (DELETE /activities/{id})
-	DeleteActivity(ctx context.Context, request DeleteActivityRequestObject) (DeleteActivityResponseObject, error)
-	// Get a specific activity
-	// (GET /activities/{id})
-	GetActivity(ctx context.Context, request GetActivityRequestObject) (GetActivityResponseObject, error)
-	// Update an activity
-	// (PUT /activities/{id})
-	UpdateActivity(ctx context.Context, request UpdateActivityRequestObject) (UpdateActivityResponseObject, error)
-	// Download application source code
-	// (GET /code/)
-	GetSourceCode(ctx context.Context, request GetSourceCodeRequestObject) (GetSourceCodeResponseObject, error)
-	// List all contacts
-	// (GET /contacts)
-	GetContacts(ctx context.Context, request GetContactsRequestObject) (GetContactsResponseObject, error)
-	// Create a new contact
-	// (POST /contacts)
-	CreateContact(ctx context.Context, request CreateContactRequestObject) (CreateContactResponseObject, error)
-	// Delete a contact
-	// (DELETE /contacts/{id})
-	DeleteContact(ctx context.Context, request DeleteContactRequestObject) (DeleteContactResponseObject, error)
-	// Get contact including debts and activities
-	// (GET /contacts/{id})
-	GetContact(ctx context.Context, request GetContactRequestObject) (GetContactResponseObject, error)
+// Status returns HTTPResponse.Status
+func (r GetWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebhookResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Webhook
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *int64
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookDeliveriesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]WebhookDelivery
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON422 *UnprocessableContent
+	ApplicationproblemJSON500 *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetActivitiesWithResponse request returning *GetActivitiesResponse
+func (c *ClientWithResponses) GetActivitiesWithResponse(ctx context.Context, params *GetActivitiesParams, reqEditors ...RequestEditorFn) (*GetActivitiesResponse, error) {
+	rsp, err := c.GetActivities(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetActivitiesResponse(rsp)
+}
+
+// CreateActivityWithBodyWithResponse request with arbitrary body returning *CreateActivityResponse
+func (c *ClientWithResponses) CreateActivityWithBodyWithResponse(ctx context.Context, params *CreateActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error) {
+	rsp, err := c.CreateActivityWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateActivityResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateActivityWithResponse(ctx context.Context, params *CreateActivityParams, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error) {
+	rsp, err := c.CreateActivity(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateActivityResponse(rsp)
+}
+
+// DeleteActivityWithResponse request returning *DeleteActivityResponse
+func (c *ClientWithResponses) DeleteActivityWithResponse(ctx context.Context, id int64, params *DeleteActivityParams, reqEditors ...RequestEditorFn) (*DeleteActivityResponse, error) {
+	rsp, err := c.DeleteActivity(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteActivityResponse(rsp)
+}
+
+// GetActivityWithResponse request returning *GetActivityResponse
+func (c *ClientWithResponses) GetActivityWithResponse(ctx context.Context, id int64, params *GetActivityParams, reqEditors ...RequestEditorFn) (*GetActivityResponse, error) {
+	rsp, err := c.GetActivity(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetActivityResponse(rsp)
+}
+
+// PatchActivityWithBodyWithResponse request with arbitrary body returning *PatchActivityResponse
+func (c *ClientWithResponses) PatchActivityWithBodyWithResponse(ctx context.Context, id int64, params *PatchActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchActivityResponse, error) {
+	rsp, err := c.PatchActivityWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchActivityResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchActivityWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int64, params *PatchActivityParams, body PatchActivityApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchActivityResponse, error) {
+	rsp, err := c.PatchActivityWithApplicationMergePatchPlusJSONBody(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchActivityResponse(rsp)
+}
+
+// UpdateActivityWithBodyWithResponse request with arbitrary body returning *UpdateActivityResponse
+func (c *ClientWithResponses) UpdateActivityWithBodyWithResponse(ctx context.Context, id int64, params *UpdateActivityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error) {
+	rsp, err := c.UpdateActivityWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateActivityResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateActivityWithResponse(ctx context.Context, id int64, params *UpdateActivityParams, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error) {
+	rsp, err := c.UpdateActivity(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateActivityResponse(rsp)
+}
+
+// BackchannelLogoutWithBodyWithResponse request with arbitrary body returning *BackchannelLogoutResponse
+func (c *ClientWithResponses) BackchannelLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BackchannelLogoutResponse, error) {
+	rsp, err := c.BackchannelLogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBackchannelLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) BackchannelLogoutWithFormdataBodyWithResponse(ctx context.Context, body BackchannelLogoutFormdataRequestBody, reqEditors ...RequestEditorFn) (*BackchannelLogoutResponse, error) {
+	rsp, err := c.BackchannelLogoutWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBackchannelLogoutResponse(rsp)
+}
+
+// PostBatchWithBodyWithResponse request with arbitrary body returning *PostBatchResponse
+func (c *ClientWithResponses) PostBatchWithBodyWithResponse(ctx context.Context, params *PostBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBatchResponse, error) {
+	rsp, err := c.PostBatchWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBatchWithResponse(ctx context.Context, params *PostBatchParams, body PostBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBatchResponse, error) {
+	rsp, err := c.PostBatch(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBatchResponse(rsp)
+}
+
+// GetSourceCodeWithResponse request returning *GetSourceCodeResponse
+func (c *ClientWithResponses) GetSourceCodeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourceCodeResponse, error) {
+	rsp, err := c.GetSourceCode(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSourceCodeResponse(rsp)
+}
+
+// GetContactsWithResponse request returning *GetContactsResponse
+func (c *ClientWithResponses) GetContactsWithResponse(ctx context.Context, params *GetContactsParams, reqEditors ...RequestEditorFn) (*GetContactsResponse, error) {
+	rsp, err := c.GetContacts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetContactsResponse(rsp)
+}
+
+// CreateContactWithBodyWithResponse request with arbitrary body returning *CreateContactResponse
+func (c *ClientWithResponses) CreateContactWithBodyWithResponse(ctx context.Context, params *CreateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContactResponse, error) {
+	rsp, err := c.CreateContactWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateContactResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateContactWithResponse(ctx context.Context, params *CreateContactParams, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContactResponse, error) {
+	rsp, err := c.CreateContact(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateContactResponse(rsp)
+}
+
+// DeleteContactWithResponse request returning *DeleteContactResponse
+func (c *ClientWithResponses) DeleteContactWithResponse(ctx context.Context, id int64, params *DeleteContactParams, reqEditors ...RequestEditorFn) (*DeleteContactResponse, error) {
+	rsp, err := c.DeleteContact(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteContactResponse(rsp)
+}
+
+// GetContactWithResponse request returning *GetContactResponse
+func (c *ClientWithResponses) GetContactWithResponse(ctx context.Context, id int64, params *GetContactParams, reqEditors ...RequestEditorFn) (*GetContactResponse, error) {
+	rsp, err := c.GetContact(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetContactResponse(rsp)
+}
+
+// PatchContactWithBodyWithResponse request with arbitrary body returning *PatchContactResponse
+func (c *ClientWithResponses) PatchContactWithBodyWithResponse(ctx context.Context, id int64, params *PatchContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchContactResponse, error) {
+	rsp, err := c.PatchContactWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchContactResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchContactWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int64, params *PatchContactParams, body PatchContactApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchContactResponse, error) {
+	rsp, err := c.PatchContactWithApplicationMergePatchPlusJSONBody(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchContactResponse(rsp)
+}
+
+// UpdateContactWithBodyWithResponse request with arbitrary body returning *UpdateContactResponse
+func (c *ClientWithResponses) UpdateContactWithBodyWithResponse(ctx context.Context, id int64, params *UpdateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error) {
+	rsp, err := c.UpdateContactWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateContactResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateContactWithResponse(ctx context.Context, id int64, params *UpdateContactParams, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error) {
+	rsp, err := c.UpdateContact(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateContactResponse(rsp)
+}
+
+// GetDebtsWithResponse request returning *GetDebtsResponse
+func (c *ClientWithResponses) GetDebtsWithResponse(ctx context.Context, params *GetDebtsParams, reqEditors ...RequestEditorFn) (*GetDebtsResponse, error) {
+	rsp, err := c.GetDebts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDebtsResponse(rsp)
+}
+
+// CreateDebtWithBodyWithResponse request with arbitrary body returning *CreateDebtResponse
+func (c *ClientWithResponses) CreateDebtWithBodyWithResponse(ctx context.Context, params *CreateDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDebtResponse, error) {
+	rsp, err := c.CreateDebtWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDebtResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDebtWithResponse(ctx context.Context, params *CreateDebtParams, body CreateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDebtResponse, error) {
+	rsp, err := c.CreateDebt(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDebtResponse(rsp)
+}
+
+// SettleDebtWithResponse request returning *SettleDebtResponse
+func (c *ClientWithResponses) SettleDebtWithResponse(ctx context.Context, id int64, params *SettleDebtParams, reqEditors ...RequestEditorFn) (*SettleDebtResponse, error) {
+	rsp, err := c.SettleDebt(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSettleDebtResponse(rsp)
+}
+
+// PatchDebtWithBodyWithResponse request with arbitrary body returning *PatchDebtResponse
+func (c *ClientWithResponses) PatchDebtWithBodyWithResponse(ctx context.Context, id int64, params *PatchDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDebtResponse, error) {
+	rsp, err := c.PatchDebtWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchDebtResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchDebtWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int64, params *PatchDebtParams, body PatchDebtApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDebtResponse, error) {
+	rsp, err := c.PatchDebtWithApplicationMergePatchPlusJSONBody(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchDebtResponse(rsp)
+}
+
+// UpdateDebtWithBodyWithResponse request with arbitrary body returning *UpdateDebtResponse
+func (c *ClientWithResponses) UpdateDebtWithBodyWithResponse(ctx context.Context, id int64, params *UpdateDebtParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDebtResponse, error) {
+	rsp, err := c.UpdateDebtWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDebtResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDebtWithResponse(ctx context.Context, id int64, params *UpdateDebtParams, body UpdateDebtJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDebtResponse, error) {
+	rsp, err := c.UpdateDebt(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDebtResponse(rsp)
+}
+
+// GetEventsWithResponse request returning *GetEventsResponse
+func (c *ClientWithResponses) GetEventsWithResponse(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*GetEventsResponse, error) {
+	rsp, err := c.GetEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventsResponse(rsp)
+}
+
+// GetSpaceInvitationsWithResponse request returning *GetSpaceInvitationsResponse
+func (c *ClientWithResponses) GetSpaceInvitationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSpaceInvitationsResponse, error) {
+	rsp, err := c.GetSpaceInvitations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSpaceInvitationsResponse(rsp)
+}
+
+// DeclineSpaceInvitationWithResponse request returning *DeclineSpaceInvitationResponse
+func (c *ClientWithResponses) DeclineSpaceInvitationWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeclineSpaceInvitationResponse, error) {
+	rsp, err := c.DeclineSpaceInvitation(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeclineSpaceInvitationResponse(rsp)
+}
+
+// AcceptSpaceInvitationWithResponse request returning *AcceptSpaceInvitationResponse
+func (c *ClientWithResponses) AcceptSpaceInvitationWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*AcceptSpaceInvitationResponse, error) {
+	rsp, err := c.AcceptSpaceInvitation(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcceptSpaceInvitationResponse(rsp)
+}
+
+// GetJournalEntriesWithResponse request returning *GetJournalEntriesResponse
+func (c *ClientWithResponses) GetJournalEntriesWithResponse(ctx context.Context, params *GetJournalEntriesParams, reqEditors ...RequestEditorFn) (*GetJournalEntriesResponse, error) {
+	rsp, err := c.GetJournalEntries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJournalEntriesResponse(rsp)
+}
+
+// CreateJournalEntryWithBodyWithResponse request with arbitrary body returning *CreateJournalEntryResponse
+func (c *ClientWithResponses) CreateJournalEntryWithBodyWithResponse(ctx context.Context, params *CreateJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateJournalEntryResponse, error) {
+	rsp, err := c.CreateJournalEntryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateJournalEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateJournalEntryWithResponse(ctx context.Context, params *CreateJournalEntryParams, body CreateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateJournalEntryResponse, error) {
+	rsp, err := c.CreateJournalEntry(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateJournalEntryResponse(rsp)
+}
+
+// DeleteJournalEntryWithResponse request returning *DeleteJournalEntryResponse
+func (c *ClientWithResponses) DeleteJournalEntryWithResponse(ctx context.Context, id int64, params *DeleteJournalEntryParams, reqEditors ...RequestEditorFn) (*DeleteJournalEntryResponse, error) {
+	rsp, err := c.DeleteJournalEntry(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteJournalEntryResponse(rsp)
+}
+
+// GetJournalEntryWithResponse request returning *GetJournalEntryResponse
+func (c *ClientWithResponses) GetJournalEntryWithResponse(ctx context.Context, id int64, params *GetJournalEntryParams, reqEditors ...RequestEditorFn) (*GetJournalEntryResponse, error) {
+	rsp, err := c.GetJournalEntry(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJournalEntryResponse(rsp)
+}
+
+// PatchJournalEntryWithBodyWithResponse request with arbitrary body returning *PatchJournalEntryResponse
+func (c *ClientWithResponses) PatchJournalEntryWithBodyWithResponse(ctx context.Context, id int64, params *PatchJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchJournalEntryResponse, error) {
+	rsp, err := c.PatchJournalEntryWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchJournalEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchJournalEntryWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int64, params *PatchJournalEntryParams, body PatchJournalEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchJournalEntryResponse, error) {
+	rsp, err := c.PatchJournalEntryWithApplicationMergePatchPlusJSONBody(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchJournalEntryResponse(rsp)
+}
+
+// UpdateJournalEntryWithBodyWithResponse request with arbitrary body returning *UpdateJournalEntryResponse
+func (c *ClientWithResponses) UpdateJournalEntryWithBodyWithResponse(ctx context.Context, id int64, params *UpdateJournalEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateJournalEntryResponse, error) {
+	rsp, err := c.UpdateJournalEntryWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateJournalEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateJournalEntryWithResponse(ctx context.Context, id int64, params *UpdateJournalEntryParams, body UpdateJournalEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateJournalEntryResponse, error) {
+	rsp, err := c.UpdateJournalEntry(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateJournalEntryResponse(rsp)
+}
+
+// GetOpenAPISpecWithResponse request returning *GetOpenAPISpecResponse
+func (c *ClientWithResponses) GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error) {
+	rsp, err := c.GetOpenAPISpec(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPISpecResponse(rsp)
+}
+
+// DeleteSessionsWithResponse request returning *DeleteSessionsResponse
+func (c *ClientWithResponses) DeleteSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionsResponse, error) {
+	rsp, err := c.DeleteSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSessionsResponse(rsp)
+}
+
+// GetSessionsWithResponse request returning *GetSessionsResponse
+func (c *ClientWithResponses) GetSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSessionsResponse, error) {
+	rsp, err := c.GetSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionsResponse(rsp)
+}
+
+// DeleteSessionWithResponse request returning *DeleteSessionResponse
+func (c *ClientWithResponses) DeleteSessionWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error) {
+	rsp, err := c.DeleteSession(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSessionResponse(rsp)
+}
+
+// GetSpacesWithResponse request returning *GetSpacesResponse
+func (c *ClientWithResponses) GetSpacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSpacesResponse, error) {
+	rsp, err := c.GetSpaces(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSpacesResponse(rsp)
+}
+
+// CreateSpaceWithBodyWithResponse request with arbitrary body returning *CreateSpaceResponse
+func (c *ClientWithResponses) CreateSpaceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error) {
+	rsp, err := c.CreateSpaceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSpaceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSpaceWithResponse(ctx context.Context, body CreateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error) {
+	rsp, err := c.CreateSpace(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSpaceResponse(rsp)
+}
+
+// DeleteSpaceWithResponse request returning *DeleteSpaceResponse
+func (c *ClientWithResponses) DeleteSpaceWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteSpaceResponse, error) {
+	rsp, err := c.DeleteSpace(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSpaceResponse(rsp)
+}
+
+// CreateSpaceInvitationWithBodyWithResponse request with arbitrary body returning *CreateSpaceInvitationResponse
+func (c *ClientWithResponses) CreateSpaceInvitationWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSpaceInvitationResponse, error) {
+	rsp, err := c.CreateSpaceInvitationWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSpaceInvitationResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSpaceInvitationWithResponse(ctx context.Context, id int64, body CreateSpaceInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSpaceInvitationResponse, error) {
+	rsp, err := c.CreateSpaceInvitation(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSpaceInvitationResponse(rsp)
+}
+
+// GetSpaceMembersWithResponse request returning *GetSpaceMembersResponse
+func (c *ClientWithResponses) GetSpaceMembersWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetSpaceMembersResponse, error) {
+	rsp, err := c.GetSpaceMembers(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSpaceMembersResponse(rsp)
+}
+
+// DeleteSpaceMemberWithResponse request returning *DeleteSpaceMemberResponse
+func (c *ClientWithResponses) DeleteSpaceMemberWithResponse(ctx context.Context, id int64, memberId int64, reqEditors ...RequestEditorFn) (*DeleteSpaceMemberResponse, error) {
+	rsp, err := c.DeleteSpaceMember(ctx, id, memberId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSpaceMemberResponse(rsp)
+}
+
+// GetStatisticsWithResponse request returning *GetStatisticsResponse
+func (c *ClientWithResponses) GetStatisticsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsResponse, error) {
+	rsp, err := c.GetStatistics(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatisticsResponse(rsp)
+}
+
+// GetSummaryWithResponse request returning *GetSummaryResponse
+func (c *ClientWithResponses) GetSummaryWithResponse(ctx context.Context, params *GetSummaryParams, reqEditors ...RequestEditorFn) (*GetSummaryResponse, error) {
+	rsp, err := c.GetSummary(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSummaryResponse(rsp)
+}
+
+// GetSyncWithResponse request returning *GetSyncResponse
+func (c *ClientWithResponses) GetSyncWithResponse(ctx context.Context, params *GetSyncParams, reqEditors ...RequestEditorFn) (*GetSyncResponse, error) {
+	rsp, err := c.GetSync(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSyncResponse(rsp)
+}
+
+// PostSyncWithBodyWithResponse request with arbitrary body returning *PostSyncResponse
+func (c *ClientWithResponses) PostSyncWithBodyWithResponse(ctx context.Context, params *PostSyncParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSyncResponse, error) {
+	rsp, err := c.PostSyncWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSyncResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSyncWithResponse(ctx context.Context, params *PostSyncParams, body PostSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSyncResponse, error) {
+	rsp, err := c.PostSync(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSyncResponse(rsp)
+}
+
+// GetAccessTokensWithResponse request returning *GetAccessTokensResponse
+func (c *ClientWithResponses) GetAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccessTokensResponse, error) {
+	rsp, err := c.GetAccessTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccessTokensResponse(rsp)
+}
+
+// CreateAccessTokenWithBodyWithResponse request with arbitrary body returning *CreateAccessTokenResponse
+func (c *ClientWithResponses) CreateAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error) {
+	rsp, err := c.CreateAccessTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAccessTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAccessTokenWithResponse(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error) {
+	rsp, err := c.CreateAccessToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAccessTokenResponse(rsp)
+}
+
+// DeleteAccessTokenWithResponse request returning *DeleteAccessTokenResponse
+func (c *ClientWithResponses) DeleteAccessTokenWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteAccessTokenResponse, error) {
+	rsp, err := c.DeleteAccessToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccessTokenResponse(rsp)
+}
+
+// DeleteUserDataWithResponse request returning *DeleteUserDataResponse
+func (c *ClientWithResponses) DeleteUserDataWithResponse(ctx context.Context, params *DeleteUserDataParams, reqEditors ...RequestEditorFn) (*DeleteUserDataResponse, error) {
+	rsp, err := c.DeleteUserData(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserDataResponse(rsp)
+}
+
+// ExportUserDataWithResponse request returning *ExportUserDataResponse
+func (c *ClientWithResponses) ExportUserDataWithResponse(ctx context.Context, params *ExportUserDataParams, reqEditors ...RequestEditorFn) (*ExportUserDataResponse, error) {
+	rsp, err := c.ExportUserData(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportUserDataResponse(rsp)
+}
+
+// ImportUserDataWithBodyWithResponse request with arbitrary body returning *ImportUserDataResponse
+func (c *ClientWithResponses) ImportUserDataWithBodyWithResponse(ctx context.Context, params *ImportUserDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportUserDataResponse, error) {
+	rsp, err := c.ImportUserDataWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportUserDataResponse(rsp)
+}
+
+// GetWebhooksWithResponse request returning *GetWebhooksResponse
+func (c *ClientWithResponses) GetWebhooksWithResponse(ctx context.Context, params *GetWebhooksParams, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error) {
+	rsp, err := c.GetWebhooks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksResponse(rsp)
+}
+
+// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
+func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhookWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhook(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+// DeleteWebhookWithResponse request returning *DeleteWebhookResponse
+func (c *ClientWithResponses) DeleteWebhookWithResponse(ctx context.Context, id int64, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error) {
+	rsp, err := c.DeleteWebhook(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookResponse(rsp)
+}
+
+// GetWebhookDeliveriesWithResponse request returning *GetWebhookDeliveriesResponse
+func (c *ClientWithResponses) GetWebhookDeliveriesWithResponse(ctx context.Context, id int64, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhookDeliveriesResponse, error) {
+	rsp, err := c.GetWebhookDeliveries(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhookDeliveriesResponse(rsp)
+}
+
+// ParseGetActivitiesResponse parses an HTTP response from a GetActivitiesWithResponse call
+func ParseGetActivitiesResponse(rsp *http.Response) (*GetActivitiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetActivitiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ActivityWithContact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateActivityResponse parses an HTTP response from a CreateActivityWithResponse call
+func ParseCreateActivityResponse(rsp *http.Response) (*CreateActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Activity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteActivityResponse parses an HTTP response from a DeleteActivityWithResponse call
+func ParseDeleteActivityResponse(rsp *http.Response) (*DeleteActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetActivityResponse parses an HTTP response from a GetActivityWithResponse call
+func ParseGetActivityResponse(rsp *http.Response) (*GetActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ActivityWithContact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchActivityResponse parses an HTTP response from a PatchActivityWithResponse call
+func ParsePatchActivityResponse(rsp *http.Response) (*PatchActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Activity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateActivityResponse parses an HTTP response from a UpdateActivityWithResponse call
+func ParseUpdateActivityResponse(rsp *http.Response) (*UpdateActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Activity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBackchannelLogoutResponse parses an HTTP response from a BackchannelLogoutWithResponse call
+func ParseBackchannelLogoutResponse(rsp *http.Response) (*BackchannelLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BackchannelLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBatchResponse parses an HTTP response from a PostBatchWithResponse call
+func ParsePostBatchResponse(rsp *http.Response) (*PostBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSourceCodeResponse parses an HTTP response from a GetSourceCodeWithResponse call
+func ParseGetSourceCodeResponse(rsp *http.Response) (*GetSourceCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSourceCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetContactsResponse parses an HTTP response from a GetContactsWithResponse call
+func ParseGetContactsResponse(rsp *http.Response) (*GetContactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetContactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Contact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateContactResponse parses an HTTP response from a CreateContactWithResponse call
+func ParseCreateContactResponse(rsp *http.Response) (*CreateContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Contact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteContactResponse parses an HTTP response from a DeleteContactWithResponse call
+func ParseDeleteContactResponse(rsp *http.Response) (*DeleteContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetContactResponse parses an HTTP response from a GetContactWithResponse call
+func ParseGetContactResponse(rsp *http.Response) (*GetContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ContactData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchContactResponse parses an HTTP response from a PatchContactWithResponse call
+func ParsePatchContactResponse(rsp *http.Response) (*PatchContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Contact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateContactResponse parses an HTTP response from a UpdateContactWithResponse call
+func ParseUpdateContactResponse(rsp *http.Response) (*UpdateContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Contact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDebtsResponse parses an HTTP response from a GetDebtsWithResponse call
+func ParseGetDebtsResponse(rsp *http.Response) (*GetDebtsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDebtsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DebtWithContact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDebtResponse parses an HTTP response from a CreateDebtWithResponse call
+func ParseCreateDebtResponse(rsp *http.Response) (*CreateDebtResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDebtResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Debt
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSettleDebtResponse parses an HTTP response from a SettleDebtWithResponse call
+func ParseSettleDebtResponse(rsp *http.Response) (*SettleDebtResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SettleDebtResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchDebtResponse parses an HTTP response from a PatchDebtWithResponse call
+func ParsePatchDebtResponse(rsp *http.Response) (*PatchDebtResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchDebtResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Debt
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDebtResponse parses an HTTP response from a UpdateDebtWithResponse call
+func ParseUpdateDebtResponse(rsp *http.Response) (*UpdateDebtResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDebtResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Debt
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEventsResponse parses an HTTP response from a GetEventsWithResponse call
+func ParseGetEventsResponse(rsp *http.Response) (*GetEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSpaceInvitationsResponse parses an HTTP response from a GetSpaceInvitationsWithResponse call
+func ParseGetSpaceInvitationsResponse(rsp *http.Response) (*GetSpaceInvitationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSpaceInvitationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SpaceInvitation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeclineSpaceInvitationResponse parses an HTTP response from a DeclineSpaceInvitationWithResponse call
+func ParseDeclineSpaceInvitationResponse(rsp *http.Response) (*DeclineSpaceInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeclineSpaceInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAcceptSpaceInvitationResponse parses an HTTP response from a AcceptSpaceInvitationWithResponse call
+func ParseAcceptSpaceInvitationResponse(rsp *http.Response) (*AcceptSpaceInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AcceptSpaceInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Space
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetJournalEntriesResponse parses an HTTP response from a GetJournalEntriesWithResponse call
+func ParseGetJournalEntriesResponse(rsp *http.Response) (*GetJournalEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJournalEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []JournalEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateJournalEntryResponse parses an HTTP response from a CreateJournalEntryWithResponse call
+func ParseCreateJournalEntryResponse(rsp *http.Response) (*CreateJournalEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateJournalEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JournalEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteJournalEntryResponse parses an HTTP response from a DeleteJournalEntryWithResponse call
+func ParseDeleteJournalEntryResponse(rsp *http.Response) (*DeleteJournalEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteJournalEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetJournalEntryResponse parses an HTTP response from a GetJournalEntryWithResponse call
+func ParseGetJournalEntryResponse(rsp *http.Response) (*GetJournalEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJournalEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JournalEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchJournalEntryResponse parses an HTTP response from a PatchJournalEntryWithResponse call
+func ParsePatchJournalEntryResponse(rsp *http.Response) (*PatchJournalEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchJournalEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JournalEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateJournalEntryResponse parses an HTTP response from a UpdateJournalEntryWithResponse call
+func ParseUpdateJournalEntryResponse(rsp *http.Response) (*UpdateJournalEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateJournalEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JournalEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenAPISpecResponse parses an HTTP response from a GetOpenAPISpecWithResponse call
+func ParseGetOpenAPISpecResponse(rsp *http.Response) (*GetOpenAPISpecResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenAPISpecResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSessionsResponse parses an HTTP response from a DeleteSessionsWithResponse call
+func ParseDeleteSessionsResponse(rsp *http.Response) (*DeleteSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionsResponse parses an HTTP response from a GetSessionsWithResponse call
+func ParseGetSessionsResponse(rsp *http.Response) (*GetSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Session
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSessionResponse parses an HTTP response from a DeleteSessionWithResponse call
+func ParseDeleteSessionResponse(rsp *http.Response) (*DeleteSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSpacesResponse parses an HTTP response from a GetSpacesWithResponse call
+func ParseGetSpacesResponse(rsp *http.Response) (*GetSpacesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSpacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Space
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSpaceResponse parses an HTTP response from a CreateSpaceWithResponse call
+func ParseCreateSpaceResponse(rsp *http.Response) (*CreateSpaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSpaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Space
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSpaceResponse parses an HTTP response from a DeleteSpaceWithResponse call
+func ParseDeleteSpaceResponse(rsp *http.Response) (*DeleteSpaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSpaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSpaceInvitationResponse parses an HTTP response from a CreateSpaceInvitationWithResponse call
+func ParseCreateSpaceInvitationResponse(rsp *http.Response) (*CreateSpaceInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSpaceInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SpaceInvitation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSpaceMembersResponse parses an HTTP response from a GetSpaceMembersWithResponse call
+func ParseGetSpaceMembersResponse(rsp *http.Response) (*GetSpaceMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSpaceMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SpaceMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSpaceMemberResponse parses an HTTP response from a DeleteSpaceMemberWithResponse call
+func ParseDeleteSpaceMemberResponse(rsp *http.Response) (*DeleteSpaceMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSpaceMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatisticsResponse parses an HTTP response from a GetStatisticsWithResponse call
+func ParseGetStatisticsResponse(rsp *http.Response) (*GetStatisticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatisticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IndexData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSummaryResponse parses an HTTP response from a GetSummaryWithResponse call
+func ParseGetSummaryResponse(rsp *http.Response) (*GetSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IndexData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSyncResponse parses an HTTP response from a GetSyncWithResponse call
+func ParseGetSyncResponse(rsp *http.Response) (*GetSyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncChangeset
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSyncResponse parses an HTTP response from a PostSyncWithResponse call
+func ParsePostSyncResponse(rsp *http.Response) (*PostSyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SyncMutationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccessTokensResponse parses an HTTP response from a GetAccessTokensWithResponse call
+func ParseGetAccessTokensResponse(rsp *http.Response) (*GetAccessTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccessTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AccessToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAccessTokenResponse parses an HTTP response from a CreateAccessTokenWithResponse call
+func ParseCreateAccessTokenResponse(rsp *http.Response) (*CreateAccessTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAccessTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAccessTokenResponse parses an HTTP response from a DeleteAccessTokenWithResponse call
+func ParseDeleteAccessTokenResponse(rsp *http.Response) (*DeleteAccessTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAccessTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUserDataResponse parses an HTTP response from a DeleteUserDataWithResponse call
+func ParseDeleteUserDataResponse(rsp *http.Response) (*DeleteUserDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUserDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportUserDataResponse parses an HTTP response from a ExportUserDataWithResponse call
+func ParseExportUserDataResponse(rsp *http.Response) (*ExportUserDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportUserDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportUserDataResponse parses an HTTP response from a ImportUserDataWithResponse call
+func ParseImportUserDataResponse(rsp *http.Response) (*ImportUserDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportUserDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksResponse parses an HTTP response from a GetWebhooksWithResponse call
+func ParseGetWebhooksResponse(rsp *http.Response) (*GetWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWebhookResponse parses an HTTP response from a CreateWebhookWithResponse call
+func ParseCreateWebhookResponse(rsp *http.Response) (*CreateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhookResponse parses an HTTP response from a DeleteWebhookWithResponse call
+func ParseDeleteWebhookResponse(rsp *http.Response) (*DeleteWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookDeliveriesResponse parses an HTTP response from a GetWebhookDeliveriesWithResponse call
+func ParseGetWebhookDeliveriesResponse(rsp *http.Response) (*GetWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List the activities of all contacts
+	// (GET /activities)
+	GetActivities(w http.ResponseWriter, r *http.Request, params GetActivitiesParams)
+	// Create a new activity
+	// (POST /activities)
+	CreateActivity(w http.ResponseWriter, r *http.Request, params CreateActivityParams)
+	// Delete an activity
+	// (DELETE /activities/{id})
+	DeleteActivity(w http.ResponseWriter, r *http.Request, id int64, params DeleteActivityParams)
+	// Get a specific activity
+	// (GET /activities/{id})
+	GetActivity(w http.ResponseWriter, r *http.Request, id int64, params GetActivityParams)
+	// Partially update an activity
+	// (PATCH /activities/{id})
+	PatchActivity(w http.ResponseWriter, r *http.Request, id int64, params PatchActivityParams)
+	// Update an activity
+	// (PUT /activities/{id})
+	UpdateActivity(w http.ResponseWriter, r *http.Request, id int64, params UpdateActivityParams)
+	// Revoke the sessions of a user who signed out with the OIDC provider (OIDC back-channel logout)
+	// (POST /backchannel-logout)
+	BackchannelLogout(w http.ResponseWriter, r *http.Request)
+	// Apply a batch of operations in one transaction
+	// (POST /batch)
+	PostBatch(w http.ResponseWriter, r *http.Request, params PostBatchParams)
+	// Download application source code
+	// (GET /code/)
+	GetSourceCode(w http.ResponseWriter, r *http.Request)
+	// List all contacts
+	// (GET /contacts)
+	GetContacts(w http.ResponseWriter, r *http.Request, params GetContactsParams)
+	// Create a new contact
+	// (POST /contacts)
+	CreateContact(w http.ResponseWriter, r *http.Request, params CreateContactParams)
+	// Delete a contact
+	// (DELETE /contacts/{id})
+	DeleteContact(w http.ResponseWriter, r *http.Request, id int64, params DeleteContactParams)
+	// Get contact including debts and activities
+	// (GET /contacts/{id})
+	GetContact(w http.ResponseWriter, r *http.Request, id int64, params GetContactParams)
+	// Partially update a contact
+	// (PATCH /contacts/{id})
+	PatchContact(w http.ResponseWriter, r *http.Request, id int64, params PatchContactParams)
+	// Update a contact
+	// (PUT /contacts/{id})
+	UpdateContact(w http.ResponseWriter, r *http.Request, id int64, params UpdateContactParams)
+	// List the debts of all contacts
+	// (GET /debts)
+	GetDebts(w http.ResponseWriter, r *http.Request, params GetDebtsParams)
+	// Create a new debt
+	// (POST /debts)
+	CreateDebt(w http.ResponseWriter, r *http.Request, params CreateDebtParams)
+	// Settle a debt
+	// (DELETE /debts/{id})
+	SettleDebt(w http.ResponseWriter, r *http.Request, id int64, params SettleDebtParams)
+	// Partially update a debt
+	// (PATCH /debts/{id})
+	PatchDebt(w http.ResponseWriter, r *http.Request, id int64, params PatchDebtParams)
+	// Update a debt
+	// (PUT /debts/{id})
+	UpdateDebt(w http.ResponseWriter, r *http.Request, id int64, params UpdateDebtParams)
+	// Stream changes to the entities of the space
+	// (GET /events)
+	GetEvents(w http.ResponseWriter, r *http.Request, params GetEventsParams)
+	// List all pending space invitations for the authenticated user's email
+	// (GET /invitations)
+	GetSpaceInvitations(w http.ResponseWriter, r *http.Request)
+	// Decline a space invitation
+	// (DELETE /invitations/{id})
+	DeclineSpaceInvitation(w http.ResponseWriter, r *http.Request, id int64)
+	// Accept a space invitation
+	// (POST /invitations/{id})
+	AcceptSpaceInvitation(w http.ResponseWriter, r *http.Request, id int64)
+	// List all journal entries
+	// (GET /journal)
+	GetJournalEntries(w http.ResponseWriter, r *http.Request, params GetJournalEntriesParams)
+	// Create a new journal entry
+	// (POST /journal)
+	CreateJournalEntry(w http.ResponseWriter, r *http.Request, params CreateJournalEntryParams)
+	// Delete a journal entry
+	// (DELETE /journal/{id})
+	DeleteJournalEntry(w http.ResponseWriter, r *http.Request, id int64, params DeleteJournalEntryParams)
+	// Get a specific journal entry
+	// (GET /journal/{id})
+	GetJournalEntry(w http.ResponseWriter, r *http.Request, id int64, params GetJournalEntryParams)
+	// Partially update a journal entry
+	// (PATCH /journal/{id})
+	PatchJournalEntry(w http.ResponseWriter, r *http.Request, id int64, params PatchJournalEntryParams)
+	// Update a journal entry
+	// (PUT /journal/{id})
+	UpdateJournalEntry(w http.ResponseWriter, r *http.Request, id int64, params UpdateJournalEntryParams)
+	// Get the OpenAPI spec
+	// (GET /openapi.json)
+	GetOpenAPISpec(w http.ResponseWriter, r *http.Request)
+	// Revoke all sessions of the authenticated user, including the current one, to sign out everywhere
+	// (DELETE /sessions)
+	DeleteSessions(w http.ResponseWriter, r *http.Request)
+	// List all active sessions of the authenticated user
+	// (GET /sessions)
+	GetSessions(w http.ResponseWriter, r *http.Request)
+	// Revoke a session
+	// (DELETE /sessions/{id})
+	DeleteSession(w http.ResponseWriter, r *http.Request, id int64)
+	// List all spaces the authenticated user is a member of
+	// (GET /spaces)
+	GetSpaces(w http.ResponseWriter, r *http.Request)
+	// Create a new shared space owned by the authenticated user
+	// (POST /spaces)
+	CreateSpace(w http.ResponseWriter, r *http.Request)
+	// Delete a shared space and all of its data
+	// (DELETE /spaces/{id})
+	DeleteSpace(w http.ResponseWriter, r *http.Request, id int64)
+	// Invite a user to a space by email
+	// (POST /spaces/{id}/invitations)
+	CreateSpaceInvitation(w http.ResponseWriter, r *http.Request, id int64)
+	// List all members of a space
+	// (GET /spaces/{id}/members)
+	GetSpaceMembers(w http.ResponseWriter, r *http.Request, id int64)
+	// Remove a member from a space, or leave it
+	// (DELETE /spaces/{id}/members/{memberId})
+	DeleteSpaceMember(w http.ResponseWriter, r *http.Request, id int64, memberId int64)
+	// Get total counts of contacts and journal entries
+	// (GET /statistics)
+	GetStatistics(w http.ResponseWriter, r *http.Request)
+	// Get counts of contacts and journal entries for the authenticated user
+	// (GET /summary)
+	GetSummary(w http.ResponseWriter, r *http.Request, params GetSummaryParams)
+	// Get the entities that changed since a cursor
+	// (GET /sync)
+	GetSync(w http.ResponseWriter, r *http.Request, params GetSyncParams)
+	// Apply a batch of mutations made by an offline client
+	// (POST /sync)
+	PostSync(w http.ResponseWriter, r *http.Request, params PostSyncParams)
+	// List all personal access tokens of the authenticated user
+	// (GET /tokens)
+	GetAccessTokens(w http.ResponseWriter, r *http.Request)
+	// Create a new personal access token for the authenticated user
+	// (POST /tokens)
+	CreateAccessToken(w http.ResponseWriter, r *http.Request)
+	// Revoke a personal access token
+	// (DELETE /tokens/{id})
+	DeleteAccessToken(w http.ResponseWriter, r *http.Request, id int64)
+	// Delete all user data
+	// (DELETE /userdata)
+	DeleteUserData(w http.ResponseWriter, r *http.Request, params DeleteUserDataParams)
+	// Export all user data
+	// (GET /userdata)
+	ExportUserData(w http.ResponseWriter, r *http.Request, params ExportUserDataParams)
+	// Import user data
+	// (POST /userdata)
+	ImportUserData(w http.ResponseWriter, r *http.Request, params ImportUserDataParams)
+	// List all webhooks
+	// (GET /webhooks)
+	GetWebhooks(w http.ResponseWriter, r *http.Request, params GetWebhooksParams)
+	// Subscribe a webhook to events
+	// (POST /webhooks)
+	CreateWebhook(w http.ResponseWriter, r *http.Request, params CreateWebhookParams)
+	// Delete a webhook and its delivery log
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, id int64, params DeleteWebhookParams)
+	// List the most recent delivery attempts of a webhook
+	// (GET /webhooks/{id}/deliveries)
+	GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, id int64, params GetWebhookDeliveriesParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetActivities(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetActivitiesParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "contact_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "contact_id", r.URL.Query(), &params.ContactId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contact_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetActivities(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateActivity operation middleware
+func (siw *ServerInterfaceWrapper) CreateActivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateActivityParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateActivity(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteActivity operation middleware
+func (siw *ServerInterfaceWrapper) DeleteActivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteActivityParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteActivity(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetActivity operation middleware
+func (siw *ServerInterfaceWrapper) GetActivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetActivityParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetActivity(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchActivity operation middleware
+func (siw *ServerInterfaceWrapper) PatchActivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchActivityParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchActivity(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateActivity operation middleware
+func (siw *ServerInterfaceWrapper) UpdateActivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateActivityParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateActivity(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BackchannelLogout operation middleware
+func (siw *ServerInterfaceWrapper) BackchannelLogout(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BackchannelLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostBatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBatchParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBatch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSourceCode operation middleware
+func (siw *ServerInterfaceWrapper) GetSourceCode(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSourceCode(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetContacts operation middleware
+func (siw *ServerInterfaceWrapper) GetContacts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetContactsParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetContacts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateContact operation middleware
+func (siw *ServerInterfaceWrapper) CreateContact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateContactParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateContact(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteContact operation middleware
+func (siw *ServerInterfaceWrapper) DeleteContact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteContactParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteContact(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetContact operation middleware
+func (siw *ServerInterfaceWrapper) GetContact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetContactParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetContact(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchContact operation middleware
+func (siw *ServerInterfaceWrapper) PatchContact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchContactParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchContact(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateContact operation middleware
+func (siw *ServerInterfaceWrapper) UpdateContact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateContactParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateContact(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDebts operation middleware
+func (siw *ServerInterfaceWrapper) GetDebts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDebtsParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "contact_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "contact_id", r.URL.Query(), &params.ContactId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contact_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "you_owe" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "you_owe", r.URL.Query(), &params.YouOwe)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "you_owe", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDebts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDebt operation middleware
+func (siw *ServerInterfaceWrapper) CreateDebt(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateDebtParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDebt(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SettleDebt operation middleware
+func (siw *ServerInterfaceWrapper) SettleDebt(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SettleDebtParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SettleDebt(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchDebt operation middleware
+func (siw *ServerInterfaceWrapper) PatchDebt(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchDebtParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchDebt(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateDebt operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDebt(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateDebtParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateDebt(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpaceInvitations operation middleware
+func (siw *ServerInterfaceWrapper) GetSpaceInvitations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpaceInvitations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeclineSpaceInvitation operation middleware
+func (siw *ServerInterfaceWrapper) DeclineSpaceInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeclineSpaceInvitation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AcceptSpaceInvitation operation middleware
+func (siw *ServerInterfaceWrapper) AcceptSpaceInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptSpaceInvitation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetJournalEntries operation middleware
+func (siw *ServerInterfaceWrapper) GetJournalEntries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJournalEntriesParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJournalEntries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateJournalEntry operation middleware
+func (siw *ServerInterfaceWrapper) CreateJournalEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateJournalEntryParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateJournalEntry(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteJournalEntry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteJournalEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteJournalEntryParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteJournalEntry(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetJournalEntry operation middleware
+func (siw *ServerInterfaceWrapper) GetJournalEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJournalEntryParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJournalEntry(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchJournalEntry operation middleware
+func (siw *ServerInterfaceWrapper) PatchJournalEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchJournalEntryParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchJournalEntry(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateJournalEntry operation middleware
+func (siw *ServerInterfaceWrapper) UpdateJournalEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateJournalEntryParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateJournalEntry(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOpenAPISpec operation middleware
+func (siw *ServerInterfaceWrapper) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOpenAPISpec(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSessions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSessions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetSessions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSession operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSession(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpaces operation middleware
+func (siw *ServerInterfaceWrapper) GetSpaces(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpaces(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSpace operation middleware
+func (siw *ServerInterfaceWrapper) CreateSpace(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSpace(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSpace operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSpace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSpace(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSpaceInvitation operation middleware
+func (siw *ServerInterfaceWrapper) CreateSpaceInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSpaceInvitation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpaceMembers operation middleware
+func (siw *ServerInterfaceWrapper) GetSpaceMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpaceMembers(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSpaceMember operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSpaceMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "memberId" -------------
+	var memberId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberId", r.PathValue("memberId"), &memberId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "memberId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSpaceMember(w, r, id, memberId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatistics operation middleware
+func (siw *ServerInterfaceWrapper) GetStatistics(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatistics(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetSummary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSummaryParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSummary(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSync operation middleware
+func (siw *ServerInterfaceWrapper) GetSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSyncParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSync(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSync operation middleware
+func (siw *ServerInterfaceWrapper) PostSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSyncParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSync(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAccessTokens operation middleware
+func (siw *ServerInterfaceWrapper) GetAccessTokens(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAccessTokens(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateAccessToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAccessToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAccessToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAccessToken(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUserData operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserData(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteUserDataParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUserData(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportUserData operation middleware
+func (siw *ServerInterfaceWrapper) ExportUserData(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportUserDataParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportUserData(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportUserData operation middleware
+func (siw *ServerInterfaceWrapper) ImportUserData(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportUserDataParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportUserData(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateWebhookParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteWebhookParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OidcScopes, []string{"senbara:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "space" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space", r.URL.Query(), &params.Space)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookDeliveries(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/activities", wrapper.GetActivities)
+	m.HandleFunc("POST "+options.BaseURL+"/activities", wrapper.CreateActivity)
+	m.HandleFunc("DELETE "+options.BaseURL+"/activities/{id}", wrapper.DeleteActivity)
+	m.HandleFunc("GET "+options.BaseURL+"/activities/{id}", wrapper.GetActivity)
+	m.HandleFunc("PATCH "+options.BaseURL+"/activities/{id}", wrapper.PatchActivity)
+	m.HandleFunc("PUT "+options.BaseURL+"/activities/{id}", wrapper.UpdateActivity)
+	m.HandleFunc("POST "+options.BaseURL+"/backchannel-logout", wrapper.BackchannelLogout)
+	m.HandleFunc("POST "+options.BaseURL+"/batch", wrapper.PostBatch)
+	m.HandleFunc("GET "+options.BaseURL+"/code/", wrapper.GetSourceCode)
+	m.HandleFunc("GET "+options.BaseURL+"/contacts", wrapper.GetContacts)
+	m.HandleFunc("POST "+options.BaseURL+"/contacts", wrapper.CreateContact)
+	m.HandleFunc("DELETE "+options.BaseURL+"/contacts/{id}", wrapper.DeleteContact)
+	m.HandleFunc("GET "+options.BaseURL+"/contacts/{id}", wrapper.GetContact)
+	m.HandleFunc("PATCH "+options.BaseURL+"/contacts/{id}", wrapper.PatchContact)
+	m.HandleFunc("PUT "+options.BaseURL+"/contacts/{id}", wrapper.UpdateContact)
+	m.HandleFunc("GET "+options.BaseURL+"/debts", wrapper.GetDebts)
+	m.HandleFunc("POST "+options.BaseURL+"/debts", wrapper.CreateDebt)
+	m.HandleFunc("DELETE "+options.BaseURL+"/debts/{id}", wrapper.SettleDebt)
+	m.HandleFunc("PATCH "+options.BaseURL+"/debts/{id}", wrapper.PatchDebt)
+	m.HandleFunc("PUT "+options.BaseURL+"/debts/{id}", wrapper.UpdateDebt)
+	m.HandleFunc("GET "+options.BaseURL+"/events", wrapper.GetEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/invitations", wrapper.GetSpaceInvitations)
+	m.HandleFunc("DELETE "+options.BaseURL+"/invitations/{id}", wrapper.DeclineSpaceInvitation)
+	m.HandleFunc("POST "+options.BaseURL+"/invitations/{id}", wrapper.AcceptSpaceInvitation)
+	m.HandleFunc("GET "+options.BaseURL+"/journal", wrapper.GetJournalEntries)
+	m.HandleFunc("POST "+options.BaseURL+"/journal", wrapper.CreateJournalEntry)
+	m.HandleFunc("DELETE "+options.BaseURL+"/journal/{id}", wrapper.DeleteJournalEntry)
+	m.HandleFunc("GET "+options.BaseURL+"/journal/{id}", wrapper.GetJournalEntry)
+	m.HandleFunc("PATCH "+options.BaseURL+"/journal/{id}", wrapper.PatchJournalEntry)
+	m.HandleFunc("PUT "+options.BaseURL+"/journal/{id}", wrapper.UpdateJournalEntry)
+	m.HandleFunc("GET "+options.BaseURL+"/openapi.json", wrapper.GetOpenAPISpec)
+	m.HandleFunc("DELETE "+options.BaseURL+"/sessions", wrapper.DeleteSessions)
+	m.HandleFunc("GET "+options.BaseURL+"/sessions", wrapper.GetSessions)
+	m.HandleFunc("DELETE "+options.BaseURL+"/sessions/{id}", wrapper.DeleteSession)
+	m.HandleFunc("GET "+options.BaseURL+"/spaces", wrapper.GetSpaces)
+	m.HandleFunc("POST "+options.BaseURL+"/spaces", wrapper.CreateSpace)
+	m.HandleFunc("DELETE "+options.BaseURL+"/spaces/{id}", wrapper.DeleteSpace)
+	m.HandleFunc("POST "+options.BaseURL+"/spaces/{id}/invitations", wrapper.CreateSpaceInvitation)
+	m.HandleFunc("GET "+options.BaseURL+"/spaces/{id}/members", wrapper.GetSpaceMembers)
+	m.HandleFunc("DELETE "+options.BaseURL+"/spaces/{id}/members/{memberId}", wrapper.DeleteSpaceMember)
+	m.HandleFunc("GET "+options.BaseURL+"/statistics", wrapper.GetStatistics)
+	m.HandleFunc("GET "+options.BaseURL+"/summary", wrapper.GetSummary)
+	m.HandleFunc("GET "+options.BaseURL+"/sync", wrapper.GetSync)
+	m.HandleFunc("POST "+options.BaseURL+"/sync", wrapper.PostSync)
+	m.HandleFunc("GET "+options.BaseURL+"/tokens", wrapper.GetAccessTokens)
+	m.HandleFunc("POST "+options.BaseURL+"/tokens", wrapper.CreateAccessToken)
+	m.HandleFunc("DELETE "+options.BaseURL+"/tokens/{id}", wrapper.DeleteAccessToken)
+	m.HandleFunc("DELETE "+options.BaseURL+"/userdata", wrapper.DeleteUserData)
+	m.HandleFunc("GET "+options.BaseURL+"/userdata", wrapper.ExportUserData)
+	m.HandleFunc("POST "+options.BaseURL+"/userdata", wrapper.ImportUserData)
+	m.HandleFunc("GET "+options.BaseURL+"/webhooks", wrapper.GetWebhooks)
+	m.HandleFunc("POST "+options.BaseURL+"/webhooks", wrapper.CreateWebhook)
+	m.HandleFunc("DELETE "+options.BaseURL+"/webhooks/{id}", wrapper.DeleteWebhook)
+	m.HandleFunc("GET "+options.BaseURL+"/webhooks/{id}/deliveries", wrapper.GetWebhookDeliveries)
+
+	return m
+}
+
+type BadRequestApplicationProblemPlusJSONResponse Problem
+
+type ConflictApplicationProblemPlusJSONResponse Problem
+
+type ForbiddenApplicationProblemPlusJSONResponse Problem
+
+type InternalServerErrorApplicationProblemPlusJSONResponse Problem
+
+type NotFoundApplicationProblemPlusJSONResponse Problem
+
+type UnauthorizedApplicationProblemPlusJSONResponse Problem
+
+type UnprocessableContentApplicationProblemPlusJSONResponse Problem
+
+type GetActivitiesRequestObject struct {
+	Params GetActivitiesParams
+}
+
+type GetActivitiesResponseObject interface {
+	VisitGetActivitiesResponse(w http.ResponseWriter) error
+}
+
+type GetActivities200JSONResponse []ActivityWithContact
+
+func (response GetActivities200JSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivities400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetActivities400ApplicationProblemPlusJSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivities401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetActivities401ApplicationProblemPlusJSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivities403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetActivities403ApplicationProblemPlusJSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivities422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetActivities422ApplicationProblemPlusJSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivities500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetActivities500ApplicationProblemPlusJSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateActivityRequestObject struct {
+	Params CreateActivityParams
+	Body   *CreateActivityJSONRequestBody
+}
+
+type CreateActivityResponseObject interface {
+	VisitCreateActivityResponse(w http.ResponseWriter) error
+}
+
+type CreateActivity200JSONResponse Activity
+
+func (response CreateActivity200JSONResponse) VisitCreateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateActivity400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateActivity400ApplicationProblemPlusJSONResponse) VisitCreateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateActivity401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateActivity401ApplicationProblemPlusJSONResponse) VisitCreateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateActivity403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateActivity403ApplicationProblemPlusJSONResponse) VisitCreateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateActivity404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CreateActivity404ApplicationProblemPlusJSONResponse) VisitCreateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateActivity409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateActivity409ApplicationProblemPlusJSONResponse) VisitCreateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateActivity422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response CreateActivity422ApplicationProblemPlusJSONResponse) VisitCreateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateActivity500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateActivity500ApplicationProblemPlusJSONResponse) VisitCreateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteActivityRequestObject struct {
+	Id     int64 `json:"id"`
+	Params DeleteActivityParams
+}
+
+type DeleteActivityResponseObject interface {
+	VisitDeleteActivityResponse(w http.ResponseWriter) error
+}
+
+type DeleteActivity200JSONResponse int64
+
+func (response DeleteActivity200JSONResponse) VisitDeleteActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteActivity400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteActivity400ApplicationProblemPlusJSONResponse) VisitDeleteActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteActivity401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteActivity401ApplicationProblemPlusJSONResponse) VisitDeleteActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteActivity403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteActivity403ApplicationProblemPlusJSONResponse) VisitDeleteActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteActivity404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteActivity404ApplicationProblemPlusJSONResponse) VisitDeleteActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteActivity409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteActivity409ApplicationProblemPlusJSONResponse) VisitDeleteActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteActivity422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteActivity422ApplicationProblemPlusJSONResponse) VisitDeleteActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteActivity500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteActivity500ApplicationProblemPlusJSONResponse) VisitDeleteActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivityRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetActivityParams
+}
+
+type GetActivityResponseObject interface {
+	VisitGetActivityResponse(w http.ResponseWriter) error
+}
+
+type GetActivity200JSONResponse ActivityWithContact
+
+func (response GetActivity200JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivity400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetActivity400ApplicationProblemPlusJSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivity401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetActivity401ApplicationProblemPlusJSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivity403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetActivity403ApplicationProblemPlusJSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivity404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetActivity404ApplicationProblemPlusJSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivity422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetActivity422ApplicationProblemPlusJSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivity500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetActivity500ApplicationProblemPlusJSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchActivityRequestObject struct {
+	Id     int64 `json:"id"`
+	Params PatchActivityParams
+	Body   *PatchActivityApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchActivityResponseObject interface {
+	VisitPatchActivityResponse(w http.ResponseWriter) error
+}
+
+type PatchActivity200JSONResponse Activity
+
+func (response PatchActivity200JSONResponse) VisitPatchActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchActivity400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PatchActivity400ApplicationProblemPlusJSONResponse) VisitPatchActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchActivity401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchActivity401ApplicationProblemPlusJSONResponse) VisitPatchActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchActivity403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PatchActivity403ApplicationProblemPlusJSONResponse) VisitPatchActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchActivity404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PatchActivity404ApplicationProblemPlusJSONResponse) VisitPatchActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchActivity409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PatchActivity409ApplicationProblemPlusJSONResponse) VisitPatchActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchActivity422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response PatchActivity422ApplicationProblemPlusJSONResponse) VisitPatchActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchActivity500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PatchActivity500ApplicationProblemPlusJSONResponse) VisitPatchActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateActivityRequestObject struct {
+	Id     int64 `json:"id"`
+	Params UpdateActivityParams
+	Body   *UpdateActivityJSONRequestBody
+}
+
+type UpdateActivityResponseObject interface {
+	VisitUpdateActivityResponse(w http.ResponseWriter) error
+}
+
+type UpdateActivity200JSONResponse Activity
+
+func (response UpdateActivity200JSONResponse) VisitUpdateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateActivity400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateActivity400ApplicationProblemPlusJSONResponse) VisitUpdateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateActivity401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateActivity401ApplicationProblemPlusJSONResponse) VisitUpdateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateActivity403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateActivity403ApplicationProblemPlusJSONResponse) VisitUpdateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateActivity404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateActivity404ApplicationProblemPlusJSONResponse) VisitUpdateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateActivity409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateActivity409ApplicationProblemPlusJSONResponse) VisitUpdateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateActivity422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateActivity422ApplicationProblemPlusJSONResponse) VisitUpdateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateActivity500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateActivity500ApplicationProblemPlusJSONResponse) VisitUpdateActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BackchannelLogoutRequestObject struct {
+	Body *BackchannelLogoutFormdataRequestBody
+}
+
+type BackchannelLogoutResponseObject interface {
+	VisitBackchannelLogoutResponse(w http.ResponseWriter) error
+}
+
+type BackchannelLogout200Response struct {
+}
+
+func (response BackchannelLogout200Response) VisitBackchannelLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type BackchannelLogout400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response BackchannelLogout400ApplicationProblemPlusJSONResponse) VisitBackchannelLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BackchannelLogout422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response BackchannelLogout422ApplicationProblemPlusJSONResponse) VisitBackchannelLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BackchannelLogout500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response BackchannelLogout500ApplicationProblemPlusJSONResponse) VisitBackchannelLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBatchRequestObject struct {
+	Params PostBatchParams
+	Body   *PostBatchJSONRequestBody
+}
+
+type PostBatchResponseObject interface {
+	VisitPostBatchResponse(w http.ResponseWriter) error
+}
+
+type PostBatch200JSONResponse BatchResult
+
+func (response PostBatch200JSONResponse) VisitPostBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBatch400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostBatch400ApplicationProblemPlusJSONResponse) VisitPostBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBatch401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostBatch401ApplicationProblemPlusJSONResponse) VisitPostBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBatch403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostBatch403ApplicationProblemPlusJSONResponse) VisitPostBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBatch404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostBatch404ApplicationProblemPlusJSONResponse) VisitPostBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBatch422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response PostBatch422ApplicationProblemPlusJSONResponse) VisitPostBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBatch500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostBatch500ApplicationProblemPlusJSONResponse) VisitPostBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceCodeRequestObject struct {
+}
+
+type GetSourceCodeResponseObject interface {
+	VisitGetSourceCodeResponse(w http.ResponseWriter) error
+}
+
+type GetSourceCode200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetSourceCode200ApplicationgzipResponse struct {
+	Body          io.Reader
+	Headers       GetSourceCode200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetSourceCode200ApplicationgzipResponse) VisitGetSourceCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetSourceCode500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSourceCode500ApplicationProblemPlusJSONResponse) VisitGetSourceCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContactsRequestObject struct {
+	Params GetContactsParams
+}
+
+type GetContactsResponseObject interface {
+	VisitGetContactsResponse(w http.ResponseWriter) error
+}
+
+type GetContacts200JSONResponse []Contact
+
+func (response GetContacts200JSONResponse) VisitGetContactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContacts400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetContacts400ApplicationProblemPlusJSONResponse) VisitGetContactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContacts401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetContacts401ApplicationProblemPlusJSONResponse) VisitGetContactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContacts403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetContacts403ApplicationProblemPlusJSONResponse) VisitGetContactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContacts404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetContacts404ApplicationProblemPlusJSONResponse) VisitGetContactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContacts422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetContacts422ApplicationProblemPlusJSONResponse) VisitGetContactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContacts500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetContacts500ApplicationProblemPlusJSONResponse) VisitGetContactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateContactRequestObject struct {
+	Params CreateContactParams
+	Body   *CreateContactJSONRequestBody
+}
+
+type CreateContactResponseObject interface {
+	VisitCreateContactResponse(w http.ResponseWriter) error
+}
+
+type CreateContact200JSONResponse Contact
+
+func (response CreateContact200JSONResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateContact400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateContact400ApplicationProblemPlusJSONResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateContact401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateContact401ApplicationProblemPlusJSONResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateContact403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateContact403ApplicationProblemPlusJSONResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateContact404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CreateContact404ApplicationProblemPlusJSONResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateContact409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateContact409ApplicationProblemPlusJSONResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateContact422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response CreateContact422ApplicationProblemPlusJSONResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateContact500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateContact500ApplicationProblemPlusJSONResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteContactRequestObject struct {
+	Id     int64 `json:"id"`
+	Params DeleteContactParams
+}
+
+type DeleteContactResponseObject interface {
+	VisitDeleteContactResponse(w http.ResponseWriter) error
+}
+
+type DeleteContact200JSONResponse int64
+
+func (response DeleteContact200JSONResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteContact400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteContact400ApplicationProblemPlusJSONResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteContact401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteContact401ApplicationProblemPlusJSONResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteContact403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteContact403ApplicationProblemPlusJSONResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteContact404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteContact404ApplicationProblemPlusJSONResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteContact409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteContact409ApplicationProblemPlusJSONResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteContact422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteContact422ApplicationProblemPlusJSONResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteContact500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteContact500ApplicationProblemPlusJSONResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContactRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetContactParams
+}
+
+type GetContactResponseObject interface {
+	VisitGetContactResponse(w http.ResponseWriter) error
+}
+
+type GetContact200JSONResponse ContactData
+
+func (response GetContact200JSONResponse) VisitGetContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContact400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetContact400ApplicationProblemPlusJSONResponse) VisitGetContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContact401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetContact401ApplicationProblemPlusJSONResponse) VisitGetContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContact403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetContact403ApplicationProblemPlusJSONResponse) VisitGetContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContact404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetContact404ApplicationProblemPlusJSONResponse) VisitGetContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContact422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetContact422ApplicationProblemPlusJSONResponse) VisitGetContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContact500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetContact500ApplicationProblemPlusJSONResponse) VisitGetContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchContactRequestObject struct {
+	Id     int64 `json:"id"`
+	Params PatchContactParams
+	Body   *PatchContactApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchContactResponseObject interface {
+	VisitPatchContactResponse(w http.ResponseWriter) error
+}
+
+type PatchContact200JSONResponse Contact
+
+func (response PatchContact200JSONResponse) VisitPatchContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchContact400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PatchContact400ApplicationProblemPlusJSONResponse) VisitPatchContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchContact401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchContact401ApplicationProblemPlusJSONResponse) VisitPatchContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchContact403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PatchContact403ApplicationProblemPlusJSONResponse) VisitPatchContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchContact404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PatchContact404ApplicationProblemPlusJSONResponse) VisitPatchContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchContact409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PatchContact409ApplicationProblemPlusJSONResponse) VisitPatchContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchContact422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response PatchContact422ApplicationProblemPlusJSONResponse) VisitPatchContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchContact500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PatchContact500ApplicationProblemPlusJSONResponse) VisitPatchContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateContactRequestObject struct {
+	Id     int64 `json:"id"`
+	Params UpdateContactParams
+	Body   *UpdateContactJSONRequestBody
+}
+
+type UpdateContactResponseObject interface {
+	VisitUpdateContactResponse(w http.ResponseWriter) error
+}
+
+type UpdateContact200JSONResponse Contact
+
+func (response UpdateContact200JSONResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateContact400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateContact400ApplicationProblemPlusJSONResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateContact401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateContact401ApplicationProblemPlusJSONResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateContact403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateContact403ApplicationProblemPlusJSONResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateContact404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateContact404ApplicationProblemPlusJSONResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateContact409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateContact409ApplicationProblemPlusJSONResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateContact422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateContact422ApplicationProblemPlusJSONResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateContact500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateContact500ApplicationProblemPlusJSONResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDebtsRequestObject struct {
+	Params GetDebtsParams
+}
+
+type GetDebtsResponseObject interface {
+	VisitGetDebtsResponse(w http.ResponseWriter) error
+}
+
+type GetDebts200JSONResponse []DebtWithContact
+
+func (response GetDebts200JSONResponse) VisitGetDebtsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDebts400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetDebts400ApplicationProblemPlusJSONResponse) VisitGetDebtsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDebts401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetDebts401ApplicationProblemPlusJSONResponse) VisitGetDebtsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDebts403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetDebts403ApplicationProblemPlusJSONResponse) VisitGetDebtsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDebts422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetDebts422ApplicationProblemPlusJSONResponse) VisitGetDebtsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDebts500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetDebts500ApplicationProblemPlusJSONResponse) VisitGetDebtsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebtRequestObject struct {
+	Params CreateDebtParams
+	Body   *CreateDebtJSONRequestBody
+}
+
+type CreateDebtResponseObject interface {
+	VisitCreateDebtResponse(w http.ResponseWriter) error
+}
+
+type CreateDebt200JSONResponse Debt
+
+func (response CreateDebt200JSONResponse) VisitCreateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebt400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateDebt400ApplicationProblemPlusJSONResponse) VisitCreateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebt401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateDebt401ApplicationProblemPlusJSONResponse) VisitCreateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebt403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateDebt403ApplicationProblemPlusJSONResponse) VisitCreateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebt404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CreateDebt404ApplicationProblemPlusJSONResponse) VisitCreateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebt409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateDebt409ApplicationProblemPlusJSONResponse) VisitCreateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebt422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response CreateDebt422ApplicationProblemPlusJSONResponse) VisitCreateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebt500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateDebt500ApplicationProblemPlusJSONResponse) VisitCreateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SettleDebtRequestObject struct {
+	Id     int64 `json:"id"`
+	Params SettleDebtParams
+}
+
+type SettleDebtResponseObject interface {
+	VisitSettleDebtResponse(w http.ResponseWriter) error
+}
+
+type SettleDebt200JSONResponse int64
+
+func (response SettleDebt200JSONResponse) VisitSettleDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SettleDebt400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response SettleDebt400ApplicationProblemPlusJSONResponse) VisitSettleDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SettleDebt401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response SettleDebt401ApplicationProblemPlusJSONResponse) VisitSettleDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SettleDebt403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response SettleDebt403ApplicationProblemPlusJSONResponse) VisitSettleDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SettleDebt404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response SettleDebt404ApplicationProblemPlusJSONResponse) VisitSettleDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SettleDebt409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response SettleDebt409ApplicationProblemPlusJSONResponse) VisitSettleDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SettleDebt422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response SettleDebt422ApplicationProblemPlusJSONResponse) VisitSettleDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SettleDebt500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response SettleDebt500ApplicationProblemPlusJSONResponse) VisitSettleDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDebtRequestObject struct {
+	Id     int64 `json:"id"`
+	Params PatchDebtParams
+	Body   *PatchDebtApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchDebtResponseObject interface {
+	VisitPatchDebtResponse(w http.ResponseWriter) error
+}
+
+type PatchDebt200JSONResponse Debt
+
+func (response PatchDebt200JSONResponse) VisitPatchDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDebt400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PatchDebt400ApplicationProblemPlusJSONResponse) VisitPatchDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDebt401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchDebt401ApplicationProblemPlusJSONResponse) VisitPatchDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDebt403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PatchDebt403ApplicationProblemPlusJSONResponse) VisitPatchDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDebt404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PatchDebt404ApplicationProblemPlusJSONResponse) VisitPatchDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDebt409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PatchDebt409ApplicationProblemPlusJSONResponse) VisitPatchDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDebt422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response PatchDebt422ApplicationProblemPlusJSONResponse) VisitPatchDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDebt500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PatchDebt500ApplicationProblemPlusJSONResponse) VisitPatchDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDebtRequestObject struct {
+	Id     int64 `json:"id"`
+	Params UpdateDebtParams
+	Body   *UpdateDebtJSONRequestBody
+}
+
+type UpdateDebtResponseObject interface {
+	VisitUpdateDebtResponse(w http.ResponseWriter) error
+}
+
+type UpdateDebt200JSONResponse Debt
+
+func (response UpdateDebt200JSONResponse) VisitUpdateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDebt400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateDebt400ApplicationProblemPlusJSONResponse) VisitUpdateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDebt401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateDebt401ApplicationProblemPlusJSONResponse) VisitUpdateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDebt403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateDebt403ApplicationProblemPlusJSONResponse) VisitUpdateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDebt404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateDebt404ApplicationProblemPlusJSONResponse) VisitUpdateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDebt409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateDebt409ApplicationProblemPlusJSONResponse) VisitUpdateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDebt422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateDebt422ApplicationProblemPlusJSONResponse) VisitUpdateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDebt500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateDebt500ApplicationProblemPlusJSONResponse) VisitUpdateDebtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsRequestObject struct {
+	Params GetEventsParams
+}
+
+type GetEventsResponseObject interface {
+	VisitGetEventsResponse(w http.ResponseWriter) error
+}
+
+type GetEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetEvents200TexteventStreamResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetEvents400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetEvents400ApplicationProblemPlusJSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetEvents401ApplicationProblemPlusJSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetEvents403ApplicationProblemPlusJSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetEvents404ApplicationProblemPlusJSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetEvents422ApplicationProblemPlusJSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetEvents500ApplicationProblemPlusJSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceInvitationsRequestObject struct {
+}
+
+type GetSpaceInvitationsResponseObject interface {
+	VisitGetSpaceInvitationsResponse(w http.ResponseWriter) error
+}
+
+type GetSpaceInvitations200JSONResponse []SpaceInvitation
+
+func (response GetSpaceInvitations200JSONResponse) VisitGetSpaceInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceInvitations401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaceInvitations401ApplicationProblemPlusJSONResponse) VisitGetSpaceInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceInvitations403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaceInvitations403ApplicationProblemPlusJSONResponse) VisitGetSpaceInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceInvitations500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaceInvitations500ApplicationProblemPlusJSONResponse) VisitGetSpaceInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineSpaceInvitationRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeclineSpaceInvitationResponseObject interface {
+	VisitDeclineSpaceInvitationResponse(w http.ResponseWriter) error
+}
+
+type DeclineSpaceInvitation200JSONResponse int64
+
+func (response DeclineSpaceInvitation200JSONResponse) VisitDeclineSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineSpaceInvitation400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeclineSpaceInvitation400ApplicationProblemPlusJSONResponse) VisitDeclineSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineSpaceInvitation401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeclineSpaceInvitation401ApplicationProblemPlusJSONResponse) VisitDeclineSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineSpaceInvitation403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeclineSpaceInvitation403ApplicationProblemPlusJSONResponse) VisitDeclineSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineSpaceInvitation404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeclineSpaceInvitation404ApplicationProblemPlusJSONResponse) VisitDeclineSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineSpaceInvitation409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeclineSpaceInvitation409ApplicationProblemPlusJSONResponse) VisitDeclineSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineSpaceInvitation422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response DeclineSpaceInvitation422ApplicationProblemPlusJSONResponse) VisitDeclineSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineSpaceInvitation500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeclineSpaceInvitation500ApplicationProblemPlusJSONResponse) VisitDeclineSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSpaceInvitationRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type AcceptSpaceInvitationResponseObject interface {
+	VisitAcceptSpaceInvitationResponse(w http.ResponseWriter) error
+}
+
+type AcceptSpaceInvitation200JSONResponse Space
+
+func (response AcceptSpaceInvitation200JSONResponse) VisitAcceptSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSpaceInvitation400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response AcceptSpaceInvitation400ApplicationProblemPlusJSONResponse) VisitAcceptSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSpaceInvitation401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response AcceptSpaceInvitation401ApplicationProblemPlusJSONResponse) VisitAcceptSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSpaceInvitation403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response AcceptSpaceInvitation403ApplicationProblemPlusJSONResponse) VisitAcceptSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSpaceInvitation404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response AcceptSpaceInvitation404ApplicationProblemPlusJSONResponse) VisitAcceptSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSpaceInvitation409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response AcceptSpaceInvitation409ApplicationProblemPlusJSONResponse) VisitAcceptSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSpaceInvitation422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response AcceptSpaceInvitation422ApplicationProblemPlusJSONResponse) VisitAcceptSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSpaceInvitation500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AcceptSpaceInvitation500ApplicationProblemPlusJSONResponse) VisitAcceptSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntriesRequestObject struct {
+	Params GetJournalEntriesParams
+}
+
+type GetJournalEntriesResponseObject interface {
+	VisitGetJournalEntriesResponse(w http.ResponseWriter) error
+}
+
+type GetJournalEntries200JSONResponse []JournalEntry
+
+func (response GetJournalEntries200JSONResponse) VisitGetJournalEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntries400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntries400ApplicationProblemPlusJSONResponse) VisitGetJournalEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntries401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntries401ApplicationProblemPlusJSONResponse) VisitGetJournalEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntries403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntries403ApplicationProblemPlusJSONResponse) VisitGetJournalEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntries404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntries404ApplicationProblemPlusJSONResponse) VisitGetJournalEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntries422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntries422ApplicationProblemPlusJSONResponse) VisitGetJournalEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntries500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntries500ApplicationProblemPlusJSONResponse) VisitGetJournalEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJournalEntryRequestObject struct {
+	Params CreateJournalEntryParams
+	Body   *CreateJournalEntryJSONRequestBody
+}
+
+type CreateJournalEntryResponseObject interface {
+	VisitCreateJournalEntryResponse(w http.ResponseWriter) error
+}
+
+type CreateJournalEntry200JSONResponse JournalEntry
+
+func (response CreateJournalEntry200JSONResponse) VisitCreateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJournalEntry400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateJournalEntry400ApplicationProblemPlusJSONResponse) VisitCreateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJournalEntry401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateJournalEntry401ApplicationProblemPlusJSONResponse) VisitCreateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJournalEntry403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateJournalEntry403ApplicationProblemPlusJSONResponse) VisitCreateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJournalEntry404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CreateJournalEntry404ApplicationProblemPlusJSONResponse) VisitCreateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJournalEntry409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateJournalEntry409ApplicationProblemPlusJSONResponse) VisitCreateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJournalEntry422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response CreateJournalEntry422ApplicationProblemPlusJSONResponse) VisitCreateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJournalEntry500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateJournalEntry500ApplicationProblemPlusJSONResponse) VisitCreateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteJournalEntryRequestObject struct {
+	Id     int64 `json:"id"`
+	Params DeleteJournalEntryParams
+}
+
+type DeleteJournalEntryResponseObject interface {
+	VisitDeleteJournalEntryResponse(w http.ResponseWriter) error
+}
+
+type DeleteJournalEntry200JSONResponse int64
+
+func (response DeleteJournalEntry200JSONResponse) VisitDeleteJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteJournalEntry400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteJournalEntry400ApplicationProblemPlusJSONResponse) VisitDeleteJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteJournalEntry401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteJournalEntry401ApplicationProblemPlusJSONResponse) VisitDeleteJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteJournalEntry403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteJournalEntry403ApplicationProblemPlusJSONResponse) VisitDeleteJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteJournalEntry404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteJournalEntry404ApplicationProblemPlusJSONResponse) VisitDeleteJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteJournalEntry409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteJournalEntry409ApplicationProblemPlusJSONResponse) VisitDeleteJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteJournalEntry422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteJournalEntry422ApplicationProblemPlusJSONResponse) VisitDeleteJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteJournalEntry500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteJournalEntry500ApplicationProblemPlusJSONResponse) VisitDeleteJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntryRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetJournalEntryParams
+}
+
+type GetJournalEntryResponseObject interface {
+	VisitGetJournalEntryResponse(w http.ResponseWriter) error
+}
+
+type GetJournalEntry200JSONResponse JournalEntry
+
+func (response GetJournalEntry200JSONResponse) VisitGetJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntry400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntry400ApplicationProblemPlusJSONResponse) VisitGetJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntry401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntry401ApplicationProblemPlusJSONResponse) VisitGetJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntry403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntry403ApplicationProblemPlusJSONResponse) VisitGetJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntry404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntry404ApplicationProblemPlusJSONResponse) VisitGetJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntry422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntry422ApplicationProblemPlusJSONResponse) VisitGetJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJournalEntry500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetJournalEntry500ApplicationProblemPlusJSONResponse) VisitGetJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchJournalEntryRequestObject struct {
+	Id     int64 `json:"id"`
+	Params PatchJournalEntryParams
+	Body   *PatchJournalEntryApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchJournalEntryResponseObject interface {
+	VisitPatchJournalEntryResponse(w http.ResponseWriter) error
+}
+
+type PatchJournalEntry200JSONResponse JournalEntry
+
+func (response PatchJournalEntry200JSONResponse) VisitPatchJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchJournalEntry400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PatchJournalEntry400ApplicationProblemPlusJSONResponse) VisitPatchJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchJournalEntry401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchJournalEntry401ApplicationProblemPlusJSONResponse) VisitPatchJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchJournalEntry403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PatchJournalEntry403ApplicationProblemPlusJSONResponse) VisitPatchJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchJournalEntry404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PatchJournalEntry404ApplicationProblemPlusJSONResponse) VisitPatchJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchJournalEntry409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PatchJournalEntry409ApplicationProblemPlusJSONResponse) VisitPatchJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchJournalEntry422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response PatchJournalEntry422ApplicationProblemPlusJSONResponse) VisitPatchJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchJournalEntry500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PatchJournalEntry500ApplicationProblemPlusJSONResponse) VisitPatchJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateJournalEntryRequestObject struct {
+	Id     int64 `json:"id"`
+	Params UpdateJournalEntryParams
+	Body   *UpdateJournalEntryJSONRequestBody
+}
+
+type UpdateJournalEntryResponseObject interface {
+	VisitUpdateJournalEntryResponse(w http.ResponseWriter) error
+}
+
+type UpdateJournalEntry200JSONResponse JournalEntry
+
+func (response UpdateJournalEntry200JSONResponse) VisitUpdateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateJournalEntry400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateJournalEntry400ApplicationProblemPlusJSONResponse) VisitUpdateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateJournalEntry401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateJournalEntry401ApplicationProblemPlusJSONResponse) VisitUpdateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateJournalEntry403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateJournalEntry403ApplicationProblemPlusJSONResponse) VisitUpdateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateJournalEntry404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateJournalEntry404ApplicationProblemPlusJSONResponse) VisitUpdateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateJournalEntry409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateJournalEntry409ApplicationProblemPlusJSONResponse) VisitUpdateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateJournalEntry422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateJournalEntry422ApplicationProblemPlusJSONResponse) VisitUpdateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateJournalEntry500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateJournalEntry500ApplicationProblemPlusJSONResponse) VisitUpdateJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOpenAPISpecRequestObject struct {
+}
+
+type GetOpenAPISpecResponseObject interface {
+	VisitGetOpenAPISpecResponse(w http.ResponseWriter) error
+}
+
+type GetOpenAPISpec200ResponseHeaders struct {
+	ContentType string
+}
+
+type GetOpenAPISpec200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       GetOpenAPISpec200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetOpenAPISpec200ApplicationoctetStreamResponse) VisitGetOpenAPISpecResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Type", fmt.Sprint(response.Headers.ContentType))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetOpenAPISpec500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetOpenAPISpec500ApplicationProblemPlusJSONResponse) VisitGetOpenAPISpecResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSessionsRequestObject struct {
+}
+
+type DeleteSessionsResponseObject interface {
+	VisitDeleteSessionsResponse(w http.ResponseWriter) error
+}
+
+type DeleteSessions200JSONResponse []int64
+
+func (response DeleteSessions200JSONResponse) VisitDeleteSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSessions401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSessions401ApplicationProblemPlusJSONResponse) VisitDeleteSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSessions403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSessions403ApplicationProblemPlusJSONResponse) VisitDeleteSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSessions409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSessions409ApplicationProblemPlusJSONResponse) VisitDeleteSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSessions500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSessions500ApplicationProblemPlusJSONResponse) VisitDeleteSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionsRequestObject struct {
+}
+
+type GetSessionsResponseObject interface {
+	VisitGetSessionsResponse(w http.ResponseWriter) error
+}
+
+type GetSessions200JSONResponse []Session
+
+func (response GetSessions200JSONResponse) VisitGetSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessions401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSessions401ApplicationProblemPlusJSONResponse) VisitGetSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessions403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSessions403ApplicationProblemPlusJSONResponse) VisitGetSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessions500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSessions500ApplicationProblemPlusJSONResponse) VisitGetSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSessionRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteSessionResponseObject interface {
+	VisitDeleteSessionResponse(w http.ResponseWriter) error
+}
+
+type DeleteSession200JSONResponse int64
+
+func (response DeleteSession200JSONResponse) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSession400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSession400ApplicationProblemPlusJSONResponse) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSession401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSession401ApplicationProblemPlusJSONResponse) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSession403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSession403ApplicationProblemPlusJSONResponse) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSession404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSession404ApplicationProblemPlusJSONResponse) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSession409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSession409ApplicationProblemPlusJSONResponse) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSession422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSession422ApplicationProblemPlusJSONResponse) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSession500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSession500ApplicationProblemPlusJSONResponse) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpacesRequestObject struct {
+}
+
+type GetSpacesResponseObject interface {
+	VisitGetSpacesResponse(w http.ResponseWriter) error
+}
+
+type GetSpaces200JSONResponse []Space
+
+func (response GetSpaces200JSONResponse) VisitGetSpacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaces401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaces401ApplicationProblemPlusJSONResponse) VisitGetSpacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaces403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaces403ApplicationProblemPlusJSONResponse) VisitGetSpacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaces500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaces500ApplicationProblemPlusJSONResponse) VisitGetSpacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpaceRequestObject struct {
+	Body *CreateSpaceJSONRequestBody
+}
+
+type CreateSpaceResponseObject interface {
+	VisitCreateSpaceResponse(w http.ResponseWriter) error
+}
+
+type CreateSpace200JSONResponse Space
+
+func (response CreateSpace200JSONResponse) VisitCreateSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpace400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpace400ApplicationProblemPlusJSONResponse) VisitCreateSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpace401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpace401ApplicationProblemPlusJSONResponse) VisitCreateSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpace403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpace403ApplicationProblemPlusJSONResponse) VisitCreateSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpace409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpace409ApplicationProblemPlusJSONResponse) VisitCreateSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpace422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpace422ApplicationProblemPlusJSONResponse) VisitCreateSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpace500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpace500ApplicationProblemPlusJSONResponse) VisitCreateSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpaceRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteSpaceResponseObject interface {
+	VisitDeleteSpaceResponse(w http.ResponseWriter) error
+}
+
+type DeleteSpace200JSONResponse int64
+
+func (response DeleteSpace200JSONResponse) VisitDeleteSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpace400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpace400ApplicationProblemPlusJSONResponse) VisitDeleteSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpace401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpace401ApplicationProblemPlusJSONResponse) VisitDeleteSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpace403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpace403ApplicationProblemPlusJSONResponse) VisitDeleteSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpace404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpace404ApplicationProblemPlusJSONResponse) VisitDeleteSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpace409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpace409ApplicationProblemPlusJSONResponse) VisitDeleteSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpace422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpace422ApplicationProblemPlusJSONResponse) VisitDeleteSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpace500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpace500ApplicationProblemPlusJSONResponse) VisitDeleteSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpaceInvitationRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *CreateSpaceInvitationJSONRequestBody
+}
+
+type CreateSpaceInvitationResponseObject interface {
+	VisitCreateSpaceInvitationResponse(w http.ResponseWriter) error
+}
+
+type CreateSpaceInvitation200JSONResponse SpaceInvitation
+
+func (response CreateSpaceInvitation200JSONResponse) VisitCreateSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpaceInvitation400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpaceInvitation400ApplicationProblemPlusJSONResponse) VisitCreateSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpaceInvitation401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpaceInvitation401ApplicationProblemPlusJSONResponse) VisitCreateSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpaceInvitation403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpaceInvitation403ApplicationProblemPlusJSONResponse) VisitCreateSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpaceInvitation404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpaceInvitation404ApplicationProblemPlusJSONResponse) VisitCreateSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpaceInvitation409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpaceInvitation409ApplicationProblemPlusJSONResponse) VisitCreateSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpaceInvitation422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpaceInvitation422ApplicationProblemPlusJSONResponse) VisitCreateSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpaceInvitation500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpaceInvitation500ApplicationProblemPlusJSONResponse) VisitCreateSpaceInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceMembersRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetSpaceMembersResponseObject interface {
+	VisitGetSpaceMembersResponse(w http.ResponseWriter) error
+}
+
+type GetSpaceMembers200JSONResponse []SpaceMember
+
+func (response GetSpaceMembers200JSONResponse) VisitGetSpaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceMembers400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaceMembers400ApplicationProblemPlusJSONResponse) VisitGetSpaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceMembers401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaceMembers401ApplicationProblemPlusJSONResponse) VisitGetSpaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceMembers403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaceMembers403ApplicationProblemPlusJSONResponse) VisitGetSpaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceMembers404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaceMembers404ApplicationProblemPlusJSONResponse) VisitGetSpaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceMembers422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaceMembers422ApplicationProblemPlusJSONResponse) VisitGetSpaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpaceMembers500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpaceMembers500ApplicationProblemPlusJSONResponse) VisitGetSpaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpaceMemberRequestObject struct {
+	Id       int64 `json:"id"`
+	MemberId int64 `json:"memberId"`
+}
+
+type DeleteSpaceMemberResponseObject interface {
+	VisitDeleteSpaceMemberResponse(w http.ResponseWriter) error
+}
+
+type DeleteSpaceMember200JSONResponse int64
+
+func (response DeleteSpaceMember200JSONResponse) VisitDeleteSpaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpaceMember400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpaceMember400ApplicationProblemPlusJSONResponse) VisitDeleteSpaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpaceMember401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpaceMember401ApplicationProblemPlusJSONResponse) VisitDeleteSpaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpaceMember403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpaceMember403ApplicationProblemPlusJSONResponse) VisitDeleteSpaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpaceMember404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpaceMember404ApplicationProblemPlusJSONResponse) VisitDeleteSpaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpaceMember409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpaceMember409ApplicationProblemPlusJSONResponse) VisitDeleteSpaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpaceMember422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpaceMember422ApplicationProblemPlusJSONResponse) VisitDeleteSpaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpaceMember500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpaceMember500ApplicationProblemPlusJSONResponse) VisitDeleteSpaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatisticsRequestObject struct {
+}
+
+type GetStatisticsResponseObject interface {
+	VisitGetStatisticsResponse(w http.ResponseWriter) error
+}
+
+type GetStatistics200JSONResponse IndexData
+
+func (response GetStatistics200JSONResponse) VisitGetStatisticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatistics500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetStatistics500ApplicationProblemPlusJSONResponse) VisitGetStatisticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSummaryRequestObject struct {
+	Params GetSummaryParams
+}
+
+type GetSummaryResponseObject interface {
+	VisitGetSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetSummary200JSONResponse IndexData
+
+func (response GetSummary200JSONResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSummary400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSummary400ApplicationProblemPlusJSONResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSummary401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSummary401ApplicationProblemPlusJSONResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSummary403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSummary403ApplicationProblemPlusJSONResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSummary404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetSummary404ApplicationProblemPlusJSONResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSummary422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetSummary422ApplicationProblemPlusJSONResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSummary500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSummary500ApplicationProblemPlusJSONResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSyncRequestObject struct {
+	Params GetSyncParams
+}
+
+type GetSyncResponseObject interface {
+	VisitGetSyncResponse(w http.ResponseWriter) error
+}
+
+type GetSync200JSONResponse SyncChangeset
+
+func (response GetSync200JSONResponse) VisitGetSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSync400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSync400ApplicationProblemPlusJSONResponse) VisitGetSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSync401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSync401ApplicationProblemPlusJSONResponse) VisitGetSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSync403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSync403ApplicationProblemPlusJSONResponse) VisitGetSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSync404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetSync404ApplicationProblemPlusJSONResponse) VisitGetSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSync422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetSync422ApplicationProblemPlusJSONResponse) VisitGetSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSync500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSync500ApplicationProblemPlusJSONResponse) VisitGetSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSyncRequestObject struct {
+	Params PostSyncParams
+	Body   *PostSyncJSONRequestBody
+}
+
+type PostSyncResponseObject interface {
+	VisitPostSyncResponse(w http.ResponseWriter) error
+}
+
+type PostSync200JSONResponse []SyncMutationResult
+
+func (response PostSync200JSONResponse) VisitPostSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSync400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostSync400ApplicationProblemPlusJSONResponse) VisitPostSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSync401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostSync401ApplicationProblemPlusJSONResponse) VisitPostSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSync403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostSync403ApplicationProblemPlusJSONResponse) VisitPostSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSync404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostSync404ApplicationProblemPlusJSONResponse) VisitPostSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSync422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response PostSync422ApplicationProblemPlusJSONResponse) VisitPostSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSync500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostSync500ApplicationProblemPlusJSONResponse) VisitPostSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccessTokensRequestObject struct {
+}
+
+type GetAccessTokensResponseObject interface {
+	VisitGetAccessTokensResponse(w http.ResponseWriter) error
+}
+
+type GetAccessTokens200JSONResponse []AccessToken
+
+func (response GetAccessTokens200JSONResponse) VisitGetAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccessTokens401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetAccessTokens401ApplicationProblemPlusJSONResponse) VisitGetAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccessTokens403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetAccessTokens403ApplicationProblemPlusJSONResponse) VisitGetAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccessTokens500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetAccessTokens500ApplicationProblemPlusJSONResponse) VisitGetAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessTokenRequestObject struct {
+	Body *CreateAccessTokenJSONRequestBody
+}
+
+type CreateAccessTokenResponseObject interface {
+	VisitCreateAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type CreateAccessToken200JSONResponse AccessToken
+
+func (response CreateAccessToken200JSONResponse) VisitCreateAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessToken400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateAccessToken400ApplicationProblemPlusJSONResponse) VisitCreateAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessToken401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateAccessToken401ApplicationProblemPlusJSONResponse) VisitCreateAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessToken403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateAccessToken403ApplicationProblemPlusJSONResponse) VisitCreateAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessToken409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateAccessToken409ApplicationProblemPlusJSONResponse) VisitCreateAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessToken422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response CreateAccessToken422ApplicationProblemPlusJSONResponse) VisitCreateAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessToken500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateAccessToken500ApplicationProblemPlusJSONResponse) VisitCreateAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccessTokenRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteAccessTokenResponseObject interface {
+	VisitDeleteAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type DeleteAccessToken200JSONResponse int64
+
+func (response DeleteAccessToken200JSONResponse) VisitDeleteAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccessToken400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAccessToken400ApplicationProblemPlusJSONResponse) VisitDeleteAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccessToken401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAccessToken401ApplicationProblemPlusJSONResponse) VisitDeleteAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccessToken403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAccessToken403ApplicationProblemPlusJSONResponse) VisitDeleteAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccessToken404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAccessToken404ApplicationProblemPlusJSONResponse) VisitDeleteAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccessToken409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAccessToken409ApplicationProblemPlusJSONResponse) VisitDeleteAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccessToken422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAccessToken422ApplicationProblemPlusJSONResponse) VisitDeleteAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccessToken500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAccessToken500ApplicationProblemPlusJSONResponse) VisitDeleteAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserDataRequestObject struct {
+	Params DeleteUserDataParams
+}
+
+type DeleteUserDataResponseObject interface {
+	VisitDeleteUserDataResponse(w http.ResponseWriter) error
+}
+
+type DeleteUserData200Response struct {
+}
+
+func (response DeleteUserData200Response) VisitDeleteUserDataResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteUserData400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUserData400ApplicationProblemPlusJSONResponse) VisitDeleteUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserData401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUserData401ApplicationProblemPlusJSONResponse) VisitDeleteUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserData403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUserData403ApplicationProblemPlusJSONResponse) VisitDeleteUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserData404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUserData404ApplicationProblemPlusJSONResponse) VisitDeleteUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserData409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUserData409ApplicationProblemPlusJSONResponse) VisitDeleteUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserData422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUserData422ApplicationProblemPlusJSONResponse) VisitDeleteUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserData500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUserData500ApplicationProblemPlusJSONResponse) VisitDeleteUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportUserDataRequestObject struct {
+	Params ExportUserDataParams
+}
+
+type ExportUserDataResponseObject interface {
+	VisitExportUserDataResponse(w http.ResponseWriter) error
+}
+
+type ExportUserData200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type ExportUserData200ApplicationjsonlResponse struct {
+	Body          io.Reader
+	Headers       ExportUserData200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportUserData200ApplicationjsonlResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/jsonl")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportUserData400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ExportUserData400ApplicationProblemPlusJSONResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportUserData401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ExportUserData401ApplicationProblemPlusJSONResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportUserData403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ExportUserData403ApplicationProblemPlusJSONResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportUserData404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response ExportUserData404ApplicationProblemPlusJSONResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportUserData422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response ExportUserData422ApplicationProblemPlusJSONResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportUserData500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ExportUserData500ApplicationProblemPlusJSONResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportUserDataRequestObject struct {
+	Params ImportUserDataParams
+	Body   *multipart.Reader
+}
+
+type ImportUserDataResponseObject interface {
+	VisitImportUserDataResponse(w http.ResponseWriter) error
+}
+
+type ImportUserData200Response struct {
+}
+
+func (response ImportUserData200Response) VisitImportUserDataResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ImportUserData400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ImportUserData400ApplicationProblemPlusJSONResponse) VisitImportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportUserData401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ImportUserData401ApplicationProblemPlusJSONResponse) VisitImportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportUserData403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ImportUserData403ApplicationProblemPlusJSONResponse) VisitImportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportUserData404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response ImportUserData404ApplicationProblemPlusJSONResponse) VisitImportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportUserData409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response ImportUserData409ApplicationProblemPlusJSONResponse) VisitImportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportUserData422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response ImportUserData422ApplicationProblemPlusJSONResponse) VisitImportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportUserData500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ImportUserData500ApplicationProblemPlusJSONResponse) VisitImportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksRequestObject struct {
+	Params GetWebhooksParams
+}
+
+type GetWebhooksResponseObject interface {
+	VisitGetWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooks200JSONResponse []Webhook
+
+func (response GetWebhooks200JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooks400ApplicationProblemPlusJSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooks401ApplicationProblemPlusJSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooks403ApplicationProblemPlusJSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooks404ApplicationProblemPlusJSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooks422ApplicationProblemPlusJSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooks500ApplicationProblemPlusJSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	Params CreateWebhookParams
+	Body   *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook200JSONResponse Webhook
+
+func (response CreateWebhook200JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook400ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook401ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook403ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook404ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook409ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook422ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook500ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	Id     int64 `json:"id"`
+	Params DeleteWebhookParams
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook200JSONResponse int64
+
+func (response DeleteWebhook200JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook400ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook401ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook403ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook404ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook409ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook422ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook500ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveriesRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetWebhookDeliveriesParams
+}
+
+type GetWebhookDeliveriesResponseObject interface {
+	VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookDeliveries200JSONResponse []WebhookDelivery
+
+func (response GetWebhookDeliveries200JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhookDeliveries400ApplicationProblemPlusJSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhookDeliveries401ApplicationProblemPlusJSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhookDeliveries403ApplicationProblemPlusJSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhookDeliveries404ApplicationProblemPlusJSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhookDeliveries422ApplicationProblemPlusJSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhookDeliveries500ApplicationProblemPlusJSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List the activities of all contacts
+	// (GET /activities)
+	GetActivities(ctx context.Context, request GetActivitiesRequestObject) (GetActivitiesResponseObject, error)
+	// Create a new activity
+	// (POST /activities)
+	CreateActivity(ctx context.Context, request CreateActivityRequestObject) (CreateActivityResponseObject, error)
+	// Delete an activity
+	// (DELETE /activities/{id})
+	DeleteActivity(ctx context.Context, request DeleteActivityRequestObject) (DeleteActivityResponseObject, error)
+	// Get a specific activity
+	// (GET /activities/{id})
+	GetActivity(ctx context.Context, request GetActivityRequestObject) (GetActivityResponseObject, error)
+	// Partially update an activity
+	// (PATCH /activities/{id})
+	PatchActivity(ctx context.Context, request PatchActivityRequestObject) (PatchActivityResponseObject, error)
+	// Update an activity
+	// (PUT /activities/{id})
+	UpdateActivity(ctx context.Context, request UpdateActivityRequestObject) (UpdateActivityResponseObject, error)
+	// Revoke the sessions of a user who signed out with the OIDC provider (OIDC back-channel logout)
+	// (POST /backchannel-logout)
+	BackchannelLogout(ctx context.Context, request BackchannelLogoutRequestObject) (BackchannelLogoutResponseObject, error)
+	// Apply a batch of operations in one transaction
+	// (POST /batch)
+	PostBatch(ctx context.Context, request PostBatchRequestObject) (PostBatchResponseObject, error)
+	// Download application source code
+	// (GET /code/)
+	GetSourceCode(ctx context.Context, request GetSourceCodeRequestObject) (GetSourceCodeResponseObject, error)
+	// List all contacts
+	// (GET /contacts)
+	GetContacts(ctx context.Context, request GetContactsRequestObject) (GetContactsResponseObject, error)
+	// Create a new contact
+	// (POST /contacts)
+	CreateContact(ctx context.Context, request CreateContactRequestObject) (CreateContactResponseObject, error)
+	// Delete a contact
+	// (DELETE /contacts/{id})
+	DeleteContact(ctx context.Context, request DeleteContactRequestObject) (DeleteContactResponseObject, error)
+	// Get contact including debts and activities
+	// (GET /contacts/{id})
+	GetContact(ctx context.Context, request GetContactRequestObject) (GetContactResponseObject, error)
+	// Partially update a contact
+	// (PATCH /contacts/{id})
+	PatchContact(ctx context.Context, request PatchContactRequestObject) (PatchContactResponseObject, error)
 	// Update a contact
 	// (PUT /contacts/{id})
 	UpdateContact(ctx context.Context, request UpdateContactRequestObject) (UpdateContactResponseObject, error)
+	// List the debts of all contacts
+	// (GET /debts)
+	GetDebts(ctx context.Context, request GetDebtsRequestObject) (GetDebtsResponseObject, error)
 	// Create a new debt
 	// (POST /debts)
 	CreateDebt(ctx context.Context, request CreateDebtRequestObject) (CreateDebtResponseObject, error)
 	// Settle a debt
 	// (DELETE /debts/{id})
 	SettleDebt(ctx context.Context, request SettleDebtRequestObject) (SettleDebtResponseObject, error)
+	// Partially update a debt
+	// (PATCH /debts/{id})
+	PatchDebt(ctx context.Context, request PatchDebtRequestObject) (PatchDebtResponseObject, error)
 	// Update a debt
 	// (PUT /debts/{id})
 	UpdateDebt(ctx context.Context, request UpdateDebtRequestObject) (UpdateDebtResponseObject, error)
+	// Stream changes to the entities of the space
+	// (GET /events)
+	GetEvents(ctx context.Context, request GetEventsRequestObject) (GetEventsResponseObject, error)
+	// List all pending space invitations for the authenticated user's email
+	// (GET /invitations)
+	GetSpaceInvitations(ctx context.Context, request GetSpaceInvitationsRequestObject) (GetSpaceInvitationsResponseObject, error)
+	// Decline a space invitation
+	// (DELETE /invitations/{id})
+	DeclineSpaceInvitation(ctx context.Context, request DeclineSpaceInvitationRequestObject) (DeclineSpaceInvitationResponseObject, error)
+	// Accept a space invitation
+	// (POST /invitations/{id})
+	AcceptSpaceInvitation(ctx context.Context, request AcceptSpaceInvitationRequestObject) (AcceptSpaceInvitationResponseObject, error)
 	// List all journal entries
 	// (GET /journal)
 	GetJournalEntries(ctx context.Context, request GetJournalEntriesRequestObject) (GetJournalEntriesResponseObject, error)
@@ -4856,18 +17683,63 @@ type StrictServerInterface interface {
 	// Get a specific journal entry
 	// (GET /journal/{id})
 	GetJournalEntry(ctx context.Context, request GetJournalEntryRequestObject) (GetJournalEntryResponseObject, error)
+	// Partially update a journal entry
+	// (PATCH /journal/{id})
+	PatchJournalEntry(ctx context.Context, request PatchJournalEntryRequestObject) (PatchJournalEntryResponseObject, error)
 	// Update a journal entry
 	// (PUT /journal/{id})
 	UpdateJournalEntry(ctx context.Context, request UpdateJournalEntryRequestObject) (UpdateJournalEntryResponseObject, error)
 	// Get the OpenAPI spec
 	// (GET /openapi.json)
 	GetOpenAPISpec(ctx context.Context, request GetOpenAPISpecRequestObject) (GetOpenAPISpecResponseObject, error)
+	// Revoke all sessions of the authenticated user, including the current one, to sign out everywhere
+	// (DELETE /sessions)
+	DeleteSessions(ctx context.Context, request DeleteSessionsRequestObject) (DeleteSessionsResponseObject, error)
+	// List all active sessions of the authenticated user
+	// (GET /sessions)
+	GetSessions(ctx context.Context, request GetSessionsRequestObject) (GetSessionsResponseObject, error)
+	// Revoke a session
+	// (DELETE /sessions/{id})
+	DeleteSession(ctx context.Context, request DeleteSessionRequestObject) (DeleteSessionResponseObject, error)
+	// List all spaces the authenticated user is a member of
+	// (GET /spaces)
+	GetSpaces(ctx context.Context, request GetSpacesRequestObject) (GetSpacesResponseObject, error)
+	// Create a new shared space owned by the authenticated user
+	// (POST /spaces)
+	CreateSpace(ctx context.Context, request CreateSpaceRequestObject) (CreateSpaceResponseObject, error)
+	// Delete a shared space and all of its data
+	// (DELETE /spaces/{id})
+	DeleteSpace(ctx context.Context, request DeleteSpaceRequestObject) (DeleteSpaceResponseObject, error)
+	// Invite a user to a space by email
+	// (POST /spaces/{id}/invitations)
+	CreateSpaceInvitation(ctx context.Context, request CreateSpaceInvitationRequestObject) (CreateSpaceInvitationResponseObject, error)
+	// List all members of a space
+	// (GET /spaces/{id}/members)
+	GetSpaceMembers(ctx context.Context, request GetSpaceMembersRequestObject) (GetSpaceMembersResponseObject, error)
+	// Remove a member from a space, or leave it
+	// (DELETE /spaces/{id}/members/{memberId})
+	DeleteSpaceMember(ctx context.Context, request DeleteSpaceMemberRequestObject) (DeleteSpaceMemberResponseObject, error)
 	// Get total counts of contacts and journal entries
 	// (GET /statistics)
 	GetStatistics(ctx context.Context, request GetStatisticsRequestObject) (GetStatisticsResponseObject, error)
 	// Get counts of contacts and journal entries for the authenticated user
 	// (GET /summary)
 	GetSummary(ctx context.Context, request GetSummaryRequestObject) (GetSummaryResponseObject, error)
+	// Get the entities that changed since a cursor
+	// (GET /sync)
+	GetSync(ctx context.Context, request GetSyncRequestObject) (GetSyncResponseObject, error)
+	// Apply a batch of mutations made by an offline client
+	// (POST /sync)
+	PostSync(ctx context.Context, request PostSyncRequestObject) (PostSyncResponseObject, error)
+	// List all personal access tokens of the authenticated user
+	// (GET /tokens)
+	GetAccessTokens(ctx context.Context, request GetAccessTokensRequestObject) (GetAccessTokensResponseObject, error)
+	// Create a new personal access token for the authenticated user
+	// (POST /tokens)
+	CreateAccessToken(ctx context.Context, request CreateAccessTokenRequestObject) (CreateAccessTokenResponseObject, error)
+	// Revoke a personal access token
+	// (DELETE /tokens/{id})
+	DeleteAccessToken(ctx context.Context, request DeleteAccessTokenRequestObject) (DeleteAccessTokenResponseObject, error)
 	// Delete all user data
 	// (DELETE /userdata)
 	DeleteUserData(ctx context.Context, request DeleteUserDataRequestObject) (DeleteUserDataResponseObject, error)
@@ -4877,42 +17749,792 @@ type StrictServerInterface interface {
 	// Import user data
 	// (POST /userdata)
 	ImportUserData(ctx context.Context, request ImportUserDataRequestObject) (ImportUserDataResponseObject, error)
+	// List all webhooks
+	// (GET /webhooks)
+	GetWebhooks(ctx context.Context, request GetWebhooksRequestObject) (GetWebhooksResponseObject, error)
+	// Subscribe a webhook to events
+	// (POST /webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// Delete a webhook and its delivery log
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// List the most recent delivery attempts of a webhook
+	// (GET /webhooks/{id}/deliveries)
+	GetWebhookDeliveries(ctx context.Context, request GetWebhookDeliveriesRequestObject) (GetWebhookDeliveriesResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetActivities operation middleware
+func (sh *strictHandler) GetActivities(w http.ResponseWriter, r *http.Request, params GetActivitiesParams) {
+	var request GetActivitiesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetActivities(ctx, request.(GetActivitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetActivities")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetActivitiesResponseObject); ok {
+		if err := validResponse.VisitGetActivitiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateActivity operation middleware
+func (sh *strictHandler) CreateActivity(w http.ResponseWriter, r *http.Request, params CreateActivityParams) {
+	var request CreateActivityRequestObject
+
+	request.Params = params
+
+	var body CreateActivityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateActivity(ctx, request.(CreateActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateActivity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateActivityResponseObject); ok {
+		if err := validResponse.VisitCreateActivityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteActivity operation middleware
+func (sh *strictHandler) DeleteActivity(w http.ResponseWriter, r *http.Request, id int64, params DeleteActivityParams) {
+	var request DeleteActivityRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteActivity(ctx, request.(DeleteActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteActivity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteActivityResponseObject); ok {
+		if err := validResponse.VisitDeleteActivityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetActivity operation middleware
+func (sh *strictHandler) GetActivity(w http.ResponseWriter, r *http.Request, id int64, params GetActivityParams) {
+	var request GetActivityRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetActivity(ctx, request.(GetActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetActivity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetActivityResponseObject); ok {
+		if err := validResponse.VisitGetActivityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchActivity operation middleware
+func (sh *strictHandler) PatchActivity(w http.ResponseWriter, r *http.Request, id int64, params PatchActivityParams) {
+	var request PatchActivityRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PatchActivityApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchActivity(ctx, request.(PatchActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchActivity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchActivityResponseObject); ok {
+		if err := validResponse.VisitPatchActivityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateActivity operation middleware
+func (sh *strictHandler) UpdateActivity(w http.ResponseWriter, r *http.Request, id int64, params UpdateActivityParams) {
+	var request UpdateActivityRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateActivityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateActivity(ctx, request.(UpdateActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateActivity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateActivityResponseObject); ok {
+		if err := validResponse.VisitUpdateActivityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BackchannelLogout operation middleware
+func (sh *strictHandler) BackchannelLogout(w http.ResponseWriter, r *http.Request) {
+	var request BackchannelLogoutRequestObject
+
+	if err := r.ParseForm(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode formdata: %w", err))
+		return
+	}
+	var body BackchannelLogoutFormdataRequestBody
+	if err := runtime.BindForm(&body, r.Form, nil, nil); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't bind formdata: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BackchannelLogout(ctx, request.(BackchannelLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BackchannelLogout")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BackchannelLogoutResponseObject); ok {
+		if err := validResponse.VisitBackchannelLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostBatch operation middleware
+func (sh *strictHandler) PostBatch(w http.ResponseWriter, r *http.Request, params PostBatchParams) {
+	var request PostBatchRequestObject
+
+	request.Params = params
+
+	var body PostBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostBatch(ctx, request.(PostBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostBatch")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostBatchResponseObject); ok {
+		if err := validResponse.VisitPostBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSourceCode operation middleware
+func (sh *strictHandler) GetSourceCode(w http.ResponseWriter, r *http.Request) {
+	var request GetSourceCodeRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSourceCode(ctx, request.(GetSourceCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSourceCode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSourceCodeResponseObject); ok {
+		if err := validResponse.VisitGetSourceCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetContacts operation middleware
+func (sh *strictHandler) GetContacts(w http.ResponseWriter, r *http.Request, params GetContactsParams) {
+	var request GetContactsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetContacts(ctx, request.(GetContactsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetContacts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetContactsResponseObject); ok {
+		if err := validResponse.VisitGetContactsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateContact operation middleware
+func (sh *strictHandler) CreateContact(w http.ResponseWriter, r *http.Request, params CreateContactParams) {
+	var request CreateContactRequestObject
+
+	request.Params = params
+
+	var body CreateContactJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateContact(ctx, request.(CreateContactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateContact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateContactResponseObject); ok {
+		if err := validResponse.VisitCreateContactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteContact operation middleware
+func (sh *strictHandler) DeleteContact(w http.ResponseWriter, r *http.Request, id int64, params DeleteContactParams) {
+	var request DeleteContactRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteContact(ctx, request.(DeleteContactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteContact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteContactResponseObject); ok {
+		if err := validResponse.VisitDeleteContactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetContact operation middleware
+func (sh *strictHandler) GetContact(w http.ResponseWriter, r *http.Request, id int64, params GetContactParams) {
+	var request GetContactRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetContact(ctx, request.(GetContactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetContact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetContactResponseObject); ok {
+		if err := validResponse.VisitGetContactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchContact operation middleware
+func (sh *strictHandler) PatchContact(w http.ResponseWriter, r *http.Request, id int64, params PatchContactParams) {
+	var request PatchContactRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PatchContactApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchContact(ctx, request.(PatchContactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchContact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchContactResponseObject); ok {
+		if err := validResponse.VisitPatchContactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateContact operation middleware
+func (sh *strictHandler) UpdateContact(w http.ResponseWriter, r *http.Request, id int64, params UpdateContactParams) {
+	var request UpdateContactRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateContactJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateContact(ctx, request.(UpdateContactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateContact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateContactResponseObject); ok {
+		if err := validResponse.VisitUpdateContactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDebts operation middleware
+func (sh *strictHandler) GetDebts(w http.ResponseWriter, r *http.Request, params GetDebtsParams) {
+	var request GetDebtsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDebts(ctx, request.(GetDebtsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDebts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDebtsResponseObject); ok {
+		if err := validResponse.VisitGetDebtsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateDebt operation middleware
+func (sh *strictHandler) CreateDebt(w http.ResponseWriter, r *http.Request, params CreateDebtParams) {
+	var request CreateDebtRequestObject
+
+	request.Params = params
+
+	var body CreateDebtJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateDebt(ctx, request.(CreateDebtRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateDebt")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateDebtResponseObject); ok {
+		if err := validResponse.VisitCreateDebtResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SettleDebt operation middleware
+func (sh *strictHandler) SettleDebt(w http.ResponseWriter, r *http.Request, id int64, params SettleDebtParams) {
+	var request SettleDebtRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SettleDebt(ctx, request.(SettleDebtRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SettleDebt")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SettleDebtResponseObject); ok {
+		if err := validResponse.VisitSettleDebtResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchDebt operation middleware
+func (sh *strictHandler) PatchDebt(w http.ResponseWriter, r *http.Request, id int64, params PatchDebtParams) {
+	var request PatchDebtRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PatchDebtApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchDebt(ctx, request.(PatchDebtRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchDebt")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchDebtResponseObject); ok {
+		if err := validResponse.VisitPatchDebtResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateDebt operation middleware
+func (sh *strictHandler) UpdateDebt(w http.ResponseWriter, r *http.Request, id int64, params UpdateDebtParams) {
+	var request UpdateDebtRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateDebtJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateDebt(ctx, request.(UpdateDebtRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateDebt")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateDebtResponseObject); ok {
+		if err := validResponse.VisitUpdateDebtResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEvents operation middleware
+func (sh *strictHandler) GetEvents(w http.ResponseWriter, r *http.Request, params GetEventsParams) {
+	var request GetEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEvents(ctx, request.(GetEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEventsResponseObject); ok {
+		if err := validResponse.VisitGetEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetSpaceInvitations operation middleware
+func (sh *strictHandler) GetSpaceInvitations(w http.ResponseWriter, r *http.Request) {
+	var request GetSpaceInvitationsRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSpaceInvitations(ctx, request.(GetSpaceInvitationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSpaceInvitations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSpaceInvitationsResponseObject); ok {
+		if err := validResponse.VisitGetSpaceInvitationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// DeclineSpaceInvitation operation middleware
+func (sh *strictHandler) DeclineSpaceInvitation(w http.ResponseWriter, r *http.Request, id int64) {
+	var request DeclineSpaceInvitationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeclineSpaceInvitation(ctx, request.(DeclineSpaceInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeclineSpaceInvitation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeclineSpaceInvitationResponseObject); ok {
+		if err := validResponse.VisitDeclineSpaceInvitationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// AcceptSpaceInvitation operation middleware
+func (sh *strictHandler) AcceptSpaceInvitation(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AcceptSpaceInvitationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AcceptSpaceInvitation(ctx, request.(AcceptSpaceInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcceptSpaceInvitation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AcceptSpaceInvitationResponseObject); ok {
+		if err := validResponse.VisitAcceptSpaceInvitationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// GetJournalEntries operation middleware
+func (sh *strictHandler) GetJournalEntries(w http.ResponseWriter, r *http.Request, params GetJournalEntriesParams) {
+	var request GetJournalEntriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJournalEntries(ctx, request.(GetJournalEntriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJournalEntries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJournalEntriesResponseObject); ok {
+		if err := validResponse.VisitGetJournalEntriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// CreateActivity operation middleware
-func (sh *strictHandler) CreateActivity(w http.ResponseWriter, r *http.Request) {
-	var request CreateActivityRequestObject
+// CreateJournalEntry operation middleware
+func (sh *strictHandler) CreateJournalEntry(w http.ResponseWriter, r *http.Request, params CreateJournalEntryParams) {
+	var request CreateJournalEntryRequestObject
 
-	var body CreateActivityJSONRequestBody
+	request.Params = params
+
+	var body CreateJournalEntryJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4920,18 +18542,18 @@ func (sh *strictHandler) CreateActivity(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateActivity(ctx, request.(CreateActivityRequestObject))
+		return sh.ssi.CreateJournalEntry(ctx, request.(CreateJournalEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateActivity")
+		handler = middleware(handler, "CreateJournalEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateActivityResponseObject); ok {
-		if err := validResponse.VisitCreateActivityResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateJournalEntryResponseObject); ok {
+		if err := validResponse.VisitCreateJournalEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4939,25 +18561,193 @@ func (sh *strictHandler) CreateActivity(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// DeleteActivity operation middleware
-func (sh *strictHandler) DeleteActivity(w http.ResponseWriter, r *http.Request, id int64) {
-	var request DeleteActivityRequestObject
+// DeleteJournalEntry operation middleware
+func (sh *strictHandler) DeleteJournalEntry(w http.ResponseWriter, r *http.Request, id int64, params DeleteJournalEntryParams) {
+	var request DeleteJournalEntryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteJournalEntry(ctx, request.(DeleteJournalEntryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteJournalEntry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteJournalEntryResponseObject); ok {
+		if err := validResponse.VisitDeleteJournalEntryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetJournalEntry operation middleware
+func (sh *strictHandler) GetJournalEntry(w http.ResponseWriter, r *http.Request, id int64, params GetJournalEntryParams) {
+	var request GetJournalEntryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJournalEntry(ctx, request.(GetJournalEntryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJournalEntry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJournalEntryResponseObject); ok {
+		if err := validResponse.VisitGetJournalEntryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchJournalEntry operation middleware
+func (sh *strictHandler) PatchJournalEntry(w http.ResponseWriter, r *http.Request, id int64, params PatchJournalEntryParams) {
+	var request PatchJournalEntryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PatchJournalEntryApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchJournalEntry(ctx, request.(PatchJournalEntryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchJournalEntry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchJournalEntryResponseObject); ok {
+		if err := validResponse.VisitPatchJournalEntryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateJournalEntry operation middleware
+func (sh *strictHandler) UpdateJournalEntry(w http.ResponseWriter, r *http.Request, id int64, params UpdateJournalEntryParams) {
+	var request UpdateJournalEntryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateJournalEntryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateJournalEntry(ctx, request.(UpdateJournalEntryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateJournalEntry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateJournalEntryResponseObject); ok {
+		if err := validResponse.VisitUpdateJournalEntryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOpenAPISpec operation middleware
+func (sh *strictHandler) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	var request GetOpenAPISpecRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOpenAPISpec(ctx, request.(GetOpenAPISpecRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOpenAPISpec")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOpenAPISpecResponseObject); ok {
+		if err := validResponse.VisitGetOpenAPISpecResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteSessions operation middleware
+func (sh *strictHandler) DeleteSessions(w http.ResponseWriter, r *http.Request) {
+	var request DeleteSessionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSessions(ctx, request.(DeleteSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSessions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteSessionsResponseObject); ok {
+		if err := validResponse.VisitDeleteSessionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	request.Id = id
+// GetSessions operation middleware
+func (sh *strictHandler) GetSessions(w http.ResponseWriter, r *http.Request) {
+	var request GetSessionsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteActivity(ctx, request.(DeleteActivityRequestObject))
+		return sh.ssi.GetSessions(ctx, request.(GetSessionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteActivity")
+		handler = middleware(handler, "GetSessions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteActivityResponseObject); ok {
-		if err := validResponse.VisitDeleteActivityResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSessionsResponseObject); ok {
+		if err := validResponse.VisitGetSessionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4965,25 +18755,25 @@ func (sh *strictHandler) DeleteActivity(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetActivity operation middleware
-func (sh *strictHandler) GetActivity(w http.ResponseWriter, r *http.Request, id int64) {
-	var request GetActivityRequestObject
+// DeleteSession operation middleware
+func (sh *strictHandler) DeleteSession(w http.ResponseWriter, r *http.Request, id int64) {
+	var request DeleteSessionRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetActivity(ctx, request.(GetActivityRequestObject))
+		return sh.ssi.DeleteSession(ctx, request.(DeleteSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetActivity")
+		handler = middleware(handler, "DeleteSession")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetActivityResponseObject); ok {
-		if err := validResponse.VisitGetActivityResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteSessionResponseObject); ok {
+		if err := validResponse.VisitDeleteSessionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4991,32 +18781,23 @@ func (sh *strictHandler) GetActivity(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// UpdateActivity operation middleware
-func (sh *strictHandler) UpdateActivity(w http.ResponseWriter, r *http.Request, id int64) {
-	var request UpdateActivityRequestObject
-
-	request.Id = id
-
-	var body UpdateActivityJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetSpaces operation middleware
+func (sh *strictHandler) GetSpaces(w http.ResponseWriter, r *http.Request) {
+	var request GetSpacesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateActivity(ctx, request.(UpdateActivityRequestObject))
+		return sh.ssi.GetSpaces(ctx, request.(GetSpacesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateActivity")
+		handler = middleware(handler, "GetSpaces")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateActivityResponseObject); ok {
-		if err := validResponse.VisitUpdateActivityResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpacesResponseObject); ok {
+		if err := validResponse.VisitGetSpacesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5024,23 +18805,30 @@ func (sh *strictHandler) UpdateActivity(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetSourceCode operation middleware
-func (sh *strictHandler) GetSourceCode(w http.ResponseWriter, r *http.Request) {
-	var request GetSourceCodeRequestObject
+// CreateSpace operation middleware
+func (sh *strictHandler) CreateSpace(w http.ResponseWriter, r *http.Request) {
+	var request CreateSpaceRequestObject
+
+	var body CreateSpaceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSourceCode(ctx, request.(GetSourceCodeRequestObject))
+		return sh.ssi.CreateSpace(ctx, request.(CreateSpaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSourceCode")
+		handler = middleware(handler, "CreateSpace")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSourceCodeResponseObject); ok {
-		if err := validResponse.VisitGetSourceCodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSpaceResponseObject); ok {
+		if err := validResponse.VisitCreateSpaceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5048,23 +18836,25 @@ func (sh *strictHandler) GetSourceCode(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetContacts operation middleware
-func (sh *strictHandler) GetContacts(w http.ResponseWriter, r *http.Request) {
-	var request GetContactsRequestObject
+// DeleteSpace operation middleware
+func (sh *strictHandler) DeleteSpace(w http.ResponseWriter, r *http.Request, id int64) {
+	var request DeleteSpaceRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetContacts(ctx, request.(GetContactsRequestObject))
+		return sh.ssi.DeleteSpace(ctx, request.(DeleteSpaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetContacts")
+		handler = middleware(handler, "DeleteSpace")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetContactsResponseObject); ok {
-		if err := validResponse.VisitGetContactsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteSpaceResponseObject); ok {
+		if err := validResponse.VisitDeleteSpaceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5072,11 +18862,13 @@ func (sh *strictHandler) GetContacts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateContact operation middleware
-func (sh *strictHandler) CreateContact(w http.ResponseWriter, r *http.Request) {
-	var request CreateContactRequestObject
+// CreateSpaceInvitation operation middleware
+func (sh *strictHandler) CreateSpaceInvitation(w http.ResponseWriter, r *http.Request, id int64) {
+	var request CreateSpaceInvitationRequestObject
 
-	var body CreateContactJSONRequestBody
+	request.Id = id
+
+	var body CreateSpaceInvitationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5084,18 +18876,18 @@ func (sh *strictHandler) CreateContact(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateContact(ctx, request.(CreateContactRequestObject))
+		return sh.ssi.CreateSpaceInvitation(ctx, request.(CreateSpaceInvitationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateContact")
+		handler = middleware(handler, "CreateSpaceInvitation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateContactResponseObject); ok {
-		if err := validResponse.VisitCreateContactResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSpaceInvitationResponseObject); ok {
+		if err := validResponse.VisitCreateSpaceInvitationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5103,25 +18895,25 @@ func (sh *strictHandler) CreateContact(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteContact operation middleware
-func (sh *strictHandler) DeleteContact(w http.ResponseWriter, r *http.Request, id int64) {
-	var request DeleteContactRequestObject
+// GetSpaceMembers operation middleware
+func (sh *strictHandler) GetSpaceMembers(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetSpaceMembersRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteContact(ctx, request.(DeleteContactRequestObject))
+		return sh.ssi.GetSpaceMembers(ctx, request.(GetSpaceMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteContact")
+		handler = middleware(handler, "GetSpaceMembers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteContactResponseObject); ok {
-		if err := validResponse.VisitDeleteContactResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpaceMembersResponseObject); ok {
+		if err := validResponse.VisitGetSpaceMembersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5129,25 +18921,26 @@ func (sh *strictHandler) DeleteContact(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetContact operation middleware
-func (sh *strictHandler) GetContact(w http.ResponseWriter, r *http.Request, id int64) {
-	var request GetContactRequestObject
+// DeleteSpaceMember operation middleware
+func (sh *strictHandler) DeleteSpaceMember(w http.ResponseWriter, r *http.Request, id int64, memberId int64) {
+	var request DeleteSpaceMemberRequestObject
 
 	request.Id = id
+	request.MemberId = memberId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetContact(ctx, request.(GetContactRequestObject))
+		return sh.ssi.DeleteSpaceMember(ctx, request.(DeleteSpaceMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetContact")
+		handler = middleware(handler, "DeleteSpaceMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetContactResponseObject); ok {
-		if err := validResponse.VisitGetContactResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteSpaceMemberResponseObject); ok {
+		if err := validResponse.VisitDeleteSpaceMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5155,32 +18948,23 @@ func (sh *strictHandler) GetContact(w http.ResponseWriter, r *http.Request, id i
 	}
 }
 
-// UpdateContact operation middleware
-func (sh *strictHandler) UpdateContact(w http.ResponseWriter, r *http.Request, id int64) {
-	var request UpdateContactRequestObject
-
-	request.Id = id
-
-	var body UpdateContactJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetStatistics operation middleware
+func (sh *strictHandler) GetStatistics(w http.ResponseWriter, r *http.Request) {
+	var request GetStatisticsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateContact(ctx, request.(UpdateContactRequestObject))
+		return sh.ssi.GetStatistics(ctx, request.(GetStatisticsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateContact")
+		handler = middleware(handler, "GetStatistics")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateContactResponseObject); ok {
-		if err := validResponse.VisitUpdateContactResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStatisticsResponseObject); ok {
+		if err := validResponse.VisitGetStatisticsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5188,30 +18972,25 @@ func (sh *strictHandler) UpdateContact(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// CreateDebt operation middleware
-func (sh *strictHandler) CreateDebt(w http.ResponseWriter, r *http.Request) {
-	var request CreateDebtRequestObject
+// GetSummary operation middleware
+func (sh *strictHandler) GetSummary(w http.ResponseWriter, r *http.Request, params GetSummaryParams) {
+	var request GetSummaryRequestObject
 
-	var body CreateDebtJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateDebt(ctx, request.(CreateDebtRequestObject))
+		return sh.ssi.GetSummary(ctx, request.(GetSummaryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateDebt")
+		handler = middleware(handler, "GetSummary")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateDebtResponseObject); ok {
-		if err := validResponse.VisitCreateDebtResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSummaryResponseObject); ok {
+		if err := validResponse.VisitGetSummaryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5219,25 +18998,25 @@ func (sh *strictHandler) CreateDebt(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// SettleDebt operation middleware
-func (sh *strictHandler) SettleDebt(w http.ResponseWriter, r *http.Request, id int64) {
-	var request SettleDebtRequestObject
+// GetSync operation middleware
+func (sh *strictHandler) GetSync(w http.ResponseWriter, r *http.Request, params GetSyncParams) {
+	var request GetSyncRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SettleDebt(ctx, request.(SettleDebtRequestObject))
+		return sh.ssi.GetSync(ctx, request.(GetSyncRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SettleDebt")
+		handler = middleware(handler, "GetSync")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SettleDebtResponseObject); ok {
-		if err := validResponse.VisitSettleDebtResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSyncResponseObject); ok {
+		if err := validResponse.VisitGetSyncResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5245,13 +19024,13 @@ func (sh *strictHandler) SettleDebt(w http.ResponseWriter, r *http.Request, id i
 	}
 }
 
-// UpdateDebt operation middleware
-func (sh *strictHandler) UpdateDebt(w http.ResponseWriter, r *http.Request, id int64) {
-	var request UpdateDebtRequestObject
+// PostSync operation middleware
+func (sh *strictHandler) PostSync(w http.ResponseWriter, r *http.Request, params PostSyncParams) {
+	var request PostSyncRequestObject
 
-	request.Id = id
+	request.Params = params
 
-	var body UpdateDebtJSONRequestBody
+	var body PostSyncJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5259,18 +19038,18 @@ func (sh *strictHandler) UpdateDebt(w http.ResponseWriter, r *http.Request, id i
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateDebt(ctx, request.(UpdateDebtRequestObject))
+		return sh.ssi.PostSync(ctx, request.(PostSyncRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateDebt")
+		handler = middleware(handler, "PostSync")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateDebtResponseObject); ok {
-		if err := validResponse.VisitUpdateDebtResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostSyncResponseObject); ok {
+		if err := validResponse.VisitPostSyncResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5278,23 +19057,23 @@ func (sh *strictHandler) UpdateDebt(w http.ResponseWriter, r *http.Request, id i
 	}
 }
 
-// GetJournalEntries operation middleware
-func (sh *strictHandler) GetJournalEntries(w http.ResponseWriter, r *http.Request) {
-	var request GetJournalEntriesRequestObject
+// GetAccessTokens operation middleware
+func (sh *strictHandler) GetAccessTokens(w http.ResponseWriter, r *http.Request) {
+	var request GetAccessTokensRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetJournalEntries(ctx, request.(GetJournalEntriesRequestObject))
+		return sh.ssi.GetAccessTokens(ctx, request.(GetAccessTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetJournalEntries")
+		handler = middleware(handler, "GetAccessTokens")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetJournalEntriesResponseObject); ok {
-		if err := validResponse.VisitGetJournalEntriesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetAccessTokensResponseObject); ok {
+		if err := validResponse.VisitGetAccessTokensResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5302,11 +19081,11 @@ func (sh *strictHandler) GetJournalEntries(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// CreateJournalEntry operation middleware
-func (sh *strictHandler) CreateJournalEntry(w http.ResponseWriter, r *http.Request) {
-	var request CreateJournalEntryRequestObject
+// CreateAccessToken operation middleware
+func (sh *strictHandler) CreateAccessToken(w http.ResponseWriter, r *http.Request) {
+	var request CreateAccessTokenRequestObject
 
-	var body CreateJournalEntryJSONRequestBody
+	var body CreateAccessTokenJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5314,18 +19093,18 @@ func (sh *strictHandler) CreateJournalEntry(w http.ResponseWriter, r *http.Reque
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateJournalEntry(ctx, request.(CreateJournalEntryRequestObject))
+		return sh.ssi.CreateAccessToken(ctx, request.(CreateAccessTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateJournalEntry")
+		handler = middleware(handler, "CreateAccessToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateJournalEntryResponseObject); ok {
-		if err := validResponse.VisitCreateJournalEntryResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateAccessTokenResponseObject); ok {
+		if err := validResponse.VisitCreateAccessTokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5333,25 +19112,25 @@ func (sh *strictHandler) CreateJournalEntry(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// DeleteJournalEntry operation middleware
-func (sh *strictHandler) DeleteJournalEntry(w http.ResponseWriter, r *http.Request, id int64) {
-	var request DeleteJournalEntryRequestObject
+// DeleteAccessToken operation middleware
+func (sh *strictHandler) DeleteAccessToken(w http.ResponseWriter, r *http.Request, id int64) {
+	var request DeleteAccessTokenRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteJournalEntry(ctx, request.(DeleteJournalEntryRequestObject))
+		return sh.ssi.DeleteAccessToken(ctx, request.(DeleteAccessTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteJournalEntry")
+		handler = middleware(handler, "DeleteAccessToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteJournalEntryResponseObject); ok {
-		if err := validResponse.VisitDeleteJournalEntryResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteAccessTokenResponseObject); ok {
+		if err := validResponse.VisitDeleteAccessTokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5359,25 +19138,25 @@ func (sh *strictHandler) DeleteJournalEntry(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetJournalEntry operation middleware
-func (sh *strictHandler) GetJournalEntry(w http.ResponseWriter, r *http.Request, id int64) {
-	var request GetJournalEntryRequestObject
+// DeleteUserData operation middleware
+func (sh *strictHandler) DeleteUserData(w http.ResponseWriter, r *http.Request, params DeleteUserDataParams) {
+	var request DeleteUserDataRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetJournalEntry(ctx, request.(GetJournalEntryRequestObject))
+		return sh.ssi.DeleteUserData(ctx, request.(DeleteUserDataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetJournalEntry")
+		handler = middleware(handler, "DeleteUserData")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetJournalEntryResponseObject); ok {
-		if err := validResponse.VisitGetJournalEntryResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteUserDataResponseObject); ok {
+		if err := validResponse.VisitDeleteUserDataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5385,32 +19164,25 @@ func (sh *strictHandler) GetJournalEntry(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// UpdateJournalEntry operation middleware
-func (sh *strictHandler) UpdateJournalEntry(w http.ResponseWriter, r *http.Request, id int64) {
-	var request UpdateJournalEntryRequestObject
-
-	request.Id = id
+// ExportUserData operation middleware
+func (sh *strictHandler) ExportUserData(w http.ResponseWriter, r *http.Request, params ExportUserDataParams) {
+	var request ExportUserDataRequestObject
 
-	var body UpdateJournalEntryJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateJournalEntry(ctx, request.(UpdateJournalEntryRequestObject))
+		return sh.ssi.ExportUserData(ctx, request.(ExportUserDataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateJournalEntry")
+		handler = middleware(handler, "ExportUserData")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateJournalEntryResponseObject); ok {
-		if err := validResponse.VisitUpdateJournalEntryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ExportUserDataResponseObject); ok {
+		if err := validResponse.VisitExportUserDataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5418,47 +19190,32 @@ func (sh *strictHandler) UpdateJournalEntry(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetOpenAPISpec operation middleware
-func (sh *strictHandler) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
-	var request GetOpenAPISpecRequestObject
-
-	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetOpenAPISpec(ctx, request.(GetOpenAPISpecRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetOpenAPISpec")
-	}
+// ImportUserData operation middleware
+func (sh *strictHandler) ImportUserData(w http.ResponseWriter, r *http.Request, params ImportUserDataParams) {
+	var request ImportUserDataRequestObject
 
-	response, err := handler(r.Context(), w, r, request)
+	request.Params = params
 
-	if err != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetOpenAPISpecResponseObject); ok {
-		if err := validResponse.VisitGetOpenAPISpecResponse(w); err != nil {
-			sh.options.ResponseErrorHandlerFunc(w, r, err)
-		}
-	} else if response != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
 	}
-}
-
-// GetStatistics operation middleware
-func (sh *strictHandler) GetStatistics(w http.ResponseWriter, r *http.Request) {
-	var request GetStatisticsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStatistics(ctx, request.(GetStatisticsRequestObject))
+		return sh.ssi.ImportUserData(ctx, request.(ImportUserDataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStatistics")
+		handler = middleware(handler, "ImportUserData")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStatisticsResponseObject); ok {
-		if err := validResponse.VisitGetStatisticsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportUserDataResponseObject); ok {
+		if err := validResponse.VisitImportUserDataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5466,23 +19223,25 @@ func (sh *strictHandler) GetStatistics(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetSummary operation middleware
-func (sh *strictHandler) GetSummary(w http.ResponseWriter, r *http.Request) {
-	var request GetSummaryRequestObject
+// GetWebhooks operation middleware
+func (sh *strictHandler) GetWebhooks(w http.ResponseWriter, r *http.Request, params GetWebhooksParams) {
+	var request GetWebhooksRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSummary(ctx, request.(GetSummaryRequestObject))
+		return sh.ssi.GetWebhooks(ctx, request.(GetWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSummary")
+		handler = middleware(handler, "GetWebhooks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSummaryResponseObject); ok {
-		if err := validResponse.VisitGetSummaryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5490,23 +19249,32 @@ func (sh *strictHandler) GetSummary(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteUserData operation middleware
-func (sh *strictHandler) DeleteUserData(w http.ResponseWriter, r *http.Request) {
-	var request DeleteUserDataRequestObject
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(w http.ResponseWriter, r *http.Request, params CreateWebhookParams) {
+	var request CreateWebhookRequestObject
+
+	request.Params = params
+
+	var body CreateWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteUserData(ctx, request.(DeleteUserDataRequestObject))
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteUserData")
+		handler = middleware(handler, "CreateWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteUserDataResponseObject); ok {
-		if err := validResponse.VisitDeleteUserDataResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5514,23 +19282,26 @@ func (sh *strictHandler) DeleteUserData(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// ExportUserData operation middleware
-func (sh *strictHandler) ExportUserData(w http.ResponseWriter, r *http.Request) {
-	var request ExportUserDataRequestObject
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, id int64, params DeleteWebhookParams) {
+	var request DeleteWebhookRequestObject
+
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ExportUserData(ctx, request.(ExportUserDataRequestObject))
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ExportUserData")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ExportUserDataResponseObject); ok {
-		if err := validResponse.VisitExportUserDataResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5538,30 +19309,26 @@ func (sh *strictHandler) ExportUserData(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// ImportUserData operation middleware
-func (sh *strictHandler) ImportUserData(w http.ResponseWriter, r *http.Request) {
-	var request ImportUserDataRequestObject
+// GetWebhookDeliveries operation middleware
+func (sh *strictHandler) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, id int64, params GetWebhookDeliveriesParams) {
+	var request GetWebhookDeliveriesRequestObject
 
-	if reader, err := r.MultipartReader(); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
-		return
-	} else {
-		request.Body = reader
-	}
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ImportUserData(ctx, request.(ImportUserDataRequestObject))
+		return sh.ssi.GetWebhookDeliveries(ctx, request.(GetWebhookDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ImportUserData")
+		handler = middleware(handler, "GetWebhookDeliveries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ImportUserDataResponseObject); ok {
-		if err := validResponse.VisitImportUserDataResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetWebhookDeliveriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5572,43 +19339,133 @@ func (sh *strictHandler) ImportUserData(w http.ResponseWriter, r *http.Request)
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbX2/juBH/KgTbR9nKXq4t4KJA0yQNcki76fkWfbgLFrQ0tpmlSJUcJfEG/u4FSf21",
-	"JVnezSa3iZ/iSORwOPObf9TwkUYqSZUEiYZOHqmJlpAw9/MkQn7HcWV/p1qloJGDexMzBPt3rnTCkE78",
-	"g4DiKgU6oQY1lwu6DmgMJtI8Ra6kHb/1nscNMlzin3+s6HCJsABtB0qWQAuFdTlWzW4hQju0YPu/HJen",
-	"SiKLcHsHLB/0cTAHkSc1fMKTCWnOtcGPHRIIqGB9b/cQXLew4liDMa30Z1zjMmartp3KTAg2E0AnqDNo",
-	"2TkkjIvGTP8k2FsIg7WyQ1o8+tT9UiG0CyHVSqpMmv0EfcaQdSIz/48jJO7HHzXM6YT+IazMNcxtNSwN",
-	"tVqMac1WHlwzHE7pDGbYRgUk6tWuyQV+WvfsKG9vNlGZxIbq5kIxrFQns2SWG2CmNcho1aqBJ3I0bZxf",
-	"yhge2nWV+wRzurWLbgDeqkxLJs4lag57zGxj7aeKVouXnqm4Q1htnmmEPGl1T4NNSzO0UzYGH//QOhg5",
-	"imGOaR1QA1GmOa6mFml+c4rHkfubgryMT5WUEOEHLeiEhuN7EGL0Sap7Gdr3PB5FSs75IrMsKlkx1JhN",
-	"A/owsnRHcaRHXHLkTIxYFIExI1SfQI5SpZGJUWbXsd5tbbmDBwSrhjMV+ehYxyL9l9JAuPQi4UoSNlMZ",
-	"ElwCmYKcMc3Iz+fTX8jJ9SW5e0cD6ojTJWJqJmG44LjMZuNIJWGqbiXOH0LjpzndyLmqAdH+zH0qnYPg",
-	"EUdm/p6qW6sY0JYKLUIC/WcxgFwXA7ZWL4mMG0RCnqSaS9yyPFpuZa40YcTwJBVAUtBGSSbI+c/X5B5m",
-	"hKWp4JGXxyzjAsk9x6UTyoUiBpmMmY6J4DPN9Cog762ezkiuKMIyXILEggKTMblWBhcapv+5IjFDRgwq",
-	"zRYwJmdg+EJCTJghjGiYg/Ui4BhMVAxaVvKP4Q6EShOQOUMXakwDKngE0ji05rI7ubi+Gh2Pj/ZQVzgT",
-	"ahYmjMvw6vL0/N/Tcys9kyUJs8ZLr+syKjnKDJeLSi6x4LOAvL88O93YtIU06MS8n09B3/EIBigRlQnj",
-	"lWQJj2hpkLQdlHegjdfw0fid2/fDKNX8jkWrUaoEt25554L5hHLRdeAMkKWcTujx+N3YrpQyXDozCpuR",
-	"MFUGc4P3VnwZ0wk91cAQygAYUA3/y8DgP3LfZw0DvIetYS68NT5O+NDV6dhfINnrztfszriGmE5+rfOX",
-	"T8lZuNl2n42pNhFzD0yqpPGb/eHoaC9RDUtF1lu+oXhHIqe0mJjM+dZ5JoTLMn48Ot5gBOEBw1QwvsHC",
-	"pnS21vogrY9Qmn+G2JL+09Yev5j0iSRW8dbjE9BaaaIil5vEjVhFJ78WUerXm/VN3dQ9aAkjEu4Jq7CL",
-	"bGGsdmvAv7Eka5YQPvJ47YOMAA+5pkGcuec1g0iZZgkgaOM4stt1NlYFAgeiJkSCmkB2ZyY3Xwmo3St0",
-	"Y8nL4e1iyeubMLkTSAFdQIsHvQD8vtAyxP3Ua/8+9GiwWfjdG8bPBaDN01KI+JxHA0CUZi0g+pDG7EW8",
-	"zlME+2eO3d9duM6cct+uiXhwD3GxNlZHKobQctblbacq0xGcqhjoXppdfOZpR9yccWlZDXZLwy9OLJNd",
-	"zi+gS2Cxs9xHd1QFEkdn3KTK8AL68MBsYUcnlCGyaGkrpr+SORdg0f2336hdYIxMjxeff6M06FPY86q7",
-	"CpzqXgrF4kYtairx1HTs/i206098+hR8Woz5SsMddGJXRrnNQ7tt0RR8HaIeveIGCROCRJWuKm3nj1y0",
-	"66k6C9E/VRx6utPwrzjk7j/KrsexGg/1FYOS65LUS0e5nkwwf3UoSeslaVQCu8Um6l5wYDVaGcrrLEYL",
-	"EB1qUV+L9gMo2BU4v/8ytP6Brwcvhzhsq88cGoTLSGQxlwvivlm6A+5adt0VoLvL0eeH01NkAV/6sf0b",
-	"flx/gW/mrzbROBTTvpjenWSUnQt9ObhrK3gy09unG2HfL0Rf1b6wUtlHdV83sZlSApjs/zJUTAuKrdXY",
-	"eGlb8a0m2xCzzw/peD0djz3GCzPxdlGzkZ1Z+BQQRWEqrzMFd6gxbp9vFzVez4R1IaY3W3pmeDy7v34e",
-	"9/vdetxDXpLnJT3eNm/d6zv7/anR3fcsJ8CNJsABx8D5eAKex0MVWp0G3zZFU4NBofpdZ8INZTyVn+ts",
-	"5Hyqjsu6B/PDAr9oucRL+64myPtBfWgwaqSPdVCvWiFd820DT3Q3YP46c8ompg6Hu/nh7m48BUPC4yvo",
-	"NtrPKR3i7Eav0RAg9RQsL4SlQ0B/Ads5VCd5dTIsmOdd9eNCJV3u+H0K8uT6cppCtF+poiIEHBnUwJJW",
-	"YdRB1RRGvqZzA3u1IP3iKDd6jzbRVzdnkFniGrQ2B90Ev5seJOsQcQmkLpOaUhNAlmvUIENukEe9nUfT",
-	"atQ3NN3qQlzL3t3Lxn2n1x73mtpUyASJVCbREDUv+4vc18zu8rKm3lzfBdUeZedDDpr+vX3PHqJ8d//M",
-	"2n7tLhvEJDPuFl6Ji5yyB4V9GecXUfvrsw8GtNNbOzg2JGpA+0tzhxrH1zhCOEU4odS0Ucq/s8Q5f0iV",
-	"xh3S7zFNsa80S9WBW/mb9PIW23bphBjQzvtW4OK1PQwu7YeGl8kWXrrqiyQTyFOmMbQOd1T4ga4SIyto",
-	"DuoT//JioAuPPGnD4xsCh1ftLmB4YvquKFubXFypiInGrWQ/tnHteBKGwo5bKoOTd8fHfwmp5SRfbOs6",
-	"OiAjJQhNVR27ZHMdPPZcGGib5nrjt6dVQGibVApge+IFSNBMtE7jNuNomdOsFdtmFuXR9tyiL6d9b3lD",
-	"yvY0982sbY7/XrQ9obxN0zap1ua2vln/PwAA//+x6NrHk0cAAA==",
+	"H4sIAAAAAAAC/+w9a3PbtrJ/BcN7Z9LOoWwnaXvmOHNmrmunrTvJSW6c3H5oMjZEriw0JKADgJZ1Mv7v",
+	"dxYPEpRAiXLkZ/QpsYjHYrG7WOwLX5JMlBPBgWuV7H9JJlTSEjRI89fJhGZwAgVkWkj8IQeVSTbRTPBk",
+	"Pzk+ImJE9BiIwoZECyImIKkGwjj5bjgjOYxoVWjThlZ6DFyzjGrISaVAPlFkAlIJTgs3AlP4If8+SROG",
+	"M/y7AjlL0oTTEpL9xDRK0kRlYygpAjQSsqQ62U8Y1z/9kKSJnk3A/gnnIJOrq6s0kaAmgiswa/qZ5u/g",
+	"3xUojX9lgmvg5r90MikQOCb47kSKYQHl3/5SuNAvwYT/LWGU7Cf/tdvgbdd+VbtvbS87aRtV78dApJ2W",
+	"ZKIqcsKFJkMgEyoV5MlVmhwKPipYdutg2S1jgpPMQaDIlOmx2bSskhK4JkrjrrrdlqBEJTNAqH8Rcsjy",
+	"HPhtg51JyJGcaKFILvgTTWhRiCnR4ZIQwmOuQXJanIC8APlSSiFvE9YDTpiDgABOTkRmsGo2/V9C/yIq",
+	"nt8+LdotJEJaBjZMDrnjw1yAMhQKl0xpBPQDR/4Vkv0H8rtiHKZIyZRi/Jxc0ILlIQ1YGCdSZKAUHRZw",
+	"2AB4F7DWGCypziwv2RE8EwU0euUlmhFQBxku4b34bHlqIrGlZlZ6ZRJQfJ5S3ZJ+OdUw0KyERgIqLRk/",
+	"R7SwvJekTJOCKn1aqeXD86ooEL/JvpYVRKazsvrL4geViQmsQm+w+hPT/ipNtMfFIrrNJzx4FPCcUEUo",
+	"GQKVIO2XF0TwYkYk6EpyyMl0jK3rfkwRh9BFvF3Vv4jhX5AZJlgAbgGmP8agxyCDOTLKPRA0J9+d4T9n",
+	"3yPf0UIJN39KqgkimVCekxwK0EC+O5tKpuHse5JTTZM0AV6Vyf6fCY6QpIn5mnyK7MBBptkF07NF8sE5",
+	"FnY2RjOtVX35CprqoIY4di3Yb5FjNgf7dQl2AcQ0uRyci4H7saSTP23TT0a+j2gGX67CdfzB9BilEM30",
+	"4mqoa3TaG5OZHap/h41t9ohJpU87+bqgy76uQQA/48a/qeXiAnMd8EBZESPkdezhmBxZDjllQcA+UQS4",
+	"ZnpGcEavY6YNrzHBvQ7BAXJC+SxJO/Zrlfw6mfHME8AR8m2zc326OnqZ63lqei2o3zyHS4MHToDKgoFs",
+	"4wexMHTHD9Ve1Jmf3cAoOe3PhJIchtrIJQc+GQmZEsaVRsklRoRpRY6PkrRFfM+fJWlSMs5KlE57UUKE",
+	"Ya/FH8GwXrndsVM72PKuh2PKz+Gl6fB+Zo8MlkfwVV9XPDkIL3aFdFI3Sftw1l+iQn3uFLiWvSjid9vh",
+	"Jbb3KxQhoa8a4E2o0W6cGrrxsYH9NwD/u2IScjy9wo0NkfBppUB4B6oqIqI0B01ZETuLZ21BQEaUFbGz",
+	"fhW9OFz5Uzpv8JN73LEQwVOqSCbKkmmrWvQgKaWprtQqUmgj5MT2mcewG2o1Qk/qObuVmAZ5uCijPiMi",
+	"LCZT86MURQE5GdLsMxlCRisFhHJhhpjHPWIO+6jPbDKBPGgfIdp6u7zm46ZHjPpPdvJTnDxJEzdsVCsy",
+	"a++ioWa3OrFBi6KBTZEpSCANQG66oRAFUMelOJUZnWko19xbB2hzTFIp6Wxhr0Mq8xPGNt7KyMV1b0TM",
+	"9iDv3sLOzvKmdTnqFh8sXylDFsDe/1LTkz+Y3RGVNqf8vIyPEdQ8qOHA9a3CSQwzhREYSZoo0LrooNJu",
+	"hTHPJSgV1bGGTOpxTmcxbW+l+gulk551T/tLurYiuN5Fs3MYzrLP3R+FhjgSJlJwUXHVU9kMNa0u7ZxB",
+	"f/atL10LLGupq/9IqAbFRumlbXj6WbbmjgtWQGErieaeUFx/QloJVE1YK1uuQ2h974xHTkWe25FSVLxt",
+	"ihkVguoGU7wqh+56aCy12SyKiQ1d52M0hZB3EdQNgb9yh2aiOhXTkCjqc/mrdmj5jX6d1a57l7+O3W8F",
+	"SodrTH+DFoIYTb3kmZyZ2V7yCyiilra3taeKeP0ALzA5mK5GoeT5QIsBcFTSza/YhOnCmtmGIp9ZRwcl",
+	"nwF9VZJdQE5GUpRG9fUuKqrUZCypgkWjQHEuJNPjMjz7L7Mxzcb02d5gIorZ0+d7P0YP+s/5KOxF5bng",
+	"z1hcKVC0aO/7cBaz5MypSg10djY3TkxJMpfH+FHoSFUdLlD3yvsxXncZrNEzRgvh1XkROtzEOH3HjF+d",
+	"rFLTxwqLbpt4qIQokUWvA1DT9KojPEL96yhXqI3y8/nGz59FG5sV9eTKcCc6JH7ndrRXT4vizSjZ/3N9",
+	"PHyaVy9ubMF9zwXvD1ognCNjllDeJGNcf1ShS5xxyNFD/u6XQ/KPH378e5L2tGi8vJwUlAcWHaa8N5Fn",
+	"tXvW+bWiZg6uNOVZVJzqcePftT4sayfC+3k+N3CN6EqygYQRGABiM6oOG8Nv79+/JfYjyUQe+paNrz5m",
+	"ZVqym+3BT8ZCaqKqsqRyNocW4q6OC5D2uQO7zbbX3zlx68c1IKXLrDDhKIuwayRu8uHdscU/Mx7OEQNl",
+	"RRCasI3tu9kNf4ZUku8r4EMq6b77uj+k+cDtZ5JGW1ShZzfeZFQ7+ePfudCDkXFjx7/7wIKOz8aZa+/Q",
+	"8Qbegb54OCKX4vIHF1SigqEQD0GIx5zjOoxXCJzvQfBFmvxfCM1xPfNVmpyAUk6CzZ2RBQPu1akuI+Kb",
+	"46NDYlvandXG726GtDYxdu4EA2olUY3u2lqgXn6weY5HMEqagw8AYcpDGD3T1rvwKwC+FuiVAnlKzx30",
+	"PU4oE6+0uD1f66xMEx+mtAyLTKFrqWekU0qmY5aN0Tv8xMQBqTGVLcNyFOFSFCtllEHCO2zYjaNjfsF0",
+	"bbVqY2uN23l/naQv3A1gdgWpjfnqf1Oxzde4a8SmDfRyyJkWMkmTCwZTkFH13AzxGszl7n6hcwUZzC9W",
+	"TDngWnstOnB09tfpAitZx0Xj9Nq2iE9zUC29z6wQ1ov+Ufw19Iy+IOycC+TZkZDOMaT6+Xo25pjvJvNQ",
+	"MTGt3KwxdcT4nY0tW4HeiC20RRsRS6a/U/YesLZqRsaqpIoFpx6a33ED8QKP/+IOcrjURM14Fkf3UK+3",
+	"yC5TrZfh64z1XpRDpQWH2IChN2KdnWjdnlc6kywqg/1ZnNhjKQ3pollwJ30ttbbff9fGHXgswp0JoAth",
+	"Sev11EN1bYA3MPcT046ub0xE15EeG7SirifKTbDL14nxr7K4LzdRh3vvW6YeN8HMXbu9EG9yXUvN+naq",
+	"TVljWldrd6c2QNdTdC3+daW7AshI6b55q4xxC3cEkPm2dx4/NqQKTleccw5mPNqa26W9bT5RJBOT2erg",
+	"J1wazpWT8L4XXCWvHce2jQDbZATYV0RUhfxx3XiqmoemVJm8i/kgmM2HVYVTBvNtLK4qRMv6UVWR3qFn",
+	"pwY3MINxoU+9xYxxYwDrvGUtiTCpA0xqFaxzlEa9vKMgoFWBPDHM/gHDsRCfN5MHARdopMOf++vPDoCX",
+	"2NWvd143731tr2RbGa0k65d34KA4goJdQMwNRrWGcqJ7nrrXQV5eWRo8LVWrU/cs4DOt4lLEgVxHEgpJ",
+	"8O+a5c1uGX7P7bLjEsZu6nI5Uw9gB/W2N2etU7QEo/xhgJ+DSllXsulGmO4nahr6ug5V9bdwGQlzmok8",
+	"7j3o8qgYFO8hermofzQIlpABur77+FyWkGeznMVUHee1yIz4sAoKJVPbz221sTVzo5QHPg2nc+w0EXX+",
+	"lyayzv/SWE5R3wi6mD+b9uZPH4Q3d5YHvdq/N93bvy/efANOVpBVkunZCW68ZVXB8mwRQW8OKj1+RqhJ",
+	"MHKZQ985TlAmcRHxgwcIO6+Qkm3gAie0yhnwDL5PrYPh+CjozYU2mUa17TkcP7XoXsiZ2iFvmihXs1Mm",
+	"c8loxk6AG7DOvJfGZDQRk92VEjHX12QpLelsc5x8b8eYJZ6Yam6GnXptynjezyXlqCkMhR7b/mrH6j/8",
+	"OD8UnEOmP6DETXZ3plAUg89cTPkufmf5wKPSO3o8UYe9jXcJ92vgsZzso82h/jnP5IBxhgmIA4vagQFw",
+	"MBFS02JgBD72MMmCcGl9SUciizhEXwsJhHHLgKjq0KGorBp/YtFA3r08eU8O3h6Ti6eJO02SsdYTtb+7",
+	"e870uBruZKLcnYi/uB5d7jrsWb/vSAQX+MAunYygYBnTVP3PRPyF9yqQOEqTdP2Lb0De+gYLs9eD7LQG",
+	"2WXlRDKuFy7ESb0UI3iJwi2Hhk5fvnuL0oEE6ZpkWLFCN5nJvwqiNOU5lTkp2FBSOUuRcvnxEXH7Fzpi",
+	"DEZ5Tt4Kpc8lnPzvK0uUSgtJz2GHHIHzvxmGqH3aBsBS5CB5g/8czH23BO4A+lUg6RUsA65suKHF3cGv",
+	"b18Nnu/srbFdu8NCDHdLyvjuq+PDl/86eWmEvvVlo6M+xFENUWWyYmu85AUbOoHQXnSSJhpkqd6MMB2a",
+	"ZRDA1LWJWqjdfMZpybLav72fxInyAqR1kSZ7O0/Nui8HE8kuaDbDcCiWzXpM6DrUk9qrEqcTluwnz3ee",
+	"7uBME6rHho122wbqc4j4Ot+ZBFB73hdUg9Kk6UWMdW0nvDsd58l+8ivog9DGGdZG6LCfNU1227UTrtJ5",
+	"kN40iamhbwHh8aEdTXh6rBhCYP1aryLCWqBwkxE20t7F6S4bMYCUplKfugYRgOJejmuAM4SRkLASHuD5",
+	"zUIzHQsFBGdLSdAJQXSb80SZz/ZPxi3IGn0Q32VUwYBxBVwxzS6gq+aF/7NZwjzIn+YqXDzb21uS9b6Y",
+	"7b5WeHsYArvoT1isftBgS4KWDDDCUlXmrBxVRWEuUD/s7XVNXy9sNwjqMF2eru7SCv0wnZ6v7tQEiGCP",
+	"Z8/6TBMpO3CVJj/2WVasOkWoORpRY3XGP5NQH0o+XX0Kz4VXTOmIIMFLTeDO0fQcpVfovEGb/ETYciht",
+	"AXhoVOGDJifmqyTgJ3v/B6V/drbn3hT6VV6IW/e3tgTzcudru6uPZ/waZu7nfO9k1FmdF/qAmHTvh9U9",
+	"6lgv0+EfqzvUEWH3Vwy4ChBtOXDo07k5TEmQzxbl/Ks0VJ92v7D8yipPxpS4IBCOzO+bEgjpF3vgoSbX",
+	"nHcsT+Z5Yj315muPw14lpTqYx1uvt8zzMJnHErixq6zgnNRfNLruDI+VPdZWEpewy4NUCddmmIejQ/4K",
+	"aJZVE8jYiGU9eGDi00DmNnhiDXiU/H7y5l+kBHkOxLQl32HSw9+f/+On71OiRON4HzEockUYb8UjUwnO",
+	"ZpwbQ4YwM9DCN7eGZAlEgQnwQFub7VQAlZAvXupN4spDYNE+mrJB7MAg9m/X41SDjnuph3qf8PYofZhH",
+	"6Vsq0S5ezJrSZn0EShU5VD+YAR4L06663t6r6OCtYNgKhg0Lhg+9xQHeTrGwD2oAHIpBIc6FlQ/eXhUx",
+	"1dpG3lFofZAggTClKhfl2ThVn6hWWhaGDMjapWoUCZplMNE+3A9oNm7N0JR3HJqE8ZwInsGi2vFzs4xX",
+	"dhV9xcXlYDqdDlAYoDsReCZyG1jeJT8seKd10czl7N9q/RX8PxeGYJPGFJFwIT5viFnvjsZr2n1nlhNm",
+	"7lkjK269RH+AT+ITlfMM1pl/EykuWA6SfGf+RLoeOIpwFPV9wAd+9JoLfLp1lPC9vl0H1WFXH1an5gJV",
+	"GSdCIiD4M/7BgWhJuULeE9yo5cCaklcmCKG0zGDmMXF6HLtRCTvEBIMxCHnN2xCHsx7V6JCDhtB4XE03",
+	"/Mx8RTszcN07JYohd+oxMEmOj0w0AMYhGv9+4xzy47M6YnCHeD8gJbZYlhEHhqnr4V9ghAzlC2XI0taQ",
+	"OF9de8tg0oXa4KBuebZfMJDdtKHxHM0iVxOhtCkCdm8s7TV8161ihqxX0stj2/Pp3t7eihSUYMa7VkbC",
+	"enERfcR8Jk7aQP4iIAAbn8J4E17mGE61S+qpraXjzrQQFJkzX0EWObbZlYhQDCSz6eDEMp7Fu0HQwYIt",
+	"8MQUWT8UOSRrUer5f9ikwyo9ZJwah/D8ub5AoXZyGwvYYWlLkzHQ3L324DZjcMTURChWRxxfUgzOQZbV",
+	"mmbjErh+QUasALw6/PNjghPsaCp3zv/zMVnqqb7a3El8JKa8EDRvhQipZsXBjpk//YY1iYdde3bYeEu/",
+	"XgrftFt+DVe8X9fW6nofPfcdfvr6p1Ve+sM6Vuh+qA73IvXydhIsb1ctWeJXcZ+2LvzH5MJvggAjMiE8",
+	"03p67zckKB6P895zzdZ3/zh898s5Jl2l932DbvtWsm43g2z1xvvnrXeUTRjPiirHHABTnsNYpFoFOuIK",
+	"5cN03z8ATr1Z732rjvf90z+3HrrH5rpfdaYucds/EmZddd+9bg2jbc2iB3Gl3oq0xxF0sPo6XReB67on",
+	"HJkGN50OaNW4u84EtFD4xDVfdqp/8prvsdQr0BMKoyzOREXEFGxuvKzge/uArVMjxdQ+AoCtsMWIFqoT",
+	"tqau1gJoQSmufrB5i9t9SJeMQXSnGZMWIJss+djTJOdfCenhlzky6NlmR95OdmQtVzscLub7Sm/LkX0u",
+	"7H64Wm724ZnbKXLYOsLWq3h4u7qi2fkOLt46Xh6T48U9CTgvGGodcaW/5QS0LjYiKh6Ps8WwiSu9tGWT",
+	"B8omlrDdi9Hxs/NhGpbvO6/erFW5ecnvPp2pW8vLozMmd4uNbjPyY2DNjSry97H4+FZsbMXGpg22S9Rw",
+	"Uze0u+jbiZZAS9Q2LDiDE+CamCqlxrA6mwA5s1rEmX8I9OzQ/U0VYVrZGoHTMXC4ANmYj1MDVto8CCMk",
+	"cWVBiSkLaqp1dpe33iHHrrangZEAz1XqNBpFSnY+1mRML4AMATgpmTIF55VwiWqKqLGoipxIMDHQNhXG",
+	"wGptjxIyWwKR8fNoYbuXFnU3HuKMRjy7TwO71DW8MgYbUaeM+eJxZ+yoc5xuszHsSEHaTVjh32T9wIWj",
+	"7m0Ey11Y5SyH1nTv3ugBn1Dm3zRAogtEgGN7KwNY/XLXUqfN3CtfKrkNY/DcpH2MwaYLCRa11DB8KwR4",
+	"R8HwE+AmnkktYMSn8Eaf2aud5Y5YTPcIsfSIls0KxmF+Dxck5iMxzMwTHsnt+rca1MONhzUbaAr6tPc2",
+	"xh5d/o4Dk//+ILlgpWjuxQe+AMCWDx4oH1gK7skGeEo4RX6ZOvF764H7B5EpuOIlwAVG+D24zjzY8r3f",
+	"QtbgX+2NCqjafVnpzW6Rxn3xaj/KB+pu10DWZvnlLL4t/PuonNctY1RUJAQnXc/MwU2Kicfj0W4z0TaJ",
+	"8JEkEa5moLSPdvgtVgFe79jZ6pX3ugZwHz54oNEeD4VNbzbqI8TCnUR/rCcutn7dRxcO0kvEdEeGPCY2",
+	"3l6Kt+JmK25uIoyk34XYPTO440mqS8PHdyYP3h6fTCBbz5MsMg3xUIgIU8ylVdk5jWq2Vj0//yRvUMhv",
+	"nntCcdZ6tzxsFHnhdoMF/VDvNMVyg2UG+1SCpm6T6tq4Ky0Wvgzxxrz9PRJmVnr4V5dGviU5sSbb3zLn",
+	"ulLLaOEOSy3HHf9pUAAFW9gYRU0Eh5RoW5XZ1GQGfD99OgYJ8WLL3Zf6jdPSUveknWxNevo2A0VMDB70",
+	"IJLu8tr+z56GUL87jzYWxK5vo8Xbt8rP3YlQzxtLGMD6v1cF0N1i2FzvYLlvV/DZTesQdqbsPSkB8wWI",
+	"GK0T8mMdSScu6nIzF9c13qK56wviitCgh+gm/Va8nmpMJW6N2Sgx5c1bEsu1gTD6x/7RVxNwTPKYY0K3",
+	"Ls1H4tJscYcpEGmfd/F5Lj04Yj7cfuUBcicxo7dcv1yKAro2tCMV4B12mT/9/ARmvHtxCoa5Cz1CZbcR",
+	"RA9bUpjtBv+alRZ1zOxwtjyvIpQQVuVcfZd47do9iMOz/73FLqt/qo9D1zYM4r6G1/oNMs+8zaeireKC",
+	"3S/2P8e9lUlHP7fCFXFvp4f4oeqr7s4roRQX28PoARuvcP8aG8ZIitJzYEqEJAVggjTTneyIWonSLFt+",
+	"FjWtblCbOsanDLvq65uPhHFL8dbw2nUYbNLZJjQtSCYqbgvi+WJ45mrQnVQQoNXh2Y+6BMmuyR3nydzK",
+	"Dm2P67t7C6EPKS9JHw6p3I3sSHzGs84yE/WbokFByXR+1jT6LkMrwz3yfqqQTQCKf/MUfZtKyLR+dBQW",
+	"31+tTTbYaYdgQVJ0ftKmb1E0/agEV64VC1O8Hzd/ufZoS55QpWxxXZyTY21WxArRLnpzBBjXqcc+UlPV",
+	"EDNFBIdoFYoTxOumKykfWpjrNeAjtGQi4YKJShmguwryIsA3WkJ26e1hxjNb2EKB7q56sb0p3EfRs8iH",
+	"Pl7ZcoHnvFDCICGGTpjuJ53LStPI282y+zFj+2bzDha7kbO6f/AUMsG/UFhO7YPPtGlkwMe3ixXJBZiX",
+	"jpF7gNtlCj0Gqaw6NgQMuKhfV/4QfW/aihe0YUFOqKllbhRShQ8t14ibkSlVNdZ8BW1gkgyp8kJvhxy2",
+	"698YEWQbIxQzBKfBlhaBWLI4wgZ+o+IvMG9CJm3MClmvpb8RYsaz15WOv7684vHlZrbbsD6uvRz/FvNq",
+	"08rrmgSu8z5zg4atTL03zzM3XF3SHNzL8mI0MiUubFWsReGKupsWn2F5baADc4q+t+1ug6iDCftQs21O",
+	"7EK+4XpAUpmkH9pCR59oL9t0ZcRDuC03HPeQJioTE1iDUE5M+2jAhB/srl1GLbJeTsZRV5EV0fa7uTTU",
+	"z0hAXr9B4qHfhlzcj5CLKFv2u2PXbNmI6Z5hF21OfZzBFy122UZiPpJIzCi7dPEE8osJzFjJEB8USGO8",
+	"vBkLa5sycTJbRnMbGvQ4QoOKglR+UwNirOmvMy/i5eVESH3D1LdEyhbRHKomPSntJF0wkPfKnzpiaiIU",
+	"88mVQRqV1jQbl8D1CzJiBeBp88+PNdpMFlnxMVlqUrza3jHvyG5nabcf8cevDcflxqm/685Rou1qQqXe",
+	"RVVi4E+FrmtH5WEKdY8h49YJtxiEfd17Qxd3sTLGXduT4eGEgpkNXMUYqKRMYTgW4vNSC8sfvs1DKJTo",
+	"gO1jmfHr2vpk7mv01rShPE+/9U/dzhdbHd+VlOEaDcOUnL19c/L+rC5K4x4MMKVtsMKBc/vYFyytIdk4",
+	"TZ4ocsbys5ScITXhv872cUr1mXGVnCE3naVkOmbZmLAcuGYj7/txzpHQp7RDAvhyKNgFSPSZaFIAVZoI",
+	"noFx6kjIAD/W7pIc8sryh3Ellz474uzE4nJgBh4cH50RqwftkF8oKyD30wTuYoleJIsEbrQp3ExGCzKk",
+	"2WcxGu185B/5S/RGub7mTQRMxPX9cGa3GU8UUZBJ0PvkfQjPCTvnVFcSPEBk7Iz09lQjZ/qfH6u9vedZ",
+	"xdkl0awEpWk5Mb9BevHUfVV+HPvBIBuk9afX3xA+/GEMlwPgmcghJ7+9PjgcnPx28OzHn7ypsZ4kRaLY",
+	"Oasd8Z4ykBp2PvIF75K1m3jxcl9cTIZIT5E21boi0lCLqS6AjibGvaNpXnAaBpbRJzrM73WGdkBlSCEv",
+	"CNO4KfbxDW8KTMxcr4Cf63Gy//SnSER+JYvFuX57//4tEZLgvyfkw7tXZlrgueVTRbRI0kZbqiSLqkqh",
+	"+RXnqReXtlB518bY+hTrPLW24foP/Rm+amgfNiHUy1EkafBPu0QOvFBh62lr3ZC8ejwFPz37bI1fjyQv",
+	"zvMOnuMmF87rK4U478lGu83J1eMedNQ0fpxctY4S4ZAxW+O+FeoJ8ZtXir4pUJqMmFTbB57u/tn1Uiht",
+	"7iNcN/xFtYZy4p5j92zYwXFmdoTGskmbLl6JjBYkB1PPrsQ5bNvEqYLJWOvJ/u5uge3GQun9p8+f/33X",
+	"aNFusvkhX4OmpGZgFabHaBqJOj0RlcyA4K0h2g0/RLo1JrNYp9rUstjxV+AgaRHtxjBcPdKnXdou1tNX",
+	"Q1vse2gjmzvWZr6pSDfzFmOsj33Hb7HDQf2iXqRTEzkd24FW8n+kt/kS6/k26sOODWG+RCd3JXOi89pv",
+	"sW5eoDXdXti4BzHl7aQzklFOSsrpOZhDKjBsuGnqXyKb13owLwajUxpjG1hoauMsm37Gv++CrwYZnaCM",
+	"8U8TBuue8Swy4s82pCsChAn2Sq4+Xf3/AH6OELiWAAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -2,6 +2,7 @@ package api
 
 const (
 	OidcDcrInitialAccessTokenPortalUrlExtensionKey = `x-oidc-dcr-initial-access-token-portal-url`
+	OidcAudienceExtensionKey                       = `x-oidc-audience`
 	PrivacyPolicyExtensionKey                      = `x-privacy-policy`
 )
 
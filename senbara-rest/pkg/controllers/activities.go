@@ -3,16 +3,77 @@ package controllers
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"time"
 
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 )
 
+func (c *Controller) GetActivities(ctx context.Context, request api.GetActivitiesRequestObject) (api.GetActivitiesResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling get activities")
+
+	var contactIDFilter int32
+	if v := request.Params.ContactId; v != nil {
+		contactIDFilter = int32(*v)
+	}
+
+	var startDate *time.Time
+	if v := request.Params.StartDate; v != nil {
+		startDate = &v.Time
+	}
+
+	var endDate *time.Time
+	if v := request.Params.EndDate; v != nil {
+		endDate = &v.Time
+	}
+
+	query := ""
+	if v := request.Params.Query; v != nil {
+		query = *v
+	}
+
+	rawActivities, err := c.persister.GetActivitiesForNamespace(ctx, namespace, contactIDFilter, startDate, endDate, query)
+	if err != nil {
+		log.Warn("Could not get activities from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	activities := []api.ActivityWithContact{}
+	for _, rawActivity := range rawActivities {
+		var (
+			activityID = int64(rawActivity.ActivityID)
+			contactID  = int64(rawActivity.ContactID)
+		)
+
+		activities = append(activities, api.ActivityWithContact{
+			ActivityId: &activityID,
+			ContactId:  &contactID,
+			Date: &types.Date{
+				Time: rawActivity.Date,
+			},
+			Description: &rawActivity.Description,
+			FirstName:   &rawActivity.FirstName,
+			LastName:    &rawActivity.LastName,
+			Name:        &rawActivity.Name,
+		})
+	}
+
+	return api.GetActivities200JSONResponse(activities), nil
+}
+
 func (c *Controller) CreateActivity(ctx context.Context, request api.CreateActivityRequestObject) (api.CreateActivityResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling create activity")
 
@@ -41,7 +102,7 @@ func (c *Controller) CreateActivity(ctx context.Context, request api.CreateActiv
 	if err != nil {
 		log.Warn("Could not create activity in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
-		return api.CreateActivity500TextResponse(errCouldNotInsertIntoDB.Error()), nil
+		return nil, errors.Join(errCouldNotInsertIntoDB, err)
 	}
 
 	id := int64(createdActivity.ID)
@@ -59,7 +120,7 @@ func (c *Controller) CreateActivity(ctx context.Context, request api.CreateActiv
 func (c *Controller) DeleteActivity(ctx context.Context, request api.DeleteActivityRequestObject) (api.DeleteActivityResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling delete activity")
 
@@ -77,7 +138,7 @@ func (c *Controller) DeleteActivity(ctx context.Context, request api.DeleteActiv
 	if err != nil {
 		log.Warn("Could not delete activity from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
 
-		return api.DeleteActivity500TextResponse(errCouldNotDeleteFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotDeleteFromDB, err)
 	}
 
 	return api.DeleteActivity200JSONResponse(id), nil
@@ -86,7 +147,7 @@ func (c *Controller) DeleteActivity(ctx context.Context, request api.DeleteActiv
 func (c *Controller) GetActivity(ctx context.Context, request api.GetActivityRequestObject) (api.GetActivityResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling get activity")
 
@@ -98,7 +159,7 @@ func (c *Controller) GetActivity(ctx context.Context, request api.GetActivityReq
 	if err != nil {
 		log.Warn("Could not get activity and contact from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
-		return api.GetActivity500TextResponse(errCouldNotFetchFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
 	}
 
 	activityID := int64(activityAndContact.ActivityID)
@@ -120,47 +181,119 @@ func (c *Controller) GetActivity(ctx context.Context, request api.GetActivityReq
 func (c *Controller) UpdateActivity(ctx context.Context, request api.UpdateActivityRequestObject) (api.UpdateActivityResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling update activity")
 
-	description := ""
-	if v := request.Body.Description; v != nil {
-		description = *v
+	updatedActivity, err := c.updateActivity(ctx, log, request.Id, *request.Body, namespace)
+	if err != nil {
+		return nil, err
 	}
 
-	log.Debug("Updating activity in DB",
+	return api.UpdateActivity200JSONResponse(updatedActivity), nil
+}
+
+func (c *Controller) PatchActivity(ctx context.Context, request api.PatchActivityRequestObject) (api.PatchActivityResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling patch activity")
+
+	schema, err := c.getRequestBodySchema("UpdateActivity")
+	if err != nil {
+		log.Warn("Could not get schema of activity", "err", err)
+
+		return nil, err
+	}
+
+	log.Debug("Patching activity in DB",
 		"id", request.Id,
-		"name", request.Body.Name,
-		"date", request.Body.Date.Time,
-		"description", description,
+	)
+
+	// The activity is locked while the patch is applied, so concurrent patches can't overwrite each other's changes
+	patchedActivity, err := c.persister.PatchActivity(ctx, int32(request.Id), namespace, func(activityAndContact models.GetActivityAndContactForUpdateRow) (models.UpdateActivityParams, error) {
+		body, err := applyMergePatch(api.UpdateActivityJSONRequestBody{
+			Date: types.Date{
+				Time: activityAndContact.Date,
+			},
+			Description: &activityAndContact.Description,
+			Name:        activityAndContact.Name,
+		}, *request.Body, schema)
+		if err != nil {
+			return models.UpdateActivityParams{}, err
+		}
+
+		return getUpdateActivityParams(body), nil
+	})
+	if err != nil {
+		if errors.Is(err, errInvalidMergePatch) {
+			log.Debug("Could not apply merge patch to activity", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not patch activity in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		return nil, errors.Join(errCouldNotUpdateInDB, err)
+	}
+
+	return api.PatchActivity200JSONResponse(getAPIActivity(patchedActivity)), nil
+}
+
+func (c *Controller) updateActivity(ctx context.Context, log *slog.Logger, rawID int64, body api.UpdateActivityJSONRequestBody, namespace string) (api.Activity, error) {
+	params := getUpdateActivityParams(body)
+
+	log.Debug("Updating activity in DB",
+		"id", rawID,
+		"name", params.Name,
+		"date", params.Date,
+		"description", params.Description,
 	)
 
 	updatedActivity, err := c.persister.UpdateActivity(
 		ctx,
 
-		int32(request.Id),
+		int32(rawID),
 
 		namespace,
 
-		request.Body.Name,
-		request.Body.Date.Time,
-		description,
+		params.Name,
+		params.Date,
+		params.Description,
 	)
 	if err != nil {
 		log.Warn("Could not update activity in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
 
-		return api.UpdateActivity500TextResponse(errCouldNotUpdateInDB.Error()), nil
+		return api.Activity{}, errors.Join(errCouldNotUpdateInDB, err)
 	}
 
-	id := int64(updatedActivity.ID)
+	return getAPIActivity(updatedActivity), nil
+}
+
+// getUpdateActivityParams returns the params to update an activity with, without its ID and namespace
+func getUpdateActivityParams(body api.UpdateActivityJSONRequestBody) models.UpdateActivityParams {
+	params := models.UpdateActivityParams{
+		Name: body.Name,
+		Date: body.Date.Time,
+	}
 
-	return api.UpdateActivity200JSONResponse{
+	if v := body.Description; v != nil {
+		params.Description = *v
+	}
+
+	return params
+}
+
+func getAPIActivity(activity models.UpdateActivityRow) api.Activity {
+	id := int64(activity.ID)
+
+	return api.Activity{
 		Date: &types.Date{
-			Time: updatedActivity.Date,
+			Time: activity.Date,
 		},
-		Description: &updatedActivity.Description,
+		Description: &activity.Description,
 		Id:          &id,
-		Name:        &updatedActivity.Name,
-	}, nil
+		Name:        &activity.Name,
+	}
 }
@@ -1,21 +1,167 @@
 package controllers
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+)
+
+var (
+	errInvalidSpace = errors.New("invalid space")
 )
 
 type contextKey int
 
 const (
 	ContextKeyNamespace contextKey = iota
+	ContextKeyAccountNamespace
+	ContextKeySessionID
+)
+
+var (
+	// accountOperations manage the account itself instead of the data in a space, so they ignore the selected space
+	// and use the account's namespace from `ContextKeyAccountNamespace`
+	accountOperations = map[string]struct{}{
+		"GetSpaces":              {},
+		"CreateSpace":            {},
+		"DeleteSpace":            {},
+		"GetSpaceMembers":        {},
+		"DeleteSpaceMember":      {},
+		"CreateSpaceInvitation":  {},
+		"GetSpaceInvitations":    {},
+		"AcceptSpaceInvitation":  {},
+		"DeclineSpaceInvitation": {},
+		"GetAccessTokens":        {},
+		"CreateAccessToken":      {},
+		"DeleteAccessToken":      {},
+		"GetSessions":            {},
+		"DeleteSessions":         {},
+		"DeleteSession":          {},
+	}
+
+	// ownerOperations can only be used by the owner of the selected space
+	ownerOperations = map[string]struct{}{
+		"GetWebhooks":          {},
+		"CreateWebhook":        {},
+		"DeleteWebhook":        {},
+		"GetWebhookDeliveries": {},
+	}
 )
 
-func (c *Controller) Authenticate(r *http.Request) (string, error) {
-	return c.authner.AuthenticateRequest(r)
+func (c *Controller) getIdentityForAccessToken(ctx context.Context, token string) (authn.Identity, error) {
+	account, err := c.persister.GetAccountForAccessToken(ctx, token)
+	if err != nil {
+		return authn.Identity{}, err
+	}
+
+	return authn.Identity{
+		Issuer:  account.Issuer,
+		Subject: account.Subject,
+		Email:   account.Email,
+
+		Scopes: authn.ScopesForAccessTokenScope(account.Scope),
+	}, nil
+}
+
+func (c *Controller) Authenticate(r *http.Request) (authn.Identity, error) {
+	return c.authner.AuthenticateRequest(r, c.getIdentityForAccessToken)
 }
 
 func (c *Controller) Authorize(f nethttp.StrictHTTPHandlerFunc, operationID string) nethttp.StrictHTTPHandlerFunc {
-	return c.authner.AuthorizeRequest(f, operationID)
+	return c.authner.AuthorizeRequest(c.authorizeSession(c.authorizeSpace(f, operationID), operationID), operationID, c.getIdentityForAccessToken, func(ctx context.Context, identity authn.Identity) (string, error) {
+		return c.persister.GetNamespaceForAccount(ctx, identity.Issuer, identity.Subject, identity.Email, c.authner.IsDefaultIssuer(identity.Issuer))
+	})
+}
+
+// authorizeSession records the session that the request was made with and rejects the request if the session has been revoked
+func (c *Controller) authorizeSession(f nethttp.StrictHTTPHandlerFunc, operationID string) nethttp.StrictHTTPHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (response interface{}, err error) {
+		namespace, ok := ctx.Value(authn.ContextKeyNamespace).(string)
+		if !ok {
+			return f(ctx, w, r, request)
+		}
+
+		identity, ok := ctx.Value(authn.ContextKeyIdentity).(authn.Identity)
+		if !ok {
+			return f(ctx, w, r, request)
+		}
+
+		// Personal access tokens and tokens of OIDC providers that don't identify the sign in aren't bound to a session
+		sessionKey := identity.SessionKey()
+		if sessionKey == "" {
+			return f(ctx, w, r, request)
+		}
+
+		log := telemetry.Logger(ctx, c.log).With("namespace", namespace, "operationID", operationID)
+
+		sessionID, err := c.persister.TouchSession(ctx, sessionKey, identity.SessionID, identity.ClientID, r.UserAgent(), namespace)
+		if err != nil {
+			log.Debug("Could not touch session", "err", err)
+
+			return nil, err
+		}
+
+		return f(context.WithValue(ctx, ContextKeySessionID, sessionID), w, r, request)
+	}
+}
+
+// authorizeSpace replaces the account's namespace in the context with the namespace of the space selected
+// by the request if the account's role in the space allows the request
+func (c *Controller) authorizeSpace(f nethttp.StrictHTTPHandlerFunc, operationID string) nethttp.StrictHTTPHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (response interface{}, err error) {
+		namespace, ok := ctx.Value(authn.ContextKeyNamespace).(string)
+		if !ok {
+			return f(ctx, w, r, request)
+		}
+
+		ctx = context.WithValue(ctx, ContextKeyAccountNamespace, namespace)
+
+		if _, ok := accountOperations[operationID]; ok {
+			return f(ctx, w, r, request)
+		}
+
+		rawSpaceID := r.URL.Query().Get("space")
+		if rawSpaceID == "" {
+			return f(ctx, w, r, request)
+		}
+
+		log := telemetry.Logger(ctx, c.log).With("namespace", namespace, "operationID", operationID)
+
+		spaceID, err := strconv.Atoi(rawSpaceID)
+		if err != nil {
+			log.Debug("Could not parse space ID", "err", errors.Join(errInvalidSpace, err))
+
+			return nil, errInvalidSpace
+		}
+
+		space, err := c.persister.GetSpace(ctx, int32(spaceID), namespace)
+		if err != nil {
+			log.Debug("Could not get space", "err", err)
+
+			return nil, err
+		}
+
+		if _, ok := ownerOperations[operationID]; ok && space.Role != models.SpaceRoleOwner {
+			log.Debug("Role in space does not allow managing it", "spaceID", spaceID, "role", space.Role)
+
+			return nil, persisters.ErrInsufficientSpaceRole
+		}
+
+		if r.Method != http.MethodGet && !canWriteToSpace(space.Role) {
+			log.Debug("Role in space does not allow writing", "spaceID", spaceID, "role", space.Role)
+
+			return nil, persisters.ErrInsufficientSpaceRole
+		}
+
+		log.Debug("Authorized space", "spaceID", spaceID, "role", space.Role)
+
+		return f(context.WithValue(ctx, authn.ContextKeyNamespace, space.Namespace), w, r, request)
+	}
 }
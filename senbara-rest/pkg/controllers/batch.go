@@ -0,0 +1,157 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+)
+
+const (
+	// maxBatchOperations is the number of operations that can be applied in one batch
+	maxBatchOperations = 1000
+)
+
+var (
+	errMissingBatchOperationID = errors.New("updates and deletions require either an ID or a reference")
+	errTooManyBatchOperations  = errors.New("too many batch operations")
+)
+
+func (c *Controller) PostBatch(ctx context.Context, request api.PostBatchRequestObject) (api.PostBatchResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling post batch", "len", len(request.Body.Operations))
+
+	if len(request.Body.Operations) > maxBatchOperations {
+		log.Debug("Could not apply batch", "err", errTooManyBatchOperations)
+
+		return nil, errTooManyBatchOperations
+	}
+
+	operations := []models.BatchOperation{}
+	for i, rawOperation := range request.Body.Operations {
+		operation, err := getBatchOperation(rawOperation)
+		if err != nil {
+			log.Debug("Could not parse batch operation", "index", i, "err", err)
+
+			return api.PostBatch200JSONResponse(getFailedBatchResult(len(request.Body.Operations), i, err)), nil
+		}
+
+		operations = append(operations, operation)
+	}
+
+	ids, failed, err := c.persister.ApplyBatch(ctx, operations, namespace)
+	if err != nil {
+		if errors.Is(err, persisters.ErrBatchEntityDoesNotExist) ||
+			errors.Is(err, persisters.ErrContactDoesNotExist) ||
+			errors.Is(err, persisters.ErrInvalidBatchReference) ||
+			errors.Is(err, persisters.ErrUnknownSyncMutation) {
+			log.Debug("Could not apply batch operation", "index", failed, "err", err)
+
+			return api.PostBatch200JSONResponse(getFailedBatchResult(len(operations), failed, err)), nil
+		}
+
+		log.Warn("Could not apply batch in DB", "index", failed, "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		return nil, errors.Join(errCouldNotUpdateInDB, err)
+	}
+
+	res := api.BatchResult{
+		Committed: true,
+		Results:   []api.BatchOperationResult{},
+	}
+	for _, id := range ids {
+		rawID := int64(id)
+
+		res.Results = append(res.Results, api.BatchOperationResult{
+			Id:     &rawID,
+			Status: api.BatchOperationStatusApplied,
+		})
+	}
+
+	return api.PostBatch200JSONResponse(res), nil
+}
+
+func getBatchOperation(rawOperation api.BatchOperation) (models.BatchOperation, error) {
+	operation := models.BatchOperation{
+		Mutation: models.SyncMutation{
+			EntityType: string(rawOperation.EntityType),
+			Operation:  string(rawOperation.Operation),
+		},
+	}
+
+	if rawOperation.Operation != api.Create {
+		switch {
+		case rawOperation.Id != nil && rawOperation.Ref == nil:
+			operation.Mutation.ID = int32(*rawOperation.Id)
+
+		case rawOperation.Id == nil && rawOperation.Ref != nil:
+			if *rawOperation.Ref < 0 {
+				return models.BatchOperation{}, persisters.ErrInvalidBatchReference
+			}
+
+			ref := int(*rawOperation.Ref)
+			operation.IDReference = &ref
+
+		default:
+			return models.BatchOperation{}, errMissingBatchOperationID
+		}
+	}
+
+	if v := rawOperation.ContactRef; v != nil {
+		if *v < 0 {
+			return models.BatchOperation{}, persisters.ErrInvalidBatchReference
+		}
+
+		ref := int(*v)
+		operation.ContactIDReference = &ref
+	}
+
+	if err := setSyncMutationData(&operation.Mutation, api.SyncMutation{
+		EntityType:   rawOperation.EntityType,
+		Operation:    rawOperation.Operation,
+		Contact:      rawOperation.Contact,
+		JournalEntry: rawOperation.JournalEntry,
+		Debt:         rawOperation.Debt,
+		Activity:     rawOperation.Activity,
+	}); err != nil {
+		return models.BatchOperation{}, err
+	}
+
+	return operation, nil
+}
+
+// getFailedBatchResult returns the results of a batch that wasn't committed because one of its operations failed
+func getFailedBatchResult(count, failed int, err error) api.BatchResult {
+	res := api.BatchResult{
+		Committed: false,
+		Results:   []api.BatchOperationResult{},
+	}
+
+	for i := range count {
+		result := api.BatchOperationResult{}
+		switch {
+		case i < failed:
+			result.Status = api.BatchOperationStatusRolledBack
+
+		case i == failed:
+			detail := err.Error()
+
+			result.Status = api.BatchOperationStatusFailed
+			result.Detail = &detail
+
+		default:
+			result.Status = api.BatchOperationStatusSkipped
+		}
+
+		res.Results = append(res.Results, result)
+	}
+
+	return res
+}
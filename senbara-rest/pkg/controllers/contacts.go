@@ -2,18 +2,22 @@ package controllers
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"log/slog"
 	"time"
 
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 )
 
 func (c *Controller) GetContacts(ctx context.Context, request api.GetContactsRequestObject) (api.GetContactsResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling get contacts")
 
@@ -21,7 +25,7 @@ func (c *Controller) GetContacts(ctx context.Context, request api.GetContactsReq
 	if err != nil {
 		log.Warn("Could not get activity and contact from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
-		return api.GetContacts500TextResponse(errCouldNotFetchFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
 	}
 
 	contacts := []api.Contact{}
@@ -54,7 +58,7 @@ func (c *Controller) GetContacts(ctx context.Context, request api.GetContactsReq
 func (c *Controller) CreateContact(ctx context.Context, request api.CreateContactRequestObject) (api.CreateContactResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling create contact")
 
@@ -85,7 +89,7 @@ func (c *Controller) CreateContact(ctx context.Context, request api.CreateContac
 	if err != nil {
 		log.Warn("Could not create contact in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
-		return api.CreateContact500TextResponse(errCouldNotInsertIntoDB.Error()), nil
+		return nil, errors.Join(errCouldNotInsertIntoDB, err)
 	}
 
 	id := int64(createdContact.ID)
@@ -113,7 +117,7 @@ func (c *Controller) CreateContact(ctx context.Context, request api.CreateContac
 func (c *Controller) DeleteContact(ctx context.Context, request api.DeleteContactRequestObject) (api.DeleteContactResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling delete contact")
 
@@ -131,7 +135,7 @@ func (c *Controller) DeleteContact(ctx context.Context, request api.DeleteContac
 	if err != nil {
 		log.Warn("Could not delete contact from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
 
-		return api.DeleteContact500TextResponse(errCouldNotDeleteFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotDeleteFromDB, err)
 	}
 
 	return api.DeleteContact200JSONResponse(id), nil
@@ -140,7 +144,7 @@ func (c *Controller) DeleteContact(ctx context.Context, request api.DeleteContac
 func (c *Controller) GetContact(ctx context.Context, request api.GetContactRequestObject) (api.GetContactResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling get contact")
 
@@ -152,7 +156,7 @@ func (c *Controller) GetContact(ctx context.Context, request api.GetContactReque
 	if err != nil {
 		log.Warn("Could not get contact from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
-		return api.GetContact500TextResponse(errCouldNotFetchFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
 	}
 
 	log.Debug("Getting debts for contact from DB",
@@ -164,7 +168,7 @@ func (c *Controller) GetContact(ctx context.Context, request api.GetContactReque
 	if err != nil {
 		log.Warn("Could not get debts from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
-		return api.GetContact500TextResponse(errCouldNotFetchFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
 	}
 
 	log.Debug("Getting activities for contact from DB",
@@ -176,7 +180,7 @@ func (c *Controller) GetContact(ctx context.Context, request api.GetContactReque
 	if err != nil {
 		log.Warn("Could not get activities from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
-		return api.GetContact500TextResponse(errCouldNotFetchFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
 	}
 
 	activities := []api.Activity{}
@@ -235,83 +239,172 @@ func (c *Controller) GetContact(ctx context.Context, request api.GetContactReque
 func (c *Controller) UpdateContact(ctx context.Context, request api.UpdateContactRequestObject) (api.UpdateContactResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling update contact")
 
-	nickname := ""
-	if v := request.Body.Nickname; v != nil {
-		nickname = *v
+	updatedContact, err := c.updateContact(ctx, log, request.Id, *request.Body, namespace)
+	if err != nil {
+		return nil, err
 	}
 
-	address := ""
-	if v := request.Body.Address; v != nil {
-		address = *v
+	return api.UpdateContact200JSONResponse(updatedContact), nil
+}
+
+func (c *Controller) PatchContact(ctx context.Context, request api.PatchContactRequestObject) (api.PatchContactResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling patch contact")
+
+	schema, err := c.getRequestBodySchema("UpdateContact")
+	if err != nil {
+		log.Warn("Could not get schema of contact", "err", err)
+
+		return nil, err
 	}
 
-	notes := ""
-	if v := request.Body.Notes; v != nil {
-		notes = *v
+	log.Debug("Patching contact in DB",
+		"id", request.Id,
+	)
+
+	// The contact is locked while the patch is applied, so concurrent patches can't overwrite each other's changes
+	patchedContact, err := c.persister.PatchContact(ctx, int32(request.Id), namespace, func(rawContact models.Contact) (models.UpdateContactParams, error) {
+		var birthday *types.Date
+		if rawContact.Birthday.Valid {
+			birthday = &types.Date{
+				Time: rawContact.Birthday.Time,
+			}
+		}
+
+		body, err := applyMergePatch(api.UpdateContactJSONRequestBody{
+			Address:   &rawContact.Address,
+			Birthday:  birthday,
+			Email:     types.Email(rawContact.Email),
+			FirstName: rawContact.FirstName,
+			LastName:  rawContact.LastName,
+			Nickname:  &rawContact.Nickname,
+			Notes:     &rawContact.Notes,
+			Pronouns:  rawContact.Pronouns,
+		}, *request.Body, schema)
+		if err != nil {
+			return models.UpdateContactParams{}, err
+		}
+
+		return getUpdateContactParams(body), nil
+	})
+	if err != nil {
+		if errors.Is(err, errInvalidMergePatch) {
+			log.Debug("Could not apply merge patch to contact", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not patch contact in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		return nil, errors.Join(errCouldNotUpdateInDB, err)
 	}
 
+	return api.PatchContact200JSONResponse(getAPIContact(patchedContact)), nil
+}
+
+func (c *Controller) updateContact(ctx context.Context, log *slog.Logger, rawID int64, body api.UpdateContactJSONRequestBody, namespace string) (api.Contact, error) {
+	params := getUpdateContactParams(body)
+
 	var birthday *time.Time
-	if request.Body.Birthday != nil {
-		birthday = &request.Body.Birthday.Time
+	if params.Birthday.Valid {
+		birthday = &params.Birthday.Time
 	}
 
 	log.Debug("Updating contact in DB",
-		"id", request.Id,
-		"firstName", request.Body.FirstName,
-		"lastName", request.Body.LastName,
-		"nickname", nickname,
-		"email", request.Body.Email,
-		"pronouns", request.Body.Pronouns,
+		"id", rawID,
+		"firstName", params.FirstName,
+		"lastName", params.LastName,
+		"nickname", params.Nickname,
+		"email", params.Email,
+		"pronouns", params.Pronouns,
 		"birthday", birthday,
-		"address", address,
-		"notes", notes,
+		"address", params.Address,
+		"notes", params.Notes,
 	)
 
 	updatedContact, err := c.persister.UpdateContact(
 		ctx,
 
-		int32(request.Id),
+		int32(rawID),
 
-		request.Body.FirstName,
-		request.Body.LastName,
-		nickname,
-		string(request.Body.Email),
-		request.Body.Pronouns,
+		params.FirstName,
+		params.LastName,
+		params.Nickname,
+		params.Email,
+		params.Pronouns,
 
 		namespace,
 
 		birthday,
-		address,
-		notes,
+		params.Address,
+		params.Notes,
 	)
 	if err != nil {
 		log.Warn("Could not update contact in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
 
-		return api.UpdateContact500TextResponse(errCouldNotUpdateInDB.Error()), nil
+		return api.Contact{}, errors.Join(errCouldNotUpdateInDB, err)
+	}
+
+	return getAPIContact(updatedContact), nil
+}
+
+// getUpdateContactParams returns the params to update a contact with, without its ID and namespace
+func getUpdateContactParams(body api.UpdateContactJSONRequestBody) models.UpdateContactParams {
+	params := models.UpdateContactParams{
+		FirstName: body.FirstName,
+		LastName:  body.LastName,
+		Email:     string(body.Email),
+		Pronouns:  body.Pronouns,
 	}
 
-	id := int64(updatedContact.ID)
+	if v := body.Nickname; v != nil {
+		params.Nickname = *v
+	}
 
-	var updatedBirthday *types.Date
-	if updatedContact.Birthday.Valid {
-		updatedBirthday = &types.Date{
-			Time: updatedContact.Birthday.Time,
+	if v := body.Address; v != nil {
+		params.Address = *v
+	}
+
+	if v := body.Notes; v != nil {
+		params.Notes = *v
+	}
+
+	if body.Birthday != nil {
+		params.Birthday = sql.NullTime{
+			Time:  body.Birthday.Time,
+			Valid: true,
 		}
 	}
 
-	return api.UpdateContact200JSONResponse{
-		Address:   &updatedContact.Address,
-		Birthday:  updatedBirthday,
-		Email:     (*types.Email)(&updatedContact.Email),
-		FirstName: &updatedContact.FirstName,
+	return params
+}
+
+func getAPIContact(contact models.Contact) api.Contact {
+	id := int64(contact.ID)
+
+	var birthday *types.Date
+	if contact.Birthday.Valid {
+		birthday = &types.Date{
+			Time: contact.Birthday.Time,
+		}
+	}
+
+	return api.Contact{
+		Address:   &contact.Address,
+		Birthday:  birthday,
+		Email:     (*types.Email)(&contact.Email),
+		FirstName: &contact.FirstName,
 		Id:        &id,
-		LastName:  &updatedContact.LastName,
-		Nickname:  &updatedContact.Nickname,
-		Notes:     &updatedContact.Notes,
-		Pronouns:  &updatedContact.Pronouns,
-	}, nil
+		LastName:  &contact.LastName,
+		Nickname:  &contact.Nickname,
+		Notes:     &contact.Notes,
+		Pronouns:  &contact.Pronouns,
+	}
 }
@@ -3,16 +3,82 @@ package controllers
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"math"
+	"time"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 )
 
+func (c *Controller) GetDebts(ctx context.Context, request api.GetDebtsRequestObject) (api.GetDebtsResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling get debts")
+
+	var contactIDFilter int32
+	if v := request.Params.ContactId; v != nil {
+		contactIDFilter = int32(*v)
+	}
+
+	currency := ""
+	if v := request.Params.Currency; v != nil {
+		currency = *v
+	}
+
+	var startDate *time.Time
+	if v := request.Params.StartDate; v != nil {
+		startDate = &v.Time
+	}
+
+	var endDate *time.Time
+	if v := request.Params.EndDate; v != nil {
+		endDate = &v.Time
+	}
+
+	query := ""
+	if v := request.Params.Query; v != nil {
+		query = *v
+	}
+
+	rawDebts, err := c.persister.GetDebtsForNamespace(ctx, namespace, contactIDFilter, currency, request.Params.YouOwe, startDate, endDate, query)
+	if err != nil {
+		log.Warn("Could not get debts from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	debts := []api.DebtWithContact{}
+	for _, rawDebt := range rawDebts {
+		var (
+			debtID    = int64(rawDebt.DebtID)
+			contactID = int64(rawDebt.ContactID)
+			amount    = float32(rawDebt.Amount)
+		)
+
+		debts = append(debts, api.DebtWithContact{
+			Amount:      &amount,
+			ContactId:   &contactID,
+			Currency:    &rawDebt.Currency,
+			DebtId:      &debtID,
+			Description: &rawDebt.Description,
+			CreatedAt:   &rawDebt.CreatedAt,
+			FirstName:   &rawDebt.FirstName,
+			LastName:    &rawDebt.LastName,
+		})
+	}
+
+	return api.GetDebts200JSONResponse(debts), nil
+}
+
 func (c *Controller) CreateDebt(ctx context.Context, request api.CreateDebtRequestObject) (api.CreateDebtResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling create debt")
 
@@ -46,7 +112,7 @@ func (c *Controller) CreateDebt(ctx context.Context, request api.CreateDebtReque
 	if err != nil {
 		log.Warn("Could not create deb in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
-		return api.CreateDebt500TextResponse(errCouldNotInsertIntoDB.Error()), nil
+		return nil, errors.Join(errCouldNotInsertIntoDB, err)
 	}
 
 	id := int64(createdDebt.ID)
@@ -63,7 +129,7 @@ func (c *Controller) CreateDebt(ctx context.Context, request api.CreateDebtReque
 func (c *Controller) SettleDebt(ctx context.Context, request api.SettleDebtRequestObject) (api.SettleDebtResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling settle debt")
 
@@ -81,7 +147,7 @@ func (c *Controller) SettleDebt(ctx context.Context, request api.SettleDebtReque
 	if err != nil {
 		log.Warn("Could not settle debt in DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
 
-		return api.SettleDebt500TextResponse(errCouldNotDeleteFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotDeleteFromDB, err)
 	}
 
 	return api.SettleDebt200JSONResponse(id), nil
@@ -90,51 +156,122 @@ func (c *Controller) SettleDebt(ctx context.Context, request api.SettleDebtReque
 func (c *Controller) UpdateDebt(ctx context.Context, request api.UpdateDebtRequestObject) (api.UpdateDebtResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling update debt")
 
-	amount := math.Abs(float64(request.Body.Amount))
-	if request.Body.YouOwe {
-		amount = -amount
+	updatedDebt, err := c.updateDebt(ctx, log, request.Id, *request.Body, namespace)
+	if err != nil {
+		return nil, err
 	}
 
-	description := ""
-	if v := request.Body.Description; v != nil {
-		description = *v
+	return api.UpdateDebt200JSONResponse(updatedDebt), nil
+}
+
+func (c *Controller) PatchDebt(ctx context.Context, request api.PatchDebtRequestObject) (api.PatchDebtResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling patch debt")
+
+	schema, err := c.getRequestBodySchema("UpdateDebt")
+	if err != nil {
+		log.Warn("Could not get schema of debt", "err", err)
+
+		return nil, err
 	}
 
-	log.Debug("Updating debt in DB",
+	log.Debug("Patching debt in DB",
 		"id", request.Id,
-		"amount", amount,
-		"currency", request.Body.Currency,
-		"description", description,
+	)
+
+	// The debt is locked while the patch is applied, so concurrent patches can't overwrite each other's changes
+	patchedDebt, err := c.persister.PatchDebt(ctx, int32(request.Id), namespace, func(debtAndContact models.GetDebtAndContactForUpdateRow) (models.UpdateDebtParams, error) {
+		// Debts that the user owes are stored with a negative amount
+		body, err := applyMergePatch(api.UpdateDebtJSONRequestBody{
+			Amount:      float32(math.Abs(debtAndContact.Amount)),
+			Currency:    debtAndContact.Currency,
+			Description: &debtAndContact.Description,
+			YouOwe:      debtAndContact.Amount <= 0,
+		}, *request.Body, schema)
+		if err != nil {
+			return models.UpdateDebtParams{}, err
+		}
+
+		return getUpdateDebtParams(body), nil
+	})
+	if err != nil {
+		if errors.Is(err, errInvalidMergePatch) {
+			log.Debug("Could not apply merge patch to debt", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not patch debt in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		return nil, errors.Join(errCouldNotUpdateInDB, err)
+	}
+
+	return api.PatchDebt200JSONResponse(getAPIDebt(patchedDebt)), nil
+}
+
+func (c *Controller) updateDebt(ctx context.Context, log *slog.Logger, rawID int64, body api.UpdateDebtJSONRequestBody, namespace string) (api.Debt, error) {
+	params := getUpdateDebtParams(body)
+
+	log.Debug("Updating debt in DB",
+		"id", rawID,
+		"amount", params.Amount,
+		"currency", params.Currency,
+		"description", params.Description,
 	)
 
 	updatedDebt, err := c.persister.UpdateDebt(
 		ctx,
 
-		int32(request.Id),
+		int32(rawID),
 
 		namespace,
 
-		amount,
-		request.Body.Currency,
-		description,
+		params.Amount,
+		params.Currency,
+		params.Description,
 	)
 	if err != nil {
 		log.Warn("Could not update debt in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
 
-		return api.UpdateDebt500TextResponse(errCouldNotUpdateInDB.Error()), nil
+		return api.Debt{}, errors.Join(errCouldNotUpdateInDB, err)
 	}
 
-	id := int64(updatedDebt.ID)
-	updatedAmount := float32(updatedDebt.Amount)
+	return getAPIDebt(updatedDebt), nil
+}
 
-	return api.UpdateDebt200JSONResponse{
-		Amount:      &updatedAmount,
-		Currency:    &updatedDebt.Currency,
-		Description: &updatedDebt.Description,
+// getUpdateDebtParams returns the params to update a debt with, without its ID and namespace
+func getUpdateDebtParams(body api.UpdateDebtJSONRequestBody) models.UpdateDebtParams {
+	params := models.UpdateDebtParams{
+		Amount:   math.Abs(float64(body.Amount)),
+		Currency: body.Currency,
+	}
+
+	if body.YouOwe {
+		params.Amount = -params.Amount
+	}
+
+	if v := body.Description; v != nil {
+		params.Description = *v
+	}
+
+	return params
+}
+
+func getAPIDebt(debt models.UpdateDebtRow) api.Debt {
+	id := int64(debt.ID)
+	amount := float32(debt.Amount)
+
+	return api.Debt{
+		Amount:      &amount,
+		Currency:    &debt.Currency,
+		Description: &debt.Description,
 		Id:          &id,
-	}, nil
+	}
 }
@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/server"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+)
+
+const (
+	// keepAliveInterval is how often a comment is sent on idle event streams so that proxies don't close them
+	keepAliveInterval = 30 * time.Second
+)
+
+// eventStream writes changes as Server-Sent Events until the request is cancelled, the subscription is closed or
+// the server shuts down, after which clients reconnect to another instance or once the server is back
+type eventStream struct {
+	ctx     context.Context
+	changes <-chan models.Change
+}
+
+func (s eventStream) VisitGetEventsResponse(w http.ResponseWriter) error {
+	rc := http.NewResponseController(w)
+
+	// Event streams are long-lived, so the server's write timeout must not apply to them
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return nil
+
+		case <-server.ShuttingDown(s.ctx):
+			return nil
+
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+
+		case change, ok := <-s.changes:
+			// The subscription was closed and changes might have been missed, so the client has to reconnect and reload
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(api.Change{
+				EntityType: api.ChangeEntityType(change.EntityType),
+				Id:         int64(change.ID),
+				Operation:  api.ChangeOperation(change.Operation),
+			})
+			if err != nil {
+				return err
+			}
+
+			if _, err := fmt.Fprintf(w, "event: change\ndata: %s\n\n", data); err != nil {
+				return err
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Controller) GetEvents(ctx context.Context, request api.GetEventsRequestObject) (api.GetEventsResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling get events")
+
+	changes, err := c.persister.SubscribeToChanges(ctx, namespace)
+	if err != nil {
+		log.Warn("Could not subscribe to changes in DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	return eventStream{
+		ctx:     ctx,
+		changes: changes,
+	}, nil
+}
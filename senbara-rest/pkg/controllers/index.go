@@ -32,6 +32,7 @@ type Controller struct {
 
 	oidcDiscoveryURL                   string
 	oidcDcrInitialAccessTokenPortalUrl string
+	oidcAudience                       string
 
 	privacyURL string
 	tosURL     string
@@ -56,6 +57,7 @@ func NewController(
 
 	oidcIssuer,
 	oidcDcrInitialAccessTokenPortalUrl,
+	oidcAudience,
 
 	privacyURL,
 	tosURL,
@@ -79,6 +81,7 @@ func NewController(
 
 		oidcDiscoveryURL:                   strings.TrimSuffix(oidcIssuer, "/") + authn.OIDCWellKnownURLSuffix,
 		oidcDcrInitialAccessTokenPortalUrl: oidcDcrInitialAccessTokenPortalUrl,
+		oidcAudience:                       oidcAudience,
 
 		privacyURL: privacyURL,
 		tosURL:     tosURL,
@@ -2,16 +2,20 @@ package controllers
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"log/slog"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 )
 
 func (c *Controller) GetJournalEntries(ctx context.Context, request api.GetJournalEntriesRequestObject) (api.GetJournalEntriesResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling get journal entries")
 
@@ -19,19 +23,28 @@ func (c *Controller) GetJournalEntries(ctx context.Context, request api.GetJourn
 	if err != nil {
 		log.Warn("Could not get journal entries from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
-		return api.GetJournalEntries500TextResponse(errCouldNotFetchFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
 	}
 
 	entries := []api.JournalEntry{}
 	for _, rawEntry := range rawEntries {
 		id := int64(rawEntry.ID)
 
+		encrypted, encryption, err := getJournalEntryEncryptionEnvelope(rawEntry)
+		if err != nil {
+			log.Warn("Could not get journal entry encryption envelope", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+			return nil, errors.Join(errCouldNotFetchFromDB, err)
+		}
+
 		entries = append(entries, api.JournalEntry{
-			Body:   &rawEntry.Body,
-			Date:   &rawEntry.Date,
-			Id:     &id,
-			Rating: &rawEntry.Rating,
-			Title:  &rawEntry.Title,
+			Body:       &rawEntry.Body,
+			Date:       &rawEntry.Date,
+			Encrypted:  &encrypted,
+			Encryption: encryption,
+			Id:         &id,
+			Rating:     &rawEntry.Rating,
+			Title:      &rawEntry.Title,
 		})
 	}
 
@@ -41,13 +54,16 @@ func (c *Controller) GetJournalEntries(ctx context.Context, request api.GetJourn
 func (c *Controller) CreateJournalEntry(ctx context.Context, request api.CreateJournalEntryRequestObject) (api.CreateJournalEntryResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling create journal entry")
 
+	encryptionAlgorithm, encryptionKDF, encryptionSalt := getJournalEntryEncryption(request.Body.Encryption)
+
 	log.Debug("Creating journal entry in DB",
 		"title", request.Body.Title,
 		"rating", request.Body.Body,
+		"encryptionAlgorithm", encryptionAlgorithm,
 	)
 
 	createdJournalEntry, err := c.persister.CreateJournalEntry(
@@ -58,28 +74,41 @@ func (c *Controller) CreateJournalEntry(ctx context.Context, request api.CreateJ
 		request.Body.Rating,
 
 		namespace,
+
+		encryptionAlgorithm,
+		encryptionKDF,
+		encryptionSalt,
 	)
 	if err != nil {
 		log.Warn("Could not create journal entry in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
-		return api.CreateJournalEntry500TextResponse(errCouldNotInsertIntoDB.Error()), nil
+		return nil, errors.Join(errCouldNotInsertIntoDB, err)
 	}
 
 	id := int64(createdJournalEntry.ID)
 
+	encrypted, encryption, err := getJournalEntryEncryptionEnvelope(createdJournalEntry)
+	if err != nil {
+		log.Warn("Could not get journal entry encryption envelope", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
 	return api.CreateJournalEntry200JSONResponse{
-		Body:   &createdJournalEntry.Body,
-		Date:   &createdJournalEntry.Date,
-		Id:     &id,
-		Rating: &createdJournalEntry.Rating,
-		Title:  &createdJournalEntry.Title,
+		Body:       &createdJournalEntry.Body,
+		Date:       &createdJournalEntry.Date,
+		Encrypted:  &encrypted,
+		Encryption: encryption,
+		Id:         &id,
+		Rating:     &createdJournalEntry.Rating,
+		Title:      &createdJournalEntry.Title,
 	}, nil
 }
 
 func (c *Controller) DeleteJournalEntry(ctx context.Context, request api.DeleteJournalEntryRequestObject) (api.DeleteJournalEntryResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling delete journal entry")
 
@@ -97,7 +126,7 @@ func (c *Controller) DeleteJournalEntry(ctx context.Context, request api.DeleteJ
 	if err != nil {
 		log.Warn("Could not delete journal entry from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
 
-		return api.DeleteJournalEntry500TextResponse(errCouldNotDeleteFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotDeleteFromDB, err)
 	}
 
 	return api.DeleteJournalEntry200JSONResponse(id), nil
@@ -106,7 +135,7 @@ func (c *Controller) DeleteJournalEntry(ctx context.Context, request api.DeleteJ
 func (c *Controller) GetJournalEntry(ctx context.Context, request api.GetJournalEntryRequestObject) (api.GetJournalEntryResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling get journal entry")
 
@@ -118,56 +147,197 @@ func (c *Controller) GetJournalEntry(ctx context.Context, request api.GetJournal
 	if err != nil {
 		log.Warn("Could not get contact from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
 
-		return api.GetJournalEntry500TextResponse(errCouldNotFetchFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
 	}
 
 	id := int64(rawJournalEntry.ID)
 
+	encrypted, encryption, err := getJournalEntryEncryptionEnvelope(rawJournalEntry)
+	if err != nil {
+		log.Warn("Could not get journal entry encryption envelope", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
 	return api.GetJournalEntry200JSONResponse{
-		Body:   &rawJournalEntry.Body,
-		Date:   &rawJournalEntry.Date,
-		Id:     &id,
-		Rating: &rawJournalEntry.Rating,
-		Title:  &rawJournalEntry.Title,
+		Body:       &rawJournalEntry.Body,
+		Date:       &rawJournalEntry.Date,
+		Encrypted:  &encrypted,
+		Encryption: encryption,
+		Id:         &id,
+		Rating:     &rawJournalEntry.Rating,
+		Title:      &rawJournalEntry.Title,
 	}, nil
 }
 
 func (c *Controller) UpdateJournalEntry(ctx context.Context, request api.UpdateJournalEntryRequestObject) (api.UpdateJournalEntryResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling update journal entry")
 
-	log.Debug("Updating journal entry in DB",
+	updatedJournalEntry, err := c.updateJournalEntry(ctx, log, request.Id, *request.Body, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.UpdateJournalEntry200JSONResponse(updatedJournalEntry), nil
+}
+
+func (c *Controller) PatchJournalEntry(ctx context.Context, request api.PatchJournalEntryRequestObject) (api.PatchJournalEntryResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling patch journal entry")
+
+	schema, err := c.getRequestBodySchema("UpdateJournalEntry")
+	if err != nil {
+		log.Warn("Could not get schema of journal entry", "err", err)
+
+		return nil, err
+	}
+
+	log.Debug("Patching journal entry in DB",
 		"id", request.Id,
-		"title", request.Body.Title,
-		"rating", request.Body.Rating,
+	)
+
+	// The journal entry is locked while the patch is applied, so concurrent patches can't overwrite each other's changes
+	patchedJournalEntry, err := c.persister.PatchJournalEntry(ctx, int32(request.Id), namespace, func(rawJournalEntry models.JournalEntry) (models.UpdateJournalEntryParams, error) {
+		_, encryption, err := getJournalEntryEncryptionEnvelope(rawJournalEntry)
+		if err != nil {
+			return models.UpdateJournalEntryParams{}, err
+		}
+
+		body, err := applyMergePatch(api.UpdateJournalEntryJSONRequestBody{
+			Body:       rawJournalEntry.Body,
+			Encryption: encryption,
+			Rating:     rawJournalEntry.Rating,
+			Title:      rawJournalEntry.Title,
+		}, *request.Body, schema)
+		if err != nil {
+			return models.UpdateJournalEntryParams{}, err
+		}
+
+		return getUpdateJournalEntryParams(body), nil
+	})
+	if err != nil {
+		if errors.Is(err, errInvalidMergePatch) {
+			log.Debug("Could not apply merge patch to journal entry", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not patch journal entry in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		return nil, errors.Join(errCouldNotUpdateInDB, err)
+	}
+
+	journalEntry, err := getAPIJournalEntry(patchedJournalEntry)
+	if err != nil {
+		log.Warn("Could not get journal entry encryption envelope", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	return api.PatchJournalEntry200JSONResponse(journalEntry), nil
+}
+
+func (c *Controller) updateJournalEntry(ctx context.Context, log *slog.Logger, rawID int64, body api.UpdateJournalEntryJSONRequestBody, namespace string) (api.JournalEntry, error) {
+	params := getUpdateJournalEntryParams(body)
+
+	log.Debug("Updating journal entry in DB",
+		"id", rawID,
+		"title", params.Title,
+		"rating", params.Rating,
+		"encryptionAlgorithm", params.EncryptionAlgorithm,
 	)
 
 	updatedJournalEntry, err := c.persister.UpdateJournalEntry(
 		ctx,
 
-		int32(request.Id),
-		request.Body.Title,
-		request.Body.Body,
-		request.Body.Rating,
+		int32(rawID),
+		params.Title,
+		params.Body,
+		params.Rating,
 
 		namespace,
+
+		params.EncryptionAlgorithm,
+		params.EncryptionKdf,
+		params.EncryptionSalt,
 	)
 	if err != nil {
 		log.Warn("Could not update journal entry in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
-		return api.UpdateJournalEntry500TextResponse(errCouldNotInsertIntoDB.Error()), nil
+		return api.JournalEntry{}, errors.Join(errCouldNotInsertIntoDB, err)
+	}
+
+	journalEntry, err := getAPIJournalEntry(updatedJournalEntry)
+	if err != nil {
+		log.Warn("Could not get journal entry encryption envelope", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return api.JournalEntry{}, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	return journalEntry, nil
+}
+
+// getUpdateJournalEntryParams returns the params to update a journal entry with, without its ID and namespace
+func getUpdateJournalEntryParams(body api.UpdateJournalEntryJSONRequestBody) models.UpdateJournalEntryParams {
+	encryptionAlgorithm, encryptionKDF, encryptionSalt := getJournalEntryEncryption(body.Encryption)
+
+	return models.UpdateJournalEntryParams{
+		Title:               body.Title,
+		Body:                body.Body,
+		Rating:              body.Rating,
+		EncryptionAlgorithm: encryptionAlgorithm,
+		EncryptionKdf:       encryptionKDF,
+		EncryptionSalt:      encryptionSalt,
+	}
+}
+
+func getAPIJournalEntry(journalEntry models.JournalEntry) (api.JournalEntry, error) {
+	id := int64(journalEntry.ID)
+
+	encrypted, encryption, err := getJournalEntryEncryptionEnvelope(journalEntry)
+	if err != nil {
+		return api.JournalEntry{}, err
+	}
+
+	return api.JournalEntry{
+		Body:       &journalEntry.Body,
+		Date:       &journalEntry.Date,
+		Encrypted:  &encrypted,
+		Encryption: encryption,
+		Id:         &id,
+		Rating:     &journalEntry.Rating,
+		Title:      &journalEntry.Title,
+	}, nil
+}
+
+func getJournalEntryEncryption(envelope *api.EncryptionEnvelope) (algorithm, kdf, salt string) {
+	if envelope == nil {
+		return "", "", ""
 	}
 
-	id := int64(updatedJournalEntry.ID)
+	return string(envelope.Algorithm), string(envelope.Kdf), base64.StdEncoding.EncodeToString(envelope.Salt)
+}
+
+func getJournalEntryEncryptionEnvelope(journalEntry models.JournalEntry) (bool, *api.EncryptionEnvelope, error) {
+	if journalEntry.EncryptionAlgorithm == "" {
+		return false, nil, nil
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(journalEntry.EncryptionSalt)
+	if err != nil {
+		return false, nil, err
+	}
 
-	return api.UpdateJournalEntry200JSONResponse{
-		Body:   &updatedJournalEntry.Body,
-		Date:   &updatedJournalEntry.Date,
-		Id:     &id,
-		Rating: &updatedJournalEntry.Rating,
-		Title:  &updatedJournalEntry.Title,
+	return true, &api.EncryptionEnvelope{
+		Algorithm: api.EncryptionEnvelopeAlgorithm(journalEntry.EncryptionAlgorithm),
+		Kdf:       api.EncryptionEnvelopeKdf(journalEntry.EncryptionKdf),
+		Salt:      salt,
 	}, nil
 }
@@ -25,6 +25,10 @@ func (c *Controller) GetOpenAPISpec(ctx context.Context, request api.GetOpenAPIS
 		s.Components.SecuritySchemes["oidc"].Value.Extensions[api.OidcDcrInitialAccessTokenPortalUrlExtensionKey] = c.oidcDcrInitialAccessTokenPortalUrl
 	}
 
+	if c.oidcAudience != "" {
+		s.Components.SecuritySchemes["oidc"].Value.Extensions[api.OidcAudienceExtensionKey] = c.oidcAudience
+	}
+
 	reader, writer := io.Pipe()
 	enc := json.NewEncoder(writer)
 	go func() {
@@ -0,0 +1,18 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	"github.com/pojntfx/senbara/senbara-common/pkg/admin"
+)
+
+// SetOperation records which operation handles a request so that request metrics can be grouped by operation
+func (c *Controller) SetOperation(f nethttp.StrictHTTPHandlerFunc, operationID string) nethttp.StrictHTTPHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (response any, err error) {
+		admin.SetOperation(ctx, operationID)
+
+		return f(ctx, w, r, request)
+	}
+}
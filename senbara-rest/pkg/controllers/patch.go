@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+var (
+	errInvalidMergePatch        = errors.New("invalid merge patch")
+	errInvalidPatchedEntity     = errors.New("patched entity is missing required fields or has invalid values")
+	errMissingRequestBodySchema = errors.New("missing request body schema")
+)
+
+// applyMergePatch applies a JSON merge patch (see RFC 7396) to the JSON representation of `target`
+// and decodes the result into a new value, so fields that the patch removes are reset. Since a patch
+// can remove required fields or set invalid values, the result is validated against `schema` first.
+func applyMergePatch[T any](target T, patch map[string]interface{}, schema *openapi3.Schema) (T, error) {
+	var patched T
+
+	rawTarget, err := json.Marshal(target)
+	if err != nil {
+		return patched, errors.Join(errInvalidMergePatch, err)
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal(rawTarget, &document); err != nil {
+		return patched, errors.Join(errInvalidMergePatch, err)
+	}
+
+	document = mergePatch(document, patch)
+
+	if err := schema.VisitJSON(document); err != nil {
+		return patched, errors.Join(errInvalidMergePatch, errInvalidPatchedEntity, err)
+	}
+
+	rawPatched, err := json.Marshal(document)
+	if err != nil {
+		return patched, errors.Join(errInvalidMergePatch, err)
+	}
+
+	if err := json.Unmarshal(rawPatched, &patched); err != nil {
+		return patched, errors.Join(errInvalidMergePatch, err)
+	}
+
+	return patched, nil
+}
+
+func mergePatch(document, patch map[string]interface{}) map[string]interface{} {
+	if document == nil {
+		document = map[string]interface{}{}
+	}
+
+	for key, value := range patch {
+		if value == nil {
+			delete(document, key)
+
+			continue
+		}
+
+		// Objects are merged recursively, all other values replace the current value
+		if rawPatch, ok := value.(map[string]interface{}); ok {
+			rawDocument, _ := document[key].(map[string]interface{})
+
+			document[key] = mergePatch(rawDocument, rawPatch)
+
+			continue
+		}
+
+		document[key] = value
+	}
+
+	return document
+}
+
+// getRequestBodySchema returns the schema of the JSON request body of an operation, e.g. to validate
+// patched entities against the schema of the operation that replaces them
+func (c *Controller) getRequestBodySchema(operationID string) (*openapi3.Schema, error) {
+	for _, pathItem := range c.spec.Paths.Map() {
+		for _, operation := range pathItem.Operations() {
+			if operation.OperationID != operationID {
+				continue
+			}
+
+			if operation.RequestBody == nil || operation.RequestBody.Value == nil {
+				return nil, errMissingRequestBodySchema
+			}
+
+			mediaType := operation.RequestBody.Value.Content.Get("application/json")
+			if mediaType == nil || mediaType.Schema == nil || mediaType.Schema.Value == nil {
+				return nil, errMissingRequestBodySchema
+			}
+
+			return mediaType.Schema.Value, nil
+		}
+	}
+
+	return nil, errMissingRequestBodySchema
+}
@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/lib/pq"
+	middleware "github.com/oapi-codegen/nethttp-middleware"
+	"github.com/pojntfx/senbara/senbara-common/pkg/admin"
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"github.com/pojntfx/senbara/senbara-common/pkg/webhooks"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+)
+
+const (
+	// See https://www.postgresql.org/docs/current/errcodes-appendix.html
+	pqCodeUniqueViolation = "23505"
+)
+
+var (
+	errResourceDoesNotExist    = errors.New("resource does not exist")
+	errResourceAlreadyExists   = errors.New("resource already exists")
+	errUnknownOperation        = errors.New("unknown operation")
+	errInvalidCredentials      = errors.New("missing or invalid credentials")
+	errCouldNotValidateRequest = errors.New("could not validate request")
+	errCouldNotDecodeRequest   = errors.New("could not decode request")
+	errServiceUnavailable      = errors.New("service is unavailable, please try again later")
+)
+
+// problemMapping maps errors that can be shown to clients to the problem type and status that they are returned with
+type problemMapping struct {
+	err    error
+	status int
+	typ    api.ProblemType
+}
+
+// problemMappings are checked in order, so if an error matches multiple mappings, the first one is used
+var problemMappings = []problemMapping{
+	{authn.ErrCouldNotLogin, http.StatusUnauthorized, api.ProblemTypeUnauthorized},
+	{persisters.ErrSessionRevoked, http.StatusUnauthorized, api.ProblemTypeUnauthorized},
+
+	{authn.ErrInsufficientScope, http.StatusForbidden, api.ProblemTypeForbidden},
+	{persisters.ErrInsufficientSpaceRole, http.StatusForbidden, api.ProblemTypeForbidden},
+
+	{persisters.ErrSpaceDoesNotExist, http.StatusNotFound, api.ProblemTypeNotFound},
+	{persisters.ErrInvitationDoesNotExist, http.StatusNotFound, api.ProblemTypeNotFound},
+	{persisters.ErrContactDoesNotExist, http.StatusNotFound, api.ProblemTypeNotFound},
+	{persisters.ErrAccessTokenDoesNotExist, http.StatusNotFound, api.ProblemTypeNotFound},
+	{persisters.ErrSessionDoesNotExist, http.StatusNotFound, api.ProblemTypeNotFound},
+	{persisters.ErrWebhookDoesNotExist, http.StatusNotFound, api.ProblemTypeNotFound},
+
+	{persisters.ErrCannotDeletePersonal, http.StatusConflict, api.ProblemTypeConflict},
+
+	{authn.ErrInvalidLogoutToken, http.StatusBadRequest, api.ProblemTypeBadRequest},
+
+	{errInvalidSpace, http.StatusUnprocessableEntity, api.ProblemTypeValidation},
+	{errCouldNotReadRequest, http.StatusUnprocessableEntity, api.ProblemTypeValidation},
+	{webhooks.ErrInvalidURL, http.StatusUnprocessableEntity, api.ProblemTypeValidation},
+	{webhooks.ErrForbiddenAddress, http.StatusUnprocessableEntity, api.ProblemTypeValidation},
+	{errInvalidSyncCursor, http.StatusUnprocessableEntity, api.ProblemTypeValidation},
+	{errInvalidPatchedEntity, http.StatusUnprocessableEntity, api.ProblemTypeValidation},
+	{errInvalidMergePatch, http.StatusUnprocessableEntity, api.ProblemTypeValidation},
+	{errTooManyBatchOperations, http.StatusUnprocessableEntity, api.ProblemTypeValidation},
+}
+
+// internalErrors are errors that describe which step of handling a request failed without exposing the underlying error
+var internalErrors = []error{
+	errCouldNotFetchFromDB,
+	errCouldNotEncodeResponse,
+	errCouldNotInsertIntoDB,
+	errCouldNotDeleteFromDB,
+	errCouldNotUpdateInDB,
+	errCouldNotStartTransaction,
+}
+
+func newProblem(r *http.Request, status int, typ api.ProblemType, detail error) api.Problem {
+	p := api.Problem{
+		Type:   typ,
+		Title:  http.StatusText(status),
+		Status: int32(status),
+	}
+
+	if detail != nil {
+		d := detail.Error()
+		p.Detail = &d
+	}
+
+	if r != nil {
+		instance := r.URL.Path
+		p.Instance = &instance
+	}
+
+	return p
+}
+
+// getProblem returns the problem details for an error. Only errors with a known problem type and
+// internal errors are shown to clients, all other errors are returned as generic internal errors.
+func getProblem(r *http.Request, err error) api.Problem {
+	for _, m := range problemMappings {
+		if errors.Is(err, m.err) {
+			return newProblem(r, m.status, m.typ, m.err)
+		}
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return newProblem(r, http.StatusNotFound, api.ProblemTypeNotFound, errResourceDoesNotExist)
+	}
+
+	if pqErr := (*pq.Error)(nil); errors.As(err, &pqErr) && pqErr.Code == pqCodeUniqueViolation {
+		return newProblem(r, http.StatusConflict, api.ProblemTypeConflict, errResourceAlreadyExists)
+	}
+
+	for _, internalErr := range internalErrors {
+		if errors.Is(err, internalErr) {
+			return newProblem(r, http.StatusInternalServerError, api.ProblemTypeInternal, internalErr)
+		}
+	}
+
+	return newProblem(r, http.StatusInternalServerError, api.ProblemTypeInternal, errCouldNotWriteResponse)
+}
+
+func (c *Controller) writeProblem(w http.ResponseWriter, p api.Problem) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(int(p.Status))
+
+	if err := json.NewEncoder(w).Encode(p); err != nil {
+		c.log.Warn("Could not encode problem", "err", errors.Join(errCouldNotEncodeResponse, err))
+	}
+}
+
+// HandleUnavailable writes the problem for requests that can't be handled because the services that the
+// controller needs, e.g. the database, aren't available yet
+func HandleUnavailable(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(http.StatusServiceUnavailable)
+
+	_ = json.NewEncoder(w).Encode(newProblem(r, http.StatusServiceUnavailable, api.ProblemTypeInternal, errServiceUnavailable))
+}
+
+// HandleResponseError writes errors returned by handlers and middlewares
+func (c *Controller) HandleResponseError(w http.ResponseWriter, r *http.Request, err error) {
+	p := getProblem(r, err)
+	if p.Status >= http.StatusInternalServerError {
+		telemetry.Logger(r.Context(), c.log).Warn("Could not handle request", "err", err)
+	} else {
+		telemetry.Logger(r.Context(), c.log).Debug("Could not handle request", "err", err, "status", p.Status)
+	}
+
+	c.writeProblem(w, p)
+}
+
+// HandleRequestError writes errors that occur while decoding requests
+func (c *Controller) HandleRequestError(w http.ResponseWriter, r *http.Request, err error) {
+	telemetry.Logger(r.Context(), c.log).Debug("Could not decode request", "err", err)
+
+	c.writeProblem(w, newProblem(r, http.StatusBadRequest, api.ProblemTypeBadRequest, errCouldNotDecodeRequest))
+}
+
+// HandleRequestValidationError writes errors for requests that don't match the OpenAPI spec
+func (c *Controller) HandleRequestValidationError(ctx context.Context, err error, w http.ResponseWriter, r *http.Request, opts middleware.ErrorHandlerOpts) {
+	telemetry.Logger(r.Context(), c.log).Debug("Could not validate request", "err", err, "status", opts.StatusCode)
+
+	// Requests that are rejected before reaching the strict handler are still recorded for their operation
+	if opts.MatchedRoute != nil && opts.MatchedRoute.Route != nil && opts.MatchedRoute.Route.Operation != nil {
+		admin.SetOperation(r.Context(), opts.MatchedRoute.Route.Operation.OperationID)
+	} else {
+		admin.SetOperation(r.Context(), admin.OperationUnknown)
+	}
+
+	switch opts.StatusCode {
+	case http.StatusNotFound:
+		c.writeProblem(w, newProblem(r, http.StatusNotFound, api.ProblemTypeNotFound, errUnknownOperation))
+
+	case http.StatusUnauthorized:
+		// Authentication errors are checked here before the operation is authorized
+		if p := getProblem(r, err); p.Status < http.StatusInternalServerError {
+			c.writeProblem(w, p)
+
+			return
+		}
+
+		c.writeProblem(w, newProblem(r, http.StatusUnauthorized, api.ProblemTypeUnauthorized, errInvalidCredentials))
+
+	case http.StatusBadRequest:
+		// Requests that can be parsed, but don't match the schema, are invalid instead of malformed. The
+		// first line of the error describes the failed validation without the whole schema.
+		detail := errors.New(strings.SplitN(err.Error(), "\n", 2)[0])
+
+		if schemaErr := (*openapi3.SchemaError)(nil); errors.As(err, &schemaErr) {
+			c.writeProblem(w, newProblem(r, http.StatusUnprocessableEntity, api.ProblemTypeValidation, detail))
+
+			return
+		}
+
+		c.writeProblem(w, newProblem(r, http.StatusBadRequest, api.ProblemTypeBadRequest, detail))
+
+	default:
+		telemetry.Logger(r.Context(), c.log).Warn("Could not validate request", "err", err)
+
+		c.writeProblem(w, newProblem(r, http.StatusInternalServerError, api.ProblemTypeInternal, errCouldNotValidateRequest))
+	}
+}
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+)
+
+func (c *Controller) GetSessions(ctx context.Context, request api.GetSessionsRequestObject) (api.GetSessionsResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling get sessions")
+
+	rawSessions, err := c.persister.GetSessions(ctx, namespace)
+	if err != nil {
+		log.Warn("Could not get sessions from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	currentSessionID, _ := ctx.Value(ContextKeySessionID).(int32)
+
+	sessions := []api.Session{}
+	for _, rawSession := range rawSessions {
+		var (
+			id      = int64(rawSession.ID)
+			current = rawSession.ID == currentSessionID
+		)
+
+		sessions = append(sessions, api.Session{
+			ClientId:   &rawSession.ClientID,
+			CreatedAt:  &rawSession.CreatedAt,
+			Current:    &current,
+			Id:         &id,
+			LastSeenAt: &rawSession.LastSeenAt,
+			UserAgent:  &rawSession.UserAgent,
+		})
+	}
+
+	return api.GetSessions200JSONResponse(sessions), nil
+}
+
+func (c *Controller) DeleteSessions(ctx context.Context, request api.DeleteSessionsRequestObject) (api.DeleteSessionsResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling delete sessions")
+
+	rawIDs, err := c.persister.RevokeSessions(ctx, namespace)
+	if err != nil {
+		log.Warn("Could not revoke sessions in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		return nil, errors.Join(errCouldNotUpdateInDB, err)
+	}
+
+	ids := []int64{}
+	for _, id := range rawIDs {
+		ids = append(ids, int64(id))
+	}
+
+	return api.DeleteSessions200JSONResponse(ids), nil
+}
+
+func (c *Controller) DeleteSession(ctx context.Context, request api.DeleteSessionRequestObject) (api.DeleteSessionResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling delete session")
+
+	log.Debug("Revoking session in DB",
+		"id", request.Id,
+	)
+
+	id, err := c.persister.RevokeSession(ctx, int32(request.Id), namespace)
+	if err != nil {
+		if errors.Is(err, persisters.ErrSessionDoesNotExist) {
+			log.Warn("Could not revoke session", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not revoke session in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		return nil, errors.Join(errCouldNotUpdateInDB, err)
+	}
+
+	return api.DeleteSession200JSONResponse(id), nil
+}
+
+func (c *Controller) BackchannelLogout(ctx context.Context, request api.BackchannelLogoutRequestObject) (api.BackchannelLogoutResponseObject, error) {
+	c.log.Debug("Handling back-channel logout")
+
+	logoutToken, err := c.authner.VerifyLogoutToken(ctx, request.Body.LogoutToken)
+	if err != nil {
+		c.log.Warn("Could not verify logout token", "err", err)
+
+		return nil, authn.ErrInvalidLogoutToken
+	}
+
+	ids, err := c.persister.RevokeOIDCSessions(ctx, logoutToken.Issuer, logoutToken.Subject, logoutToken.SessionID)
+	if err != nil {
+		c.log.Warn("Could not revoke sessions in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		return nil, errors.Join(errCouldNotUpdateInDB, err)
+	}
+
+	c.log.Debug("Revoked sessions", "ids", ids)
+
+	return api.BackchannelLogout200Response{}, nil
+}
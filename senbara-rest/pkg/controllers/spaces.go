@@ -0,0 +1,317 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+)
+
+func isSpaceAccessError(err error) bool {
+	return errors.Is(err, persisters.ErrSpaceDoesNotExist) ||
+		errors.Is(err, persisters.ErrInsufficientSpaceRole) ||
+		errors.Is(err, persisters.ErrCannotDeletePersonal) ||
+		errors.Is(err, persisters.ErrInvitationDoesNotExist)
+}
+
+func (c *Controller) GetSpaces(ctx context.Context, request api.GetSpacesRequestObject) (api.GetSpacesResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling get spaces")
+
+	rawSpaces, err := c.persister.GetSpaces(ctx, namespace)
+	if err != nil {
+		log.Warn("Could not get spaces from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	spaces := []api.Space{}
+	for _, rawSpace := range rawSpaces {
+		var (
+			id   = int64(rawSpace.ID)
+			role = api.SpaceRole(rawSpace.Role)
+		)
+
+		spaces = append(spaces, api.Space{
+			Id:       &id,
+			Name:     &rawSpace.Name,
+			Personal: &rawSpace.Personal,
+			Role:     &role,
+		})
+	}
+
+	return api.GetSpaces200JSONResponse(spaces), nil
+}
+
+func (c *Controller) CreateSpace(ctx context.Context, request api.CreateSpaceRequestObject) (api.CreateSpaceResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling create space")
+
+	log.Debug("Creating space in DB",
+		"name", request.Body.Name,
+	)
+
+	createdSpace, err := c.persister.CreateSpace(ctx, request.Body.Name, namespace)
+	if err != nil {
+		log.Warn("Could not create space in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
+
+		return nil, errors.Join(errCouldNotInsertIntoDB, err)
+	}
+
+	var (
+		id   = int64(createdSpace.ID)
+		role = api.SpaceRole(createdSpace.Role)
+	)
+
+	return api.CreateSpace200JSONResponse{
+		Id:       &id,
+		Name:     &createdSpace.Name,
+		Personal: &createdSpace.Personal,
+		Role:     &role,
+	}, nil
+}
+
+func (c *Controller) DeleteSpace(ctx context.Context, request api.DeleteSpaceRequestObject) (api.DeleteSpaceResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling delete space")
+
+	log.Debug("Deleting space from DB",
+		"id", request.Id,
+	)
+
+	id, err := c.persister.DeleteSpace(ctx, int32(request.Id), namespace)
+	if err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not delete space", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not delete space from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
+
+		return nil, errors.Join(errCouldNotDeleteFromDB, err)
+	}
+
+	return api.DeleteSpace200JSONResponse(id), nil
+}
+
+func (c *Controller) GetSpaceMembers(ctx context.Context, request api.GetSpaceMembersRequestObject) (api.GetSpaceMembersResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling get space members")
+
+	rawMembers, err := c.persister.GetSpaceMembers(ctx, int32(request.Id), namespace)
+	if err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not get space members", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not get space members from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	members := []api.SpaceMember{}
+	for _, rawMember := range rawMembers {
+		var (
+			id   = int64(rawMember.ID)
+			role = api.SpaceRole(rawMember.Role)
+		)
+
+		members = append(members, api.SpaceMember{
+			Email: (*types.Email)(&rawMember.Email),
+			Id:    &id,
+			Role:  &role,
+		})
+	}
+
+	return api.GetSpaceMembers200JSONResponse(members), nil
+}
+
+func (c *Controller) DeleteSpaceMember(ctx context.Context, request api.DeleteSpaceMemberRequestObject) (api.DeleteSpaceMemberResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling delete space member")
+
+	log.Debug("Deleting space member from DB",
+		"id", request.Id,
+		"memberId", request.MemberId,
+	)
+
+	memberID, err := c.persister.DeleteSpaceMember(ctx, int32(request.Id), int32(request.MemberId), namespace)
+	if err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not delete space member", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not delete space member from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
+
+		return nil, errors.Join(errCouldNotDeleteFromDB, err)
+	}
+
+	return api.DeleteSpaceMember200JSONResponse(memberID), nil
+}
+
+func (c *Controller) CreateSpaceInvitation(ctx context.Context, request api.CreateSpaceInvitationRequestObject) (api.CreateSpaceInvitationResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling create space invitation")
+
+	log.Debug("Creating space invitation in DB",
+		"id", request.Id,
+		"email", request.Body.Email,
+		"role", request.Body.Role,
+	)
+
+	createdInvitation, err := c.persister.CreateSpaceInvitation(
+		ctx,
+
+		int32(request.Id),
+		string(request.Body.Email),
+		string(request.Body.Role),
+
+		namespace,
+	)
+	if err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not create space invitation", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not create space invitation in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
+
+		return nil, errors.Join(errCouldNotInsertIntoDB, err)
+	}
+
+	var (
+		id      = int64(createdInvitation.ID)
+		spaceID = int64(createdInvitation.SpaceID)
+		role    = api.SpaceInvitationRole(createdInvitation.Role)
+	)
+
+	return api.CreateSpaceInvitation200JSONResponse{
+		Email:   (*types.Email)(&createdInvitation.Email),
+		Id:      &id,
+		Role:    &role,
+		SpaceId: &spaceID,
+	}, nil
+}
+
+func (c *Controller) GetSpaceInvitations(ctx context.Context, request api.GetSpaceInvitationsRequestObject) (api.GetSpaceInvitationsResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling get space invitations")
+
+	rawInvitations, err := c.persister.GetSpaceInvitations(ctx, namespace)
+	if err != nil {
+		log.Warn("Could not get space invitations from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	invitations := []api.SpaceInvitation{}
+	for _, rawInvitation := range rawInvitations {
+		var (
+			id      = int64(rawInvitation.ID)
+			spaceID = int64(rawInvitation.SpaceID)
+			role    = api.SpaceInvitationRole(rawInvitation.Role)
+		)
+
+		invitations = append(invitations, api.SpaceInvitation{
+			Email:     (*types.Email)(&rawInvitation.Email),
+			Id:        &id,
+			Role:      &role,
+			SpaceId:   &spaceID,
+			SpaceName: &rawInvitation.SpaceName,
+		})
+	}
+
+	return api.GetSpaceInvitations200JSONResponse(invitations), nil
+}
+
+func (c *Controller) AcceptSpaceInvitation(ctx context.Context, request api.AcceptSpaceInvitationRequestObject) (api.AcceptSpaceInvitationResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling accept space invitation")
+
+	space, err := c.persister.AcceptSpaceInvitation(ctx, int32(request.Id), namespace)
+	if err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not accept space invitation", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not accept space invitation in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+		return nil, errors.Join(errCouldNotUpdateInDB, err)
+	}
+
+	var (
+		id   = int64(space.ID)
+		role = api.SpaceRole(space.Role)
+	)
+
+	return api.AcceptSpaceInvitation200JSONResponse{
+		Id:       &id,
+		Name:     &space.Name,
+		Personal: &space.Personal,
+		Role:     &role,
+	}, nil
+}
+
+func (c *Controller) DeclineSpaceInvitation(ctx context.Context, request api.DeclineSpaceInvitationRequestObject) (api.DeclineSpaceInvitationResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling decline space invitation")
+
+	id, err := c.persister.DeclineSpaceInvitation(ctx, int32(request.Id), namespace)
+	if err != nil {
+		if isSpaceAccessError(err) {
+			log.Warn("Could not decline space invitation", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not decline space invitation in DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
+
+		return nil, errors.Join(errCouldNotDeleteFromDB, err)
+	}
+
+	return api.DeclineSpaceInvitation200JSONResponse(id), nil
+}
+
+// canWriteToSpace returns whether the role allows creating, updating and deleting data in a space
+func canWriteToSpace(role string) bool {
+	return role == models.SpaceRoleOwner || role == models.SpaceRoleEditor
+}
@@ -16,7 +16,7 @@ func (c *Controller) GetStatistics(ctx context.Context, request api.GetStatistic
 	if err != nil {
 		c.log.Warn("Could not count all contacts and journal entries for statistics", "err", errors.Join(errCouldNotFetchFromDB, err))
 
-		return api.GetStatistics500TextResponse(errCouldNotFetchFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
 	}
 
 	return api.GetStatistics200JSONResponse(api.IndexData{
@@ -5,13 +5,14 @@ import (
 	"errors"
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 )
 
 func (c *Controller) GetSummary(ctx context.Context, request api.GetSummaryRequestObject) (api.GetSummaryResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling summary")
 
@@ -21,7 +22,7 @@ func (c *Controller) GetSummary(ctx context.Context, request api.GetSummaryReque
 	if err != nil {
 		log.Warn("Could not count contacts and journal entries for summary", "err", errors.Join(errCouldNotFetchFromDB, err))
 
-		return api.GetSummary500TextResponse(errCouldNotFetchFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
 	}
 
 	return api.GetSummary200JSONResponse(api.IndexData{
@@ -0,0 +1,372 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+)
+
+var (
+	errInvalidSyncCursor       = errors.New("invalid sync cursor")
+	errMissingSyncMutationID   = errors.New("updates and deletions require an ID and a base cursor")
+	errMissingSyncMutationData = errors.New("creations and updates require the data of their entity type")
+)
+
+// Cursors are change sequence numbers, but clients should treat them as opaque
+func parseSyncCursor(cursor string) (int64, error) {
+	changeSequence, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil || changeSequence < 0 {
+		return -1, errors.Join(errInvalidSyncCursor, err)
+	}
+
+	return changeSequence, nil
+}
+
+func formatSyncCursor(changeSequence int64) string {
+	return strconv.FormatInt(changeSequence, 10)
+}
+
+func (c *Controller) GetSync(ctx context.Context, request api.GetSyncRequestObject) (api.GetSyncResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling get sync")
+
+	var since int64
+	if request.Params.Since != nil {
+		var err error
+		since, err = parseSyncCursor(*request.Params.Since)
+		if err != nil {
+			log.Debug("Could not parse sync cursor", "err", err)
+
+			return nil, errInvalidSyncCursor
+		}
+	}
+
+	changeset, err := c.persister.GetChangesSince(ctx, since, namespace)
+	if err != nil {
+		log.Warn("Could not get changes from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	res := api.SyncChangeset{
+		Cursor:         formatSyncCursor(changeset.Cursor),
+		Contacts:       []api.Contact{},
+		JournalEntries: []api.JournalEntry{},
+		Debts:          []api.SyncDebt{},
+		Activities:     []api.SyncActivity{},
+		Deleted:        []api.SyncTombstone{},
+	}
+
+	for _, rawContact := range changeset.Contacts {
+		id := int64(rawContact.ID)
+
+		var birthday *types.Date
+		if rawContact.Birthday.Valid {
+			birthday = &types.Date{
+				Time: rawContact.Birthday.Time,
+			}
+		}
+
+		res.Contacts = append(res.Contacts, api.Contact{
+			Address:   &rawContact.Address,
+			Birthday:  birthday,
+			Email:     (*types.Email)(&rawContact.Email),
+			FirstName: &rawContact.FirstName,
+			Id:        &id,
+			LastName:  &rawContact.LastName,
+			Nickname:  &rawContact.Nickname,
+			Notes:     &rawContact.Notes,
+			Pronouns:  &rawContact.Pronouns,
+		})
+	}
+
+	for _, rawEntry := range changeset.JournalEntries {
+		id := int64(rawEntry.ID)
+
+		encrypted, encryption, err := getJournalEntryEncryptionEnvelope(rawEntry)
+		if err != nil {
+			log.Warn("Could not get journal entry encryption envelope", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+			return nil, errors.Join(errCouldNotFetchFromDB, err)
+		}
+
+		res.JournalEntries = append(res.JournalEntries, api.JournalEntry{
+			Body:       &rawEntry.Body,
+			Date:       &rawEntry.Date,
+			Encrypted:  &encrypted,
+			Encryption: encryption,
+			Id:         &id,
+			Rating:     &rawEntry.Rating,
+			Title:      &rawEntry.Title,
+		})
+	}
+
+	for _, rawDebt := range changeset.Debts {
+		var (
+			id        = int64(rawDebt.ID)
+			contactID = int64(rawDebt.ContactID)
+			amount    = float32(rawDebt.Amount)
+		)
+
+		res.Debts = append(res.Debts, api.SyncDebt{
+			Amount:      &amount,
+			ContactId:   &contactID,
+			Currency:    &rawDebt.Currency,
+			Description: &rawDebt.Description,
+			Id:          &id,
+		})
+	}
+
+	for _, rawActivity := range changeset.Activities {
+		var (
+			id        = int64(rawActivity.ID)
+			contactID = int64(rawActivity.ContactID)
+		)
+
+		res.Activities = append(res.Activities, api.SyncActivity{
+			ContactId: &contactID,
+			Date: &types.Date{
+				Time: rawActivity.Date,
+			},
+			Description: &rawActivity.Description,
+			Id:          &id,
+			Name:        &rawActivity.Name,
+		})
+	}
+
+	for _, rawTombstone := range changeset.Tombstones {
+		res.Deleted = append(res.Deleted, api.SyncTombstone{
+			EntityType: api.ChangeEntityType(rawTombstone.EntityType),
+			Id:         int64(rawTombstone.EntityID),
+		})
+	}
+
+	return api.GetSync200JSONResponse(res), nil
+}
+
+func (c *Controller) PostSync(ctx context.Context, request api.PostSyncRequestObject) (api.PostSyncResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling post sync", "len", len(request.Body.Mutations))
+
+	results := []api.SyncMutationResult{}
+	for i, rawMutation := range request.Body.Mutations {
+		log := log.With("index", i, "entityType", rawMutation.EntityType, "operation", rawMutation.Operation)
+
+		mutation, err := getSyncMutation(rawMutation)
+		if err != nil {
+			log.Debug("Could not parse sync mutation", "err", err)
+
+			results = append(results, getSyncMutationResult(api.SyncMutationStatusInvalid, -1, err))
+
+			continue
+		}
+
+		log.Debug("Applying sync mutation in DB", "id", mutation.ID, "baseChangeSequence", mutation.BaseChangeSequence)
+
+		id, err := c.persister.ApplySyncMutation(ctx, mutation, namespace)
+		if err != nil {
+			switch {
+			case errors.Is(err, persisters.ErrSyncConflict):
+				log.Debug("Could not apply sync mutation", "err", err)
+
+				results = append(results, getSyncMutationResult(api.SyncMutationStatusConflict, -1, err))
+
+			case errors.Is(err, persisters.ErrSyncEntityDoesNotExist),
+				errors.Is(err, persisters.ErrContactDoesNotExist):
+				log.Debug("Could not apply sync mutation", "err", err)
+
+				results = append(results, getSyncMutationResult(api.SyncMutationStatusNotFound, -1, err))
+
+			case errors.Is(err, persisters.ErrUnknownSyncMutation):
+				log.Debug("Could not apply sync mutation", "err", err)
+
+				results = append(results, getSyncMutationResult(api.SyncMutationStatusInvalid, -1, err))
+
+			default:
+				log.Warn("Could not apply sync mutation in DB", "err", errors.Join(errCouldNotUpdateInDB, err))
+
+				return nil, errors.Join(errCouldNotUpdateInDB, err)
+			}
+
+			continue
+		}
+
+		results = append(results, getSyncMutationResult(api.SyncMutationStatusApplied, id, nil))
+	}
+
+	return api.PostSync200JSONResponse(results), nil
+}
+
+func getSyncMutation(rawMutation api.SyncMutation) (models.SyncMutation, error) {
+	mutation := models.SyncMutation{
+		EntityType: string(rawMutation.EntityType),
+		Operation:  string(rawMutation.Operation),
+	}
+
+	if rawMutation.Operation != api.Create {
+		if rawMutation.Id == nil || rawMutation.BaseCursor == nil {
+			return models.SyncMutation{}, errMissingSyncMutationID
+		}
+
+		baseChangeSequence, err := parseSyncCursor(*rawMutation.BaseCursor)
+		if err != nil {
+			return models.SyncMutation{}, errInvalidSyncCursor
+		}
+
+		mutation.ID = int32(*rawMutation.Id)
+		mutation.BaseChangeSequence = baseChangeSequence
+	}
+
+	if err := setSyncMutationData(&mutation, rawMutation); err != nil {
+		return models.SyncMutation{}, err
+	}
+
+	return mutation, nil
+}
+
+// setSyncMutationData sets the data of the mutation's entity type from the raw mutation
+func setSyncMutationData(mutation *models.SyncMutation, rawMutation api.SyncMutation) error {
+	// Deletions only need the ID of the entity
+	if rawMutation.Operation == api.Delete {
+		return nil
+	}
+
+	switch rawMutation.EntityType {
+	case api.ChangeEntityTypeContact:
+		if rawMutation.Contact == nil {
+			return errMissingSyncMutationData
+		}
+
+		nickname := ""
+		if v := rawMutation.Contact.Nickname; v != nil {
+			nickname = *v
+		}
+
+		address := ""
+		if v := rawMutation.Contact.Address; v != nil {
+			address = *v
+		}
+
+		notes := ""
+		if v := rawMutation.Contact.Notes; v != nil {
+			notes = *v
+		}
+
+		var birthday *time.Time
+		if rawMutation.Contact.Birthday != nil {
+			birthday = &rawMutation.Contact.Birthday.Time
+		}
+
+		mutation.Contact = models.SyncContact{
+			FirstName: rawMutation.Contact.FirstName,
+			LastName:  rawMutation.Contact.LastName,
+			Nickname:  nickname,
+			Email:     string(rawMutation.Contact.Email),
+			Pronouns:  rawMutation.Contact.Pronouns,
+			Birthday:  birthday,
+			Address:   address,
+			Notes:     notes,
+		}
+
+	case api.ChangeEntityTypeJournalEntry:
+		if rawMutation.JournalEntry == nil {
+			return errMissingSyncMutationData
+		}
+
+		encryptionAlgorithm, encryptionKDF, encryptionSalt := getJournalEntryEncryption(rawMutation.JournalEntry.Encryption)
+
+		mutation.JournalEntry = models.SyncJournalEntry{
+			Title:  rawMutation.JournalEntry.Title,
+			Body:   rawMutation.JournalEntry.Body,
+			Rating: rawMutation.JournalEntry.Rating,
+
+			EncryptionAlgorithm: encryptionAlgorithm,
+			EncryptionKDF:       encryptionKDF,
+			EncryptionSalt:      encryptionSalt,
+		}
+
+	case api.ChangeEntityTypeDebt:
+		if rawMutation.Debt == nil {
+			return errMissingSyncMutationData
+		}
+
+		var contactID int32
+		if v := rawMutation.Debt.ContactId; v != nil {
+			contactID = int32(*v)
+		}
+
+		amount := math.Abs(float64(rawMutation.Debt.Amount))
+		if rawMutation.Debt.YouOwe {
+			amount = -amount
+		}
+
+		description := ""
+		if v := rawMutation.Debt.Description; v != nil {
+			description = *v
+		}
+
+		mutation.Debt = models.SyncDebt{
+			ContactID: contactID,
+
+			Amount:      amount,
+			Currency:    rawMutation.Debt.Currency,
+			Description: description,
+		}
+
+	case api.ChangeEntityTypeActivity:
+		if rawMutation.Activity == nil {
+			return errMissingSyncMutationData
+		}
+
+		var contactID int32
+		if v := rawMutation.Activity.ContactId; v != nil {
+			contactID = int32(*v)
+		}
+
+		description := ""
+		if v := rawMutation.Activity.Description; v != nil {
+			description = *v
+		}
+
+		mutation.Activity = models.SyncActivity{
+			ContactID: contactID,
+
+			Name:        rawMutation.Activity.Name,
+			Date:        rawMutation.Activity.Date.Time,
+			Description: description,
+		}
+	}
+
+	return nil
+}
+
+func getSyncMutationResult(status api.SyncMutationStatus, id int32, err error) api.SyncMutationResult {
+	result := api.SyncMutationResult{
+		Status: status,
+	}
+
+	if err != nil {
+		detail := err.Error()
+		result.Detail = &detail
+	} else {
+		rawID := int64(id)
+		result.Id = &rawID
+	}
+
+	return result
+}
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+)
+
+func (c *Controller) GetAccessTokens(ctx context.Context, request api.GetAccessTokensRequestObject) (api.GetAccessTokensResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling get access tokens")
+
+	rawAccessTokens, err := c.persister.GetAccessTokens(ctx, namespace)
+	if err != nil {
+		log.Warn("Could not get access tokens from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	accessTokens := []api.AccessToken{}
+	for _, rawAccessToken := range rawAccessTokens {
+		var (
+			id    = int64(rawAccessToken.ID)
+			scope = api.AccessTokenScope(rawAccessToken.Scope)
+		)
+
+		accessToken := api.AccessToken{
+			CreatedAt: &rawAccessToken.CreatedAt,
+			Id:        &id,
+			Name:      &rawAccessToken.Name,
+			Scope:     &scope,
+		}
+
+		if rawAccessToken.LastUsedAt.Valid {
+			accessToken.LastUsedAt = &rawAccessToken.LastUsedAt.Time
+		}
+
+		accessTokens = append(accessTokens, accessToken)
+	}
+
+	return api.GetAccessTokens200JSONResponse(accessTokens), nil
+}
+
+func (c *Controller) CreateAccessToken(ctx context.Context, request api.CreateAccessTokenRequestObject) (api.CreateAccessTokenResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling create access token")
+
+	log.Debug("Creating access token in DB",
+		"name", request.Body.Name,
+		"scope", request.Body.Scope,
+	)
+
+	createdAccessToken, token, err := c.persister.CreateAccessToken(
+		ctx,
+
+		request.Body.Name,
+		string(request.Body.Scope),
+
+		namespace,
+	)
+	if err != nil {
+		log.Warn("Could not create access token in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
+
+		return nil, errors.Join(errCouldNotInsertIntoDB, err)
+	}
+
+	var (
+		id    = int64(createdAccessToken.ID)
+		scope = api.AccessTokenScope(createdAccessToken.Scope)
+	)
+
+	return api.CreateAccessToken200JSONResponse{
+		CreatedAt: &createdAccessToken.CreatedAt,
+		Id:        &id,
+		Name:      &createdAccessToken.Name,
+		Scope:     &scope,
+		Token:     &token,
+	}, nil
+}
+
+func (c *Controller) DeleteAccessToken(ctx context.Context, request api.DeleteAccessTokenRequestObject) (api.DeleteAccessTokenResponseObject, error) {
+	namespace := ctx.Value(ContextKeyAccountNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling delete access token")
+
+	log.Debug("Deleting access token from DB",
+		"id", request.Id,
+	)
+
+	id, err := c.persister.DeleteAccessToken(ctx, int32(request.Id), namespace)
+	if err != nil {
+		if errors.Is(err, persisters.ErrAccessTokenDoesNotExist) {
+			log.Warn("Could not delete access token", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not delete access token from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
+
+		return nil, errors.Join(errCouldNotDeleteFromDB, err)
+	}
+
+	return api.DeleteAccessToken200JSONResponse(id), nil
+}
@@ -0,0 +1,27 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"go.opentelemetry.io/otel"
+)
+
+var (
+	tracer = otel.Tracer("github.com/pojntfx/senbara/senbara-rest/pkg/controllers")
+)
+
+// Trace records a span for the operation that handles a request
+func (c *Controller) Trace(f nethttp.StrictHTTPHandlerFunc, operationID string) nethttp.StrictHTTPHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (response any, err error) {
+		ctx, span := tracer.Start(ctx, operationID)
+		defer span.End()
+
+		response, err = f(ctx, w, r, request)
+		telemetry.RecordError(span, err)
+
+		return response, err
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
 	"github.com/pojntfx/senbara/senbara-common/pkg/models"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
 	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
 )
 
@@ -21,7 +22,7 @@ const (
 func (c *Controller) DeleteUserData(ctx context.Context, request api.DeleteUserDataRequestObject) (api.DeleteUserDataResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling delete user data")
 
@@ -34,7 +35,7 @@ func (c *Controller) DeleteUserData(ctx context.Context, request api.DeleteUserD
 	); err != nil {
 		log.Warn("Could not delete user data from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
 
-		return api.DeleteUserData500TextResponse(errCouldNotDeleteFromDB.Error()), nil
+		return nil, errors.Join(errCouldNotDeleteFromDB, err)
 	}
 
 	return api.DeleteUserData200Response{}, nil
@@ -43,7 +44,7 @@ func (c *Controller) DeleteUserData(ctx context.Context, request api.DeleteUserD
 func (c *Controller) ExportUserData(ctx context.Context, request api.ExportUserDataRequestObject) (api.ExportUserDataResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling export user data")
 
@@ -143,7 +144,7 @@ func (c *Controller) ExportUserData(ctx context.Context, request api.ExportUserD
 func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserDataRequestObject) (api.ImportUserDataResponseObject, error) {
 	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
 
-	log := c.log.With("namespace", namespace)
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
 
 	log.Debug("Handling import user data")
 
@@ -151,14 +152,14 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 	if err != nil {
 		log.Warn("Could not read user data file from request", "err", errors.Join(errCouldNotReadRequest, err))
 
-		return api.ImportUserData500TextResponse(errCouldNotReadRequest.Error()), nil
+		return nil, errors.Join(errCouldNotReadRequest, err)
 	}
 	defer file.Close()
 
 	if file.FormName() != "userData" {
 		log.Warn("Could not read user data file from request, invalid file name", "err", errCouldNotReadRequest, "fileName", file.FileName())
 
-		return api.ImportUserData500TextResponse(errCouldNotReadRequest.Error()), nil
+		return nil, errCouldNotReadRequest
 	}
 
 	log.Debug("Importing user data to DB")
@@ -177,7 +178,7 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 	if err != nil {
 		log.Warn("Could not start transaction for user data import", "err", errors.Join(errCouldNotStartTransaction, err))
 
-		return api.ImportUserData500TextResponse(errCouldNotStartTransaction.Error()), nil
+		return nil, errors.Join(errCouldNotStartTransaction, err)
 	}
 	defer rollback()
 
@@ -190,14 +191,14 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 
 			log.Warn("Could not decode user data", "err", errors.Join(errCouldNotReadRequest, err))
 
-			return api.ImportUserData500TextResponse(errCouldNotReadRequest.Error()), nil
+			return nil, errors.Join(errCouldNotReadRequest, err)
 		}
 
 		var entityIdentifier models.ExportedEntityIdentifier
 		if err := json.Unmarshal(b, &entityIdentifier); err != nil {
 			log.Warn("Could not unmarshal entity identifier", "err", errors.Join(errCouldNotReadRequest, err))
 
-			return api.ImportUserData500TextResponse(errCouldNotReadRequest.Error()), nil
+			return nil, errors.Join(errCouldNotReadRequest, err)
 		}
 
 		log.Debug("Processing user data entity for user data import", "entityType", entityIdentifier.EntityName)
@@ -208,7 +209,7 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 			if err := json.Unmarshal(b, &journalEntry); err != nil {
 				log.Warn("Could not unmarshal journal entry", "err", errors.Join(errCouldNotReadRequest, err))
 
-				return api.ImportUserData500TextResponse(errCouldNotReadRequest.Error()), nil
+				return nil, errors.Join(errCouldNotReadRequest, err)
 			}
 
 			log.Debug("Importing journal entry",
@@ -221,7 +222,7 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 			if err := createJournalEntry(journalEntry); err != nil {
 				log.Warn("Could not create journal entry in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
-				return api.ImportUserData500TextResponse(errCouldNotInsertIntoDB.Error()), nil
+				return nil, errors.Join(errCouldNotInsertIntoDB, err)
 			}
 
 		case EntityNameExportedContact:
@@ -229,7 +230,7 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 			if err := json.Unmarshal(b, &contact); err != nil {
 				log.Warn("Could not unmarshal contact", "err", errors.Join(errCouldNotReadRequest, err))
 
-				return api.ImportUserData500TextResponse(errCouldNotReadRequest.Error()), nil
+				return nil, errors.Join(errCouldNotReadRequest, err)
 			}
 
 			log.Debug("Importing contact",
@@ -242,7 +243,7 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 			if err := createContact(contact); err != nil {
 				log.Warn("Could not create contact in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
-				return api.ImportUserData500TextResponse(errCouldNotInsertIntoDB.Error()), nil
+				return nil, errors.Join(errCouldNotInsertIntoDB, err)
 			}
 
 		case EntityNameExportedDebt:
@@ -250,7 +251,7 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 			if err := json.Unmarshal(b, &debt); err != nil {
 				log.Warn("Could not unmarshal debt", "err", errors.Join(errCouldNotReadRequest, err))
 
-				return api.ImportUserData500TextResponse(errCouldNotReadRequest.Error()), nil
+				return nil, errors.Join(errCouldNotReadRequest, err)
 			}
 
 			log.Debug("Importing debt",
@@ -263,7 +264,7 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 			if err := createDebt(debt); err != nil {
 				log.Warn("Could not create debt in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
-				return api.ImportUserData500TextResponse(errCouldNotInsertIntoDB.Error()), nil
+				return nil, errors.Join(errCouldNotInsertIntoDB, err)
 			}
 
 		case EntityNameExportedActivity:
@@ -271,7 +272,7 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 			if err := json.Unmarshal(b, &activity); err != nil {
 				log.Warn("Could not unmarshal activity", "err", errors.Join(errCouldNotReadRequest, err))
 
-				return api.ImportUserData500TextResponse(errCouldNotReadRequest.Error()), nil
+				return nil, errors.Join(errCouldNotReadRequest, err)
 			}
 
 			log.Debug("Importing activity",
@@ -284,7 +285,7 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 			if err := createActivity(activity); err != nil {
 				log.Warn("Could not create activity in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
-				return api.ImportUserData500TextResponse(errCouldNotInsertIntoDB.Error()), nil
+				return nil, errors.Join(errCouldNotInsertIntoDB, err)
 			}
 
 		default:
@@ -302,7 +303,7 @@ func (c *Controller) ImportUserData(ctx context.Context, request api.ImportUserD
 	if err := commit(); err != nil {
 		log.Warn("Could not commit user data import transaction", "err", errors.Join(errCouldNotInsertIntoDB, err))
 
-		return api.ImportUserData500TextResponse(errCouldNotInsertIntoDB.Error()), nil
+		return nil, errors.Join(errCouldNotInsertIntoDB, err)
 	}
 
 	return api.ImportUserData200Response{}, nil
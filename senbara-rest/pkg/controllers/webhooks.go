@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn"
+	"github.com/pojntfx/senbara/senbara-common/pkg/persisters"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"github.com/pojntfx/senbara/senbara-common/pkg/webhooks"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+)
+
+const (
+	// maxWebhookDeliveries is the number of delivery attempts that are listed for a webhook
+	maxWebhookDeliveries = 100
+)
+
+func (c *Controller) GetWebhooks(ctx context.Context, request api.GetWebhooksRequestObject) (api.GetWebhooksResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling get webhooks")
+
+	rawWebhooks, err := c.persister.GetWebhooks(ctx, namespace)
+	if err != nil {
+		log.Warn("Could not get webhooks from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	webhooks := []api.Webhook{}
+	for _, rawWebhook := range rawWebhooks {
+		var (
+			id         = int64(rawWebhook.ID)
+			eventTypes = []api.WebhookEventType{}
+		)
+		for _, eventType := range rawWebhook.EventTypes {
+			eventTypes = append(eventTypes, api.WebhookEventType(eventType))
+		}
+
+		webhooks = append(webhooks, api.Webhook{
+			CreatedAt:  &rawWebhook.CreatedAt,
+			EventTypes: &eventTypes,
+			Id:         &id,
+			Url:        &rawWebhook.Url,
+		})
+	}
+
+	return api.GetWebhooks200JSONResponse(webhooks), nil
+}
+
+func (c *Controller) CreateWebhook(ctx context.Context, request api.CreateWebhookRequestObject) (api.CreateWebhookResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling create webhook")
+
+	u, err := webhooks.ValidateURL(ctx, request.Body.Url)
+	if err != nil {
+		log.Debug("Could not validate webhook URL", "err", err)
+
+		return nil, err
+	}
+
+	eventTypes := []string{}
+	for _, eventType := range request.Body.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	log.Debug("Creating webhook in DB",
+		"url", u.Redacted(),
+		"eventTypes", eventTypes,
+	)
+
+	createdWebhook, err := c.persister.CreateWebhook(
+		ctx,
+
+		u.String(),
+		request.Body.Secret,
+		eventTypes,
+
+		namespace,
+	)
+	if err != nil {
+		log.Warn("Could not create webhook in DB", "err", errors.Join(errCouldNotInsertIntoDB, err))
+
+		return nil, errors.Join(errCouldNotInsertIntoDB, err)
+	}
+
+	id := int64(createdWebhook.ID)
+
+	return api.CreateWebhook200JSONResponse{
+		CreatedAt:  &createdWebhook.CreatedAt,
+		EventTypes: &request.Body.EventTypes,
+		Id:         &id,
+		Url:        &createdWebhook.Url,
+	}, nil
+}
+
+func (c *Controller) DeleteWebhook(ctx context.Context, request api.DeleteWebhookRequestObject) (api.DeleteWebhookResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling delete webhook")
+
+	log.Debug("Deleting webhook from DB",
+		"id", request.Id,
+	)
+
+	id, err := c.persister.DeleteWebhook(ctx, int32(request.Id), namespace)
+	if err != nil {
+		if errors.Is(err, persisters.ErrWebhookDoesNotExist) {
+			log.Debug("Could not delete webhook", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not delete webhook from DB", "err", errors.Join(errCouldNotDeleteFromDB, err))
+
+		return nil, errors.Join(errCouldNotDeleteFromDB, err)
+	}
+
+	return api.DeleteWebhook200JSONResponse(id), nil
+}
+
+func (c *Controller) GetWebhookDeliveries(ctx context.Context, request api.GetWebhookDeliveriesRequestObject) (api.GetWebhookDeliveriesResponseObject, error) {
+	namespace := ctx.Value(authn.ContextKeyNamespace).(string)
+
+	log := telemetry.Logger(ctx, c.log).With("namespace", namespace)
+
+	log.Debug("Handling get webhook deliveries")
+
+	rawDeliveries, err := c.persister.GetWebhookDeliveries(ctx, int32(request.Id), maxWebhookDeliveries, namespace)
+	if err != nil {
+		if errors.Is(err, persisters.ErrWebhookDoesNotExist) {
+			log.Debug("Could not get webhook deliveries", "err", err)
+
+			return nil, err
+		}
+
+		log.Warn("Could not get webhook deliveries from DB", "err", errors.Join(errCouldNotFetchFromDB, err))
+
+		return nil, errors.Join(errCouldNotFetchFromDB, err)
+	}
+
+	deliveries := []api.WebhookDelivery{}
+	for _, rawDelivery := range rawDeliveries {
+		eventType := api.WebhookEventType(rawDelivery.EventType)
+
+		deliveries = append(deliveries, api.WebhookDelivery{
+			Attempt:    &rawDelivery.Attempt,
+			CreatedAt:  &rawDelivery.CreatedAt,
+			DurationMs: &rawDelivery.DurationMs,
+			Error:      &rawDelivery.Error,
+			EventId:    &rawDelivery.OutboxID,
+			EventType:  &eventType,
+			Id:         &rawDelivery.ID,
+			StatusCode: &rawDelivery.StatusCode,
+		})
+	}
+
+	return api.GetWebhookDeliveries200JSONResponse(deliveries), nil
+}
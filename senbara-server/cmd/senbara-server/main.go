@@ -0,0 +1,382 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adrg/xdg"
+	"github.com/pojntfx/senbara/senbara-common/pkg/admin"
+	"github.com/pojntfx/senbara/senbara-common/pkg/authn/oidctest"
+	"github.com/pojntfx/senbara/senbara-common/pkg/bootstrap"
+	"github.com/pojntfx/senbara/senbara-common/pkg/server"
+	"github.com/pojntfx/senbara/senbara-common/pkg/telemetry"
+	"github.com/pojntfx/senbara/senbara-common/pkg/webhooks"
+	formsV1 "github.com/pojntfx/senbara/senbara-forms/api/rest/v1"
+	formsControllers "github.com/pojntfx/senbara/senbara-forms/pkg/controllers"
+	restV1 "github.com/pojntfx/senbara/senbara-rest/api/openapi/v1"
+	"github.com/pojntfx/senbara/senbara-rest/pkg/api"
+	restControllers "github.com/pojntfx/senbara/senbara-rest/pkg/controllers"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	errMissingOIDCClientID    = errors.New("missing OIDC client ID")
+	errMissingOIDCRedirectURL = errors.New("missing OIDC redirect URL")
+	errConflictingPrefixes    = errors.New("REST API can't be served under the root prefix, which is used by the web app")
+)
+
+const (
+	configKey               = "config"
+	laddrKey                = "laddr"
+	adminLaddrKey           = "admin-laddr"
+	tlsCertKey              = "tls-cert"
+	tlsKeyKey               = "tls-key"
+	readTimeoutKey          = "read-timeout"
+	writeTimeoutKey         = "write-timeout"
+	idleTimeoutKey          = "idle-timeout"
+	shutdownTimeoutKey      = "shutdown-timeout"
+	webhooksPollIntervalKey = "webhooks-poll-interval"
+	restPrefixKey           = "rest-prefix"
+	devOIDCKey              = "dev-oidc"
+	devOIDCLaddrKey         = "dev-oidc-laddr"
+	devOIDCUsersKey         = "dev-oidc-users"
+	otelExporterKey         = "otel-exporter"
+	otelEndpointKey         = "otel-endpoint"
+)
+
+// appControllers are the controllers of the web app and the REST API, which share the same services
+type appControllers struct {
+	forms *formsControllers.Controller
+	rest  *restControllers.Controller
+}
+
+// normalizePrefix turns a path prefix into the form `http.StripPrefix` expects, i.e. with a leading and without a
+// trailing slash; the root prefix is empty
+func normalizePrefix(prefix string) string {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return ""
+	}
+
+	return "/" + prefix
+}
+
+func main() {
+	cmd := &cobra.Command{
+		Use:   "senbara-server",
+		Short: "Personal ERP web app and REST API using the Go stdlib, OIDC and PostgreSQL",
+		Long: `Serves both the HTML forms web app and the REST API for a simple personal ERP web application from a single process, built with the Go standard library, OpenID Connect authentication and PostgreSQL data storage. Designed for self-hosting Senbara with just one service.
+
+For more information, please visit https://github.com/pojntfx/senbara.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithCancel(cmd.Context())
+			defer cancel()
+
+			opts := &slog.HandlerOptions{}
+			if viper.GetBool(bootstrap.VerboseKey) {
+				opts.Level = slog.LevelDebug
+			}
+			log := slog.New(slog.NewJSONHandler(os.Stderr, opts))
+
+			if viper.IsSet(configKey) {
+				viper.SetConfigFile(viper.GetString(configKey))
+				if err := viper.ReadInConfig(); err != nil {
+					return err
+				}
+			} else {
+				viper.SetConfigName(cmd.Use)
+				viper.AddConfigPath(xdg.ConfigHome)
+				if err := viper.ReadInConfig(); err != nil && !errors.As(err, &viper.ConfigFileNotFoundError{}) {
+					return err
+				}
+			}
+
+			if v := os.Getenv("PORT"); v != "" && !strings.HasPrefix(viper.GetString(laddrKey), server.UnixPrefix) {
+				log.Info("Using port from PORT env variable")
+
+				la, err := net.ResolveTCPAddr("tcp", viper.GetString(laddrKey))
+				if err != nil {
+					return err
+				}
+
+				p, err := strconv.Atoi(v)
+				if err != nil {
+					return err
+				}
+
+				la.Port = p
+
+				viper.Set(laddrKey, la.String())
+			}
+
+			config := bootstrap.LoadConfig(viper.GetViper())
+
+			if len(config.OIDCClientIDs) == 0 && !viper.GetBool(devOIDCKey) {
+				return errMissingOIDCClientID
+			}
+
+			if config.OIDCRedirectURL == "" {
+				return errMissingOIDCRedirectURL
+			}
+
+			restPrefix := normalizePrefix(viper.GetString(restPrefixKey))
+			if restPrefix == "" {
+				return errConflictingPrefixes
+			}
+
+			shutdownTracing, err := telemetry.InitTracing(
+				ctx,
+
+				slog.New(log.Handler().WithGroup("telemetry")),
+
+				cmd.Use,
+				viper.GetString(otelExporterKey),
+				viper.GetString(otelEndpointKey),
+			)
+			if err != nil {
+				return err
+			}
+			defer shutdownTracing(context.Background())
+
+			adm := admin.NewAdmin(slog.New(log.Handler().WithGroup("admin")))
+
+			if viper.GetBool(devOIDCKey) {
+				users := []oidctest.User{}
+				for _, rawUser := range viper.GetStringSlice(devOIDCUsersKey) {
+					u, err := oidctest.ParseUser(rawUser)
+					if err != nil {
+						return err
+					}
+
+					users = append(users, u)
+				}
+
+				// Without a configured client ID, use a client that is pre-registered with the development OIDC issuer
+				if len(config.OIDCClientIDs) == 0 {
+					config.OIDCClientIDs = []string{cmd.Use}
+				}
+
+				// The back-channel logout endpoint is served by the web app next to the redirect URL
+				backchannelLogoutURL, err := url.Parse(config.OIDCRedirectURL)
+				if err != nil {
+					return err
+				}
+
+				backchannelLogoutURL.Path = "/backchannel-logout"
+				backchannelLogoutURL.RawQuery = ""
+
+				i, err := oidctest.Listen(
+					slog.New(log.Handler().WithGroup("devOIDC")),
+
+					viper.GetString(devOIDCLaddrKey),
+					0,
+
+					users,
+					[]oidctest.Client{
+						{
+							ID:           config.OIDCClientIDs[0],
+							Name:         cmd.Use,
+							RedirectURIs: []string{config.OIDCRedirectURL},
+
+							BackchannelLogoutURI: backchannelLogoutURL.String(),
+						},
+					},
+				)
+				if err != nil {
+					return err
+				}
+				defer i.Close()
+
+				config.OIDCIssuers = []string{i.URL()}
+			}
+
+			if config.OIDCStateKey == "" {
+				log.Warn("No OIDC state key configured, sign ins that are started on another instance or before a restart will fail")
+			}
+
+			if config.OIDCAudience == "" {
+				log.Warn("No OIDC audience configured, accepting ID tokens for any client of the OIDC issuer as bearer tokens")
+			}
+
+			s, err := api.GetSwagger()
+			if err != nil {
+				return err
+			}
+
+			// The web app signs users in with the client IDs, while the REST API also accepts tokens that were issued to
+			// other clients of the same issuers, so both can share one persister and authner
+			b := bootstrap.NewBootstrapper(log, config, func(ctx context.Context, services bootstrap.Services) (appControllers, error) {
+				fc, err := formsV1.NewController(ctx, slog.New(log.Handler().WithGroup("formsController")), config, services)
+				if err != nil {
+					return appControllers{}, err
+				}
+
+				return appControllers{
+					forms: fc,
+					rest:  restV1.NewController(slog.New(log.Handler().WithGroup("restController")), config, services, s),
+				}, nil
+			})
+			b.EnableMetrics(adm.Registerer())
+
+			c, services, err := b.Init(ctx)
+			if err != nil {
+				return err
+			}
+
+			adm.AddCheck("database", services.Persister.Ping)
+			adm.AddCheck("oidc", services.Authner.Ready)
+
+			if err := adm.Init(ctx); err != nil {
+				return err
+			}
+
+			// The dispatcher stops once the servers have shut down; deliveries that are interrupted are retried
+			if pollInterval := viper.GetDuration(webhooksPollIntervalKey); pollInterval > 0 {
+				d := webhooks.NewDispatcher(
+					slog.New(log.Handler().WithGroup("webhooks")),
+
+					services.Persister,
+
+					pollInterval,
+				)
+				d.EnableMetrics(adm.Registerer())
+
+				go func() {
+					if err := d.Run(ctx); err != nil {
+						log.Warn("Could not deliver webhooks", "err", err)
+					}
+				}()
+			}
+
+			// The web app links to absolute paths, so it is served at the root; the REST API sees request paths
+			// relative to its prefix, so it routes the same way as if it were served by its own process
+			mux := http.NewServeMux()
+
+			mux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				formsV1.SenbaraFormsHandler(w, r, c.forms)
+			}))
+
+			mux.Handle(restPrefix+"/", http.StripPrefix(restPrefix, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				restV1.SenbaraRESTHandler(
+					w,
+					r,
+
+					r.Context(),
+					slog.New(log.Handler().WithGroup("handler")),
+					config.CORSOrigins,
+					c.rest,
+					s,
+				)
+			})))
+
+			listeners, err := server.SystemdListeners()
+			if err != nil {
+				return err
+			}
+
+			options := server.Options{
+				ReadTimeout:  viper.GetDuration(readTimeoutKey),
+				WriteTimeout: viper.GetDuration(writeTimeoutKey),
+				IdleTimeout:  viper.GetDuration(idleTimeoutKey),
+			}
+
+			srvOptions := options
+			srvOptions.TLSCertFile = viper.GetString(tlsCertKey)
+			srvOptions.TLSKeyFile = viper.GetString(tlsKeyKey)
+
+			srv := server.NewServer(
+				slog.New(log.Handler().WithGroup("server")),
+
+				viper.GetString(laddrKey),
+				telemetry.Instrument(adm.Instrument(mux)),
+				srvOptions,
+			)
+
+			// The first socket passed by systemd is used for requests and the second one for admin requests
+			if len(listeners) > 0 {
+				srv.UseListener(listeners[0])
+			}
+
+			servers := []*server.Server{srv}
+			if adminLaddr := viper.GetString(adminLaddrKey); adminLaddr != "" || len(listeners) > 1 {
+				adminSrv := server.NewServer(
+					slog.New(log.Handler().WithGroup("adminServer")),
+
+					adminLaddr,
+					adm.Handler(),
+					options,
+				)
+
+				if len(listeners) > 1 {
+					adminSrv.UseListener(listeners[1])
+				}
+
+				servers = append(servers, adminSrv)
+			}
+
+			for _, sv := range servers {
+				if err := sv.Init(ctx); err != nil {
+					return err
+				}
+			}
+
+			return server.Run(ctx, log, viper.GetDuration(shutdownTimeoutKey), servers...)
+		},
+	}
+
+	cmd.PersistentFlags().BoolP(bootstrap.VerboseKey, "v", false, "Whether to enable verbose logging")
+	cmd.PersistentFlags().StringP(configKey, "c", "", "Config file to use (by default "+cmd.Use+".yaml in the XDG config directory is read if it exists)")
+	cmd.PersistentFlags().StringP(laddrKey, "l", ":1337", "Listen address (prefix with unix: to listen on a Unix socket; port can also be set with `PORT` env variable)")
+	cmd.PersistentFlags().String(adminLaddrKey, ":1340", "Listen address for the health check (/healthz and /readyz) and metrics (/metrics) endpoints (disabled if empty)")
+	cmd.PersistentFlags().String(tlsCertKey, "", "TLS certificate file to serve HTTPS with (reloaded when it changes; if empty, HTTP is served)")
+	cmd.PersistentFlags().String(tlsKeyKey, "", "TLS key file for the TLS certificate")
+	cmd.PersistentFlags().Duration(readTimeoutKey, 5*time.Minute, "Maximum duration for reading a request, including its body (e.g. an import) (0 to disable)")
+	cmd.PersistentFlags().Duration(writeTimeoutKey, 5*time.Minute, "Maximum duration for writing a response (e.g. an export) (0 to disable)")
+	cmd.PersistentFlags().Duration(idleTimeoutKey, 2*time.Minute, "Maximum duration to keep idle keep-alive connections open (0 to disable)")
+	cmd.PersistentFlags().Duration(shutdownTimeoutKey, time.Minute, "Maximum duration to wait for in-flight requests (e.g. imports and exports) to finish when shutting down")
+	cmd.PersistentFlags().Duration(webhooksPollIntervalKey, 5*time.Second, "Interval to check for webhook events to deliver at (0 to not deliver webhooks from this instance)")
+	cmd.PersistentFlags().String(restPrefixKey, "/api/v1", "Path prefix to serve the REST API under (the web app is served at the root)")
+	cmd.PersistentFlags().StringP(bootstrap.PgaddrKey, "p", bootstrap.DefaultPgaddr, "Database address")
+	cmd.PersistentFlags().StringSlice(bootstrap.OIDCIssuerKey, []string{}, "OIDC issuers that users can sign in with, the first one is the default issuer (can be specified multiple times) (e.g. https://heuristic-rhodes-wqkaaxzmwj.projects.oryapis.com)")
+	cmd.PersistentFlags().StringSlice(bootstrap.OIDCClientIDKey, []string{}, "OIDC client IDs of the web app, one for each OIDC issuer in the same order (can be specified multiple times) (e.g. myoidcclientid)")
+	cmd.PersistentFlags().String(bootstrap.OIDCRedirectURLKey, bootstrap.DefaultOIDCRedirectURL, "OIDC redirect URL of the web app")
+	cmd.PersistentFlags().String(bootstrap.OIDCStateKeyKey, "", "Secret key to sign the OIDC state with (must be the same for all instances, by default a random key is generated on startup)")
+	cmd.PersistentFlags().String(bootstrap.OIDCAudienceKey, "", "OIDC audience to validate access tokens for (if not set, ID tokens are accepted instead of access tokens)")
+	cmd.PersistentFlags().String(bootstrap.OIDCIntrospectionIssuerKey, "", "OIDC issuer to introspect opaque access tokens with, opaque access tokens are never sent to other OIDC issuers (if not set, the default issuer is used)")
+	cmd.PersistentFlags().String(bootstrap.OIDCIntrospectionClientIDKey, "", "OIDC client ID to authenticate with when introspecting opaque access tokens with the OIDC introspection issuer")
+	cmd.PersistentFlags().String(bootstrap.OIDCIntrospectionClientSecretKey, "", "OIDC client secret to authenticate with when introspecting opaque access tokens with the OIDC introspection issuer")
+	cmd.PersistentFlags().Bool(devOIDCKey, false, "Whether to start an embedded OIDC issuer with test users for local development instead of using the OIDC issuer")
+	cmd.PersistentFlags().String(devOIDCLaddrKey, "localhost:1339", "Listen address for the embedded development OIDC issuer")
+	cmd.PersistentFlags().StringArray(devOIDCUsersKey, []string{"jane@example.com"}, "Test users for the embedded development OIDC issuer (in the format email[:unverified])")
+	cmd.PersistentFlags().String(bootstrap.OIDCDcrInitialAccessTokenPortalUrlKey, "", "OIDC DCR initial access token portal URL")
+	cmd.PersistentFlags().StringArray(bootstrap.CORSOriginsKey, []string{}, "CORS origins to allow for the REST API")
+	cmd.PersistentFlags().String(bootstrap.PrivacyURLKey, "", "Privacy policy URL")
+	cmd.PersistentFlags().String(bootstrap.TOSURLKey, "", "Terms of service URL")
+	cmd.PersistentFlags().String(bootstrap.ImprintURLKey, "", "Imprint URL")
+	cmd.PersistentFlags().String(bootstrap.ContactNameKey, bootstrap.DefaultContactName, "Contact name")
+	cmd.PersistentFlags().String(bootstrap.ContactEmailKey, bootstrap.DefaultContactEmail, "Contact email")
+	cmd.PersistentFlags().String(bootstrap.ServerURLKey, "http://localhost:1337/api/v1/", "Server URL of the REST API")
+	cmd.PersistentFlags().String(bootstrap.ServerDescriptionKey, bootstrap.DefaultServerDescription, "Server description")
+	cmd.PersistentFlags().String(otelExporterKey, "", "Exporter for OpenTelemetry traces (otlp or stdout; if empty, tracing is disabled)")
+	cmd.PersistentFlags().String(otelEndpointKey, "http://localhost:4318", "OTLP/HTTP endpoint to export traces to if the otlp exporter is used")
+
+	if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+		panic(err)
+	}
+
+	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	viper.AutomaticEnv()
+
+	if err := cmd.Execute(); err != nil {
+		panic(err)
+	}
+}
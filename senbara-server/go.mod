@@ -0,0 +1,11 @@
+module github.com/pojntfx/senbara/senbara-server
+
+go 1.24.0
+
+require (
+	github.com/adrg/xdg v0.5.3
+	github.com/pojntfx/senbara/senbara-common v0.0.0-20251011063231-959fe0be4948
+	github.com/pojntfx/senbara/senbara-rest v0.0.0-20251011063231-959fe0be4948
+	github.com/spf13/cobra v1.10.1
+	github.com/spf13/viper v1.21.0
+)